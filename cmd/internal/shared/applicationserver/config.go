@@ -21,9 +21,11 @@ import (
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/remote"
 )
 
 // DefaultWebhookTemplatesConfig is the default configuration for the Webhook templates.
@@ -40,6 +42,10 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 		PublicAddress:    fmt.Sprintf("%s:1883", shared.DefaultPublicHost),
 		PublicTLSAddress: fmt.Sprintf("%s:8883", shared.DefaultPublicHost),
 	},
+	MQTTSessions: mqtt.SessionsConfig{
+		Expiry:      time.Hour,
+		MaxMessages: 1024,
+	},
 	Webhooks: applicationserver.WebhooksConfig{
 		Templates: DefaultWebhookTemplatesConfig,
 		Target:    "direct",
@@ -47,6 +53,15 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 		QueueSize: 1024,
 		Workers:   1024,
 		Downlinks: web.DownlinksConfig{PublicAddress: shared.DefaultPublicURL + "/api/v3"},
+		DeliveryQueue: web.DeliveryQueueConfig{
+			Consumers:      16,
+			MaxAttempts:    8,
+			InitialBackoff: 5 * time.Second,
+			MaxBackoff:     time.Hour,
+			MaxPending:     1024,
+			MaxDeadLetters: 1000,
+			ConsumerGroup:  "as",
+		},
 	},
 	UplinkStorage: applicationserver.UplinkStorageConfig{
 		Limit: 16,
@@ -94,7 +109,16 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 		},
 	},
 	Formatters: applicationserver.FormattersConfig{
-		MaxParameterLength: 40960,
+		MaxParameterLength:     40960,
+		MaxWASMParameterLength: 1398104, // 1 MiB module, base64 encoded.
+		Remote: remote.Config{
+			MaxServices:      1024,
+			Timeout:          5 * time.Second,
+			CacheSize:        4096,
+			CacheTTL:         time.Minute,
+			BreakerThreshold: 10,
+			BreakerCooldown:  30 * time.Second,
+		},
 	},
 	DeviceLastSeen: applicationserver.LastSeenConfig{
 		BatchSize:     1000,
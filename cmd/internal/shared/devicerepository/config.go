@@ -15,8 +15,11 @@
 package devicerepository
 
 import (
+	"time"
+
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/bleve"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/remote"
 )
 
 // DefaultDeviceRepositoryConfig is the default configuration for the Device Repository.
@@ -31,4 +34,16 @@ var DefaultDeviceRepositoryConfig = devicerepository.Config{
 	},
 
 	AssetsBaseURL: "https://raw.githubusercontent.com/TheThingsNetwork/lorawan-devices/master",
+
+	Formatters: devicerepository.FormattersConfig{
+		MaxParameterLength: 40960,
+		MaxExamples:        100,
+		Timeout:            10 * time.Second,
+		Remote: remote.Config{
+			MaxServices:      1024,
+			Timeout:          5 * time.Second,
+			BreakerThreshold: 10,
+			BreakerCooldown:  30 * time.Second,
+		},
+	},
 }
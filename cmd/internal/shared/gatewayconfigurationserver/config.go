@@ -22,7 +22,8 @@ import (
 
 // DefaultGatewayConfigurationServerConfig is the default configuration for the Gateway Configuration Server.
 var DefaultGatewayConfigurationServerConfig = gatewayconfigurationserver.Config{
-	RequireAuth: true,
+	RequireAuth:      true,
+	TemplateVersions: 10,
 }
 
 func init() {
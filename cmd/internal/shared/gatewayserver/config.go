@@ -36,10 +36,20 @@ var DefaultGatewayServerConfig = gatewayserver.Config{
 	UpdateConnectionStatsDebounceTime: 30 * time.Second,
 	ConnectionStatsTTL:                12 * time.Hour,
 	ConnectionStatsDisconnectTTL:      48 * time.Hour,
-	UpdateVersionInfoDelay:            5 * time.Second,
+	DownlinkLoadReportInterval:        30 * time.Second,
+	ConnectionStatsHistory: gatewayserver.ConnectionStatsHistoryConfig{
+		Interval:  5 * time.Minute,
+		Retention: 7 * 24 * time.Hour,
+		MaxRange:  7 * 24 * time.Hour,
+		MaxPoints: 2016,
+	},
+	UpdateVersionInfoDelay: 5 * time.Second,
 	Forward: map[string][]string{
 		"": {"00000000/0"},
 	},
+	Routing: gatewayserver.RoutingConfig{
+		ReloadInterval: 5 * time.Minute,
+	},
 	PacketBroker: gatewayserver.PacketBrokerConfig{
 		UpdateGatewayInterval: packetbroker.DefaultUpdateGatewayInterval,
 		UpdateGatewayJitter:   packetbroker.DefaultUpdateGatewayJitter,
@@ -25,6 +25,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
 	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/util"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
@@ -212,6 +213,50 @@ var (
 			return nil
 		},
 	}
+	applicationsWebhooksRotateSigningSecretCommand = &cobra.Command{
+		Use:   "rotate-signing-secret [application-id] [webhook-id]",
+		Short: "Generate a new signing secret for an application webhook",
+		Long: `Generate a new signing secret for an application webhook.
+
+Requests are signed with both the new and the previous signing secret, until
+the previous signing secret is dropped with --drop-previous.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			webhookID, err := getApplicationWebhookID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			dropPrevious, _ := cmd.Flags().GetBool("drop-previous")
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			client := ttnpb.NewApplicationWebhookRegistryClient(as)
+			webhook, err := client.Get(ctx, &ttnpb.GetApplicationWebhookRequest{
+				Ids:       webhookID,
+				FieldMask: ttnpb.FieldMask("signing_secrets"),
+			})
+			if err != nil {
+				return err
+			}
+			secrets := []*ttnpb.Secret{{Value: random.Bytes(32)}}
+			if !dropPrevious && len(webhook.SigningSecrets) > 0 {
+				secrets = append(secrets, webhook.SigningSecrets[0])
+			}
+			res, err := client.Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+				Webhook: &ttnpb.ApplicationWebhook{
+					Ids:            webhookID,
+					SigningSecrets: secrets,
+				},
+				FieldMask: ttnpb.FieldMask("signing_secrets"),
+			})
+			if err != nil {
+				return err
+			}
+
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
 )
 
 func init() {
@@ -231,5 +276,8 @@ func init() {
 	applicationsWebhooksCommand.AddCommand(applicationsWebhooksSetCommand)
 	applicationsWebhooksDeleteCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
 	applicationsWebhooksCommand.AddCommand(applicationsWebhooksDeleteCommand)
+	applicationsWebhooksRotateSigningSecretCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
+	applicationsWebhooksRotateSigningSecretCommand.Flags().Bool("drop-previous", false, "stop signing with the previous signing secret")
+	applicationsWebhooksCommand.AddCommand(applicationsWebhooksRotateSigningSecretCommand)
 	applicationsCommand.AddCommand(applicationsWebhooksCommand)
 }
@@ -0,0 +1,143 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errNoDeadLetterIDs = errors.DefineInvalidArgument(
+	"no_dead_letter_ids", "no dead letter IDs set, use --all to select all dead letters",
+)
+
+func applicationWebhookDeadLetterFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringSlice("dead-letter-id", nil, "")
+	flagSet.Bool("all", false, "select all dead letters of the webhook")
+	return flagSet
+}
+
+func getApplicationWebhookDeadLettersRequest(
+	flagSet *pflag.FlagSet, args []string,
+) (*ttnpb.ApplicationWebhookDeadLettersRequest, error) {
+	webhookID, err := getApplicationWebhookID(flagSet, args)
+	if err != nil {
+		return nil, err
+	}
+	deadLetterIDs, _ := flagSet.GetStringSlice("dead-letter-id")
+	all, _ := flagSet.GetBool("all")
+	if len(deadLetterIDs) == 0 && !all {
+		return nil, errNoDeadLetterIDs.New()
+	}
+	return &ttnpb.ApplicationWebhookDeadLettersRequest{
+		Ids:           webhookID,
+		DeadLetterIds: deadLetterIDs,
+	}, nil
+}
+
+var (
+	applicationsWebhooksDeadLettersCommand = &cobra.Command{
+		Use:     "dead-letters",
+		Aliases: []string{"dead-letter", "dl"},
+		Short:   "Application webhook dead letters commands",
+	}
+	applicationsWebhooksDeadLettersListCommand = &cobra.Command{
+		Use:     "list [application-id] [webhook-id]",
+		Aliases: []string{"ls"},
+		Short:   "List the dead letters of an application webhook",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			webhookID, err := getApplicationWebhookID(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewApplicationWebhookRegistryClient(as).ListDeadLetters(
+				ctx,
+				&ttnpb.ListApplicationWebhookDeadLettersRequest{
+					Ids:   webhookID,
+					Limit: limit,
+					Page:  page,
+				},
+				opt,
+			)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Deliveries)
+		},
+	}
+	applicationsWebhooksDeadLettersReplayCommand = &cobra.Command{
+		Use:   "replay [application-id] [webhook-id]",
+		Short: "Add dead letters of an application webhook back to the delivery queue",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := getApplicationWebhookDeadLettersRequest(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewApplicationWebhookRegistryClient(as).ReplayDeadLetters(ctx, req)
+			return err
+		},
+	}
+	applicationsWebhooksDeadLettersPurgeCommand = &cobra.Command{
+		Use:     "purge [application-id] [webhook-id]",
+		Aliases: []string{"clear"},
+		Short:   "Remove dead letters of an application webhook",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req, err := getApplicationWebhookDeadLettersRequest(cmd.Flags(), args)
+			if err != nil {
+				return err
+			}
+
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			_, err = ttnpb.NewApplicationWebhookRegistryClient(as).PurgeDeadLetters(ctx, req)
+			return err
+		},
+	}
+)
+
+func init() {
+	applicationsWebhooksDeadLettersListCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
+	applicationsWebhooksDeadLettersListCommand.Flags().AddFlagSet(paginationFlags())
+	applicationsWebhooksDeadLettersCommand.AddCommand(applicationsWebhooksDeadLettersListCommand)
+	applicationsWebhooksDeadLettersReplayCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
+	applicationsWebhooksDeadLettersReplayCommand.Flags().AddFlagSet(applicationWebhookDeadLetterFlags())
+	applicationsWebhooksDeadLettersCommand.AddCommand(applicationsWebhooksDeadLettersReplayCommand)
+	applicationsWebhooksDeadLettersPurgeCommand.Flags().AddFlagSet(applicationWebhookIDFlags())
+	applicationsWebhooksDeadLettersPurgeCommand.Flags().AddFlagSet(applicationWebhookDeadLetterFlags())
+	applicationsWebhooksDeadLettersCommand.AddCommand(applicationsWebhooksDeadLettersPurgeCommand)
+	applicationsWebhooksCommand.AddCommand(applicationsWebhooksDeadLettersCommand)
+}
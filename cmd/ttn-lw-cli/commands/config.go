@@ -63,6 +63,7 @@ type Config struct {
 	DeviceClaimingServerGRPCAddress    string        `name:"device-claiming-server-grpc-address" yaml:"device-claiming-server-grpc-address" description:"Device Claiming Server address"`          //nolint:lll
 	QRCodeGeneratorGRPCAddress         string        `name:"qr-code-generator-grpc-address" yaml:"qr-code-generator-grpc-address" description:"QR Code Generator address"`                         //nolint:lll
 	PacketBrokerAgentGRPCAddress       string        `name:"packet-broker-agent-grpc-address" yaml:"packet-broker-agent-grpc-address" description:"Packet Broker Agent address"`                   //nolint:lll
+	DeviceRepositoryGRPCAddress        string        `name:"device-repository-grpc-address" yaml:"device-repository-grpc-address" description:"Device Repository address"`                         //nolint:lll
 	Insecure                           bool          `name:"insecure" yaml:"insecure" description:"Connect without TLS"`                                                                           //nolint:lll
 	CA                                 string        `name:"ca" yaml:"ca" description:"CA certificate file"`
 	DumpRequests                       bool          `name:"dump-requests" yaml:"dump-requests" description:"When log level is set to debug, also dump request payload as JSON"` //nolint:lll
@@ -99,6 +100,7 @@ func (c Config) getHosts() []string {
 	hosts = append(hosts, c.DeviceClaimingServerGRPCAddress)
 	hosts = append(hosts, c.QRCodeGeneratorGRPCAddress)
 	hosts = append(hosts, c.PacketBrokerAgentGRPCAddress)
+	hosts = append(hosts, c.DeviceRepositoryGRPCAddress)
 	return getHosts(hosts...)
 }
 
@@ -127,6 +129,7 @@ func MakeDefaultConfig(clusterGRPCAddress string, oauthServerAddress string, ins
 		DeviceClaimingServerGRPCAddress:    clusterGRPCAddress,
 		QRCodeGeneratorGRPCAddress:         clusterGRPCAddress,
 		PacketBrokerAgentGRPCAddress:       clusterGRPCAddress,
+		DeviceRepositoryGRPCAddress:        clusterGRPCAddress,
 		Insecure:                           insecure,
 		Retry:                              defaultRetryConfig,
 		Telemetry:                          defaultTelemetryConfig,
@@ -0,0 +1,107 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errNoEndDeviceVersionIDs = errors.DefineInvalidArgument(
+		"no_end_device_version_ids", "no end device brand and model ID set",
+	)
+	errPayloadFormatterTestsFailed = errors.DefineAborted(
+		"payload_formatter_tests_failed", "`{failed}` of `{total}` payload formatter tests failed",
+	)
+)
+
+var (
+	deviceRepositoryCommand = &cobra.Command{
+		Use:     "device-repository",
+		Aliases: []string{"dr"},
+		Short:   "Device Repository commands",
+	}
+	deviceRepositoryTestFormattersCommand = &cobra.Command{
+		Use:   "test-formatters",
+		Short: "Test payload formatters against the examples of the Device Repository",
+		Long: `Test payload formatters against the examples of the Device Repository
+
+The codecs of the end device and their examples are loaded from the Device
+Repository. The examples are executed with the payload formatters of the codecs,
+or with the payload formatters that are set with the formatters flags.
+
+The command fails if any of the examples does not pass.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &ttnpb.TestPayloadFormattersRequest{
+				VersionIds: &ttnpb.EndDeviceVersionIdentifiers{},
+				Formatters: &ttnpb.MessagePayloadFormatters{},
+			}
+			if _, err := req.VersionIds.SetFromFlags(cmd.Flags(), "version-ids"); err != nil {
+				return err
+			}
+			if req.VersionIds.BrandId == "" || req.VersionIds.ModelId == "" {
+				return errNoEndDeviceVersionIDs.New()
+			}
+			paths, err := req.Formatters.SetFromFlags(cmd.Flags(), "formatters")
+			if err != nil {
+				return err
+			}
+			newPaths, err := parsePayloadFormatterParameterFlags("formatters", req.Formatters, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			if len(paths)+len(newPaths) == 0 {
+				req.Formatters = nil
+			}
+
+			dr, err := api.Dial(ctx, config.DeviceRepositoryGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewDeviceRepositoryClient(dr).TestPayloadFormatters(ctx, req)
+			if err != nil {
+				return err
+			}
+			if err := io.Write(os.Stdout, config.OutputFormat, res); err != nil {
+				return err
+			}
+
+			failed := 0
+			for _, result := range res.Results {
+				if !result.Passed {
+					failed++
+				}
+			}
+			if failed > 0 {
+				return errPayloadFormatterTestsFailed.WithAttributes("failed", failed, "total", len(res.Results))
+			}
+			return nil
+		},
+	}
+)
+
+func init() {
+	ttnpb.AddSetFlagsForEndDeviceVersionIdentifiers(deviceRepositoryTestFormattersCommand.Flags(), "version-ids", false)
+	ttnpb.AddSetFlagsForMessagePayloadFormatters(deviceRepositoryTestFormattersCommand.Flags(), "formatters", false)
+	deviceRepositoryTestFormattersCommand.Flags().AddFlagSet(payloadFormatterParameterFlags("formatters"))
+	deviceRepositoryCommand.AddCommand(deviceRepositoryTestFormattersCommand)
+	Root.AddCommand(deviceRepositoryCommand)
+}
@@ -17,6 +17,7 @@ package commands
 import (
 	"os"
 	"strings"
+	"time"
 
 	"github.com/TheThingsIndustries/protoc-gen-go-flags/flagsplugin"
 	"github.com/spf13/cobra"
@@ -30,13 +31,15 @@ import (
 	ttntypes "go.thethings.network/lorawan-stack/v3/pkg/types"
 	"google.golang.org/grpc"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
 	selectGatewayFlags    = util.NormalizedFlagSet()
 	selectAllGatewayFlags = util.SelectAllFlagSet("gateway")
 
-	gatewayFlattenPaths = []string{"lbs_lns_secret", "claim_authentication_code", "target_cups_key"}
+	gatewayFlattenPaths = []string{"lbs_lns_secret", "claim_authentication_code", "target_cups_key", "udp_pre_shared_key"}
 )
 
 func gatewayIDFlags() *pflag.FlagSet {
@@ -515,6 +518,53 @@ If both the parameter and the flag are provided, the flag is ignored.`,
 			return io.Write(os.Stdout, config.OutputFormat, res)
 		},
 	}
+	gatewaysConnectionStatsHistory = &cobra.Command{
+		Use:     "get-connection-stats-history [gateway-id]",
+		Aliases: []string{"connection-stats-history", "stats-history"},
+		Short:   "Get the history of connection stats for a gateway",
+		Long: `Get the history of connection stats for a gateway
+The history is recorded by the Gateway Server at a fixed interval. Use the
+--step flag to aggregate the history in larger intervals.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			gtwID, err := getGatewayID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			req := &ttnpb.GetGatewayConnectionStatsHistoryRequest{
+				GatewayIds: gtwID,
+			}
+			from, err := getTimestampFlags(cmd.Flags(), "from")
+			if err != nil {
+				return err
+			}
+			if from == nil {
+				last, _ := cmd.Flags().GetDuration("last")
+				t := time.Now().Add(-last)
+				from = &t
+			}
+			req.From = timestamppb.New(*from)
+			to, err := getTimestampFlags(cmd.Flags(), "to")
+			if err != nil {
+				return err
+			}
+			if to != nil {
+				req.To = timestamppb.New(*to)
+			}
+			if step, _ := cmd.Flags().GetDuration("step"); step > 0 {
+				req.Step = durationpb.New(step)
+			}
+
+			gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewGsClient(gs).GetGatewayConnectionStatsHistory(ctx, req)
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
 	gatewaysContactInfoCommand = contactInfoCommands("gateway", func(cmd *cobra.Command, args []string) (*ttnpb.EntityIdentifiers, error) {
 		gtwID, err := getGatewayID(cmd.Flags(), args, true)
 		if err != nil {
@@ -601,6 +651,12 @@ func init() {
 		"gateway-ids",
 	)
 	gatewaysCommand.AddCommand(gatewaysConnectionStats)
+	gatewaysConnectionStatsHistory.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysConnectionStatsHistory.Flags().AddFlagSet(timestampFlags("from", "start of the history (default now minus --last)"))
+	gatewaysConnectionStatsHistory.Flags().AddFlagSet(timestampFlags("to", "end of the history (default now)"))
+	gatewaysConnectionStatsHistory.Flags().Duration("last", 24*time.Hour, "get the history of the last hours or minutes")
+	gatewaysConnectionStatsHistory.Flags().Duration("step", 0, "aggregate the history in intervals of the step")
+	gatewaysCommand.AddCommand(gatewaysConnectionStatsHistory)
 	gatewaysContactInfoCommand.PersistentFlags().AddFlagSet(gatewayIDFlags())
 	gatewaysCommand.AddCommand(gatewaysContactInfoCommand)
 	gatewaysPurgeCommand.Flags().AddFlagSet(gatewayIDFlags())
@@ -0,0 +1,136 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	stdio "io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/loratap"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+var gatewaysCaptureCommand = &cobra.Command{
+	Use:   "capture [gateway-id]",
+	Short: "Capture the traffic of a gateway in a PCAP file",
+	Long: `Capture the traffic of a gateway in a PCAP file
+The gateway must be connected to the Gateway Server. The uplink and downlink
+messages of the gateway are written in a PCAP file with the LoRaTap link type,
+which can be analyzed with Wireshark. Only LoRa modulated messages are written.
+
+Transmission acknowledgments are not written in the PCAP file, as the LoRaTap
+format cannot represent them. Instead, the result and correlation IDs of each
+transmission acknowledgment are logged, so that they can be matched with the
+downlink messages in the PCAP file.
+
+If no output file is given, the PCAP file is written to stdout.
+
+Capturing traffic requires the RIGHT_GATEWAY_TRAFFIC_READ right.`,
+	Example: `  Capture the traffic of the next 5 minutes to a file:
+    $ ttn-lw-cli gateways capture my-gateway --duration 5m --output-file my-gateway.pcap
+
+  Capture the traffic with Wireshark:
+    $ ttn-lw-cli gateways capture my-gateway | wireshark -k -i -`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		gtwID, err := getGatewayID(cmd.Flags(), args, true)
+		if err != nil {
+			return err
+		}
+		duration, _ := cmd.Flags().GetDuration("duration")
+
+		var out stdio.Writer = os.Stdout
+		if outputFile, _ := cmd.Flags().GetString("output-file"); outputFile != "" {
+			f, err := os.Create(outputFile)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			out = f
+		}
+		w := loratap.NewWriter(out)
+		if err := w.WriteHeader(); err != nil {
+			return err
+		}
+
+		gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		stream, err := ttnpb.NewGsClient(gs).CaptureGatewayTraffic(ctx, &ttnpb.CaptureGatewayTrafficRequest{
+			GatewayIds: gtwID,
+			Duration:   durationpb.New(duration),
+		})
+		if err != nil {
+			return err
+		}
+
+		var written, skipped, txAcks int
+		defer func() {
+			logger.WithFields(log.Fields(
+				"written", written,
+				"skipped", skipped,
+				"tx_acknowledgments", txAcks,
+			)).Info("Captured gateway traffic")
+		}()
+		for {
+			msg, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, stdio.EOF) {
+					return nil
+				}
+				return err
+			}
+			var (
+				p  loratap.Packet
+				ok bool
+			)
+			switch m := msg.Message.(type) {
+			case *ttnpb.GatewayTrafficCapture_UplinkMessage:
+				p, ok = loratap.FromUplinkMessage(msg.Time.AsTime(), m.UplinkMessage)
+			case *ttnpb.GatewayTrafficCapture_DownlinkMessage:
+				p, ok = loratap.FromDownlinkMessage(msg.Time.AsTime(), m.DownlinkMessage)
+			case *ttnpb.GatewayTrafficCapture_TxAcknowledgment:
+				// LoRaTap cannot represent transmission acknowledgments, so they are logged instead.
+				txAcks++
+				logger.WithFields(log.Fields(
+					"result", m.TxAcknowledgment.Result,
+					"correlation_ids", m.TxAcknowledgment.CorrelationIds,
+				)).Info("Received transmission acknowledgment")
+				continue
+			}
+			if !ok {
+				skipped++
+				continue
+			}
+			if err := w.WritePacket(p); err != nil {
+				return err
+			}
+			written++
+		}
+	},
+}
+
+func init() {
+	gatewaysCaptureCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysCaptureCommand.Flags().Duration("duration", time.Minute, "duration of the capture (max 1h)")
+	gatewaysCaptureCommand.Flags().String("output-file", "", "file to write the PCAP file to (default stdout)")
+	gatewaysCommand.AddCommand(gatewaysCaptureCommand)
+}
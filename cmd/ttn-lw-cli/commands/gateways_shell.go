@@ -0,0 +1,135 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	stdio "io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"golang.org/x/term"
+)
+
+var gatewaysShellCommand = &cobra.Command{
+	Use:   "shell [gateway-id] [-- command [arguments...]]",
+	Short: "Open a remote shell on a gateway (EXPERIMENTAL)",
+	Long: `Open a remote shell on a gateway (EXPERIMENTAL)
+The gateway must be connected to the Gateway Server. Remote shells are only
+supported by LoRa Basics Station gateways.
+
+If a command is given after --, the command is run on the gateway instead of
+opening a remote shell. The command is run asynchronously by the gateway, and
+its output is not returned.
+
+Remote shell sessions and commands require the RIGHT_GATEWAY_REMOTE_SHELL
+right, and are recorded in the gateway events. The right is not implied by
+RIGHT_GATEWAY_ALL, so it must be granted explicitly to gateway API keys and
+collaborators.`,
+	Example: `  Open a remote shell:
+    $ ttn-lw-cli gateways shell my-gateway
+
+  Run a command:
+    $ ttn-lw-cli gateways shell my-gateway -- /sbin/reboot`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var commandArgs []string
+		if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+			args, commandArgs = args[:dash], args[dash:]
+		}
+		gtwID, err := getGatewayID(cmd.Flags(), args, true)
+		if err != nil {
+			return err
+		}
+		gs, err := api.Dial(ctx, config.GatewayServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		client := ttnpb.NewGsClient(gs)
+
+		if len(commandArgs) > 0 {
+			_, err := client.RunGatewayCommand(ctx, &ttnpb.RunGatewayCommandRequest{
+				GatewayIds: gtwID,
+				Command:    commandArgs[0],
+				Arguments:  commandArgs[1:],
+			})
+			return err
+		}
+
+		termType, _ := cmd.Flags().GetString("term")
+		stream, err := client.GatewayRemoteShell(ctx)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(&ttnpb.GatewayRemoteShellRequest{
+			Message: &ttnpb.GatewayRemoteShellRequest_Start{
+				Start: &ttnpb.GatewayRemoteShellStart{
+					GatewayIds: gtwID,
+					Term:       termType,
+				},
+			},
+		}); err != nil {
+			return err
+		}
+
+		if fd := int(os.Stdin.Fd()); term.IsTerminal(fd) {
+			state, err := term.MakeRaw(fd)
+			if err != nil {
+				return err
+			}
+			defer term.Restore(fd, state) //nolint:errcheck
+		}
+
+		go func() {
+			buf := make([]byte, 1024)
+			for {
+				n, err := os.Stdin.Read(buf)
+				if n > 0 {
+					data := make([]byte, n)
+					copy(data, buf[:n])
+					if err := stream.Send(&ttnpb.GatewayRemoteShellRequest{
+						Message: &ttnpb.GatewayRemoteShellRequest_Data{Data: data},
+					}); err != nil {
+						return
+					}
+				}
+				if err != nil {
+					stream.CloseSend() //nolint:errcheck
+					return
+				}
+			}
+		}()
+
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				if errors.Is(err, stdio.EOF) {
+					return nil
+				}
+				return err
+			}
+			if _, err := os.Stdout.Write(res.Data); err != nil {
+				return err
+			}
+		}
+	},
+}
+
+func init() {
+	gatewaysShellCommand.Flags().AddFlagSet(gatewayIDFlags())
+	gatewaysShellCommand.Flags().String("term", os.Getenv("TERM"), "terminal type of the remote shell")
+	gatewaysCommand.AddCommand(gatewaysShellCommand)
+}
@@ -0,0 +1,84 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/json"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/simulator"
+)
+
+var errNoScenarioFile = errors.DefineInvalidArgument("no_scenario_file", "no scenario file set")
+
+var simulateScenarioCommand = &cobra.Command{
+	Use:   "scenario [scenario-file]",
+	Short: "Simulate a network of gateways and end devices described by a scenario file",
+	Long: `Simulate a network of gateways and end devices described by a scenario file.
+
+The virtual gateways connect to the Gateway Server using the Semtech UDP packet
+forwarder protocol or the LoRa Basics Station LNS protocol. The virtual end
+devices join, send uplinks, answer MAC commands and react to ADR. The same
+scenario seed results in the same traffic, so that simulations can be used for
+load and regression testing.
+
+The gateways and end devices must be registered beforehand. OTAA devices use
+DevNonces from the configured dev-nonce, and ABP devices start at the
+configured f-cnt: adjust these when running a scenario again against the same
+network.`,
+	Example:           `  ttn-lw-cli simulate scenario scenario.yml --trace`,
+	PersistentPreRunE: preRun(),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) != 1 {
+			return errNoScenarioFile.New()
+		}
+		b, err := os.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+		scenario, err := simulator.ParseScenario(b)
+		if err != nil {
+			return err
+		}
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		opts := []simulator.Option{simulator.WithDryRun(dryRun)}
+		if trace, _ := cmd.Flags().GetBool("trace"); trace {
+			enc := json.NewEncoder(os.Stderr)
+			opts = append(opts, simulator.WithTrace(func(ev simulator.Event) {
+				if err := enc.Encode(ev); err != nil {
+					logger.WithError(err).Warn("Failed to write event")
+				}
+			}))
+		}
+		sim, err := simulator.New(scenario, opts...)
+		if err != nil {
+			return err
+		}
+		report, err := sim.Run(ctx)
+		if err != nil {
+			return err
+		}
+		return io.Write(os.Stdout, config.OutputFormat, report)
+	},
+}
+
+func init() {
+	simulateScenarioCommand.Flags().Bool("dry-run", false, "simulate without connecting gateways, on a virtual clock")
+	simulateScenarioCommand.Flags().Bool("trace", false, "write simulation events as JSON lines to stderr")
+	simulateCommand.AddCommand(simulateScenarioCommand)
+}
@@ -15,6 +15,7 @@
 package commands
 
 import (
+	"encoding/base64"
 	"fmt"
 	stdio "io"
 	"net"
@@ -76,6 +77,15 @@ func payloadFormatterParameterFlags(prefix string) *pflag.FlagSet {
 	return flagSet
 }
 
+// payloadFormatterParameter returns the formatter parameter with the given contents.
+// WebAssembly modules are binary, so they are base64 encoded.
+func payloadFormatterParameter(formatter ttnpb.PayloadFormatter, b []byte) string {
+	if formatter == ttnpb.PayloadFormatter_FORMATTER_WASM {
+		return base64.StdEncoding.EncodeToString(b)
+	}
+	return string(b)
+}
+
 // parsePayloadFormatterParameterFlags parses formatter-parameter-local-file arguments,
 // updates formatters with the file contents and returns the extra field mask paths.
 func parsePayloadFormatterParameterFlags(prefix string, formatters *ttnpb.MessagePayloadFormatters, flags *pflag.FlagSet) ([]string, error) {
@@ -90,7 +100,7 @@ func parsePayloadFormatterParameterFlags(prefix string, formatters *ttnpb.Messag
 		if err != nil {
 			return nil, err
 		}
-		formatters.UpFormatterParameter = string(b)
+		formatters.UpFormatterParameter = payloadFormatterParameter(formatters.UpFormatter, b)
 		paths = append(paths, prefix+".up-formatter-parameter")
 	default:
 		if !errors.IsInvalidArgument(err) {
@@ -105,7 +115,7 @@ func parsePayloadFormatterParameterFlags(prefix string, formatters *ttnpb.Messag
 		if err != nil {
 			return nil, err
 		}
-		formatters.DownFormatterParameter = string(b)
+		formatters.DownFormatterParameter = payloadFormatterParameter(formatters.DownFormatter, b)
 		paths = append(paths, prefix+".down-formatter-parameter")
 	default:
 		if !errors.IsInvalidArgument(err) {
@@ -116,6 +116,16 @@ func (ebv *ExactBytesSliceValue) String() string {
 	return "[" + flagsplugin.JoinSliceElements(vs) + "]"
 }
 
+func New32BytesFlag(name, usage string, opts ...flagsplugin.FlagOption) *pflag.Flag {
+	flag := &pflag.Flag{
+		Name:  name,
+		Usage: usage,
+		Value: &ExactBytesValue{length: 32},
+	}
+	flagsplugin.ApplyOptions(flag, opts...)
+	return flag
+}
+
 func New16BytesFlag(name, usage string, opts ...flagsplugin.FlagOption) *pflag.Flag {
 	flag := &pflag.Flag{
 		Name:  name,
@@ -24,6 +24,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
 	asdistribredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution/redis"
+	asiomqttredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt/redis"
 	asioapredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/redis"
 	asiopsredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/redis"
 	asiowebredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/redis"
@@ -38,6 +39,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	events_grpc "go.thethings.network/lorawan-stack/v3/pkg/events/grpc"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayconfigurationserver"
+	gcsredis "go.thethings.network/lorawan-stack/v3/pkg/gatewayconfigurationserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
 	gsredis "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
@@ -280,6 +282,9 @@ var startCommand = &cobra.Command{
 					return shared.ErrInitializeGatewayServer.WithCause(err)
 				}
 				config.GS.Stats = gatewayConnectionStatsRegistry
+				config.GS.StatsHistory = &gsredis.GatewayConnectionStatsHistoryRegistry{
+					Redis: redis.New(config.Cache.Redis.WithNamespace("gs", "cache", "connstats", "history")),
+				}
 			}
 			gs, err := gatewayserver.New(c, &config.GS)
 			if err != nil {
@@ -334,6 +339,17 @@ var startCommand = &cobra.Command{
 			config.NS.ScheduledDownlinkMatcher = &nsredis.ScheduledDownlinkMatcher{
 				Redis: redis.New(config.Cache.Redis.WithNamespace("ns", "scheduled-downlinks")),
 			}
+			config.NS.DownlinkLoad.Registry = &nsredis.GatewayLoadRegistry{
+				Redis: redis.New(config.Cache.Redis.WithNamespace("ns", "gateway-downlink-loads")),
+			}
+			campaigns := &nsredis.CampaignRegistry{
+				Redis:   redis.New(config.Redis.WithNamespace("ns", "campaigns")),
+				LockTTL: defaultLockTTL,
+			}
+			if err := campaigns.Init(ctx); err != nil {
+				return shared.ErrInitializeNetworkServer.WithCause(err)
+			}
+			config.NS.Campaigns.Registry = campaigns
 			ns, err := networkserver.New(c, &config.NS)
 			if err != nil {
 				return shared.ErrInitializeNetworkServer.WithCause(err)
@@ -391,6 +407,26 @@ var startCommand = &cobra.Command{
 					return shared.ErrInitializeApplicationServer.WithCause(err)
 				}
 				config.AS.Webhooks.Registry = webhookRegistry
+				if queue := &config.AS.Webhooks.DeliveryQueue; queue.Enable {
+					deliveries := asiowebredis.NewDeliveryQueue(
+						redis.New(config.Redis.WithNamespace("as", "io", "webhooks", "deliveries")),
+						queue.MaxPending,
+						queue.MaxDeadLetters,
+						queue.ConsumerGroup,
+						redis.DefaultStreamBlockLimit,
+					)
+					if err := deliveries.Init(ctx); err != nil {
+						return shared.ErrInitializeApplicationServer.WithCause(err)
+					}
+					defer deliveries.Close(ctx)
+					queue.Queue = deliveries
+				}
+			}
+			if sessions := &config.AS.MQTTSessions; sessions.Enable {
+				sessions.Store = asiomqttredis.NewSessionStore(
+					redis.New(config.Redis.WithNamespace("as", "io", "mqtt", "sessions")),
+					sessions.MaxMessages,
+				)
 			}
 			if cache := &config.AS.EndDeviceMetadataStorage.Location.Cache; cache.Enable {
 				switch config.Cache.Service {
@@ -468,6 +504,14 @@ var startCommand = &cobra.Command{
 
 		if start.GatewayConfigurationServer {
 			logger.Info("Setting up Gateway Configuration Server")
+			templateRegistry := &gcsredis.TemplateRegistry{
+				Redis:   redis.New(config.Redis.WithNamespace("gcs", "templates")),
+				LockTTL: defaultLockTTL,
+			}
+			if err := templateRegistry.Init(ctx); err != nil {
+				return shared.ErrInitializeGatewayConfigurationServer.WithCause(err)
+			}
+			config.GCS.Templates = templateRegistry
 			gcs, err := gatewayconfigurationserver.New(c, &config.GCS)
 			if err != nil {
 				return shared.ErrInitializeGatewayConfigurationServer.WithCause(err)
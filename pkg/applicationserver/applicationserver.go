@@ -43,9 +43,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
-	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/cayennelpp"
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/devicerepository"
-	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/javascript"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/payloadformatters"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpctracer"
@@ -83,6 +82,7 @@ type ApplicationServer struct {
 	pubsub                 *pubsub.PubSub
 	appPackages            packages.Server
 	deviceLastSeenProvider lastseen.LastSeenProvider
+	uplinkLimiters         *uplinkLimiters
 
 	clusterDistributor distribution.Distributor
 	localDistributor   distribution.Distributor
@@ -134,7 +134,7 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		deviceRegistry:   wrapEndDeviceRegistryWithReplacedFields(conf.Devices, replacedEndDeviceFields...),
 		appUpsRegistry:   conf.UplinkStorage.Registry,
 		locationRegistry: conf.EndDeviceMetadataStorage.Location.Registry,
-		formatters:       make(messageprocessors.MapPayloadProcessor),
+		formatters:       payloadformatters.New(ctx, c, conf.Formatters.Remote),
 		clusterDistributor: distribution.NewPubSubDistributor(
 			ctx,
 			c,
@@ -149,12 +149,11 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 			conf.Distribution.Local.Broadcast.SubscriptionOptions(),
 			conf.Distribution.Local.Individual.SubscriptionOptions(),
 		),
-		interopClient: interopCl,
-		interopID:     conf.Interop.ID,
+		interopClient:  interopCl,
+		interopID:      conf.Interop.ID,
+		uplinkLimiters: newUplinkLimiters(ctx, c.GetBaseConfig(ctx).RateLimiting.Memory.MaxSize),
 	}
 
-	as.formatters[ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT] = javascript.New()
-	as.formatters[ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP] = cayennelpp.New()
 	as.formatters[ttnpb.PayloadFormatter_FORMATTER_REPOSITORY] = devicerepository.New(as.formatters, as)
 
 	as.activationPool = workerpool.NewWorkerPool(workerpool.Config[*ttnpb.EndDeviceIdentifiers]{
@@ -210,6 +209,13 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		}
 	}()
 
+	var mqttOpts []mqtt.Option
+	if conf.MQTTSessions.Enable {
+		if conf.MQTTSessions.Store == nil {
+			return nil, errMQTTSessionStore.New()
+		}
+		mqttOpts = append(mqttOpts, mqtt.WithSessions(conf.MQTTSessions))
+	}
 	for _, version := range []struct {
 		Format mqtt.Format
 		Config config.MQTT
@@ -244,7 +250,7 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 						)
 					}
 					defer lis.Close()
-					return mqtt.Serve(ctx, as, lis, version.Format, endpoint.Protocol())
+					return mqtt.Serve(ctx, as, lis, version.Format, endpoint.Protocol(), mqttOpts...)
 				},
 				Restart: task.RestartOnFailure,
 				Backoff: task.DefaultBackoffConfig,
@@ -307,7 +313,9 @@ func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterAsEndDeviceRegistryServer(s, as.grpc.asDevices)
 	ttnpb.RegisterAppAsServer(s, as.grpc.appAs)
 	if wh := as.webhooks; wh != nil {
-		ttnpb.RegisterApplicationWebhookRegistryServer(s, ioweb.NewWebhookRegistryRPC(wh.Registry(), as.webhookTemplates))
+		ttnpb.RegisterApplicationWebhookRegistryServer(s, ioweb.NewWebhookRegistryRPC(
+			wh.Registry(), as.webhookTemplates, as.config.Webhooks.deliveryQueue(),
+		))
 	}
 	if ps := as.pubsub; ps != nil {
 		ttnpb.RegisterApplicationPubSubRegistryServer(s, ps)
@@ -372,6 +380,7 @@ func (as *ApplicationServer) processUpAsync(ctx context.Context, up *ttnpb.Appli
 	link, err := as.getLink(ctx, up.EndDeviceIds.ApplicationIds, []string{
 		"default_formatters",
 		"skip_payload_crypto",
+		"uplink_policy",
 	})
 	if err != nil {
 		log.FromContext(ctx).WithError(err).Warn("Failed to retrieve application link")
@@ -405,7 +414,11 @@ func (as *ApplicationServer) processUp(ctx context.Context, up *ttnpb.Applicatio
 
 	pass, err := as.handleUp(ctx, up, link)
 	if err != nil {
-		log.FromContext(ctx).WithError(err).Warn("Failed to process upstream message")
+		if isUplinkPolicyViolation(err) {
+			log.FromContext(ctx).WithError(err).Debug("Drop upstream message that violates uplink policy")
+		} else {
+			log.FromContext(ctx).WithError(err).Warn("Failed to process upstream message")
+		}
 		registerDropUp(ctx, up, err)
 		return nil
 	}
@@ -1153,10 +1166,26 @@ func (as *ApplicationServer) handleUplink(ctx context.Context, info uplinkInfo)
 		return err
 	}
 
+	// Enforce the rate limits before decoding, so that end devices that exceed the rate limits use no resources.
+	if err := as.checkOperatorUplinkRate(ctx, info.ids); err != nil {
+		return err
+	}
+	policy := info.link.GetUplinkPolicy()
+	if err := as.checkUplinkRate(ctx, info.ids, policy); err != nil {
+		if err := applyUplinkPolicy(info.uplink, policy, policyViolationRateLimit, err); err != nil {
+			return err
+		}
+	}
+
 	if !as.skipPayloadCrypto(ctx, info.link, dev, dev.Session) {
 		if err := as.decryptAndDecodeUplink(ctx, dev, info.uplink, info.link.DefaultFormatters); err != nil {
 			return err
 		}
+		if err := checkDecodedPayloadSize(info.uplink, policy); err != nil {
+			if err := applyUplinkPolicy(info.uplink, policy, policyViolationDecodedPayloadSize, err); err != nil {
+				return err
+			}
+		}
 		if err := as.publishNormalizedUplink(ctx, info); err != nil {
 			return err
 		}
@@ -20,6 +20,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	alcsyncv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1"
 	loraclouddevicemanagementv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loradms/v1"
@@ -31,6 +32,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/remote"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/protobuf/types/known/durationpb"
 )
@@ -90,7 +93,17 @@ type EndDeviceLocationStorageCacheConfig struct {
 
 // FormattersConfig represents the configuration for payload formatters.
 type FormattersConfig struct {
-	MaxParameterLength int `name:"max-parameter-length" description:"Maximum allowed size for length of formatter parameters (payload formatter scripts)"`
+	MaxParameterLength     int           `name:"max-parameter-length" description:"Maximum allowed size for length of formatter parameters (payload formatter scripts)"`
+	MaxWASMParameterLength int           `name:"max-wasm-parameter-length" description:"Maximum allowed size for length of WebAssembly formatter parameters (base64 encoded modules)"`
+	Remote                 remote.Config `name:"remote" description:"Remote payload formatters configuration"`
+}
+
+// maxParameterLength returns the maximum length of the parameter of the given formatter.
+func (c FormattersConfig) maxParameterLength(formatter ttnpb.PayloadFormatter) int {
+	if formatter == ttnpb.PayloadFormatter_FORMATTER_WASM {
+		return c.MaxWASMParameterLength
+	}
+	return c.MaxParameterLength
 }
 
 // Config represents the ApplicationServer configuration.
@@ -104,6 +117,7 @@ type Config struct {
 	EndDeviceFetcher         EndDeviceFetcherConfig         `name:"fetcher" description:"Deprecated - End Device fetcher configuration"`
 	EndDeviceMetadataStorage EndDeviceMetadataStorageConfig `name:"end-device-metadata-storage" description:"End device metadata storage configuration"`
 	MQTT                     config.MQTT                    `name:"mqtt" description:"MQTT configuration"`
+	MQTTSessions             mqtt.SessionsConfig            `name:"mqtt-sessions" description:"Persistent MQTT sessions configuration"`
 	Webhooks                 WebhooksConfig                 `name:"webhooks" description:"Webhooks configuration"`
 	PubSub                   PubSubConfig                   `name:"pubsub" description:"Pub/sub messaging configuration"`
 	Packages                 ApplicationPackagesConfig      `name:"packages" description:"Application packages configuration"`
@@ -122,6 +136,8 @@ func (c Config) toProto() *ttnpb.AsConfiguration {
 var (
 	errWebhooksRegistry = errors.DefineInvalidArgument("webhooks_registry", "invalid webhooks registry")
 	errWebhooksTarget   = errors.DefineInvalidArgument("webhooks_target", "invalid webhooks target `{target}`")
+	errWebhooksQueue    = errors.DefineInvalidArgument("webhooks_queue", "invalid webhooks delivery queue")
+	errMQTTSessionStore = errors.DefineInvalidArgument("mqtt_session_store", "invalid MQTT session store")
 )
 
 // UplinkStorageConfig defines the configuration of the application uplinks storage used by integrations.
@@ -132,15 +148,17 @@ type UplinkStorageConfig struct {
 
 // WebhooksConfig defines the configuration of the webhooks integration.
 type WebhooksConfig struct {
-	Registry                   web.WebhookRegistry `name:"-"`
-	Target                     string              `name:"target" description:"Target of the integration (direct)"`
-	Timeout                    time.Duration       `name:"timeout" description:"Wait timeout of the target to process the request"`
-	QueueSize                  int                 `name:"queue-size" description:"Number of requests to queue"`
-	Workers                    int                 `name:"workers" description:"Number of workers to process requests"`
-	UnhealthyAttemptsThreshold int                 `name:"unhealthy-attempts-threshold" description:"Number of failed webhook attempts before the webhook is disabled"`
-	UnhealthyRetryInterval     time.Duration       `name:"unhealthy-retry-interval" description:"Time interval after which disabled webhooks may execute again"`
-	Templates                  web.TemplatesConfig `name:"templates" description:"The store of the webhook templates"`
-	Downlinks                  web.DownlinksConfig `name:"downlink" description:"The downlink queue operations configuration"`
+	Registry                   web.WebhookRegistry     `name:"-"`
+	Target                     string                  `name:"target" description:"Target of the integration (direct)"`
+	Timeout                    time.Duration           `name:"timeout" description:"Wait timeout of the target to process the request"`
+	QueueSize                  int                     `name:"queue-size" description:"Number of requests to queue"`
+	Workers                    int                     `name:"workers" description:"Number of workers to process requests"`
+	UnhealthyAttemptsThreshold int                     `name:"unhealthy-attempts-threshold" description:"Number of failed webhook attempts before the webhook is disabled"`
+	UnhealthyRetryInterval     time.Duration           `name:"unhealthy-retry-interval" description:"Time interval after which disabled webhooks may execute again"`
+	Templates                  web.TemplatesConfig     `name:"templates" description:"The store of the webhook templates"`
+	Downlinks                  web.DownlinksConfig     `name:"downlink" description:"The downlink queue operations configuration"`
+	DeliveryQueue              web.DeliveryQueueConfig `name:"delivery-queue" description:"Persistent delivery queue configuration"`
+	EncryptionKeyID            string                  `name:"encryption-key-id" description:"ID of the key used to encrypt webhook signing secrets at rest"`
 }
 
 func (c WebhooksConfig) toProto() *ttnpb.AsConfiguration_Webhooks {
@@ -249,15 +267,38 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 	if c.Registry == nil {
 		return nil, errWebhooksRegistry.New()
 	}
+	if c.EncryptionKeyID == "" {
+		log.FromContext(ctx).Warn("No webhooks encryption key defined, webhook signing secrets are stored in plaintext")
+	}
+	registry := web.NewSecretsRegistry(c.Registry, server.KeyService(), c.EncryptionKeyID)
 	if c.UnhealthyAttemptsThreshold > 0 || c.UnhealthyRetryInterval > 0 {
-		registry := web.NewHealthStatusRegistry(c.Registry)
-		registry = web.NewCachedHealthStatusRegistry(registry)
-		sink = web.NewHealthCheckSink(sink, registry, c.UnhealthyAttemptsThreshold, c.UnhealthyRetryInterval)
+		healthStatusRegistry := web.NewCachedHealthStatusRegistry(web.NewHealthStatusRegistry(registry))
+		sink = web.NewHealthCheckSink(
+			sink, healthStatusRegistry, c.UnhealthyAttemptsThreshold, c.UnhealthyRetryInterval,
+		)
 	}
-	if c.QueueSize > 0 || c.Workers > 0 {
+	switch {
+	case c.DeliveryQueue.Enable:
+		if c.DeliveryQueue.Queue == nil {
+			return nil, errWebhooksQueue.New()
+		}
+		var err error
+		sink, err = web.NewQueuedSink(ctx, server, sink, registry, c.DeliveryQueue)
+		if err != nil {
+			return nil, err
+		}
+	case c.QueueSize > 0 || c.Workers > 0:
 		sink = web.NewPooledSink(ctx, server, sink, c.Workers, c.QueueSize)
 	}
-	return web.NewWebhooks(ctx, server, c.Registry, sink, c.Downlinks)
+	return web.NewWebhooks(ctx, server, registry, sink, c.Downlinks)
+}
+
+// deliveryQueue returns the persistent delivery queue, if enabled.
+func (c WebhooksConfig) deliveryQueue() web.DeliveryQueue {
+	if !c.DeliveryQueue.Enable {
+		return nil
+	}
+	return c.DeliveryQueue.Queue
 }
 
 // NewPubSub returns a new pubsub.PubSub based on the configuration.
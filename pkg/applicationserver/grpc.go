@@ -72,16 +72,18 @@ func (as *ApplicationServer) GetLink(ctx context.Context, req *ttnpb.GetApplicat
 // SetLink implements ttnpb.AsServer.
 func (as *ApplicationServer) SetLink(ctx context.Context, req *ttnpb.SetApplicationLinkRequest) (*ttnpb.ApplicationLink, error) {
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "default_formatters.up_formatter_parameter") {
-		if size := len(req.Link.GetDefaultFormatters().GetUpFormatterParameter()); size > as.config.Formatters.MaxParameterLength {
+		maxSize := as.config.Formatters.maxParameterLength(req.Link.GetDefaultFormatters().GetUpFormatter())
+		if size := len(req.Link.GetDefaultFormatters().GetUpFormatterParameter()); size > maxSize {
 			return nil, errInvalidFieldValue.WithAttributes("field", "default_formatters.up_formatter_parameter").WithCause(
-				errFormatterScriptTooLarge.WithAttributes("size", size, "max_size", as.config.Formatters.MaxParameterLength),
+				errFormatterScriptTooLarge.WithAttributes("size", size, "max_size", maxSize),
 			)
 		}
 	}
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "default_formatters.down_formatter_parameter") {
-		if size := len(req.Link.GetDefaultFormatters().GetDownFormatterParameter()); size > as.config.Formatters.MaxParameterLength {
+		maxSize := as.config.Formatters.maxParameterLength(req.Link.GetDefaultFormatters().GetDownFormatter())
+		if size := len(req.Link.GetDefaultFormatters().GetDownFormatterParameter()); size > maxSize {
 			return nil, errInvalidFieldValue.WithAttributes("field", "default_formatters.down_formatter_parameter").WithCause(
-				errFormatterScriptTooLarge.WithAttributes("size", size, "max_size", as.config.Formatters.MaxParameterLength),
+				errFormatterScriptTooLarge.WithAttributes("size", size, "max_size", maxSize),
 			)
 		}
 	}
@@ -184,16 +184,18 @@ func (r asEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndDev
 		return nil, errInvalidFieldValue.WithAttributes("field", "session.keys.app_s_key.key")
 	}
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "formatters.up_formatter_parameter") {
-		if size := len(req.EndDevice.GetFormatters().GetUpFormatterParameter()); size > r.AS.config.Formatters.MaxParameterLength {
+		maxSize := r.AS.config.Formatters.maxParameterLength(req.EndDevice.GetFormatters().GetUpFormatter())
+		if size := len(req.EndDevice.GetFormatters().GetUpFormatterParameter()); size > maxSize {
 			return nil, errInvalidFieldValue.WithAttributes("field", "formatters.up_formatter_parameter").WithCause(
-				errFormatterScriptTooLarge.WithAttributes("size", size, "max_size", r.AS.config.Formatters.MaxParameterLength),
+				errFormatterScriptTooLarge.WithAttributes("size", size, "max_size", maxSize),
 			)
 		}
 	}
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "formatters.down_formatter_parameter") {
-		if size := len(req.EndDevice.GetFormatters().GetDownFormatterParameter()); size > r.AS.config.Formatters.MaxParameterLength {
+		maxSize := r.AS.config.Formatters.maxParameterLength(req.EndDevice.GetFormatters().GetDownFormatter())
+		if size := len(req.EndDevice.GetFormatters().GetDownFormatterParameter()); size > maxSize {
 			return nil, errInvalidFieldValue.WithAttributes("field", "formatters.down_formatter_parameter").WithCause(
-				errFormatterScriptTooLarge.WithAttributes("size", size, "max_size", r.AS.config.Formatters.MaxParameterLength),
+				errFormatterScriptTooLarge.WithAttributes("size", size, "max_size", maxSize),
 			)
 		}
 	}
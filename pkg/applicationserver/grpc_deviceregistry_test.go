@@ -344,7 +344,7 @@ func TestDeviceRegistrySet(t *testing.T) {
 			DownFormatter: ttnpb.PayloadFormatter_FORMATTER_REPOSITORY,
 		},
 	}
-	maxParameterLength := 1024
+	maxParameterLength, maxWASMParameterLength := 1024, 4096
 	for _, tc := range []struct {
 		Name            string
 		ContextFunc     func(context.Context) context.Context
@@ -566,6 +566,35 @@ func TestDeviceRegistrySet(t *testing.T) {
 				return a.So(errors.IsInvalidArgument(err), should.BeTrue)
 			},
 		},
+		{
+			Name: "WebAssembly formatter module size exceeds maximum allowed",
+			ContextFunc: func(ctx context.Context) context.Context {
+				return rights.NewContext(ctx, &rights.Rights{
+					ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+						unique.ID(test.Context(), &ttnpb.ApplicationIdentifiers{ApplicationId: registeredApplicationID}): ttnpb.RightsFrom(
+							ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+						),
+					}),
+				})
+			},
+			DeviceRequest: &ttnpb.SetEndDeviceRequest{
+				EndDevice: func() *ttnpb.EndDevice {
+					dev := ttnpb.Clone(registeredDevice)
+					dev.Formatters.UpFormatter = ttnpb.PayloadFormatter_FORMATTER_WASM
+					dev.Formatters.UpFormatterParameter = strings.Repeat("-", maxWASMParameterLength+1)
+					return dev
+				}(),
+				FieldMask: ttnpb.FieldMask("formatters.up_formatter", "formatters.up_formatter_parameter"),
+			},
+			SetFunc: func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error) {
+				test.MustTFromContext(ctx).Errorf("SetFunc must not be called")
+				return nil, errors.New("SetFunc must not be called")
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				a := assertions.New(t)
+				return a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			},
+		},
 	} {
 		t.Run(tc.Name, func(t *testing.T) {
 			a := assertions.New(t)
@@ -581,7 +610,8 @@ func TestDeviceRegistrySet(t *testing.T) {
 						},
 					},
 					Formatters: applicationserver.FormattersConfig{
-						MaxParameterLength: maxParameterLength,
+						MaxParameterLength:     maxParameterLength,
+						MaxWASMParameterLength: maxWASMParameterLength,
 					},
 				}))
 
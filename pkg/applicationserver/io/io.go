@@ -20,6 +20,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/errorcontext"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
@@ -98,6 +99,8 @@ type Server interface {
 	FillContext(ctx context.Context) context.Context
 	// RateLimiter returns the rate limiter instance.
 	RateLimiter() ratelimit.Interface
+	// KeyService returns the key service used to encrypt and decrypt secrets.
+	KeyService() crypto.KeyService
 }
 
 // ContextualApplicationUp represents an ttnpb.ApplicationUp with its context.
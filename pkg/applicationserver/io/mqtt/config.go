@@ -0,0 +1,30 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt/mqtt5"
+)
+
+// SessionsConfig defines the configuration of persistent MQTT sessions.
+type SessionsConfig struct {
+	Store mqtt5.SessionStore `name:"-"`
+
+	Enable      bool          `name:"enable" description:"Persist the sessions of MQTT clients that do not request a clean session"`
+	Expiry      time.Duration `name:"expiry" description:"Maximum time to keep a session after the client disconnects"`
+	MaxMessages int64         `name:"max-messages" description:"Maximum number of stored messages per session"`
+}
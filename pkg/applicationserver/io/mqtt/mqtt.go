@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net"
 	"sync"
+	"time"
 
 	"github.com/TheThingsIndustries/mystique/pkg/auth"
 	mqttnet "github.com/TheThingsIndustries/mystique/pkg/net"
@@ -32,6 +33,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt/mqtt5"
 	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
 	"go.thethings.network/lorawan-stack/v3/pkg/task"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -39,10 +41,45 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
-const qosUpstream byte = 0
+const (
+	qosUpstream byte = 0
+	// qosPersistentUpstream is the QoS of upstream messages of MQTT 5 sessions and persistent MQTT 3.1.1 sessions.
+	// The messages are stored until the client acknowledges them.
+	qosPersistentUpstream byte = 1
+
+	connectTimeout = 10 * time.Second
+)
+
+// Option represents an option for the MQTT frontend.
+type Option interface {
+	apply(*options)
+}
+
+type options struct {
+	sessions SessionsConfig
+}
+
+type optionFunc func(*options)
+
+func (f optionFunc) apply(o *options) { f(o) }
+
+// WithSessions configures persistent sessions.
+// If persistent sessions are enabled, MQTT 3.1.1 clients that do not request a clean session are served by the
+// MQTT 5 session implementation, which stores the session state.
+func WithSessions(conf SessionsConfig) Option {
+	return optionFunc(func(o *options) {
+		o.sessions = conf
+	})
+}
 
 // Serve serves the MQTT frontend.
-func Serve(ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string) error {
+func Serve(
+	ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string, opts ...Option,
+) error {
+	o := &options{}
+	for _, opt := range opts {
+		opt.apply(o)
+	}
 	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/mqtt")
 	lis := mqttnet.NewListener(listener, protocol)
 	go func() {
@@ -53,7 +90,7 @@ func Serve(ctx context.Context, server io.Server, listener net.Listener, format
 		ctx, lis, server,
 		ratelimit.ApplicationAcceptMQTTConnectionResource, server.RateLimiter(),
 		func(ctx context.Context, mqttConn mqttnet.Conn) error {
-			return setupConnection(ctx, mqttConn, format, server)
+			return setupConnection(ctx, mqttConn, format, server, o)
 		},
 	)
 }
@@ -65,7 +102,20 @@ type connection struct {
 	resource ratelimit.Resource
 }
 
-func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format, server io.Server) error {
+func setupConnection(
+	ctx context.Context, mqttConn mqttnet.Conn, format Format, server io.Server, opts *options,
+) error {
+	conn, header, err := mqtt.PeekConnect(mqttConn.NetConn(), connectTimeout)
+	if err != nil {
+		return err
+	}
+	switch {
+	case header.ProtocolLevel == mqtt.ProtocolLevel5,
+		header.ProtocolLevel == mqtt.ProtocolLevel311 && !header.CleanSession && opts.sessions.Enable:
+		return setupConnection5(ctx, conn, mqttConn.Transport(), format, server, opts)
+	}
+	mqttConn = mqttnet.NewConn(conn, mqttConn.Transport())
+
 	c := &connection{
 		format: format,
 		server: server,
@@ -80,48 +130,65 @@ func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format,
 		return err
 	}
 	ctx = c.io.Context()
+	if conf := opts.sessions; conf.Enable {
+		// Clients that request a clean session discard their persistent session.
+		info := session.AuthInfo()
+		id := mqtt5.SessionID{Username: info.Username, ClientID: info.ClientID}
+		if err := mqtt5.DiscardSession(ctx, conf.Store, id); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to discard persistent session")
+		}
+	}
 
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
-	f := func(ctx context.Context) error {
+	server.StartTask(&task.Config{
+		Context: ctx,
+		ID:      "mqtt_publish_uplinks",
+		Func: c.publishUplinks(func(_ *io.ContextualApplicationUp, topicName string, topicParts []string, buf []byte) {
+			session.Publish(&packet.PublishPacket{
+				TopicName:  topicName,
+				TopicParts: topicParts,
+				QoS:        qosUpstream,
+				Message:    buf,
+			})
+		}),
+		Done:    wg.Done,
+		Restart: task.RestartNever,
+		Backoff: task.DefaultBackoffConfig,
+	})
+
+	mqtt.RunSession(ctx, c.io.Disconnect, server, session, mqttConn, wg)
+
+	return nil
+}
+
+// publishFunc publishes the marshaled upstream message to the client.
+type publishFunc func(up *io.ContextualApplicationUp, topicName string, topicParts []string, buf []byte)
+
+// publishUplinks returns the task that publishes the upstream messages of the subscription.
+func (c *connection) publishUplinks(publish publishFunc) func(context.Context) error {
+	return func(ctx context.Context) error {
 		for {
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
 			case up := <-c.io.Up():
 				logger := log.FromContext(ctx).WithField("device_uid", unique.ID(up.Context, up.EndDeviceIds))
-				topicParts := TopicParts(up, format)
+				topicParts := TopicParts(up, c.format)
 				if topicParts == nil {
 					continue
 				}
-				buf, err := format.FromUp(up.ApplicationUp)
+				buf, err := c.format.FromUp(up.ApplicationUp)
 				if err != nil {
 					logger.WithError(err).Warn("Failed to marshal upstream message")
 					continue
 				}
 				topicName := topic.Join(topicParts)
 				logger.WithField("topic", topicName).Debug("Publish upstream message")
-				session.Publish(&packet.PublishPacket{
-					TopicName:  topicName,
-					TopicParts: topicParts,
-					QoS:        qosUpstream,
-					Message:    buf,
-				})
+				publish(up, topicName, topicParts, buf)
 			}
 		}
 	}
-	server.StartTask(&task.Config{
-		Context: ctx,
-		ID:      "mqtt_publish_uplinks",
-		Func:    f,
-		Done:    wg.Done,
-		Restart: task.RestartNever,
-		Backoff: task.DefaultBackoffConfig,
-	})
-
-	mqtt.RunSession(ctx, c.io.Disconnect, server, session, mqttConn, wg)
-
-	return nil
 }
 
 type topicAccess struct {
@@ -239,6 +306,11 @@ func (c *connection) CanWrite(info *auth.Info, topicParts ...string) bool {
 	return false
 }
 
+var (
+	errUnknownTopic    = errors.DefineNotFound("unknown_topic", "unknown topic `{topic}`")
+	errDecodeDownlinks = errors.DefineInvalidArgument("decode_downlinks", "decode downlink messages")
+)
+
 func (c *connection) deliver(pkt *packet.PublishPacket) {
 	logger := log.FromContext(c.io.Context()).WithField("topic", pkt.TopicName)
 
@@ -248,27 +320,35 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 		return
 	}
 
+	c.handleDownlinks(pkt.TopicName, pkt.TopicParts, pkt.Message, nil) //nolint:errcheck
+}
+
+// handleDownlinks handles the downlink messages that are published by the client.
+// The correlation IDs are added to the downlink messages.
+func (c *connection) handleDownlinks(topicName string, topicParts []string, message []byte, correlationIDs []string) error {
+	logger := log.FromContext(c.io.Context()).WithField("topic", topicName)
+
 	var deviceID string
 	var op func(io.Server, context.Context, *ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error
 	switch {
-	case c.format.IsDownlinkPushTopic(pkt.TopicParts):
-		deviceID = c.format.ParseDownlinkPushTopic(pkt.TopicParts)
+	case c.format.IsDownlinkPushTopic(topicParts):
+		deviceID = c.format.ParseDownlinkPushTopic(topicParts)
 		op = io.Server.DownlinkQueuePush
-	case c.format.IsDownlinkReplaceTopic(pkt.TopicParts):
-		deviceID = c.format.ParseDownlinkReplaceTopic(pkt.TopicParts)
+	case c.format.IsDownlinkReplaceTopic(topicParts):
+		deviceID = c.format.ParseDownlinkReplaceTopic(topicParts)
 		op = io.Server.DownlinkQueueReplace
 	default:
 		logger.Error("Invalid topic path")
-		return
+		return errUnknownTopic.WithAttributes("topic", topicName)
 	}
-	items, err := c.format.ToDownlinks(pkt.Message)
+	items, err := c.format.ToDownlinks(message)
 	if err != nil {
 		logger.WithError(err).Warn("Failed to decode downlink messages")
-		return
+		return errDecodeDownlinks.WithCause(err)
 	}
 	if err := items.ValidateFields(); err != nil {
 		logger.WithError(err).Warn("Failed to validate downlink messages")
-		return
+		return err
 	}
 	ids := &ttnpb.EndDeviceIdentifiers{
 		ApplicationIds: c.io.ApplicationIDs(),
@@ -276,7 +356,10 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 	}
 	if err := ids.ValidateContext(c.io.Context()); err != nil {
 		logger.WithError(err).Warn("Failed to validate message identifiers")
-		return
+		return err
+	}
+	for _, down := range items.Downlinks {
+		down.CorrelationIds = append(down.CorrelationIds, correlationIDs...)
 	}
 	logger.WithFields(log.Fields(
 		"device_uid", unique.ID(c.io.Context(), ids),
@@ -284,5 +367,7 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 	)).Debug("Handle downlink messages")
 	if err := op(c.server, c.io.Context(), ids, items.Downlinks); err != nil {
 		logger.WithError(err).Warn("Failed to handle downlink messages")
+		return err
 	}
+	return nil
 }
@@ -0,0 +1,154 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/TheThingsIndustries/mystique/pkg/auth"
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/errorcontext"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt/mqtt5"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// upstreamMessageExpiry is the message expiry interval of upstream messages.
+// Upstream messages that cannot be sent to the client within the interval are dropped.
+const upstreamMessageExpiry = 10 * time.Minute
+
+// User properties of upstream and downstream messages.
+const (
+	userPropertyApplicationID = "application_id"
+	userPropertyDeviceID      = "device_id"
+	userPropertyDevEUI        = "dev_eui"
+	userPropertyCorrelationID = "correlation_id"
+)
+
+// sharedSubscriptions are the shared subscriptions of all MQTT 5 sessions of the process.
+// Shared subscriptions are scoped to the application, as the accepted topic filters contain the application ID.
+// The members of a group are selected by the end device, so that all messages of an end device are published to the
+// same member.
+//
+// The members of shared subscriptions are not coordinated between Application Server instances: in deployments with
+// multiple instances, each instance publishes the message to a member of the group that is connected to that instance.
+// Clients that use shared subscriptions should therefore connect to a single instance.
+var sharedSubscriptions = mqtt5.NewSharedSubscriptions(func(pkt *mqtt5.Publish) string {
+	if ids := pkt.Properties.UserProperty(userPropertyDeviceID); len(ids) > 0 {
+		return ids[0]
+	}
+	return pkt.TopicName
+})
+
+// setupConnection5 sets up an MQTT 5 connection, or an MQTT 3.1.1 connection with a persistent session.
+// The connection uses the same authentication and topic access checks as MQTT 3.1.1 connections.
+// Persistent sessions keep the application subscription after the connection closes, so that upstream messages are
+// stored until the session expires.
+func setupConnection5(
+	ctx context.Context, conn net.Conn, transport string, format Format, server io.Server, opts *options,
+) error {
+	c := &connection{
+		format: format,
+		server: server,
+	}
+
+	ctx = auth.NewContextWithInterface(ctx, c)
+	sessionOpts := []mqtt5.SessionOption{mqtt5.WithSharedSubscriptions(sharedSubscriptions)}
+	if conf := opts.sessions; conf.Enable {
+		sessionOpts = append(sessionOpts, mqtt5.WithSessionStore(conf.Store, conf.Expiry))
+	}
+	session := mqtt5.NewSession(ctx, conn, transport, c.deliver5, sessionOpts...)
+	if err := session.ReadConnect(); err != nil {
+		if c.io != nil {
+			c.io.Disconnect(err)
+		}
+		return err
+	}
+	ctx = c.io.Context()
+
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	server.StartTask(&task.Config{
+		Context: ctx,
+		ID:      "mqtt_publish_uplinks",
+		Func: c.publishUplinks(func(up *io.ContextualApplicationUp, topicName string, _ []string, buf []byte) {
+			session.Publish(&mqtt5.Publish{
+				TopicName:  topicName,
+				QoS:        qosPersistentUpstream,
+				Properties: upstreamProperties(up),
+				Payload:    buf,
+			})
+		}),
+		Done:    wg.Done,
+		Restart: task.RestartNever,
+		Backoff: task.DefaultBackoffConfig,
+	})
+
+	connCtx, cancel := errorcontext.New(ctx)
+	mqtt5.RunSession(connCtx, cancel, server, session, wg)
+
+	server.StartTask(&task.Config{
+		Context: ctx,
+		ID:      "mqtt_session_expiry",
+		Func: func(ctx context.Context) error {
+			if err := session.WaitExpiry(ctx); err != nil {
+				return err
+			}
+			c.io.Disconnect(connCtx.Err())
+			return nil
+		},
+		Restart: task.RestartNever,
+		Backoff: task.DefaultBackoffConfig,
+	})
+
+	return nil
+}
+
+// upstreamProperties returns the MQTT 5 properties of the upstream message.
+// The end device identifiers and correlation IDs are set as user properties.
+func upstreamProperties(up *io.ContextualApplicationUp) mqtt5.Properties {
+	props := mqtt5.Properties{
+		MessageExpiryInterval: mqtt5.Uint32(uint32(upstreamMessageExpiry / time.Second)),
+	}
+	ids := up.GetEndDeviceIds()
+	props.AddUserProperty(userPropertyApplicationID, ids.GetApplicationIds().GetApplicationId())
+	props.AddUserProperty(userPropertyDeviceID, ids.GetDeviceId())
+	if devEUI := types.MustEUI64(ids.GetDevEui()).OrZero(); !devEUI.IsZero() {
+		props.AddUserProperty(userPropertyDevEUI, devEUI.String())
+	}
+	for _, id := range up.GetCorrelationIds() {
+		props.AddUserProperty(userPropertyCorrelationID, id)
+	}
+	return props
+}
+
+// deliver5 handles a message that is published by an MQTT 5 client.
+// Unlike MQTT 3.1.1 connections, the connection is not terminated when the rate limit is exceeded: the client is
+// informed with the reason code in the PUBACK packet instead.
+func (c *connection) deliver5(pkt *mqtt5.Publish) error {
+	if err := ratelimit.Require(c.server.RateLimiter(), c.resource); err != nil {
+		log.FromContext(c.io.Context()).WithError(err).WithField("topic", pkt.TopicName).Warn("Drop downlink messages")
+		return err
+	}
+	return c.handleDownlinks(
+		pkt.TopicName, topic.Split(pkt.TopicName), pkt.Payload, pkt.Properties.UserProperty(userPropertyCorrelationID),
+	)
+}
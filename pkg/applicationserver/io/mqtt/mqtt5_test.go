@@ -0,0 +1,236 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mock"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	mockis "go.thethings.network/lorawan-stack/v3/pkg/identityserver/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt/mqtt5"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func dialMQTT5(t *testing.T, addr net.Addr, username, password string) (net.Conn, *mqtt5.Connack) {
+	t.Helper()
+	conn, err := net.Dial("tcp", addr.String())
+	if err != nil {
+		t.Fatalf("Failed to dial: %v", err)
+	}
+	if err := mqtt5.Write(conn, &mqtt5.Connect{
+		CleanStart: true,
+		KeepAlive:  60,
+		Username:   username,
+		Password:   []byte(password),
+	}, mqtt.ProtocolLevel5); err != nil {
+		t.Fatalf("Failed to send CONNECT: %v", err)
+	}
+	connack, ok := receiveMQTT5(t, conn).(*mqtt5.Connack)
+	if !ok {
+		t.Fatal("Expected CONNACK")
+	}
+	return conn, connack
+}
+
+func receiveMQTT5(t *testing.T, conn net.Conn) mqtt5.Packet {
+	t.Helper()
+	conn.SetReadDeadline(time.Now().Add(timeout)) //nolint:errcheck
+	pkt, err := mqtt5.Read(conn, mqtt.ProtocolLevel5, 0)
+	if err != nil {
+		t.Fatalf("Failed to read packet: %v", err)
+	}
+	return pkt
+}
+
+func TestMQTT5(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	is, isAddr, closeIS := mockis.New(ctx)
+	defer closeIS()
+	is.ApplicationRegistry().Add(ctx, registeredApplicationID, registeredApplicationKey, testRights...)
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	as := mock.NewServer(c)
+	lis, err := net.Listen("tcp", ":0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	go Serve(c.Context(), as, lis, JSON, "tcp")
+
+	conn, connack := dialMQTT5(t, lis.Addr(), registeredApplicationUID, "invalid-key")
+	conn.Close()
+	a.So(connack.ReasonCode, should.Equal, mqtt5.NotAuthorized)
+
+	conn, connack = dialMQTT5(t, lis.Addr(), registeredApplicationUID, registeredApplicationKey)
+	defer conn.Close()
+	if !a.So(connack.ReasonCode, should.Equal, mqtt5.Success) {
+		t.FailNow()
+	}
+
+	var sub *io.Subscription
+	select {
+	case sub = <-as.Subscriptions():
+	case <-time.After(timeout):
+		t.Fatal("Connection timeout")
+	}
+
+	t.Run("Upstream", func(t *testing.T) {
+		a := assertions.New(t)
+
+		err := mqtt5.Write(conn, &mqtt5.Subscribe{
+			PacketID: 1,
+			Subscriptions: []mqtt5.Subscription{
+				{Filter: fmt.Sprintf("$share/group/v3/%v/devices/+/up", registeredApplicationUID), QoS: 1},
+				{Filter: "v3/invalid-application/devices/+/up", QoS: 1},
+			},
+		}, mqtt.ProtocolLevel5)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(receiveMQTT5(t, conn), should.Resemble, &mqtt5.Suback{
+			PacketID:    1,
+			ReasonCodes: []mqtt5.ReasonCode{mqtt5.GrantedQoS1, mqtt5.NotAuthorized},
+		})
+
+		up := &ttnpb.ApplicationUp{
+			EndDeviceIds:   registeredDeviceID,
+			CorrelationIds: []string{"test:up"},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{FrmPayload: []byte{0x1, 0x2, 0x3}},
+			},
+		}
+		if err := sub.Publish(ctx, up); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		pub, ok := receiveMQTT5(t, conn).(*mqtt5.Publish)
+		if !a.So(ok, should.BeTrue) {
+			t.FailNow()
+		}
+		a.So(pub.TopicName, should.Equal, fmt.Sprintf(
+			"v3/%v/devices/%v/up", registeredApplicationUID, registeredDeviceID.DeviceId,
+		))
+		a.So(pub.QoS, should.Equal, 1)
+		a.So(pub.Properties.MessageExpiryInterval, should.NotBeNil)
+		a.So(pub.Properties.UserProperty("application_id"), should.Resemble, []string{registeredApplicationID.ApplicationId})
+		a.So(pub.Properties.UserProperty("device_id"), should.Resemble, []string{registeredDeviceID.DeviceId})
+		a.So(pub.Properties.UserProperty("correlation_id"), should.Resemble, []string{"test:up"})
+		actual := &ttnpb.ApplicationUp{}
+		if a.So(jsonpb.TTN().Unmarshal(pub.Payload, actual), should.BeNil) {
+			a.So(actual, should.Resemble, up)
+		}
+	})
+
+	t.Run("Downstream", func(t *testing.T) {
+		pushTopic := fmt.Sprintf(
+			"v3/%v/devices/%v/down/push", unique.ID(ctx, registeredDeviceID.ApplicationIds), registeredDeviceID.DeviceId,
+		)
+		for i, tc := range []struct {
+			Name       string
+			Topic      string
+			Payload    []byte
+			ReasonCode mqtt5.ReasonCode
+		}{
+			{
+				Name:       "Push",
+				Topic:      pushTopic,
+				Payload:    []byte(`{"downlinks":[{"f_port":42,"frm_payload":"AQEB"}]}`),
+				ReasonCode: mqtt5.Success,
+			},
+			{
+				Name:       "InvalidPayload",
+				Topic:      pushTopic,
+				Payload:    []byte(`{"downlinks":"invalid"}`),
+				ReasonCode: mqtt5.PayloadFormatInvalid,
+			},
+			{
+				Name:       "InvalidApplication",
+				Topic:      "v3/invalid-application/devices/invalid-device/down/push",
+				Payload:    []byte(`{"downlinks":[{"f_port":42,"frm_payload":"AgIC"}]}`),
+				ReasonCode: mqtt5.NotAuthorized,
+			},
+		} {
+			packetID := uint16(i + 1)
+			tc := tc
+			t.Run(tc.Name, func(t *testing.T) {
+				a := assertions.New(t)
+
+				pub := &mqtt5.Publish{
+					QoS:       1,
+					TopicName: tc.Topic,
+					PacketID:  packetID,
+					Payload:   tc.Payload,
+				}
+				pub.Properties.AddUserProperty("correlation_id", "test:down")
+				if err := mqtt5.Write(conn, pub, mqtt.ProtocolLevel5); !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				puback, ok := receiveMQTT5(t, conn).(*mqtt5.Puback)
+				if !a.So(ok, should.BeTrue) {
+					t.FailNow()
+				}
+				a.So(puback.PacketID, should.Equal, packetID)
+				a.So(puback.ReasonCode, should.Equal, tc.ReasonCode)
+				if tc.ReasonCode.IsError() {
+					a.So(puback.Properties.ReasonString, should.NotBeEmpty)
+				}
+			})
+		}
+
+		res, err := as.DownlinkQueueList(ctx, registeredDeviceID)
+		a.So(err, should.BeNil)
+		a.So(res, should.Resemble, []*ttnpb.ApplicationDownlink{
+			{
+				FPort:          42,
+				FrmPayload:     []byte{0x1, 0x1, 0x1},
+				CorrelationIds: []string{"test:down"},
+			},
+		})
+	})
+}
@@ -0,0 +1,253 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the persistent MQTT session store using Redis.
+package redis
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt/mqtt5"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+)
+
+var errInvalidSession = errors.DefineCorruption("invalid_session", "invalid stored session")
+
+const (
+	metaKey          = "meta"
+	subscriptionsKey = "subscriptions"
+	messagesKey      = "messages"
+
+	messageSeparator = ":"
+)
+
+// resumeScript sets the owner of the session at KEYS[1] to ARGV[1], and sets the expiry of the keys to ARGV[3]
+// milliseconds. If ARGV[2] is 1, or if the session does not exist, the state of the session is discarded.
+// The script returns the subscriptions hash at KEYS[2] and the messages sorted set at KEYS[3], or nil if the state is
+// discarded.
+var resumeScript = redis.NewScript(`local exists = ARGV[2] ~= '1' and redis.call('exists', KEYS[1]) == 1
+if not exists then
+	redis.call('del', KEYS[1], KEYS[2], KEYS[3])
+end
+redis.call('hset', KEYS[1], 'owner', ARGV[1])
+for i = 1, #KEYS do
+	redis.call('pexpire', KEYS[i], ARGV[3])
+end
+if not exists then
+	return nil
+end
+return {redis.call('hgetall', KEYS[2]), redis.call('zrange', KEYS[3], 0, -1)}`)
+
+// updateScript updates the session at KEYS[1] if it is owned by ARGV[1], and sets the expiry of the keys to ARGV[2]
+// milliseconds. The arguments from ARGV[4] are:
+//   - The number of subscriptions to set, followed by the topic filter and QoS of each subscription.
+//   - The number of subscriptions to delete, followed by the topic filters.
+//   - The number of messages to delete, followed by the sequence numbers.
+//   - The messages to add.
+//
+// The subscriptions are stored in the hash at KEYS[2]. The messages are stored in the sorted set at KEYS[3] by
+// sequence number, of which the oldest are removed if the set contains more than ARGV[3] messages.
+// The script returns the sequence numbers of the added messages, or nil if the session is owned by another owner.
+var updateScript = redis.NewScript(`if redis.call('hget', KEYS[1], 'owner') ~= ARGV[1] then
+	return nil
+end
+local i = 4
+local n = tonumber(ARGV[i])
+i = i + 1
+for _ = 1, n do
+	redis.call('hset', KEYS[2], ARGV[i], ARGV[i+1])
+	i = i + 2
+end
+n = tonumber(ARGV[i])
+i = i + 1
+for _ = 1, n do
+	redis.call('hdel', KEYS[2], ARGV[i])
+	i = i + 1
+end
+n = tonumber(ARGV[i])
+i = i + 1
+for _ = 1, n do
+	redis.call('zremrangebyscore', KEYS[3], ARGV[i], ARGV[i])
+	i = i + 1
+end
+local seqs = {}
+while i <= #ARGV do
+	local seq = redis.call('hincrby', KEYS[1], 'seq', 1)
+	redis.call('zadd', KEYS[3], seq, seq .. ':' .. ARGV[i])
+	seqs[#seqs+1] = seq
+	i = i + 1
+end
+local max = tonumber(ARGV[3])
+if max > 0 then
+	redis.call('zremrangebyrank', KEYS[3], 0, -max-1)
+end
+for k = 1, #KEYS do
+	redis.call('pexpire', KEYS[k], ARGV[2])
+end
+return seqs`)
+
+// deleteScript deletes the keys of the session at KEYS[1] if it is owned by ARGV[1].
+// The script returns nil if the session is owned by another owner.
+var deleteScript = redis.NewScript(`if redis.call('hget', KEYS[1], 'owner') ~= ARGV[1] then
+	return nil
+end
+redis.call('del', unpack(KEYS))
+return 1`)
+
+// SessionStore is an implementation of mqtt5.SessionStore.
+// Each session is stored in a hash with the owner and the last sequence number, a hash with the subscriptions and a
+// sorted set with the messages by sequence number.
+type SessionStore struct {
+	redis       *ttnredis.Client
+	maxMessages int64
+}
+
+var _ mqtt5.SessionStore = (*SessionStore)(nil)
+
+// NewSessionStore returns a new persistent MQTT session store.
+// maxMessages is the maximum number of stored messages per session.
+func NewSessionStore(cl *ttnredis.Client, maxMessages int64) *SessionStore {
+	return &SessionStore{
+		redis:       cl,
+		maxMessages: maxMessages,
+	}
+}
+
+func (s *SessionStore) keys(id mqtt5.SessionID) []string {
+	k := s.redis.Key(id.Username, id.ClientID)
+	return []string{
+		ttnredis.Key(k, metaKey),
+		ttnredis.Key(k, subscriptionsKey),
+		ttnredis.Key(k, messagesKey),
+	}
+}
+
+func milliseconds(d time.Duration) int64 {
+	if ms := d.Milliseconds(); ms > 0 {
+		return ms
+	}
+	return 1
+}
+
+// Resume implements mqtt5.SessionStore.
+func (s *SessionStore) Resume(
+	ctx context.Context, id mqtt5.SessionID, owner string, clean bool, expiry time.Duration,
+) (*mqtt5.SessionState, error) {
+	cleanArg := "0"
+	if clean {
+		cleanArg = "1"
+	}
+	res, err := resumeScript.Run(ctx, s.redis, s.keys(id), owner, cleanArg, milliseconds(expiry)).Slice()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, nil
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(res) != 2 {
+		return nil, errInvalidSession.New()
+	}
+	subscriptions, ok := res[0].([]any)
+	if !ok || len(subscriptions)%2 != 0 {
+		return nil, errInvalidSession.New()
+	}
+	messages, ok := res[1].([]any)
+	if !ok {
+		return nil, errInvalidSession.New()
+	}
+	state := &mqtt5.SessionState{
+		Subscriptions: make(map[string]byte, len(subscriptions)/2),
+		Messages:      make([]mqtt5.StoredMessage, 0, len(messages)),
+	}
+	for i := 0; i < len(subscriptions); i += 2 {
+		filter, _ := subscriptions[i].(string)
+		qosStr, _ := subscriptions[i+1].(string)
+		qos, err := strconv.ParseUint(qosStr, 10, 8)
+		if err != nil {
+			return nil, errInvalidSession.WithCause(err)
+		}
+		state.Subscriptions[filter] = byte(qos)
+	}
+	for _, v := range messages {
+		member, _ := v.(string)
+		seqStr, data, ok := strings.Cut(member, messageSeparator)
+		if !ok {
+			return nil, errInvalidSession.New()
+		}
+		seq, err := strconv.ParseUint(seqStr, 10, 64)
+		if err != nil {
+			return nil, errInvalidSession.WithCause(err)
+		}
+		state.Messages = append(state.Messages, mqtt5.StoredMessage{
+			Seq:  seq,
+			Data: []byte(data),
+		})
+	}
+	return state, nil
+}
+
+// Update implements mqtt5.SessionStore.
+func (s *SessionStore) Update(
+	ctx context.Context, id mqtt5.SessionID, owner string, expiry time.Duration, update *mqtt5.SessionUpdate,
+) ([]uint64, error) {
+	args := make([]any, 0, 6+
+		2*len(update.SetSubscriptions)+
+		len(update.DeleteSubscriptions)+
+		len(update.DeleteMessages)+
+		len(update.AddMessages),
+	)
+	args = append(args, owner, milliseconds(expiry), s.maxMessages, len(update.SetSubscriptions))
+	for filter, qos := range update.SetSubscriptions {
+		args = append(args, filter, qos)
+	}
+	args = append(args, len(update.DeleteSubscriptions))
+	for _, filter := range update.DeleteSubscriptions {
+		args = append(args, filter)
+	}
+	args = append(args, len(update.DeleteMessages))
+	for _, seq := range update.DeleteMessages {
+		args = append(args, seq)
+	}
+	for _, data := range update.AddMessages {
+		args = append(args, data)
+	}
+	res, err := updateScript.Run(ctx, s.redis, s.keys(id), args...).Int64Slice()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, mqtt5.ErrSessionTakenOver.New()
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	seqs := make([]uint64, len(res))
+	for i, seq := range res {
+		seqs[i] = uint64(seq)
+	}
+	return seqs, nil
+}
+
+// Delete implements mqtt5.SessionStore.
+func (s *SessionStore) Delete(ctx context.Context, id mqtt5.SessionID, owner string) error {
+	if err := deleteScript.Run(ctx, s.redis, s.keys(id), owner).Err(); err != nil {
+		if errors.Is(err, redis.Nil) {
+			return mqtt5.ErrSessionTakenOver.New()
+		}
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
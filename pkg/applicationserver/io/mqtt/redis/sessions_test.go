@@ -0,0 +1,81 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt/mqtt5"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestSessionStore(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "mqtt_redis_test")
+	defer flush()
+	defer cl.Close()
+
+	store := redis.NewSessionStore(cl, 2)
+	id := mqtt5.SessionID{Username: "foo-app", ClientID: "foo-client"}
+
+	state, err := store.Resume(ctx, id, "owner-1", false, time.Minute)
+	a.So(err, should.BeNil)
+	a.So(state, should.BeNil)
+
+	seqs, err := store.Update(ctx, id, "owner-1", time.Minute, &mqtt5.SessionUpdate{
+		SetSubscriptions: map[string]byte{"foo/#": 1, "bar/#": 0},
+		AddMessages:      [][]byte{[]byte("1"), []byte("2"), []byte("3:3")},
+	})
+	a.So(err, should.BeNil)
+	a.So(seqs, should.Resemble, []uint64{1, 2, 3})
+
+	seqs, err = store.Update(ctx, id, "owner-1", time.Minute, &mqtt5.SessionUpdate{
+		DeleteSubscriptions: []string{"bar/#"},
+		DeleteMessages:      []uint64{2},
+	})
+	a.So(err, should.BeNil)
+	a.So(seqs, should.BeEmpty)
+
+	// The session is taken over by another owner.
+	state, err = store.Resume(ctx, id, "owner-2", false, time.Minute)
+	a.So(err, should.BeNil)
+	a.So(state, should.Resemble, &mqtt5.SessionState{
+		Subscriptions: map[string]byte{"foo/#": 1},
+		Messages: []mqtt5.StoredMessage{
+			{Seq: 3, Data: []byte("3:3")},
+		},
+	})
+	_, err = store.Update(ctx, id, "owner-1", time.Minute, &mqtt5.SessionUpdate{})
+	a.So(errors.Resemble(err, mqtt5.ErrSessionTakenOver), should.BeTrue)
+	a.So(errors.Resemble(store.Delete(ctx, id, "owner-1"), mqtt5.ErrSessionTakenOver), should.BeTrue)
+
+	// The state is discarded when the session is resumed with a clean session.
+	state, err = store.Resume(ctx, id, "owner-3", true, time.Minute)
+	a.So(err, should.BeNil)
+	a.So(state, should.BeNil)
+	a.So(store.Delete(ctx, id, "owner-3"), should.BeNil)
+
+	// The state expires.
+	_, err = store.Resume(ctx, id, "owner-4", false, test.Delay)
+	a.So(err, should.BeNil)
+	time.Sleep(2 * test.Delay)
+	state, err = store.Resume(ctx, id, "owner-5", false, time.Minute)
+	a.So(err, should.BeNil)
+	a.So(state, should.BeNil)
+}
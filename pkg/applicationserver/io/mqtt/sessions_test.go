@@ -0,0 +1,164 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mock"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	mockis "go.thethings.network/lorawan-stack/v3/pkg/identityserver/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt/mqtt5"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestPersistentSession(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	is, isAddr, closeIS := mockis.New(ctx)
+	defer closeIS()
+	is.ApplicationRegistry().Add(ctx, registeredApplicationID, registeredApplicationKey, testRights...)
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	as := mock.NewServer(c)
+	lis, err := net.Listen("tcp", ":0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	go Serve(c.Context(), as, lis, JSON, "tcp", WithSessions(SessionsConfig{
+		Enable:      true,
+		Store:       mqtt5.NewMemorySessionStore(16),
+		Expiry:      time.Minute,
+		MaxMessages: 16,
+	}))
+
+	received := make(chan *ttnpb.ApplicationUp, 4)
+	connect := func(t *testing.T) (mqtt.Client, *io.Subscription, bool) {
+		t.Helper()
+		clientOpts := mqtt.NewClientOptions()
+		clientOpts.AddBroker(fmt.Sprintf("tcp://%v", lis.Addr()))
+		clientOpts.SetClientID("test-client")
+		clientOpts.SetCleanSession(false)
+		clientOpts.SetAutoReconnect(false)
+		clientOpts.SetUsername(registeredApplicationUID)
+		clientOpts.SetPassword(registeredApplicationKey)
+		clientOpts.SetDefaultPublishHandler(func(_ mqtt.Client, msg mqtt.Message) {
+			up := &ttnpb.ApplicationUp{}
+			if err := jsonpb.TTN().Unmarshal(msg.Payload(), up); err == nil {
+				received <- up
+			}
+		})
+		client := mqtt.NewClient(clientOpts)
+		token := client.Connect()
+		if !token.WaitTimeout(timeout) {
+			t.Fatal("Connection timeout")
+		}
+		if token.Error() != nil {
+			t.Fatalf("Failed to connect: %v", token.Error())
+		}
+		select {
+		case sub := <-as.Subscriptions():
+			return client, sub, token.(*mqtt.ConnectToken).SessionPresent()
+		case <-time.After(timeout):
+			t.Fatal("Subscription timeout")
+		}
+		return nil, nil, false
+	}
+	uplink := func(fCnt uint32) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIds: registeredDeviceID,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{
+				UplinkMessage: &ttnpb.ApplicationUplink{FCnt: fCnt},
+			},
+		}
+	}
+	expectUplink := func(t *testing.T, fCnt uint32) {
+		t.Helper()
+		select {
+		case up := <-received:
+			a.So(up.GetUplinkMessage().GetFCnt(), should.Equal, fCnt)
+		case <-time.After(timeout):
+			t.Fatalf("Receive uplink %d timeout", fCnt)
+		}
+	}
+
+	client, sub, present := connect(t)
+	a.So(present, should.BeFalse)
+	token := client.Subscribe(fmt.Sprintf("v3/%v/devices/+/up", registeredApplicationUID), 1, nil)
+	if !token.WaitTimeout(timeout) || !a.So(token.Error(), should.BeNil) {
+		t.FailNow()
+	}
+	if err := sub.Publish(ctx, uplink(1)); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	expectUplink(t, 1)
+
+	// Uplinks are stored while the client is disconnected.
+	client.Disconnect(100)
+	time.Sleep(timeout / 2)
+	if err := sub.Publish(ctx, uplink(2)); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	client, newSub, present := connect(t)
+	defer client.Disconnect(100)
+	a.So(present, should.BeTrue)
+	expectUplink(t, 2)
+
+	// The subscription of the previous connection ends when the session is resumed.
+	select {
+	case <-sub.Context().Done():
+	case <-time.After(timeout):
+		t.Fatal("Previous subscription did not end")
+	}
+
+	if err := newSub.Publish(ctx, uplink(3)); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	expectUplink(t, 3)
+}
@@ -0,0 +1,263 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	stdio "io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const (
+	defaultBatchMaxSize  = 1 << 20 // 1 MiB
+	defaultBatchMaxDelay = time.Second
+
+	// batchFlushTimeout is the timeout for processing the pending batches when the batcher is done.
+	batchFlushTimeout = 10 * time.Second
+
+	// BatchSizeHeader is the HTTP header which contains the number of messages in a batched request.
+	BatchSizeHeader = "X-Tts-Batch-Size"
+)
+
+// batchingEnabled returns whether the messages of the webhook are batched.
+func batchingEnabled(hook *ttnpb.ApplicationWebhook) bool {
+	if hook.GetBatching().GetMaxMessages() <= 1 {
+		return false
+	}
+	format, ok := formats[hook.GetFormat()]
+	return ok && format.Batch != nil
+}
+
+// batch contains the encoded messages of a webhook to the same URL.
+// The batch may contain the messages of multiple end devices, so the context of the batch only contains the
+// webhook identifiers. The outcome of the batch is reported to each message with its done function.
+type batch struct {
+	ctx      context.Context
+	hook     *ttnpb.ApplicationWebhook
+	format   Format
+	url      string
+	header   http.Header
+	messages [][]byte
+	done     []func(error)
+	size     int
+	timer    *time.Timer
+
+	// downlinkPush and downlinkReplace contain the downlink queue operation URLs of each message.
+	downlinkPush    []string
+	downlinkReplace []string
+}
+
+// detachedContext is a context.Context with the values of the parent context, which is never done.
+type detachedContext struct {
+	context.Context
+}
+
+// Deadline implements context.Context.
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+
+// Done implements context.Context.
+func (detachedContext) Done() <-chan struct{} { return nil }
+
+// Err implements context.Context.
+func (detachedContext) Err() error { return nil }
+
+// batcher accumulates the requests of webhooks in batches, which are processed by the target sink
+// once the maximum number of messages, the maximum size or the maximum delay is reached.
+// The pending batches are processed when the context of the batcher is done.
+type batcher struct {
+	ctx     context.Context
+	starter task.Starter
+	target  Sink
+
+	mu      sync.Mutex
+	batches map[string]*batch
+}
+
+func newBatcher(ctx context.Context, starter task.Starter, target Sink) *batcher {
+	b := &batcher{
+		ctx:     ctx,
+		starter: starter,
+		target:  target,
+		batches: make(map[string]*batch),
+	}
+	go b.flushOnDone()
+	return b
+}
+
+// flushOnDone processes the pending batches once the context of the batcher is done.
+// The batches are processed with a context which is not canceled, as the context of the batcher is done.
+func (b *batcher) flushOnDone() {
+	<-b.ctx.Done()
+	b.mu.Lock()
+	pending := make([]*batch, 0, len(b.batches))
+	for key, current := range b.batches {
+		current.timer.Stop()
+		pending = append(pending, current)
+		delete(b.batches, key)
+	}
+	b.mu.Unlock()
+	for _, current := range pending {
+		ctx, cancel := context.WithTimeout(detachedContext{current.ctx}, batchFlushTimeout)
+		b.process(WithCachedHealthStatus(ctx, current.hook.HealthStatus), current) //nolint:errcheck
+		cancel()
+	}
+}
+
+// batchHeader returns the headers of the request which apply to all messages of the batch.
+// The downlink queue operation headers are specific to the end device, and are set per message.
+func batchHeader(header http.Header) http.Header {
+	header = header.Clone()
+	header.Del(downlinkPushHeader)
+	header.Del(downlinkReplaceHeader)
+	header.Del(SignatureHeader)
+	return header
+}
+
+// add adds the message of the request to the batch of the webhook and URL of the request.
+// The headers of the first request of the batch apply to the batch, except the headers which are specific
+// to the end device, which are set per message. The done function is called with the result of processing the batch.
+func (b *batcher) add(
+	ctx context.Context, hook *ttnpb.ApplicationWebhook, req *http.Request, done func(error),
+) error {
+	format, ok := formats[hook.Format]
+	if !ok {
+		return errFormatNotFound.WithAttributes("format", hook.Format)
+	}
+	body, err := stdio.ReadAll(req.Body)
+	if err != nil {
+		return err
+	}
+	maxMessages := int(hook.Batching.MaxMessages)
+	maxSize := int(hook.Batching.MaxSize)
+	if maxSize == 0 {
+		maxSize = defaultBatchMaxSize
+	}
+	maxDelay := hook.Batching.MaxDelay.AsDuration()
+	if maxDelay <= 0 {
+		maxDelay = defaultBatchMaxDelay
+	}
+	url := req.URL.String()
+	key := unique.ID(ctx, hook.Ids) + " " + url
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	current := b.batches[key]
+	if current != nil && current.size+len(body) > maxSize {
+		b.flushLocked(key)
+		current = nil
+	}
+	if current == nil {
+		// The batch is processed after the request context is done, so the batch context derives from the
+		// context of the batcher.
+		current = &batch{
+			ctx:    withWebhookID(b.ctx, hook.Ids),
+			format: format,
+			url:    url,
+			header: batchHeader(req.Header),
+		}
+		b.batches[key] = current
+		pending := current
+		current.timer = time.AfterFunc(maxDelay, func() {
+			b.mu.Lock()
+			defer b.mu.Unlock()
+			if b.batches[key] == pending {
+				b.flushLocked(key)
+			}
+		})
+	}
+	// Use the latest webhook for the health status and the signing secrets.
+	current.hook = hook
+	current.messages = append(current.messages, body)
+	current.done = append(current.done, done)
+	current.downlinkPush = append(current.downlinkPush, req.Header.Get(downlinkPushHeader))
+	current.downlinkReplace = append(current.downlinkReplace, req.Header.Get(downlinkReplaceHeader))
+	current.size += len(body)
+	if len(current.messages) >= maxMessages {
+		b.flushLocked(key)
+	}
+	return nil
+}
+
+// flushLocked removes the batch with the given key and starts processing it.
+// The caller must hold the lock.
+func (b *batcher) flushLocked(key string) {
+	pending := b.batches[key]
+	delete(b.batches, key)
+	pending.timer.Stop()
+	ctx := WithCachedHealthStatus(pending.ctx, pending.hook.HealthStatus)
+	b.starter.StartTask(&task.Config{
+		Context: ctx,
+		ID:      "execute_webhook_batch",
+		Func: func(ctx context.Context) error {
+			return b.process(ctx, pending)
+		},
+		Restart: task.RestartNever,
+		Backoff: task.DefaultBackoffConfig,
+	})
+}
+
+// process processes the batch with the target sink, and reports the result to the messages of the batch.
+func (b *batcher) process(ctx context.Context, pending *batch) (err error) {
+	defer func() {
+		for _, done := range pending.done {
+			done(err)
+		}
+	}()
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"hook", pending.hook.Ids.WebhookId,
+		"url", pending.url,
+		"messages", len(pending.messages),
+	))
+	body := pending.format.Batch(pending.messages)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, pending.url, bytes.NewReader(body))
+	if err != nil {
+		logger.WithError(err).Warn("Failed to create batch request")
+		return err
+	}
+	req.Header = pending.header
+	req.Header.Set("Content-Type", pending.format.BatchContentType)
+	req.Header.Set(BatchSizeHeader, strconv.Itoa(len(pending.messages)))
+	addMessageHeader(req.Header, downlinkPushHeader, pending.downlinkPush)
+	addMessageHeader(req.Header, downlinkReplaceHeader, pending.downlinkReplace)
+	signRequest(req, body, pending.hook, time.Now())
+	logger.Debug("Process batch")
+	if err := b.target.Process(req); err != nil {
+		logger.WithError(err).Warn("Failed to process batch")
+		return err
+	}
+	return nil
+}
+
+// addMessageHeader adds the header values of the messages of a batch, in the order of the messages.
+// The header is only set if all messages have a value, so that the values correspond to the messages.
+func addMessageHeader(header http.Header, key string, values []string) {
+	for _, value := range values {
+		if value == "" {
+			return
+		}
+	}
+	for _, value := range values {
+		header.Add(key, value)
+	}
+}
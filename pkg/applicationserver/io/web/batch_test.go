@@ -0,0 +1,199 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"bytes"
+	"context"
+	stdio "io"
+	"net/http"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type batchRequest struct {
+	header http.Header
+	url    string
+	body   []byte
+}
+
+func TestBatcher(t *testing.T) {
+	t.Parallel()
+
+	secret := bytes.Repeat([]byte{0x42}, 16)
+	newRequest := func(ctx context.Context, t *testing.T, url, body string) *http.Request {
+		t.Helper()
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBufferString(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set("Authorization", "Bearer secret")
+		req.Header.Set("X-Downlink-Push", "https://example.com/push/"+body)
+		return req
+	}
+	newBatcher := func(
+		ctx context.Context,
+	) (func(context.Context, *ttnpb.ApplicationWebhook, *http.Request, func(error)) error, <-chan batchRequest) {
+		ch := make(chan batchRequest, 10)
+		sink := sinkFunc(func(req *http.Request) error {
+			body, err := stdio.ReadAll(req.Body)
+			if err != nil {
+				return err
+			}
+			ch <- batchRequest{header: req.Header, url: req.URL.String(), body: body}
+			return nil
+		})
+		starter := task.StartTaskFunc(func(conf *task.Config) {
+			conf.Func(conf.Context) //nolint:errcheck
+		})
+		return web.NewBatcher(ctx, starter, sink), ch
+	}
+
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		add, ch := newBatcher(ctx)
+		hook := &ttnpb.ApplicationWebhook{
+			Ids:            registeredWebhookIDs,
+			Format:         "json",
+			SigningSecrets: []*ttnpb.Secret{{Value: secret}},
+			Batching: &ttnpb.ApplicationWebhook_Batching{
+				MaxMessages: 3,
+				MaxDelay:    durationpb.New(time.Hour),
+			},
+		}
+		reqCtx := web.WithDeviceID(web.WithWebhookID(ctx, registeredWebhookIDs), registeredDeviceID)
+		results := make(chan error, 4)
+		done := func(err error) { results <- err }
+		for _, body := range []string{`{"a":1}`, `{"b":2}`} {
+			a.So(add(reqCtx, hook, newRequest(reqCtx, t, "https://example.com/up", body), done), should.BeNil)
+		}
+		// Messages to other URLs are batched separately.
+		a.So(add(reqCtx, hook, newRequest(reqCtx, t, "https://example.com/join", `{"c":3}`), done), should.BeNil)
+		select {
+		case <-ch:
+			t.Fatal("Batch processed before it is full")
+		default:
+		}
+		a.So(add(reqCtx, hook, newRequest(reqCtx, t, "https://example.com/up", `{"d":4}`), done), should.BeNil)
+		select {
+		case req := <-ch:
+			a.So(req.url, should.Equal, "https://example.com/up")
+			a.So(string(req.body), should.Equal, `[{"a":1},{"b":2},{"d":4}]`)
+			a.So(req.header.Get("Content-Type"), should.Equal, "application/json")
+			a.So(req.header.Get(web.BatchSizeHeader), should.Equal, "3")
+			a.So(req.header.Get("Authorization"), should.Equal, "Bearer secret")
+			// The downlink queue operation URLs are set per message, in the order of the messages.
+			a.So(req.header.Values("X-Downlink-Push"), should.Resemble, []string{
+				`https://example.com/push/{"a":1}`,
+				`https://example.com/push/{"b":2}`,
+				`https://example.com/push/{"d":4}`,
+			})
+			a.So(req.header.Values("X-Downlink-Replace"), should.BeEmpty)
+			a.So(
+				web.VerifySignature(req.header.Get(web.SignatureHeader), req.body, [][]byte{secret}, time.Now()),
+				should.BeNil,
+			)
+		default:
+			t.Fatal("Batch not processed")
+		}
+		// The result of the batch is reported to each message of the batch.
+		a.So(results, should.HaveLength, 3)
+		for len(results) > 0 {
+			a.So(<-results, should.BeNil)
+		}
+	})
+
+	t.Run("Protobuf", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		add, ch := newBatcher(ctx)
+		hook := &ttnpb.ApplicationWebhook{
+			Ids:    registeredWebhookIDs,
+			Format: "protobuf",
+			Batching: &ttnpb.ApplicationWebhook_Batching{
+				MaxMessages: 100,
+				MaxSize:     6,
+				MaxDelay:    durationpb.New(50 * time.Millisecond),
+			},
+		}
+		reqCtx := web.WithDeviceID(web.WithWebhookID(ctx, registeredWebhookIDs), registeredDeviceID)
+		done := func(error) {}
+		// The second message exceeds the maximum size of the batch, so the first batch is processed.
+		for _, body := range []string{"abcd", "efgh", "ij"} {
+			a.So(add(reqCtx, hook, newRequest(reqCtx, t, "https://example.com/up", body), done), should.BeNil)
+		}
+		decode := func(b []byte) (messages []string) {
+			for len(b) > 0 {
+				msg, n := protowire.ConsumeBytes(b)
+				if !a.So(n, should.BeGreaterThan, 0) {
+					t.FailNow()
+				}
+				messages, b = append(messages, string(msg)), b[n:]
+			}
+			return messages
+		}
+		select {
+		case req := <-ch:
+			a.So(decode(req.body), should.Resemble, []string{"abcd"})
+			a.So(req.header.Get(web.BatchSizeHeader), should.Equal, "1")
+			a.So(req.header.Get(web.SignatureHeader), should.BeEmpty)
+		default:
+			t.Fatal("Batch not processed")
+		}
+		// The remaining messages are processed after the maximum delay.
+		select {
+		case req := <-ch:
+			a.So(req.header.Get("Content-Type"), should.Equal, "application/octet-stream")
+			a.So(decode(req.body), should.Resemble, []string{"efgh", "ij"})
+			a.So(req.header.Get(web.BatchSizeHeader), should.Equal, "2")
+		case <-time.After(test.Delay << 8):
+			t.Fatal("Batch not processed after maximum delay")
+		}
+	})
+	t.Run("Done", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		ctx, cancel := context.WithCancel(ctx)
+		add, ch := newBatcher(ctx)
+		hook := &ttnpb.ApplicationWebhook{
+			Ids:    registeredWebhookIDs,
+			Format: "json",
+			Batching: &ttnpb.ApplicationWebhook_Batching{
+				MaxMessages: 10,
+				MaxDelay:    durationpb.New(time.Hour),
+			},
+		}
+		reqCtx := web.WithDeviceID(web.WithWebhookID(ctx, registeredWebhookIDs), registeredDeviceID)
+		a.So(add(reqCtx, hook, newRequest(reqCtx, t, "https://example.com/up", `{"a":1}`), func(error) {}), should.BeNil)
+		// The pending batches are processed when the context of the batcher is done.
+		cancel()
+		select {
+		case req := <-ch:
+			a.So(string(req.body), should.Equal, `[{"a":1}]`)
+			a.So(req.header.Get(web.BatchSizeHeader), should.Equal, "1")
+		case <-time.After(test.Delay << 8):
+			t.Fatal("Batch not processed when the batcher is done")
+		}
+	})
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"net/url"
 	"os"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
@@ -82,3 +83,20 @@ type DownlinksConfig struct {
 	PublicAddress    string `name:"public-address" description:"Public address of the HTTP webhooks frontend"`
 	PublicTLSAddress string `name:"public-tls-address" description:"Public address of the HTTPS webhooks frontend"`
 }
+
+// DeliveryQueueConfig defines the configuration of the persistent webhook delivery queue.
+// When enabled, requests are persisted before they are sent, and failed requests are retried
+// with exponential backoff until the maximum number of attempts is reached, after which they
+// are moved to the dead letters of the webhook.
+type DeliveryQueueConfig struct {
+	Queue DeliveryQueue `name:"-"`
+
+	Enable         bool          `name:"enable" description:"Persist webhook requests and retry failed requests"`
+	Consumers      int           `name:"consumers" description:"Number of consumers that process queued requests"`
+	MaxAttempts    int           `name:"max-attempts" description:"Number of attempts after which a request is moved to the dead letters"`
+	InitialBackoff time.Duration `name:"initial-backoff" description:"Time to wait before retrying a failed request"`
+	MaxBackoff     time.Duration `name:"max-backoff" description:"Maximum time to wait before retrying a failed request"`
+	MaxPending     int64         `name:"max-pending" description:"Maximum number of queued requests per webhook and end device"`
+	MaxDeadLetters int64         `name:"max-dead-letters" description:"Approximate maximum number of dead letters per webhook"`
+	ConsumerGroup  string        `name:"consumer-group" description:"Name of the consumer group of the delivery task stream"`
+}
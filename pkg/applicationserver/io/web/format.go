@@ -24,6 +24,11 @@ type Format struct {
 	formatters.Formatter
 	Name        string
 	ContentType string
+
+	// BatchContentType is the content type of batched messages.
+	BatchContentType string
+	// Batch combines the given encoded messages into a single body.
+	Batch func(messages [][]byte) []byte
 }
 
 var (
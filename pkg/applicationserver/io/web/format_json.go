@@ -14,12 +14,36 @@
 
 package web
 
-import "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/formatters"
+import (
+	"bytes"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/formatters"
+)
+
+// batchJSON combines the JSON encoded messages into a JSON array.
+func batchJSON(messages [][]byte) []byte {
+	n := 2 + len(messages)
+	for _, msg := range messages {
+		n += len(msg)
+	}
+	buf := bytes.NewBuffer(make([]byte, 0, n))
+	buf.WriteByte('[')
+	for i, msg := range messages {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(msg)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes()
+}
 
 func init() {
 	formats["json"] = Format{
-		Formatter:   formatters.JSON,
-		Name:        "JSON",
-		ContentType: "application/json",
+		Formatter:        formatters.JSON,
+		Name:             "JSON",
+		ContentType:      "application/json",
+		BatchContentType: "application/json",
+		Batch:            batchJSON,
 	}
 }
@@ -14,12 +14,31 @@
 
 package web
 
-import "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/formatters"
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/formatters"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// batchProtobuf combines the binary encoded messages into a stream of messages,
+// each prefixed with its length as varint.
+func batchProtobuf(messages [][]byte) []byte {
+	n := 0
+	for _, msg := range messages {
+		n += protowire.SizeBytes(len(msg))
+	}
+	buf := make([]byte, 0, n)
+	for _, msg := range messages {
+		buf = protowire.AppendBytes(buf, msg)
+	}
+	return buf
+}
 
 func init() {
 	formats["protobuf"] = Format{
-		Formatter:   formatters.Protobuf,
-		Name:        "Protocol Buffers",
-		ContentType: "application/octet-stream",
+		Formatter:        formatters.Protobuf,
+		Name:             "Protocol Buffers",
+		ContentType:      "application/octet-stream",
+		BatchContentType: "application/octet-stream",
+		Batch:            batchProtobuf,
 	}
 }
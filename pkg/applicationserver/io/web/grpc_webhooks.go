@@ -40,15 +40,20 @@ func appendImplicitWebhookGetPaths(paths ...string) []string {
 type webhookRegistryRPC struct {
 	ttnpb.UnimplementedApplicationWebhookRegistryServer
 
-	webhooks  WebhookRegistry
-	templates TemplateStore
+	webhooks   WebhookRegistry
+	templates  TemplateStore
+	deliveries DeliveryQueue
 }
 
 // NewWebhookRegistryRPC returns a new webhook registry gRPC server.
-func NewWebhookRegistryRPC(webhooks WebhookRegistry, templates TemplateStore) ttnpb.ApplicationWebhookRegistryServer {
+// If deliveries is nil, the dead letter operations are not available.
+func NewWebhookRegistryRPC(
+	webhooks WebhookRegistry, templates TemplateStore, deliveries DeliveryQueue,
+) ttnpb.ApplicationWebhookRegistryServer {
 	return &webhookRegistryRPC{
-		webhooks:  webhooks,
-		templates: templates,
+		webhooks:   webhooks,
+		templates:  templates,
+		deliveries: deliveries,
 	}
 }
 
@@ -70,15 +75,32 @@ func (s webhookRegistryRPC) ListTemplates(ctx context.Context, req *ttnpb.ListAp
 	return s.templates.ListTemplates(ctx, req)
 }
 
+// webhookReadRights returns the rights required to read the given webhook fields.
+// Reading the signing secrets requires the same rights as setting them.
+func webhookReadRights(paths []string) []ttnpb.Right {
+	if ttnpb.HasAnyField(paths, "signing_secrets") {
+		return []ttnpb.Right{
+			ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC,
+			ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+			ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+		}
+	}
+	return []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ}
+}
+
 func (s webhookRegistryRPC) Get(ctx context.Context, req *ttnpb.GetApplicationWebhookRequest) (*ttnpb.ApplicationWebhook, error) {
-	if err := rights.RequireApplication(ctx, req.Ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+	if err := rights.RequireApplication(
+		ctx, req.Ids.ApplicationIds, webhookReadRights(req.FieldMask.GetPaths())...,
+	); err != nil {
 		return nil, err
 	}
 	return s.webhooks.Get(ctx, req.Ids, appendImplicitWebhookGetPaths(req.FieldMask.GetPaths()...))
 }
 
 func (s webhookRegistryRPC) List(ctx context.Context, req *ttnpb.ListApplicationWebhooksRequest) (*ttnpb.ApplicationWebhooks, error) {
-	if err := rights.RequireApplication(ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+	if err := rights.RequireApplication(
+		ctx, req.ApplicationIds, webhookReadRights(req.FieldMask.GetPaths())...,
+	); err != nil {
 		return nil, err
 	}
 	webhooks, err := s.webhooks.List(ctx, req.ApplicationIds, appendImplicitWebhookGetPaths(req.FieldMask.GetPaths()...))
@@ -103,6 +125,11 @@ func (s webhookRegistryRPC) Set(ctx context.Context, req *ttnpb.SetApplicationWe
 	); err != nil {
 		return nil, err
 	}
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "signing_secrets") {
+		if err := validateSigningSecrets(req.Webhook.SigningSecrets); err != nil {
+			return nil, err
+		}
+	}
 	return s.webhooks.Set(ctx, req.Webhook.Ids, appendImplicitWebhookGetPaths(req.FieldMask.GetPaths()...),
 		func(webhook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
 			if webhook != nil {
@@ -134,3 +161,60 @@ func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWe
 	}
 	return ttnpb.Empty, nil
 }
+
+func (s webhookRegistryRPC) ListDeadLetters(
+	ctx context.Context, req *ttnpb.ListApplicationWebhookDeadLettersRequest,
+) (*ttnpb.ApplicationWebhookDeliveries, error) {
+	if err := rights.RequireApplication(ctx, req.Ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	if s.deliveries == nil {
+		return nil, errDeliveryQueueDisabled.New()
+	}
+	deliveries, total, err := s.deliveries.ListDeadLetters(ctx, req.Ids, req.Limit, req.Page)
+	if err != nil {
+		return nil, err
+	}
+	setTotalHeader(ctx, uint64(total))
+	return &ttnpb.ApplicationWebhookDeliveries{
+		Deliveries: deliveries,
+	}, nil
+}
+
+func (s webhookRegistryRPC) ReplayDeadLetters(
+	ctx context.Context, req *ttnpb.ApplicationWebhookDeadLettersRequest,
+) (*emptypb.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.Ids.ApplicationIds,
+		ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC,
+		ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+		ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if s.deliveries == nil {
+		return nil, errDeliveryQueueDisabled.New()
+	}
+	if err := s.deliveries.ReplayDeadLetters(ctx, req.Ids, req.DeadLetterIds); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+func (s webhookRegistryRPC) PurgeDeadLetters(
+	ctx context.Context, req *ttnpb.ApplicationWebhookDeadLettersRequest,
+) (*emptypb.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.Ids.ApplicationIds,
+		ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC,
+		ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+		ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if s.deliveries == nil {
+		return nil, errDeliveryQueueDisabled.New()
+	}
+	if err := s.deliveries.PurgeDeadLetters(ctx, req.Ids, req.DeadLetterIds); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
@@ -63,7 +63,7 @@ func TestWebhookRegistryRPC(t *testing.T) {
 	if err := webhookReg.Init(ctx); !a.So(err, should.BeNil) {
 		t.FailNow()
 	}
-	srv := web.NewWebhookRegistryRPC(webhookReg, nil)
+	srv := web.NewWebhookRegistryRPC(webhookReg, nil, nil)
 	c.RegisterGRPC(&mockRegisterer{ctx, srv})
 	componenttest.StartComponent(t, c)
 	defer c.Close()
@@ -234,7 +234,7 @@ description: Bar`),
 			store, err := config.NewTemplateStore(ctx, c)
 			a.So(err, should.BeNil)
 
-			c.RegisterGRPC(&mockRegisterer{ctx, web.NewWebhookRegistryRPC(nil, store)})
+			c.RegisterGRPC(&mockRegisterer{ctx, web.NewWebhookRegistryRPC(nil, store, nil)})
 			componenttest.StartComponent(t, c)
 			defer c.Close()
 
@@ -14,7 +14,23 @@
 
 package web
 
+import (
+	"context"
+	"net/http"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
 var (
 	WebhookIDFromContext = webhookIDFromContext
 	WithWebhookID        = withWebhookID
+	WithDeviceID         = withDeviceID
 )
+
+// NewBatcher returns a function that adds the requests of webhooks to batches, which are processed by the target.
+func NewBatcher(
+	ctx context.Context, starter task.Starter, target Sink,
+) func(context.Context, *ttnpb.ApplicationWebhook, *http.Request, func(error)) error {
+	return newBatcher(ctx, starter, target).add
+}
@@ -31,6 +31,13 @@ var evtWebhookFail = events.Define(
 	events.WithPropagateToParent(),
 )
 
+var evtWebhookDeadLetter = events.Define(
+	"as.webhook.dead_letter", "move webhook request to dead letters",
+	events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+	events.WithDataType(&ttnpb.ApplicationWebhookIdentifiers{}),
+	events.WithPropagateToParent(),
+)
+
 const (
 	subsystem = "as_webhook"
 	unknown   = "unknown"
@@ -53,6 +60,30 @@ var webhookMetrics = &messageMetrics{
 		},
 		[]string{"error"},
 	),
+	webhooksQueued: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "queued_total",
+			Help:      "Total number of queued webhook requests",
+		},
+		[]string{},
+	),
+	webhooksRetried: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "retried_total",
+			Help:      "Total number of retried webhook requests",
+		},
+		[]string{},
+	),
+	webhooksDeadLettered: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "dead_lettered_total",
+			Help:      "Total number of webhook requests moved to the dead letters",
+		},
+		[]string{},
+	),
 }
 
 func init() {
@@ -60,18 +91,27 @@ func init() {
 }
 
 type messageMetrics struct {
-	webhooksSent   *metrics.ContextualCounterVec
-	webhooksFailed *metrics.ContextualCounterVec
+	webhooksSent         *metrics.ContextualCounterVec
+	webhooksFailed       *metrics.ContextualCounterVec
+	webhooksQueued       *metrics.ContextualCounterVec
+	webhooksRetried      *metrics.ContextualCounterVec
+	webhooksDeadLettered *metrics.ContextualCounterVec
 }
 
 func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.webhooksSent.Describe(ch)
 	m.webhooksFailed.Describe(ch)
+	m.webhooksQueued.Describe(ch)
+	m.webhooksRetried.Describe(ch)
+	m.webhooksDeadLettered.Describe(ch)
 }
 
 func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.webhooksSent.Collect(ch)
 	m.webhooksFailed.Collect(ch)
+	m.webhooksQueued.Collect(ch)
+	m.webhooksRetried.Collect(ch)
+	m.webhooksDeadLettered.Collect(ch)
 }
 
 func registerWebhookSent(ctx context.Context) {
@@ -87,3 +127,16 @@ func registerWebhookFailed(ctx context.Context, err error) {
 	ids := deviceIDFromContext(ctx)
 	events.Publish(evtWebhookFail.NewWithIdentifiersAndData(ctx, ids, err))
 }
+
+func registerWebhookQueued(ctx context.Context) {
+	webhookMetrics.webhooksQueued.WithLabelValues(ctx).Inc()
+}
+
+func registerWebhookRetried(ctx context.Context) {
+	webhookMetrics.webhooksRetried.WithLabelValues(ctx).Inc()
+}
+
+func registerWebhookDeadLettered(ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers) {
+	webhookMetrics.webhooksDeadLettered.WithLabelValues(ctx).Inc()
+	events.Publish(evtWebhookDeadLetter.NewWithIdentifiersAndData(ctx, deviceIDFromContext(ctx), ids))
+}
@@ -0,0 +1,313 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	stdio "io"
+	"net/http"
+	"os"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// DeliveryResult is the outcome of a webhook delivery attempt.
+type DeliveryResult int
+
+const (
+	// DeliveryDone indicates that the delivery is completed and should be removed from the queue.
+	DeliveryDone DeliveryResult = iota
+	// DeliveryRetry indicates that the delivery should be attempted again.
+	DeliveryRetry
+	// DeliveryDeadLetter indicates that the delivery failed permanently and should be moved to the dead letters.
+	DeliveryDeadLetter
+)
+
+// DeliveryDoneFunc reports the result of a delivery attempt.
+// If the result is DeliveryRetry, the time is the time at which the delivery should be attempted again.
+type DeliveryDoneFunc func(DeliveryResult, time.Time)
+
+// DeliveryFunc attempts to deliver the given delivery.
+// The attempt may complete after DeliveryFunc returns, for example when the delivery is batched. The result is
+// reported by calling done exactly once, which may happen before or after DeliveryFunc returns.
+type DeliveryFunc func(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery, done DeliveryDoneFunc)
+
+// DeliveryQueue is a persistent queue of webhook deliveries.
+// The deliveries of a webhook to an end device are attempted in the order in which they are added.
+type DeliveryQueue interface {
+	// Add adds the delivery to the queue.
+	Add(ctx context.Context, delivery *ttnpb.ApplicationWebhookDelivery) error
+	// Dispatch dispatches the deliveries which are ready to be attempted. It blocks until the context is done.
+	Dispatch(ctx context.Context, consumerID string) error
+	// Pop calls f on the next delivery which is ready to be attempted.
+	// Pop does not wait until f reports the result of the delivery. Until the result is reported, the delivery
+	// is not attempted by other consumers.
+	Pop(ctx context.Context, consumerID string, f DeliveryFunc) error
+
+	// ListDeadLetters lists the dead letters of the given webhook.
+	ListDeadLetters(
+		ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, limit, page uint32,
+	) ([]*ttnpb.ApplicationWebhookDelivery, int64, error)
+	// ReplayDeadLetters adds the given dead letters of the webhook back to the queue.
+	// If no dead letters are specified, all dead letters of the webhook are replayed.
+	ReplayDeadLetters(ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, deadLetterIDs []string) error
+	// PurgeDeadLetters removes the given dead letters of the webhook.
+	// If no dead letters are specified, all dead letters of the webhook are removed.
+	PurgeDeadLetters(ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, deadLetterIDs []string) error
+}
+
+var errDeliveryQueueDisabled = errors.DefineFailedPrecondition(
+	"delivery_queue_disabled", "webhook delivery queue disabled",
+)
+
+const (
+	deliveryDispatchTaskName = "webhooks_dispatch_deliveries"
+	deliveryProcessTaskName  = "webhooks_process_deliveries"
+
+	deliveryBackoffJitter = 0.2
+)
+
+var deliveryTaskBackoff = &task.BackoffConfig{
+	Jitter:       task.DefaultBackoffConfig.Jitter,
+	IntervalFunc: task.MakeBackoffIntervalFunc(true, task.DefaultBackoffResetDuration, task.DefaultBackoffIntervals[:]...),
+}
+
+// persistedHeaders are the headers of the requests that are persisted in the delivery queue.
+// The other headers, such as the headers configured in the webhook and the downlink API key, may contain
+// credentials. These are set from the webhook when the request is delivered.
+var persistedHeaders = []string{
+	"Content-Type",
+	downlinkPushHeader,
+	downlinkReplaceHeader,
+	domainHeader,
+}
+
+// queuedSink is a Sink which persists the requests in a DeliveryQueue.
+type queuedSink struct {
+	queue    DeliveryQueue
+	sink     Sink
+	registry WebhookRegistry
+	batches  *batcher
+
+	maxAttempts    int
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+}
+
+// NewQueuedSink returns a Sink that adds the requests to the delivery queue of the configuration.
+// The queued requests are processed by the given sink in consumer tasks, which retry failed requests
+// with exponential backoff and move them to the dead letters once the maximum number of attempts is reached.
+// The requests of webhooks with batching enabled are batched when they are delivered, so that they remain in
+// the delivery queue until the batch is processed. The consumers do not wait for the batches to be processed.
+func NewQueuedSink(
+	ctx context.Context, c task.Starter, sink Sink, registry WebhookRegistry, conf DeliveryQueueConfig,
+) (Sink, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+	s := &queuedSink{
+		queue:          conf.Queue,
+		sink:           sink,
+		registry:       registry,
+		batches:        newBatcher(ctx, c, sink),
+		maxAttempts:    conf.MaxAttempts,
+		initialBackoff: conf.InitialBackoff,
+		maxBackoff:     conf.MaxBackoff,
+	}
+	consumerIDPrefix := fmt.Sprintf("%s:%d", hostname, os.Getpid())
+	c.StartTask(&task.Config{
+		Context: ctx,
+		ID:      deliveryDispatchTaskName,
+		Func: func(ctx context.Context) error {
+			return s.queue.Dispatch(ctx, consumerIDPrefix)
+		},
+		Restart: task.RestartAlways,
+		Backoff: deliveryTaskBackoff,
+	})
+	for i := 0; i < conf.Consumers; i++ {
+		consumerID := fmt.Sprintf("%s:%d", consumerIDPrefix, i)
+		c.StartTask(&task.Config{
+			Context: ctx,
+			ID:      fmt.Sprintf("%s_%d", deliveryProcessTaskName, i),
+			Func: func(ctx context.Context) error {
+				return s.queue.Pop(ctx, consumerID, s.deliver)
+			},
+			Restart: task.RestartAlways,
+			Backoff: deliveryTaskBackoff,
+		})
+	}
+	return s, nil
+}
+
+// Process adds the request to the delivery queue.
+func (s *queuedSink) Process(req *http.Request) error {
+	ctx := req.Context()
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = stdio.ReadAll(req.Body); err != nil {
+			return err
+		}
+	}
+	headers := make(map[string]string, len(persistedHeaders))
+	for _, key := range persistedHeaders {
+		if value := req.Header.Get(key); value != "" {
+			headers[key] = value
+		}
+	}
+	ids := webhookIDFromContext(ctx)
+	if err := s.queue.Add(ctx, &ttnpb.ApplicationWebhookDelivery{
+		Ids:          ids,
+		EndDeviceIds: deviceIDFromContext(ctx),
+		Url:          req.URL.String(),
+		Headers:      headers,
+		Body:         body,
+		CreatedAt:    timestamppb.Now(),
+	}); err != nil {
+		return err
+	}
+	registerWebhookQueued(ctx)
+	return nil
+}
+
+// backoff returns the time to wait before the next attempt, after the given number of attempts.
+func (s *queuedSink) backoff(attempts uint32) time.Duration {
+	d := s.initialBackoff
+	for i := uint32(1); i < attempts && d < s.maxBackoff; i++ {
+		d *= 2
+	}
+	if d > s.maxBackoff {
+		d = s.maxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return random.Jitter(d, deliveryBackoffJitter)
+}
+
+func (*queuedSink) newRequest(
+	ctx context.Context, d *ttnpb.ApplicationWebhookDelivery, hook *ttnpb.ApplicationWebhook,
+) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, d.Url, bytes.NewReader(d.Body))
+	if err != nil {
+		return nil, err
+	}
+	for key, value := range hook.Headers {
+		req.Header.Set(key, value)
+	}
+	for key, value := range d.Headers {
+		req.Header.Set(key, value)
+	}
+	if hook.DownlinkApiKey != "" {
+		req.Header.Set(downlinkKeyHeader, hook.DownlinkApiKey)
+	}
+	return req, nil
+}
+
+// deliver attempts to deliver the queued request.
+// The requests of webhooks with batching enabled are added to the batch of the webhook, and the result is
+// reported when the batch is processed.
+func (s *queuedSink) deliver(ctx context.Context, d *ttnpb.ApplicationWebhookDelivery, done DeliveryDoneFunc) {
+	ctx = log.NewContextWithField(ctx, "namespace", namespace)
+	ctx = withDeviceID(withWebhookID(ctx, d.Ids), d.EndDeviceIds)
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"hook", d.Ids.WebhookId,
+		"attempts", d.Attempts,
+	))
+	now := time.Now()
+
+	hook, err := s.registry.Get(ctx, d.Ids, []string{
+		"batching",
+		"downlink_api_key",
+		"format",
+		"headers",
+		"health_status",
+		"signing_secrets",
+	})
+	switch {
+	case errors.IsNotFound(err):
+		logger.Debug("Webhook not found, drop queued request")
+		done(DeliveryDone, time.Time{})
+		return
+	case err != nil:
+		logger.WithError(err).Warn("Failed to get webhook")
+		done(DeliveryRetry, now.Add(s.backoff(d.Attempts)))
+		return
+	}
+	ctx = WithCachedHealthStatus(ctx, hook.HealthStatus)
+
+	req, err := s.newRequest(ctx, d, hook)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to create request")
+		registerWebhookDeadLettered(ctx, d.Ids)
+		done(DeliveryDeadLetter, time.Time{})
+		return
+	}
+	if batchingEnabled(hook) {
+		logger.WithField("url", req.URL).Debug("Batch queued message")
+		if err := s.batches.add(ctx, hook, req, func(err error) {
+			done(s.result(ctx, d, now, err))
+		}); err != nil {
+			done(s.result(ctx, d, now, err))
+		}
+		return
+	}
+	signRequest(req, d.Body, hook, now)
+	logger.WithField("url", req.URL).Debug("Process queued message")
+	done(s.result(ctx, d, now, s.sink.Process(req)))
+}
+
+// result returns the result of the delivery attempt at the given time, which failed if err is not nil.
+// If the attempt failed, the attempts and the last error of the delivery are updated.
+func (s *queuedSink) result(
+	ctx context.Context, d *ttnpb.ApplicationWebhookDelivery, now time.Time, err error,
+) (DeliveryResult, time.Time) {
+	if err == nil {
+		registerWebhookSent(ctx)
+		return DeliveryDone, time.Time{}
+	}
+	if !errors.Is(err, errWebhookDisabled) {
+		// Requests to disabled webhooks are not attempted, but they count as failed attempts, such that
+		// the requests are moved to the dead letters if the webhook remains disabled.
+		registerWebhookFailed(ctx, err)
+	}
+
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"hook", d.Ids.WebhookId,
+		"attempts", d.Attempts,
+	))
+	d.Attempts++
+	d.LastAttemptAt = timestamppb.New(now)
+	if ttnErr, ok := errors.From(err); ok {
+		d.LastError = ttnpb.ErrorDetailsToProto(ttnErr)
+	}
+	if s.maxAttempts > 0 && int(d.Attempts) >= s.maxAttempts {
+		logger.WithError(err).Warn("Failed to process queued message, move to dead letters")
+		registerWebhookDeadLettered(ctx, d.Ids)
+		return DeliveryDeadLetter, time.Time{}
+	}
+	logger.WithError(err).Debug("Failed to process queued message, retry")
+	registerWebhookRetried(ctx)
+	return DeliveryRetry, now.Add(s.backoff(d.Attempts))
+}
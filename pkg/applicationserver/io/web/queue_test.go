@@ -0,0 +1,321 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"bytes"
+	"context"
+	stdio "io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+// mockDeliveryQueue is an in-memory web.DeliveryQueue with a single pending list.
+// The results of the deliveries are sent to the results channel once they are stored.
+type mockDeliveryQueue struct {
+	mu          sync.Mutex
+	pending     []*ttnpb.ApplicationWebhookDelivery
+	deadLetters []*ttnpb.ApplicationWebhookDelivery
+	retryAt     time.Time
+	results     chan web.DeliveryResult
+}
+
+func (q *mockDeliveryQueue) Add(_ context.Context, d *ttnpb.ApplicationWebhookDelivery) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.pending = append(q.pending, proto.Clone(d).(*ttnpb.ApplicationWebhookDelivery))
+	return nil
+}
+
+func (*mockDeliveryQueue) Dispatch(ctx context.Context, _ string) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (q *mockDeliveryQueue) Pop(ctx context.Context, _ string, f web.DeliveryFunc) error {
+	q.mu.Lock()
+	if len(q.pending) == 0 {
+		q.mu.Unlock()
+		return nil
+	}
+	d := q.pending[0]
+	q.mu.Unlock()
+	f(ctx, d, func(res web.DeliveryResult, retryAt time.Time) {
+		q.mu.Lock()
+		switch res {
+		case web.DeliveryRetry:
+			q.retryAt = retryAt
+		case web.DeliveryDeadLetter:
+			q.deadLetters = append(q.deadLetters, q.pending[0])
+			q.pending = q.pending[1:]
+		default:
+			q.pending = q.pending[1:]
+		}
+		q.mu.Unlock()
+		q.results <- res
+	})
+	return nil
+}
+
+func (q *mockDeliveryQueue) ListDeadLetters(
+	context.Context, *ttnpb.ApplicationWebhookIdentifiers, uint32, uint32,
+) ([]*ttnpb.ApplicationWebhookDelivery, int64, error) {
+	return q.deadLetters, int64(len(q.deadLetters)), nil
+}
+
+func (*mockDeliveryQueue) ReplayDeadLetters(context.Context, *ttnpb.ApplicationWebhookIdentifiers, []string) error {
+	return nil
+}
+
+func (*mockDeliveryQueue) PurgeDeadLetters(context.Context, *ttnpb.ApplicationWebhookIdentifiers, []string) error {
+	return nil
+}
+
+var errMockNotFound = errors.DefineNotFound("mock_not_found", "not found")
+
+// mockWebhookRegistry is a web.WebhookRegistry which contains at most one webhook.
+type mockWebhookRegistry struct {
+	web.WebhookRegistry
+	webhook *ttnpb.ApplicationWebhook
+}
+
+func (r *mockWebhookRegistry) Get(
+	context.Context, *ttnpb.ApplicationWebhookIdentifiers, []string,
+) (*ttnpb.ApplicationWebhook, error) {
+	if r.webhook == nil {
+		return nil, errMockNotFound.New()
+	}
+	return proto.Clone(r.webhook).(*ttnpb.ApplicationWebhook), nil
+}
+
+func (r *mockWebhookRegistry) Set(
+	_ context.Context,
+	_ *ttnpb.ApplicationWebhookIdentifiers,
+	_ []string,
+	f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error),
+) (*ttnpb.ApplicationWebhook, error) {
+	var stored *ttnpb.ApplicationWebhook
+	if r.webhook != nil {
+		stored = proto.Clone(r.webhook).(*ttnpb.ApplicationWebhook)
+	}
+	hook, sets, err := f(stored)
+	if err != nil {
+		return nil, err
+	}
+	if hook == nil {
+		r.webhook = nil
+		return nil, nil
+	}
+	if r.webhook == nil {
+		r.webhook = &ttnpb.ApplicationWebhook{}
+	}
+	if err := r.webhook.SetFields(hook, sets...); err != nil {
+		return nil, err
+	}
+	return proto.Clone(r.webhook).(*ttnpb.ApplicationWebhook), nil
+}
+
+type sinkFunc func(*http.Request) error
+
+func (f sinkFunc) Process(req *http.Request) error { return f(req) }
+
+// taskRecorder records the started tasks of the delivery queue instead of running them.
+// The other tasks are run.
+type taskRecorder struct {
+	tasks map[string]*task.Config
+}
+
+func (r *taskRecorder) StartTask(conf *task.Config) {
+	if !strings.HasPrefix(conf.ID, "webhooks_") {
+		go conf.Func(conf.Context) //nolint:errcheck
+		return
+	}
+	r.tasks[conf.ID] = conf
+}
+
+func TestQueuedSink(t *testing.T) {
+	a, ctx := test.New(t)
+
+	var (
+		requests []*http.Request
+		bodies   [][]byte
+		sinkErr  error
+	)
+	sink := sinkFunc(func(req *http.Request) error {
+		body, err := stdio.ReadAll(req.Body)
+		if err != nil {
+			return err
+		}
+		requests = append(requests, req)
+		bodies = append(bodies, body)
+		return sinkErr
+	})
+	queue := &mockDeliveryQueue{results: make(chan web.DeliveryResult, 1)}
+	registry := &mockWebhookRegistry{
+		webhook: &ttnpb.ApplicationWebhook{
+			Ids:     registeredWebhookIDs,
+			Headers: map[string]string{"Authorization": "Bearer secret"},
+		},
+	}
+	starter := &taskRecorder{tasks: make(map[string]*task.Config)}
+	queued, err := web.NewQueuedSink(ctx, starter, sink, registry, web.DeliveryQueueConfig{
+		Queue:          queue,
+		Consumers:      1,
+		MaxAttempts:    3,
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Minute,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(starter.tasks, should.ContainKey, "webhooks_dispatch_deliveries")
+	consumer, ok := starter.tasks["webhooks_process_deliveries_0"]
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+
+	// pop attempts the next delivery, and waits until the result is stored.
+	pop := func() web.DeliveryResult {
+		t.Helper()
+		if !a.So(consumer.Func(ctx), should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case res := <-queue.results:
+			return res
+		case <-time.After(test.Delay << 8):
+			t.Fatal("Delivery result not stored")
+			return 0
+		}
+	}
+
+	process := func(body string) {
+		t.Helper()
+		reqCtx := web.WithDeviceID(web.WithWebhookID(ctx, registeredWebhookIDs), registeredDeviceID)
+		req, err := http.NewRequestWithContext(
+			reqCtx, http.MethodPost, "https://example.com/uplink", bytes.NewBufferString(body),
+		)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Authorization", "Bearer secret")
+		if !a.So(queued.Process(req), should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	// Requests are queued, not sent. The headers which may contain credentials are not persisted.
+	process(`{"foo":"bar"}`)
+	process(`{"bar":"baz"}`)
+	a.So(requests, should.BeEmpty)
+	if !a.So(queue.pending, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(queue.pending[0].Ids, should.Resemble, registeredWebhookIDs)
+	a.So(queue.pending[0].EndDeviceIds, should.Resemble, registeredDeviceID)
+	a.So(queue.pending[0].Url, should.Equal, "https://example.com/uplink")
+	a.So(queue.pending[0].Headers, should.Resemble, map[string]string{"Content-Type": "application/json"})
+	a.So(queue.pending[0].Body, should.Resemble, []byte(`{"foo":"bar"}`))
+
+	// Successful deliveries are removed from the queue. The requests are signed and the headers of the webhook
+	// are set at delivery time.
+	secret := bytes.Repeat([]byte{0x42}, 16)
+	registry.webhook.SigningSecrets = []*ttnpb.Secret{{Value: secret}}
+	a.So(pop(), should.Equal, web.DeliveryDone)
+	if !a.So(requests, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(web.VerifyRequestSignature(requests[0], bodies[0], [][]byte{secret}, time.Now()), should.BeNil)
+	a.So(requests[0].Header.Get("Content-Type"), should.Equal, "application/json")
+	a.So(requests[0].Header.Get("Authorization"), should.Equal, "Bearer secret")
+	a.So(requests[0].URL.String(), should.Equal, "https://example.com/uplink")
+	a.So(bodies[0], should.Resemble, []byte(`{"foo":"bar"}`))
+	a.So(queue.pending, should.HaveLength, 1)
+
+	// Failed deliveries are retried with backoff, until they are moved to the dead letters.
+	sinkErr = errors.DefineUnavailable("test", "test").New()
+	for i := 1; i < 3; i++ {
+		start := time.Now()
+		a.So(pop(), should.Equal, web.DeliveryRetry)
+		if !a.So(queue.pending, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		a.So(queue.pending[0].Attempts, should.Equal, i)
+		a.So(queue.pending[0].LastError, should.NotBeNil)
+		a.So(queue.retryAt, should.HappenAfter, start)
+	}
+	a.So(pop(), should.Equal, web.DeliveryDeadLetter)
+	a.So(queue.pending, should.BeEmpty)
+	if !a.So(queue.deadLetters, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(queue.deadLetters[0].Attempts, should.Equal, 3)
+	a.So(queue.deadLetters[0].Body, should.Resemble, []byte(`{"bar":"baz"}`))
+	a.So(bodies, should.HaveLength, 4)
+
+	// Deliveries of deleted webhooks are dropped.
+	process(`{"baz":"qux"}`)
+	registry.webhook = nil
+	a.So(pop(), should.Equal, web.DeliveryDone)
+	a.So(queue.pending, should.BeEmpty)
+	a.So(bodies, should.HaveLength, 4)
+
+	// Requests of webhooks with batching enabled are batched when they are delivered, and the batch is signed.
+	// The consumer does not wait until the batch is processed, and the delivery remains queued until then.
+	registry.webhook = &ttnpb.ApplicationWebhook{
+		Ids:            registeredWebhookIDs,
+		Format:         "json",
+		Headers:        map[string]string{"Authorization": "Bearer secret"},
+		SigningSecrets: []*ttnpb.Secret{{Value: secret}},
+		Batching: &ttnpb.ApplicationWebhook_Batching{
+			MaxMessages: 10,
+			MaxDelay:    durationpb.New(test.Delay << 3),
+		},
+	}
+	sinkErr = nil
+	process(`{"qux":"quux"}`)
+	a.So(consumer.Func(ctx), should.BeNil)
+	queue.mu.Lock()
+	a.So(queue.pending, should.HaveLength, 1)
+	queue.mu.Unlock()
+	select {
+	case res := <-queue.results:
+		a.So(res, should.Equal, web.DeliveryDone)
+	case <-time.After(test.Delay << 8):
+		t.Fatal("Batch not processed")
+	}
+	a.So(queue.pending, should.BeEmpty)
+	if !a.So(requests, should.HaveLength, 5) {
+		t.FailNow()
+	}
+	a.So(bodies[4], should.Resemble, []byte(`[{"qux":"quux"}]`))
+	a.So(requests[4].Header.Get("Content-Type"), should.Equal, "application/json")
+	a.So(requests[4].Header.Get(web.BatchSizeHeader), should.Equal, "1")
+	a.So(requests[4].Header.Get("Authorization"), should.Equal, "Bearer secret")
+	a.So(web.VerifyRequestSignature(requests[4], bodies[4], [][]byte{secret}, time.Now()), should.BeNil)
+}
@@ -0,0 +1,466 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errDeliveryQueueFull = errors.DefineResourceExhausted(
+		"delivery_queue_full", "delivery queue of webhook `{webhook_id}` for end device `{device_uid}` is full",
+	)
+	errInvalidPayload = errors.DefineCorruption("invalid_payload", "invalid task payload `{payload}`")
+)
+
+const (
+	deliveryTasksKey = "tasks"
+	pendingKey       = "pending"
+	tokenKey         = "token"
+	deadLettersKey   = "dead-letters"
+
+	deadLetterField = "delivery"
+
+	payloadSeparator = ":"
+	tokenLength      = 16
+
+	// deliveryLeaseTimeout is the time after which a delivery is attempted again if the result of the attempt
+	// is not reported. It exceeds the maximum batching delay of webhooks and the timeout of the requests.
+	deliveryLeaseTimeout = 5 * time.Minute
+	// deliveryReportTimeout is the timeout for storing the result of a delivery attempt.
+	deliveryReportTimeout = 10 * time.Second
+)
+
+// enqueueScript appends ARGV[1] to the pending list at KEYS[1] if the list contains less than ARGV[2] items.
+// If the list was empty, the token at KEYS[3] is set to ARGV[5] and a task with payload ARGV[3] and
+// start time ARGV[4] is added to the input stream at KEYS[2].
+// The script returns the new length of the list, or -1 if the list is full.
+var enqueueScript = redis.NewScript(`local max = tonumber(ARGV[2])
+if max > 0 and redis.call('llen', KEYS[1]) >= max then
+	return -1
+end
+local n = redis.call('rpush', KEYS[1], ARGV[1])
+if n == 1 then
+	redis.call('set', KEYS[3], ARGV[5])
+	redis.call('xadd', KEYS[2], '*', 'payload', ARGV[3], 'start_at', ARGV[4])
+end
+return n`)
+
+// completeScript removes the head of the pending list at KEYS[1] if the token at KEYS[3] equals ARGV[1].
+// If KEYS[4] is set, the head is added to the dead letter stream at KEYS[4] as ARGV[5], which is trimmed to
+// approximately ARGV[6] items. If the list is not empty afterwards, the token is set to ARGV[4] and a task
+// with payload ARGV[2] and start time ARGV[3] is added to the input stream at KEYS[2].
+// The script returns 0 if the token does not match, and 1 otherwise.
+var completeScript = redis.NewScript(`if (redis.call('get', KEYS[3]) or '') ~= ARGV[1] then
+	return 0
+end
+redis.call('lpop', KEYS[1])
+if #KEYS > 3 then
+	if tonumber(ARGV[6]) > 0 then
+		redis.call('xadd', KEYS[4], 'maxlen', '~', ARGV[6], '*', 'delivery', ARGV[5])
+	else
+		redis.call('xadd', KEYS[4], '*', 'delivery', ARGV[5])
+	end
+end
+if redis.call('llen', KEYS[1]) > 0 then
+	redis.call('set', KEYS[3], ARGV[4])
+	redis.call('xadd', KEYS[2], '*', 'payload', ARGV[2], 'start_at', ARGV[3])
+else
+	redis.call('del', KEYS[3])
+end
+return 1`)
+
+// retryScript sets the token at KEYS[3] to ARGV[4] and adds a task with payload ARGV[2] and start time ARGV[3]
+// to the input stream at KEYS[2], if the token equals ARGV[1] and the pending list at KEYS[1] is not empty.
+// If ARGV[5] is not empty, the head of the pending list is replaced with ARGV[5].
+// The script returns 0 if the token does not match or the list is empty, and 1 otherwise.
+var retryScript = redis.NewScript(`if (redis.call('get', KEYS[3]) or '') ~= ARGV[1] then
+	return 0
+end
+if redis.call('llen', KEYS[1]) == 0 then
+	return 0
+end
+if ARGV[5] ~= '' then
+	redis.call('lset', KEYS[1], 0, ARGV[5])
+end
+redis.call('set', KEYS[3], ARGV[4])
+redis.call('xadd', KEYS[2], '*', 'payload', ARGV[2], 'start_at', ARGV[3])
+return 1`)
+
+// DeliveryQueue is an implementation of web.DeliveryQueue.
+// The deliveries of each webhook and end device are stored in a pending list, of which the head is being attempted.
+// There is at most one valid task per non-empty pending list, so that the deliveries are attempted in order.
+// The task streams are never trimmed, as trimming could drop the only task of a pending list.
+//
+// Each task carries the token of its pending list, which changes whenever a task for the list is added.
+// Tasks of which the token does not match are stale and are dropped. As the result of a delivery attempt may be
+// reported after the task is acknowledged, each attempt is leased: a task which retries the delivery after
+// deliveryLeaseTimeout is added before the attempt, which becomes stale once the result is reported.
+type DeliveryQueue struct {
+	redis          *ttnredis.Client
+	queue          *ttnredis.TaskQueue
+	maxPending     int64
+	maxDeadLetters int64
+}
+
+var _ web.DeliveryQueue = (*DeliveryQueue)(nil)
+
+// NewDeliveryQueue returns a new webhook delivery queue.
+// maxPending is the maximum number of queued deliveries per webhook and end device.
+// maxDeadLetters is the approximate maximum number of dead letters per webhook.
+func NewDeliveryQueue(
+	cl *ttnredis.Client, maxPending, maxDeadLetters int64, group string, streamBlockLimit time.Duration,
+) *DeliveryQueue {
+	return &DeliveryQueue{
+		redis: cl,
+		queue: &ttnredis.TaskQueue{
+			Redis:            cl,
+			Group:            group,
+			Key:              cl.Key(deliveryTasksKey),
+			StreamBlockLimit: streamBlockLimit,
+		},
+		maxPending:     maxPending,
+		maxDeadLetters: maxDeadLetters,
+	}
+}
+
+// Init initializes the DeliveryQueue.
+func (q *DeliveryQueue) Init(ctx context.Context) error {
+	return q.queue.Init(ctx)
+}
+
+// Close closes the DeliveryQueue.
+func (q *DeliveryQueue) Close(ctx context.Context) error {
+	return q.queue.Close(ctx)
+}
+
+// listKey returns the key of the pending list of the webhook and end device, which is used in the redis keys.
+func listKey(ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, devID *ttnpb.EndDeviceIdentifiers) string {
+	return strings.Join([]string{unique.ID(ctx, ids.ApplicationIds), ids.WebhookId, devID.DeviceId}, payloadSeparator)
+}
+
+// taskPayload returns the payload of the task of the pending list with the given token.
+func taskPayload(listKey, token string) string {
+	return listKey + payloadSeparator + token
+}
+
+// parseTaskPayload parses the task payload. The payloads of tasks without token have three parts.
+func parseTaskPayload(
+	payload string,
+) (*ttnpb.ApplicationWebhookIdentifiers, *ttnpb.EndDeviceIdentifiers, string, error) {
+	parts := strings.Split(payload, payloadSeparator)
+	if len(parts) != 3 && len(parts) != 4 {
+		return nil, nil, "", errInvalidPayload.WithAttributes("payload", payload)
+	}
+	appIDs, err := unique.ToApplicationID(parts[0])
+	if err != nil {
+		return nil, nil, "", errInvalidPayload.WithAttributes("payload", payload).WithCause(err)
+	}
+	var token string
+	if len(parts) == 4 {
+		token = parts[3]
+	}
+	return &ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIds: appIDs,
+		WebhookId:      parts[1],
+	}, &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appIDs,
+		DeviceId:       parts[2],
+	}, token, nil
+}
+
+func newToken() string {
+	return random.String(tokenLength)
+}
+
+func (q *DeliveryQueue) pendingKey(listKey string) string {
+	return q.redis.Key(pendingKey, listKey)
+}
+
+func (q *DeliveryQueue) tokenKey(listKey string) string {
+	return q.redis.Key(tokenKey, listKey)
+}
+
+func (q *DeliveryQueue) deadLettersKey(ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers) string {
+	return q.redis.Key(deadLettersKey, unique.ID(ctx, ids.ApplicationIds), ids.WebhookId)
+}
+
+func (q *DeliveryQueue) enqueue(ctx context.Context, d *ttnpb.ApplicationWebhookDelivery) error {
+	s, err := ttnredis.MarshalProto(d)
+	if err != nil {
+		return err
+	}
+	lk, token := listKey(ctx, d.Ids, d.EndDeviceIds), newToken()
+	n, err := enqueueScript.Run(ctx, q.redis,
+		[]string{q.pendingKey(lk), ttnredis.InputTaskKey(q.queue.Key), q.tokenKey(lk)},
+		s, q.maxPending, taskPayload(lk, token), time.Now().UnixNano(), token,
+	).Int64()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	if n < 0 {
+		return errDeliveryQueueFull.WithAttributes(
+			"webhook_id", d.Ids.WebhookId,
+			"device_uid", unique.ID(ctx, d.EndDeviceIds),
+		)
+	}
+	return nil
+}
+
+// complete removes the head of the pending list if the token matches.
+// If deadLetter is set, the head is moved to the dead letters of the webhook.
+func (q *DeliveryQueue) complete(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, lk, token string,
+	deadLetter *ttnpb.ApplicationWebhookDelivery,
+) error {
+	next := newToken()
+	keys := []string{q.pendingKey(lk), ttnredis.InputTaskKey(q.queue.Key), q.tokenKey(lk)}
+	args := []any{token, taskPayload(lk, next), time.Now().UnixNano(), next}
+	if deadLetter != nil {
+		s, err := ttnredis.MarshalProto(deadLetter)
+		if err != nil {
+			return err
+		}
+		keys = append(keys, q.deadLettersKey(ctx, ids))
+		args = append(args, s, q.maxDeadLetters)
+	}
+	return ttnredis.ConvertError(completeScript.Run(ctx, q.redis, keys, args...).Err())
+}
+
+// retry attempts the head of the pending list again at the given time if the token matches, and returns the
+// new token of the pending list. If d is set, the head is replaced with d.
+// If the token does not match or the pending list is empty, retry returns false.
+func (q *DeliveryQueue) retry(
+	ctx context.Context, lk, token string, at time.Time, d *ttnpb.ApplicationWebhookDelivery,
+) (string, bool, error) {
+	var s string
+	if d != nil {
+		var err error
+		if s, err = ttnredis.MarshalProto(d); err != nil {
+			return "", false, err
+		}
+	}
+	next := newToken()
+	n, err := retryScript.Run(ctx, q.redis,
+		[]string{q.pendingKey(lk), ttnredis.InputTaskKey(q.queue.Key), q.tokenKey(lk)},
+		token, taskPayload(lk, next), at.UnixNano(), next, s,
+	).Int64()
+	if err != nil {
+		return "", false, ttnredis.ConvertError(err)
+	}
+	return next, n == 1, nil
+}
+
+// Add implements web.DeliveryQueue.
+func (q *DeliveryQueue) Add(ctx context.Context, d *ttnpb.ApplicationWebhookDelivery) error {
+	return q.enqueue(ctx, d)
+}
+
+// Dispatch implements web.DeliveryQueue.
+func (q *DeliveryQueue) Dispatch(ctx context.Context, consumerID string) error {
+	return q.queue.Dispatch(ctx, consumerID, nil)
+}
+
+// attempt is a leased delivery attempt of the head of a pending list.
+type attempt struct {
+	q     *DeliveryQueue
+	ctx   context.Context
+	ids   *ttnpb.ApplicationWebhookIdentifiers
+	lk    string
+	token string
+	d     *ttnpb.ApplicationWebhookDelivery
+}
+
+// report stores the result of the attempt.
+// The result may be reported after the context of the attempt is done, so it is stored with a new context.
+func (a *attempt) report(res web.DeliveryResult, retryAt time.Time) {
+	logger := log.FromContext(a.ctx)
+	ctx, cancel := context.WithTimeout(log.NewContext(context.Background(), logger), deliveryReportTimeout)
+	defer cancel()
+	var err error
+	switch res {
+	case web.DeliveryRetry:
+		_, _, err = a.q.retry(ctx, a.lk, a.token, retryAt, a.d)
+	case web.DeliveryDeadLetter:
+		err = a.q.complete(ctx, a.ids, a.lk, a.token, a.d)
+	default:
+		err = a.q.complete(ctx, a.ids, a.lk, a.token, nil)
+	}
+	if err != nil {
+		// The delivery is attempted again once the lease expires.
+		logger.WithError(err).Warn("Failed to store delivery result")
+	}
+}
+
+// Pop implements web.DeliveryQueue.
+// Before the delivery is attempted, the attempt is leased: the task is replaced by a task which retries the
+// delivery after deliveryLeaseTimeout, so that the delivery is attempted again if the result is never reported.
+func (q *DeliveryQueue) Pop(ctx context.Context, consumerID string, f web.DeliveryFunc) error {
+	return q.queue.Pop(ctx, consumerID, nil, func(_ redis.Pipeliner, payload string, _ time.Time) error {
+		ids, devIDs, token, err := parseTaskPayload(payload)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Drop invalid delivery task")
+			return nil
+		}
+		lk := listKey(ctx, ids, devIDs)
+		token, ok, err := q.retry(ctx, lk, token, time.Now().Add(deliveryLeaseTimeout), nil)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			// The task is stale, or the pending deliveries have been removed.
+			return nil
+		}
+		s, err := q.redis.LIndex(ctx, q.pendingKey(lk), 0).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				// The pending deliveries have been removed.
+				return nil
+			}
+			return ttnredis.ConvertError(err)
+		}
+		d := &ttnpb.ApplicationWebhookDelivery{}
+		if err := ttnredis.UnmarshalProto(s, d); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Drop invalid delivery")
+			return q.complete(ctx, ids, lk, token, nil)
+		}
+		d.Ids, d.EndDeviceIds = ids, devIDs
+
+		a := &attempt{q: q, ctx: ctx, ids: ids, lk: lk, token: token, d: d}
+		f(ctx, d, a.report)
+		return nil
+	})
+}
+
+func parseDeadLetter(msg redis.XMessage) (*ttnpb.ApplicationWebhookDelivery, error) {
+	s, _ := msg.Values[deadLetterField].(string)
+	d := &ttnpb.ApplicationWebhookDelivery{}
+	if err := ttnredis.UnmarshalProto(s, d); err != nil {
+		return nil, err
+	}
+	d.Id = msg.ID
+	return d, nil
+}
+
+func (q *DeliveryQueue) rangeDeadLetters(
+	ctx context.Context, k string, deadLetterIDs []string,
+) ([]redis.XMessage, error) {
+	if len(deadLetterIDs) == 0 {
+		msgs, err := q.redis.XRange(ctx, k, "-", "+").Result()
+		if err != nil {
+			return nil, ttnredis.ConvertError(err)
+		}
+		return msgs, nil
+	}
+	cmds := make([]*redis.XMessageSliceCmd, 0, len(deadLetterIDs))
+	if _, err := q.redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for _, id := range deadLetterIDs {
+			cmds = append(cmds, p.XRange(ctx, k, id, id))
+		}
+		return nil
+	}); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	msgs := make([]redis.XMessage, 0, len(deadLetterIDs))
+	for _, cmd := range cmds {
+		msgs = append(msgs, cmd.Val()...)
+	}
+	return msgs, nil
+}
+
+// ListDeadLetters implements web.DeliveryQueue.
+// The dead letters are returned from newest to oldest.
+func (q *DeliveryQueue) ListDeadLetters(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, limit, page uint32,
+) ([]*ttnpb.ApplicationWebhookDelivery, int64, error) {
+	k := q.deadLettersKey(ctx, ids)
+	total, err := q.redis.XLen(ctx, k).Result()
+	if err != nil {
+		return nil, 0, ttnredis.ConvertError(err)
+	}
+	if page == 0 {
+		page = 1
+	}
+	var msgs []redis.XMessage
+	if limit == 0 {
+		msgs, err = q.redis.XRevRange(ctx, k, "+", "-").Result()
+	} else {
+		msgs, err = q.redis.XRevRangeN(ctx, k, "+", "-", int64(limit)*int64(page)).Result()
+		if offset := int(limit) * int(page-1); offset < len(msgs) {
+			msgs = msgs[offset:]
+		} else {
+			msgs = nil
+		}
+	}
+	if err != nil {
+		return nil, 0, ttnredis.ConvertError(err)
+	}
+	deliveries := make([]*ttnpb.ApplicationWebhookDelivery, 0, len(msgs))
+	for _, msg := range msgs {
+		d, err := parseDeadLetter(msg)
+		if err != nil {
+			return nil, 0, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, total, nil
+}
+
+// ReplayDeadLetters implements web.DeliveryQueue.
+func (q *DeliveryQueue) ReplayDeadLetters(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, deadLetterIDs []string,
+) error {
+	k := q.deadLettersKey(ctx, ids)
+	msgs, err := q.rangeDeadLetters(ctx, k, deadLetterIDs)
+	if err != nil {
+		return err
+	}
+	for _, msg := range msgs {
+		d, err := parseDeadLetter(msg)
+		if err != nil {
+			return err
+		}
+		d.Id, d.Attempts, d.LastAttemptAt, d.LastError = "", 0, nil, nil
+		if err := q.enqueue(ctx, d); err != nil {
+			return err
+		}
+		if err := q.redis.XDel(ctx, k, msg.ID).Err(); err != nil {
+			return ttnredis.ConvertError(err)
+		}
+	}
+	return nil
+}
+
+// PurgeDeadLetters implements web.DeliveryQueue.
+func (q *DeliveryQueue) PurgeDeadLetters(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, deadLetterIDs []string,
+) error {
+	k := q.deadLettersKey(ctx, ids)
+	if len(deadLetterIDs) == 0 {
+		return ttnredis.ConvertError(q.redis.Del(ctx, k).Err())
+	}
+	return ttnredis.ConvertError(q.redis.XDel(ctx, k, deadLetterIDs...).Err())
+}
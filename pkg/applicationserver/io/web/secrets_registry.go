@@ -0,0 +1,159 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/proto"
+)
+
+// secretsRegistry is a WebhookRegistry which encrypts the signing secrets of the webhooks at rest.
+type secretsRegistry struct {
+	WebhookRegistry
+	keys  crypto.KeyService
+	keyID string
+}
+
+// NewSecretsRegistry returns a WebhookRegistry that encrypts the signing secrets of the webhooks with the key
+// identified by keyID before they are stored, and decrypts them when they are retrieved.
+// If keyID is empty, the signing secrets are stored in plaintext.
+func NewSecretsRegistry(registry WebhookRegistry, keys crypto.KeyService, keyID string) WebhookRegistry {
+	return &secretsRegistry{
+		WebhookRegistry: registry,
+		keys:            keys,
+		keyID:           keyID,
+	}
+}
+
+func (r *secretsRegistry) decrypt(ctx context.Context, hook *ttnpb.ApplicationWebhook) error {
+	for _, secret := range hook.GetSigningSecrets() {
+		if secret.KeyId == "" {
+			continue
+		}
+		value, err := r.keys.Decrypt(ctx, secret.Value, secret.KeyId)
+		if err != nil {
+			return err
+		}
+		secret.Value, secret.KeyId = value, ""
+	}
+	return nil
+}
+
+func (r *secretsRegistry) encrypt(
+	ctx context.Context, hook *ttnpb.ApplicationWebhook,
+) (*ttnpb.ApplicationWebhook, error) {
+	if len(hook.SigningSecrets) == 0 {
+		return hook, nil
+	}
+	if r.keyID == "" {
+		return hook, nil
+	}
+	// The webhook may be owned by the caller, so the secrets are encrypted in a copy.
+	hook = proto.Clone(hook).(*ttnpb.ApplicationWebhook)
+	for _, secret := range hook.SigningSecrets {
+		value, err := r.keys.Encrypt(ctx, secret.Value, r.keyID)
+		if err != nil {
+			return nil, err
+		}
+		secret.Value, secret.KeyId = value, r.keyID
+	}
+	return hook, nil
+}
+
+// Get implements WebhookRegistry.
+func (r *secretsRegistry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, paths []string,
+) (*ttnpb.ApplicationWebhook, error) {
+	hook, err := r.WebhookRegistry.Get(ctx, ids, paths)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.decrypt(ctx, hook); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+// List implements WebhookRegistry.
+func (r *secretsRegistry) List(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, paths []string,
+) ([]*ttnpb.ApplicationWebhook, error) {
+	hooks, err := r.WebhookRegistry.List(ctx, ids, paths)
+	if err != nil {
+		return nil, err
+	}
+	for _, hook := range hooks {
+		if err := r.decrypt(ctx, hook); err != nil {
+			return nil, err
+		}
+	}
+	return hooks, nil
+}
+
+// Set implements WebhookRegistry.
+func (r *secretsRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.ApplicationWebhookIdentifiers,
+	paths []string,
+	f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error),
+) (*ttnpb.ApplicationWebhook, error) {
+	hook, err := r.WebhookRegistry.Set(ctx, ids, paths,
+		func(stored *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			if stored != nil {
+				if err := r.decrypt(ctx, stored); err != nil {
+					return nil, nil, err
+				}
+			}
+			hook, sets, err := f(stored)
+			if err != nil || hook == nil || !ttnpb.HasAnyField(sets, "signing_secrets") {
+				return hook, sets, err
+			}
+			hook, err = r.encrypt(ctx, hook)
+			if err != nil {
+				return nil, nil, err
+			}
+			return hook, sets, nil
+		},
+	)
+	if err != nil || hook == nil {
+		return hook, err
+	}
+	if err := r.decrypt(ctx, hook); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+// Range implements WebhookRegistry.
+// Webhooks of which the signing secrets cannot be decrypted are skipped.
+func (r *secretsRegistry) Range(
+	ctx context.Context,
+	paths []string,
+	f func(context.Context, *ttnpb.ApplicationIdentifiers, *ttnpb.ApplicationWebhook) bool,
+) error {
+	return r.WebhookRegistry.Range(ctx, paths,
+		func(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, hook *ttnpb.ApplicationWebhook) bool {
+			if err := r.decrypt(ctx, hook); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to decrypt webhook signing secrets")
+				return true
+			}
+			return f(ctx, ids, hook)
+		},
+	)
+}
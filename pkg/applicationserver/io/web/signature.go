@@ -0,0 +1,172 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// SignatureHeader is the HTTP header which contains the signature of signed webhook requests.
+	// The value has the form `t=<timestamp>,w=<window>,v1=<signature>[,v1=<signature>]`, where timestamp is the
+	// Unix time in seconds at which the request was signed, window is the replay window in seconds within which the
+	// receiver should accept the request, and each signature is the hex encoded HMAC-SHA256 of `<timestamp>.<body>`
+	// with one of the signing secrets of the webhook.
+	SignatureHeader = "X-Tts-Signature"
+
+	// SignatureReplayWindow is the replay window advertised in the signature header.
+	// It is also the maximum replay window that is accepted when verifying signatures.
+	SignatureReplayWindow = 5 * time.Minute
+
+	// MinSigningSecretLength is the minimum length of webhook signing secrets.
+	MinSigningSecretLength = 16
+
+	signatureTimestampKey = "t"
+	signatureWindowKey    = "w"
+	signatureV1Key        = "v1"
+)
+
+var (
+	errNoSignature          = errors.DefineUnauthenticated("no_signature", "no signature")
+	errInvalidSignature     = errors.DefineInvalidArgument("invalid_signature", "invalid signature header")
+	errSignatureExpired     = errors.DefineUnauthenticated("signature_expired", "signature expired")
+	errSignatureMismatch    = errors.DefineUnauthenticated("signature_mismatch", "signature mismatch")
+	errInvalidSigningSecret = errors.DefineInvalidArgument(
+		"invalid_signing_secret", "signing secret must be at least `{min_length}` bytes",
+	)
+)
+
+func computeSignature(secret []byte, timestamp string, body []byte) []byte {
+	h := hmac.New(sha256.New, secret)
+	h.Write([]byte(timestamp)) //nolint:errcheck
+	h.Write([]byte{'.'})       //nolint:errcheck
+	h.Write(body)              //nolint:errcheck
+	return h.Sum(nil)
+}
+
+// Signature returns the value of the signature header for the given body, signed with each of the secrets at time t.
+func Signature(body []byte, secrets [][]byte, t time.Time) string {
+	timestamp := strconv.FormatInt(t.Unix(), 10)
+	parts := make([]string, 0, 2+len(secrets))
+	parts = append(parts,
+		signatureTimestampKey+"="+timestamp,
+		signatureWindowKey+"="+strconv.FormatInt(int64(SignatureReplayWindow/time.Second), 10),
+	)
+	for _, secret := range secrets {
+		parts = append(parts, signatureV1Key+"="+hex.EncodeToString(computeSignature(secret, timestamp, body)))
+	}
+	return strings.Join(parts, ",")
+}
+
+// VerifySignature verifies the signature header value against the body, using any of the given secrets.
+// The signature must have been created within the replay window before now. The replay window of the header is
+// capped at SignatureReplayWindow, so that replayed requests cannot extend it.
+func VerifySignature(header string, body []byte, secrets [][]byte, now time.Time) error {
+	if header == "" {
+		return errNoSignature.New()
+	}
+	var (
+		timestamp  string
+		window     = SignatureReplayWindow
+		signatures [][]byte
+	)
+	for _, part := range strings.Split(header, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return errInvalidSignature.New()
+		}
+		switch key {
+		case signatureTimestampKey:
+			timestamp = value
+		case signatureWindowKey:
+			seconds, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || seconds < 0 {
+				return errInvalidSignature.WithCause(err)
+			}
+			if seconds < int64(SignatureReplayWindow/time.Second) {
+				window = time.Duration(seconds) * time.Second
+			}
+		case signatureV1Key:
+			signature, err := hex.DecodeString(value)
+			if err != nil {
+				return errInvalidSignature.WithCause(err)
+			}
+			signatures = append(signatures, signature)
+		}
+	}
+	if timestamp == "" || len(signatures) == 0 {
+		return errInvalidSignature.New()
+	}
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errInvalidSignature.WithCause(err)
+	}
+	if d := now.Sub(time.Unix(seconds, 0)); d > window || d < -window {
+		return errSignatureExpired.New()
+	}
+	for _, secret := range secrets {
+		expected := computeSignature(secret, timestamp, body)
+		for _, signature := range signatures {
+			if hmac.Equal(expected, signature) {
+				return nil
+			}
+		}
+	}
+	return errSignatureMismatch.New()
+}
+
+// VerifyRequestSignature verifies the signature of the request, using any of the given secrets.
+// See VerifySignature.
+func VerifyRequestSignature(req *http.Request, body []byte, secrets [][]byte, now time.Time) error {
+	return VerifySignature(req.Header.Get(SignatureHeader), body, secrets, now)
+}
+
+func signingSecrets(hook *ttnpb.ApplicationWebhook) [][]byte {
+	secrets := make([][]byte, 0, len(hook.GetSigningSecrets()))
+	for _, secret := range hook.GetSigningSecrets() {
+		if len(secret.GetValue()) > 0 {
+			secrets = append(secrets, secret.Value)
+		}
+	}
+	return secrets
+}
+
+// signRequest sets the signature header of the request if the webhook has signing secrets.
+func signRequest(req *http.Request, body []byte, hook *ttnpb.ApplicationWebhook, t time.Time) {
+	secrets := signingSecrets(hook)
+	if len(secrets) == 0 {
+		req.Header.Del(SignatureHeader)
+		return
+	}
+	req.Header.Set(SignatureHeader, Signature(body, secrets, t))
+}
+
+func validateSigningSecrets(secrets []*ttnpb.Secret) error {
+	for _, secret := range secrets {
+		if len(secret.GetValue()) < MinSigningSecretLength {
+			return errInvalidSigningSecret.WithAttributes("min_length", MinSigningSecretLength)
+		}
+	}
+	return nil
+}
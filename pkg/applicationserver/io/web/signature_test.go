@@ -0,0 +1,184 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestSignature(t *testing.T) {
+	t.Parallel()
+
+	var (
+		body      = []byte(`{"uplink_message":{}}`)
+		oldSecret = bytes.Repeat([]byte{0x01}, 32)
+		newSecret = bytes.Repeat([]byte{0x02}, 32)
+		signedAt  = time.Unix(1700000000, 0)
+	)
+
+	for _, tc := range []struct {
+		Name       string
+		Header     string
+		Body       []byte
+		Secrets    [][]byte
+		Now        time.Time
+		ErrorCheck func(error) bool
+	}{
+		{
+			Name:    "Valid",
+			Header:  web.Signature(body, [][]byte{newSecret}, signedAt),
+			Body:    body,
+			Secrets: [][]byte{newSecret},
+			Now:     signedAt.Add(time.Minute),
+		},
+		{
+			Name:    "RotatedReceiverOld",
+			Header:  web.Signature(body, [][]byte{newSecret, oldSecret}, signedAt),
+			Body:    body,
+			Secrets: [][]byte{oldSecret},
+			Now:     signedAt,
+		},
+		{
+			Name:    "RotatedReceiverNew",
+			Header:  web.Signature(body, [][]byte{newSecret, oldSecret}, signedAt),
+			Body:    body,
+			Secrets: [][]byte{newSecret},
+			Now:     signedAt,
+		},
+		{
+			Name:       "NoHeader",
+			Body:       body,
+			Secrets:    [][]byte{newSecret},
+			Now:        signedAt,
+			ErrorCheck: errors.IsUnauthenticated,
+		},
+		{
+			Name:       "Malformed",
+			Header:     "t=1700000000,v1",
+			Body:       body,
+			Secrets:    [][]byte{newSecret},
+			Now:        signedAt,
+			ErrorCheck: errors.IsInvalidArgument,
+		},
+		{
+			Name:       "Expired",
+			Header:     web.Signature(body, [][]byte{newSecret}, signedAt),
+			Body:       body,
+			Secrets:    [][]byte{newSecret},
+			Now:        signedAt.Add(web.SignatureReplayWindow + time.Second),
+			ErrorCheck: errors.IsUnauthenticated,
+		},
+		{
+			Name:       "ExtendedWindow",
+			Header:     strings.Replace(web.Signature(body, [][]byte{newSecret}, signedAt), "w=300", "w=86400", 1),
+			Body:       body,
+			Secrets:    [][]byte{newSecret},
+			Now:        signedAt.Add(time.Hour),
+			ErrorCheck: errors.IsUnauthenticated,
+		},
+		{
+			Name:       "TamperedBody",
+			Header:     web.Signature(body, [][]byte{newSecret}, signedAt),
+			Body:       []byte(`{"uplink_message":{"f_port":1}}`),
+			Secrets:    [][]byte{newSecret},
+			Now:        signedAt,
+			ErrorCheck: errors.IsUnauthenticated,
+		},
+		{
+			Name:       "UnknownSecret",
+			Header:     web.Signature(body, [][]byte{oldSecret}, signedAt),
+			Body:       body,
+			Secrets:    [][]byte{newSecret},
+			Now:        signedAt,
+			ErrorCheck: errors.IsUnauthenticated,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			err := web.VerifySignature(tc.Header, tc.Body, tc.Secrets, tc.Now)
+			if tc.ErrorCheck == nil {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(tc.ErrorCheck(err), should.BeTrue)
+			}
+		})
+	}
+}
+
+func TestSecretsRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	keys := crypto.NewKeyService(cryptoutil.NewMemKeyVault(map[string][]byte{
+		"webhooks": {0x00, 0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88, 0x99, 0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff},
+	}))
+	inner := &mockWebhookRegistry{}
+	registry := web.NewSecretsRegistry(inner, keys, "webhooks")
+
+	secret := bytes.Repeat([]byte{0x42}, 32)
+	req := &ttnpb.ApplicationWebhook{
+		Ids:            registeredWebhookIDs,
+		SigningSecrets: []*ttnpb.Secret{{Value: secret}},
+	}
+	hook, err := registry.Set(ctx, registeredWebhookIDs, []string{"signing_secrets"},
+		func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			return req, []string{"ids", "signing_secrets"}, nil
+		},
+	)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(hook.SigningSecrets, should.Resemble, []*ttnpb.Secret{{Value: secret}})
+	// The request is not modified.
+	a.So(req.SigningSecrets, should.Resemble, []*ttnpb.Secret{{Value: secret}})
+
+	// The secret is stored encrypted.
+	if !a.So(inner.webhook.SigningSecrets, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(inner.webhook.SigningSecrets[0].KeyId, should.Equal, "webhooks")
+	a.So(inner.webhook.SigningSecrets[0].Value, should.NotResemble, secret)
+
+	hook, err = registry.Get(ctx, registeredWebhookIDs, []string{"signing_secrets"})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(hook.SigningSecrets, should.Resemble, []*ttnpb.Secret{{Value: secret}})
+
+	// Updates of other fields do not encrypt the stored secrets again.
+	_, err = registry.Set(ctx, registeredWebhookIDs, []string{"base_url"},
+		func(stored *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			a.So(stored.SigningSecrets, should.Resemble, []*ttnpb.Secret{{Value: secret}})
+			stored.BaseUrl = "https://example.com"
+			return stored, []string{"base_url"}, nil
+		},
+	)
+	a.So(err, should.BeNil)
+	a.So(inner.webhook.SigningSecrets[0].KeyId, should.Equal, "webhooks")
+	a.So(inner.webhook.SigningSecrets[0].Value, should.NotResemble, secret)
+}
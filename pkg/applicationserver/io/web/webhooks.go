@@ -23,6 +23,7 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/jtacoma/uritemplates"
@@ -141,6 +142,8 @@ type webhooks struct {
 	server    io.Server
 	registry  WebhookRegistry
 	target    Sink
+	queued    bool
+	batches   *batcher
 	downlinks DownlinksConfig
 }
 
@@ -160,6 +163,10 @@ func NewWebhooks(
 		target:    target,
 		downlinks: downlinks,
 	}
+	// The delivery queue persists the requests before they are batched and signed, so that batches are not lost.
+	if _, w.queued = target.(*queuedSink); !w.queued {
+		w.batches = newBatcher(ctx, server, target)
+	}
 	sub, err := server.Subscribe(ctx, "webhooks", nil, false)
 	if err != nil {
 		return nil, err
@@ -241,6 +248,7 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 	hooks, err := w.registry.List(ctx, msg.EndDeviceIds.ApplicationIds,
 		[]string{
 			"base_url",
+			"batching",
 			"downlink_ack",
 			"downlink_api_key",
 			"downlink_failed",
@@ -255,6 +263,7 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 			"join_accept",
 			"location_solved",
 			"service_data",
+			"signing_secrets",
 			"uplink_message",
 			"uplink_normalized",
 		},
@@ -278,6 +287,14 @@ func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error
 			if req == nil {
 				return nil
 			}
+			if w.batches != nil && batchingEnabled(hook) {
+				logger.WithField("url", req.URL).Debug("Batch message")
+				return w.batches.add(ctx, hook, req, func(err error) {
+					if err != nil {
+						registerWebhookFailed(ctx, err)
+					}
+				})
+			}
 			logger.WithField("url", req.URL).Debug("Process message")
 			if err := w.target.Process(req); err != nil {
 				registerWebhookFailed(ctx, err)
@@ -428,6 +445,10 @@ func (w *webhooks) newRequest(
 		req.Header.Set(domainHeader, domain)
 	}
 	req.Header.Set("Content-Type", format.ContentType)
+	// Queued requests are signed when they are delivered, and batched requests are signed as a batch.
+	if !w.queued && !batchingEnabled(hook) {
+		signRequest(req, buf, hook, time.Now())
+	}
 	return req, nil
 }
 
@@ -25,6 +25,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/goproto"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/normalizedpayload"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 )
@@ -222,8 +223,38 @@ func (as *ApplicationServer) decodeDownlink(ctx context.Context, dev *ttnpb.EndD
 	return nil
 }
 
+// locationFromNormalizedPayload returns the location of the last measurement of the normalized payload with a position.
+func locationFromNormalizedPayload(uplink *ttnpb.ApplicationUplink) *ttnpb.Location {
+	measurements, err := normalizedpayload.Parse(uplink.NormalizedPayload)
+	if err != nil {
+		return nil
+	}
+	for i := len(measurements) - 1; i >= 0; i-- {
+		pos := measurements[i].Position
+		if pos.Latitude == nil || pos.Longitude == nil {
+			continue
+		}
+		loc := &ttnpb.Location{
+			Latitude:  *pos.Latitude,
+			Longitude: *pos.Longitude,
+			Source:    ttnpb.LocationSource_SOURCE_GPS,
+		}
+		if pos.Altitude != nil {
+			loc.Altitude = int32(*pos.Altitude)
+		}
+		if pos.Accuracy != nil {
+			loc.Accuracy = int32(*pos.Accuracy)
+		}
+		return loc
+	}
+	return nil
+}
+
 func (*ApplicationServer) locationFromPayload(uplink *ttnpb.ApplicationUplink) (res *ttnpb.Location) {
-	// TODO: Prefer location from normalized payload (https://github.com/TheThingsNetwork/lorawan-stack/issues/5429)
+	// Prefer the location from the normalized payload, as it is validated.
+	if loc := locationFromNormalizedPayload(uplink); loc != nil {
+		return loc
+	}
 	m, err := goproto.Map(uplink.DecodedPayload)
 	if err != nil {
 		return nil
@@ -0,0 +1,174 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	policyViolationRateLimit          = "rate_limit"
+	policyViolationDecodedPayloadSize = "decoded_payload_size"
+
+	defaultUplinkPolicyWindow = time.Minute
+
+	// maxUplinkLimiters is the maximum number of rate limiters of distinct uplink quotas that are kept.
+	// When exceeded, the least recently used rate limiter is evicted.
+	maxUplinkLimiters = 1 << 8
+)
+
+var (
+	errUplinkRateLimit = errors.DefineResourceExhausted(
+		"uplink_rate_limit", "uplink rate limit of `{limit}` messages exceeded",
+	)
+	errDecodedPayloadSize = errors.DefineResourceExhausted(
+		"decoded_payload_size", "decoded payload size `{size}` exceeds maximum `{max_size}`",
+	)
+)
+
+// isUplinkPolicyViolation returns whether the error is returned for uplink messages that violate the uplink policy.
+func isUplinkPolicyViolation(err error) bool {
+	return errors.Resemble(err, errUplinkRateLimit) || errors.Resemble(err, errDecodedPayloadSize)
+}
+
+type uplinkQuota struct {
+	maxUplinks uint32
+	window     time.Duration
+}
+
+// uplinkLimiters provides the rate limiters of the uplink policies.
+// The rate limiters are shared between applications with the same quota, as the resources are end devices.
+// The rate limiters of quotas that are not used anymore are evicted, so that the number of rate limiters is bounded.
+type uplinkLimiters struct {
+	ctx  context.Context
+	size uint
+
+	mu       sync.Mutex
+	limiters gcache.Cache
+
+	// throttled contains the unique IDs of the end devices that are throttled, so that the throttle event is
+	// only published when throttling starts.
+	throttled gcache.Cache
+}
+
+func newUplinkLimiters(ctx context.Context, size uint) *uplinkLimiters {
+	cacheSize := int(size)
+	if cacheSize == 0 {
+		cacheSize = 1 << 12
+	}
+	return &uplinkLimiters{
+		ctx:       ctx,
+		size:      size,
+		limiters:  gcache.New(maxUplinkLimiters).LRU().Build(),
+		throttled: gcache.New(cacheSize).LRU().Build(),
+	}
+}
+
+func (l *uplinkLimiters) get(quota uplinkQuota) (ratelimit.Interface, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if limiter, err := l.limiters.Get(quota); err == nil {
+		return limiter.(ratelimit.Interface), nil
+	}
+	limiter, err := ratelimit.NewWindow(l.ctx, uint(quota.maxUplinks), quota.window, l.size)
+	if err != nil {
+		return nil, err
+	}
+	l.limiters.Set(quota, limiter) //nolint:errcheck
+	return limiter, nil
+}
+
+// rateLimit returns an error if the resource exceeds the rate limit of the limiter, and publishes the throttle event
+// if the end device was not throttled before.
+func (l *uplinkLimiters) rateLimit(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, limiter ratelimit.Interface, resource ratelimit.Resource,
+) error {
+	limit, result := limiter.RateLimit(resource)
+	if !limit {
+		return nil
+	}
+	err := errUplinkRateLimit.WithAttributes(
+		"limit", result.Limit,
+		"retry_after", result.RetryAfter,
+		"reset_after", result.ResetAfter,
+	)
+	uid := unique.ID(ctx, ids)
+	if _, cacheErr := l.throttled.Get(uid); cacheErr != nil {
+		events.Publish(evtThrottleDataUp.NewWithIdentifiersAndData(ctx, ids, err))
+		l.throttled.SetWithExpire(uid, struct{}{}, result.ResetAfter) //nolint:errcheck
+	}
+	return err
+}
+
+// checkOperatorUplinkRate checks the uplink rate of the end device against the rate limits of the Application Server.
+// These rate limits are configured by the operator, and the uplink policy of the application does not apply.
+func (as *ApplicationServer) checkOperatorUplinkRate(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	return as.uplinkLimiters.rateLimit(ctx, ids, as.RateLimiter(), ratelimit.ApplicationUpResource(ctx, ids))
+}
+
+// checkUplinkRate checks the uplink rate of the end device against the uplink policy of the application.
+func (as *ApplicationServer) checkUplinkRate(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, policy *ttnpb.ApplicationUplinkPolicy,
+) error {
+	if policy.GetMaxUplinks() == 0 {
+		return nil
+	}
+	quota := uplinkQuota{
+		maxUplinks: policy.MaxUplinks,
+		window:     defaultUplinkPolicyWindow,
+	}
+	if window := policy.GetWindow(); window != nil {
+		quota.window = window.AsDuration()
+	}
+	limiter, err := as.uplinkLimiters.get(quota)
+	if err != nil {
+		return err
+	}
+	return as.uplinkLimiters.rateLimit(ctx, ids, limiter, ratelimit.ApplicationUpResource(ctx, ids))
+}
+
+// checkDecodedPayloadSize checks the size of the decoded payload against the uplink policy of the application.
+func checkDecodedPayloadSize(up *ttnpb.ApplicationUplink, policy *ttnpb.ApplicationUplinkPolicy) error {
+	maxSize := int(policy.GetMaxDecodedPayloadSize())
+	if maxSize == 0 || up.DecodedPayload == nil {
+		return nil
+	}
+	if size := proto.Size(up.DecodedPayload); size > maxSize {
+		return errDecodedPayloadSize.WithAttributes("size", size, "max_size", maxSize)
+	}
+	return nil
+}
+
+// applyUplinkPolicy applies the action of the uplink policy to the uplink message that violates the policy.
+// If the uplink message should be dropped, the violation error is returned.
+func applyUplinkPolicy(
+	up *ttnpb.ApplicationUplink, policy *ttnpb.ApplicationUplinkPolicy, violation string, err error,
+) error {
+	if policy.GetAction() != ttnpb.ApplicationUplinkPolicy_TAG {
+		return err
+	}
+	up.PolicyViolations = append(up.PolicyViolations, violation)
+	return nil
+}
@@ -0,0 +1,100 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestUplinkRateLimit(t *testing.T) {
+	a, ctx := test.New(t)
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+		DeviceId:       "foo-device",
+	}
+	as := &ApplicationServer{uplinkLimiters: newUplinkLimiters(ctx, 0)}
+	policy := &ttnpb.ApplicationUplinkPolicy{
+		MaxUplinks: 3,
+		Window:     durationpb.New(time.Hour),
+	}
+
+	evs := test.CollectEvents(func() {
+		for i := 0; i < 3; i++ {
+			a.So(as.checkUplinkRate(ctx, ids, policy), should.BeNil)
+		}
+		for i := 0; i < 3; i++ {
+			err := as.checkUplinkRate(ctx, ids, policy)
+			a.So(errors.IsResourceExhausted(err), should.BeTrue)
+			a.So(isUplinkPolicyViolation(err), should.BeTrue)
+		}
+	})
+	// The throttle event is only published when throttling starts.
+	if a.So(evs, should.HaveLength, 1) {
+		a.So(evs[0].Name(), should.Equal, "as.up.data.throttle")
+	}
+
+	// The rate limit applies per end device.
+	otherIDs := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: ids.ApplicationIds,
+		DeviceId:       "bar-device",
+	}
+	a.So(as.checkUplinkRate(ctx, otherIDs, policy), should.BeNil)
+
+	// No rate limit applies without maximum.
+	a.So(as.checkUplinkRate(ctx, ids, &ttnpb.ApplicationUplinkPolicy{}), should.BeNil)
+
+	// The rate limiters of unused quotas are evicted.
+	for i := uint32(1); i <= maxUplinkLimiters+1; i++ {
+		a.So(as.checkUplinkRate(ctx, ids, &ttnpb.ApplicationUplinkPolicy{MaxUplinks: 100 + i}), should.BeNil)
+	}
+	a.So(as.uplinkLimiters.limiters.Len(false), should.Equal, maxUplinkLimiters)
+	a.So(as.uplinkLimiters.limiters.Has(uplinkQuota{maxUplinks: 3, window: time.Hour}), should.BeFalse)
+}
+
+func TestApplyUplinkPolicy(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	up := &ttnpb.ApplicationUplink{
+		DecodedPayload: &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"temperature": structpb.NewNumberValue(21.5),
+				"message":     structpb.NewStringValue("a message that makes the decoded payload large"),
+			},
+		},
+	}
+	a.So(checkDecodedPayloadSize(up, nil), should.BeNil)
+	a.So(checkDecodedPayloadSize(up, &ttnpb.ApplicationUplinkPolicy{MaxDecodedPayloadSize: 1024}), should.BeNil)
+	err := checkDecodedPayloadSize(up, &ttnpb.ApplicationUplinkPolicy{MaxDecodedPayloadSize: 16})
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+
+	// Uplink messages are dropped by default.
+	a.So(applyUplinkPolicy(up, &ttnpb.ApplicationUplinkPolicy{}, policyViolationDecodedPayloadSize, err), should.Equal, err)
+	a.So(up.PolicyViolations, should.BeEmpty)
+
+	policy := &ttnpb.ApplicationUplinkPolicy{Action: ttnpb.ApplicationUplinkPolicy_TAG}
+	a.So(applyUplinkPolicy(up, policy, policyViolationDecodedPayloadSize, err), should.BeNil)
+	a.So(up.PolicyViolations, should.Resemble, []string{"decoded_payload_size"})
+}
@@ -87,4 +87,12 @@ var (
 			},
 		}
 	}
+
+	// as923LRFHSSDownlinkDR maps the LR-FHSS data rates DR8 to DR11 to the LoRa data rate used as base for RX1.
+	as923LRFHSSDownlinkDR = [4]ttnpb.DataRateIndex{
+		ttnpb.DataRateIndex_DATA_RATE_1,
+		ttnpb.DataRateIndex_DATA_RATE_2,
+		ttnpb.DataRateIndex_DATA_RATE_1,
+		ttnpb.DataRateIndex_DATA_RATE_2,
+	}
 )
@@ -1573,9 +1573,10 @@ func TestRx1DataRate(t *testing.T) {
 			invalidOffsets: []ttnpb.DataRateOffset{6, 7},
 		},
 		{
-			bandID:       "AS_923",
-			validIndexes: []ttnpb.DataRateIndex{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
-			validOffsets: []ttnpb.DataRateOffset{0, 1, 2, 3, 4, 5, 6, 7},
+			bandID:         "AS_923",
+			validIndexes:   []ttnpb.DataRateIndex{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11},
+			invalidIndexes: []ttnpb.DataRateIndex{12, 13, 14, 15},
+			validOffsets:   []ttnpb.DataRateOffset{0, 1, 2, 3, 4, 5, 6, 7},
 		},
 		{
 			bandID:         "CN_470_510",
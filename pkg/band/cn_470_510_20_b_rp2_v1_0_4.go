@@ -0,0 +1,19 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package band
+
+// CN_470_510_20_B_RP2_v1_0_4 is the band definition for CN470-510 20MHz antenna, type B in the RP002-1.0.4 specification.
+// It shares the RP002-1.0.3 band definition.
+var CN_470_510_20_B_RP2_v1_0_4 = CN_470_510_20_B_RP2_v1_0_3
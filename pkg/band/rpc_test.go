@@ -55,6 +55,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "EU_863_870",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -79,6 +80,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "AU_915_928",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -102,6 +104,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "AS_923",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -123,6 +126,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "AS_923",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -137,6 +141,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "AS_923_2",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -145,6 +150,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "AS_923_3",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -153,12 +159,14 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "AS_923_4",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 						},
 					},
 					{
 						BandId: "AU_915_928",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -185,6 +193,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "CN_470_510_20_A",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -194,6 +203,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "CN_470_510_20_B",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -203,6 +213,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "CN_470_510_26_A",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -212,6 +223,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "CN_470_510_26_B",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -221,6 +233,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "CN_779_787",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -237,6 +250,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "EU_433",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -253,6 +267,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "EU_863_870",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -269,6 +284,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "IN_865_867",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -282,6 +298,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "ISM_2400",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -298,6 +315,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "KR_920_923",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -312,6 +330,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "MA_869_870_DRAFT",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -328,6 +347,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "RU_864_870",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -340,6 +360,7 @@ func TestGetPhyVersions(t *testing.T) {
 					{
 						BandId: "US_902_928",
 						PhyVersions: []ttnpb.PHYVersion{
+							ttnpb.PHYVersion_RP002_V1_0_4,
 							ttnpb.PHYVersion_RP002_V1_0_3,
 							ttnpb.PHYVersion_RP002_V1_0_2,
 							ttnpb.PHYVersion_RP002_V1_0_1,
@@ -19,6 +19,8 @@ import (
 	"context"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
@@ -33,16 +35,19 @@ type ServerConfig struct {
 		APIKey string `name:"api-key" description:"API Key to use for unknown gateway registration"`
 	} `name:"owner-for-unknown"`
 	Default struct {
-		LNSURI string `name:"lns-uri" description:"The default LNS URI that the gateways should use"`
+		LNSURI        string `name:"lns-uri" description:"The default LNS URI that the gateways should use"`
+		UpdateChannel string `name:"update-channel" description:"The default update channel that the gateways should use"`
 	} `name:"default" description:"Default gateway settings"`
-	AllowCUPSURIUpdate bool `name:"allow-cups-uri-update" description:"Allow CUPS URI updates"`
+	AllowCUPSURIUpdate bool                  `name:"allow-cups-uri-update" description:"Allow CUPS URI updates"`
+	Firmware           config.BlobPathConfig `name:"firmware" description:"Blob location of the firmware catalog"`
 }
 
 // NewServer returns a new CUPS server from this config on top of the component.
-func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option) *Server {
+func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option) (*Server, error) {
 	opts := []Option{
 		WithAllowCUPSURIUpdate(conf.AllowCUPSURIUpdate),
 		WithDefaultLNSURI(conf.Default.LNSURI),
+		WithDefaultUpdateChannel(conf.Default.UpdateChannel),
 	}
 	var registerUnknownTo *ttnpb.OrganizationOrUserIdentifiers
 	switch conf.RegisterUnknown.Type {
@@ -71,7 +76,15 @@ func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option)
 	if tlsConfig, err := c.GetTLSClientConfig(c.Context()); err == nil {
 		opts = append(opts, WithTLSConfig(tlsConfig))
 	}
+	if conf.Firmware.Bucket != "" {
+		ctx := c.Context()
+		bucket, err := c.GetBaseConfig(ctx).Blob.Bucket(ctx, conf.Firmware.Bucket, c)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithFirmwareCatalog(NewFirmwareCatalog(fetch.FromBucket(ctx, bucket, conf.Firmware.Path))))
+	}
 	s := NewServer(c, append(opts, customOpts...)...)
 	c.RegisterWeb(s)
-	return s
+	return s, nil
 }
@@ -0,0 +1,308 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/sha512"
+	"hash/fnv"
+	"strings"
+	"time"
+
+	"github.com/blang/semver"
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// firmwareReleasesFile is the name of the file that lists the releases of a model in an update channel.
+	firmwareReleasesFile = "releases.yml"
+	// defaultUpdateChannel is the update channel used when neither the gateway nor the server configure one.
+	defaultUpdateChannel = "stable"
+
+	// firmwareImageCacheSize is the number of update images that are cached by the firmware catalog.
+	firmwareImageCacheSize = 8
+	// firmwareImageCacheTTL is the duration for which update images are cached by the firmware catalog.
+	firmwareImageCacheTTL = time.Hour
+
+	cupsUpdateVersionAttribute = "cups-update-version"
+	cupsUpdateStatusAttribute  = "cups-update-status"
+)
+
+// Firmware update statuses, as reported in the gateway attributes.
+const (
+	// FirmwareUpdatePending indicates that an update is available, but the gateway is not yet part of the rollout.
+	FirmwareUpdatePending = "pending"
+	// FirmwareUpdateOffered indicates that the update has been sent to the gateway.
+	FirmwareUpdateOffered = "offered"
+	// FirmwareUpdateInstalled indicates that the gateway reported the version of the update.
+	FirmwareUpdateInstalled = "installed"
+)
+
+var (
+	errFirmwareReleases = errors.DefineCorruption(
+		"firmware_releases", "invalid firmware releases for model `{model}` in update channel `{channel}`",
+	)
+	errFirmwareVersion = errors.DefineInvalidArgument("firmware_version", "invalid firmware version `{version}`")
+)
+
+// FirmwareRelease is a firmware release in a FirmwareCatalog.
+type FirmwareRelease struct {
+	// Version is the package version of the release.
+	Version string `yaml:"version"`
+	// MinStationVersion is the minimum Station version that is required to install the release.
+	MinStationVersion string `yaml:"min-station-version,omitempty"`
+	// File is the name of the update image, relative to the directory of the model.
+	File string `yaml:"file"`
+	// RolloutPercentage is the percentage of gateways that receive the release.
+	// If nil, the release is rolled out to all gateways.
+	RolloutPercentage *uint32 `yaml:"rollout-percentage,omitempty"`
+}
+
+// FirmwareCatalog is a catalog of firmware releases.
+type FirmwareCatalog interface {
+	// Releases returns the firmware releases for the given model in the given update channel.
+	Releases(ctx context.Context, channel, model string) ([]FirmwareRelease, error)
+	// Image returns the update image of the given firmware release.
+	// The returned image must not be modified.
+	Image(ctx context.Context, channel, model string, release FirmwareRelease) ([]byte, error)
+}
+
+type firmwareImageKey struct {
+	channel, model, version, file string
+}
+
+type fetcherFirmwareCatalog struct {
+	fetcher fetch.Interface
+	images  gcache.Cache
+}
+
+// NewFirmwareCatalog returns a FirmwareCatalog that fetches releases and images with the given fetcher.
+// The releases of a model are listed in `{channel}/{model}/releases.yml`. The update images are
+// stored next to it. The update images are cached per release, so that they are not fetched for
+// every gateway that is updated.
+func NewFirmwareCatalog(fetcher fetch.Interface) FirmwareCatalog {
+	return &fetcherFirmwareCatalog{
+		fetcher: fetcher,
+		images:  gcache.New(firmwareImageCacheSize).LRU().Expiration(firmwareImageCacheTTL).Build(),
+	}
+}
+
+// Releases implements FirmwareCatalog.
+func (c *fetcherFirmwareCatalog) Releases(_ context.Context, channel, model string) ([]FirmwareRelease, error) {
+	b, err := c.fetcher.File(channel, model, firmwareReleasesFile)
+	if err != nil {
+		return nil, err
+	}
+	var releases struct {
+		Releases []FirmwareRelease `yaml:"releases"`
+	}
+	if err := yaml.UnmarshalStrict(b, &releases); err != nil {
+		return nil, errFirmwareReleases.WithCause(err).WithAttributes("channel", channel, "model", model)
+	}
+	return releases.Releases, nil
+}
+
+// Image implements FirmwareCatalog.
+func (c *fetcherFirmwareCatalog) Image(
+	_ context.Context, channel, model string, release FirmwareRelease,
+) ([]byte, error) {
+	key := firmwareImageKey{channel, model, release.Version, release.File}
+	if image, err := c.images.Get(key); err == nil {
+		return image.([]byte), nil
+	}
+	image, err := c.fetcher.File(channel, model, release.File)
+	if err != nil {
+		return nil, err
+	}
+	c.images.Set(key, image) //nolint:errcheck
+	return image, nil
+}
+
+// parseVersion parses a Station or package version. Station versions may contain build information
+// after the version, e.g. `2.0.6(rpi/std) 2022-01-28 11:47:53`.
+func parseVersion(version string) (semver.Version, error) {
+	if i := strings.IndexAny(version, "( "); i >= 0 {
+		version = version[:i]
+	}
+	v, err := semver.ParseTolerant(version)
+	if err != nil {
+		return semver.Version{}, errFirmwareVersion.WithCause(err).WithAttributes("version", version)
+	}
+	return v, nil
+}
+
+// latestRelease returns the newest release that is newer than the given package version and that can
+// be installed on the given Station version.
+func latestRelease(releases []FirmwareRelease, stationVersion, packageVersion semver.Version) (*FirmwareRelease, error) {
+	var (
+		latest        *FirmwareRelease
+		latestVersion semver.Version
+	)
+	for i, release := range releases {
+		version, err := parseVersion(release.Version)
+		if err != nil {
+			return nil, err
+		}
+		if version.LTE(packageVersion) {
+			continue
+		}
+		if release.MinStationVersion != "" {
+			minStationVersion, err := parseVersion(release.MinStationVersion)
+			if err != nil {
+				return nil, err
+			}
+			if stationVersion.LT(minStationVersion) {
+				continue
+			}
+		}
+		if latest == nil || version.GT(latestVersion) {
+			latest, latestVersion = &releases[i], version
+		}
+	}
+	return latest, nil
+}
+
+// inRollout returns whether the gateway is part of the rollout of the given release.
+// Gateways are assigned to a rollout deterministically based on their EUI and the release version,
+// so that increasing the rollout percentage only adds gateways to the rollout, and that different
+// releases are rolled out to different gateways first.
+func inRollout(ids *ttnpb.GatewayIdentifiers, release *FirmwareRelease) bool {
+	if release.RolloutPercentage == nil {
+		return true
+	}
+	h := fnv.New32a()
+	_, _ = h.Write(ids.GetEui())
+	_, _ = h.Write([]byte(ids.GetGatewayId()))
+	_, _ = h.Write([]byte(release.Version))
+	return h.Sum32()%100 < *release.RolloutPercentage
+}
+
+// updateFirmware checks whether a firmware update is available for the gateway, and adds the signed
+// update to the response if the gateway is part of the rollout. The rollout status is stored in the
+// gateway attributes.
+func (s *Server) updateFirmware(
+	ctx context.Context, gtw *ttnpb.Gateway, req UpdateInfoRequest, res *UpdateInfoResponse,
+) error {
+	if s.firmware == nil {
+		return nil
+	}
+	channel := gtw.UpdateChannel
+	if channel == "" {
+		channel = s.defaultUpdateChannel
+	}
+	if channel == "" {
+		channel = defaultUpdateChannel
+	}
+	model := req.Model
+	if model == "" {
+		model = gtw.GetVersionIds().GetModelId()
+	}
+	if model == "" {
+		return nil
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"update_channel", channel,
+		"model", model,
+		"package", req.Package,
+	))
+
+	// Without the versions that are installed on the gateway, it is unknown whether a release is an update
+	// and whether it can be installed, so no update is offered.
+	packageVersion, err := parseVersion(req.Package)
+	if err != nil {
+		logger.WithError(err).Debug("Invalid package version, no firmware update offered")
+		return nil
+	}
+	stationVersion, err := parseVersion(req.Station)
+	if err != nil {
+		logger.WithError(err).Debug("Invalid Station version, no firmware update offered")
+		return nil
+	}
+
+	if version := gtw.Attributes[cupsUpdateVersionAttribute]; version != "" &&
+		gtw.Attributes[cupsUpdateStatusAttribute] == FirmwareUpdateOffered {
+		if offered, err := parseVersion(version); err == nil && packageVersion.EQ(offered) {
+			logger.WithField("version", version).Info("Firmware update installed")
+			gtw.Attributes[cupsUpdateStatusAttribute] = FirmwareUpdateInstalled
+			registerFirmwareUpdate(ctx, channel, FirmwareUpdateInstalled)
+		}
+	}
+
+	releases, err := s.firmware.Releases(ctx, channel, model)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	release, err := latestRelease(releases, stationVersion, packageVersion)
+	if err != nil {
+		return errFirmwareReleases.WithCause(err).WithAttributes("channel", channel, "model", model)
+	}
+	if release == nil {
+		return nil
+	}
+	logger = logger.WithField("version", release.Version)
+	gtw.Attributes[cupsUpdateVersionAttribute] = release.Version
+
+	if !inRollout(gtw.GetIds(), release) {
+		logger.Debug("Firmware update available but gateway not in rollout")
+		gtw.Attributes[cupsUpdateStatusAttribute] = FirmwareUpdatePending
+		registerFirmwareUpdate(ctx, channel, FirmwareUpdatePending)
+		return nil
+	}
+
+	var (
+		keyCRC uint32
+		signer crypto.Signer
+	)
+	for _, crc := range req.KeyCRCs {
+		if sig, ok := s.signers[crc]; ok {
+			keyCRC, signer = crc, sig
+			break
+		}
+	}
+	if signer == nil {
+		logger.Warn("No signing key available for firmware update")
+		gtw.Attributes[cupsUpdateStatusAttribute] = FirmwareUpdatePending
+		registerFirmwareUpdate(ctx, channel, FirmwareUpdatePending)
+		return nil
+	}
+
+	updateData, err := s.firmware.Image(ctx, channel, model, *release)
+	if err != nil {
+		return err
+	}
+	hash := sha512.Sum512(updateData)
+	sig, err := signer.Sign(rand.Reader, hash[:], nil)
+	if err != nil {
+		return err
+	}
+	res.SignatureKeyCRC = keyCRC
+	res.Signature = sig
+	res.UpdateData = updateData
+
+	logger.Info("Offer firmware update")
+	gtw.Attributes[cupsUpdateStatusAttribute] = FirmwareUpdateOffered
+	registerFirmwareUpdate(ctx, channel, FirmwareUpdateOffered)
+	return nil
+}
@@ -0,0 +1,202 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/blang/semver"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestLatestRelease(t *testing.T) {
+	t.Parallel()
+
+	releases := []FirmwareRelease{
+		{Version: "2.0.5", File: "2.0.5.bin"},
+		{Version: "2.0.7-rc1", File: "2.0.7-rc1.bin", MinStationVersion: "2.0.6"},
+		{Version: "2.0.6", File: "2.0.6.bin"},
+	}
+	for _, tc := range []struct {
+		Name     string
+		Station  string
+		Package  string
+		Expected string
+	}{
+		{
+			Name:     "Outdated",
+			Station:  "2.0.6(rpi/std) 2022-01-28 11:47:53",
+			Package:  "2.0.5",
+			Expected: "2.0.7-rc1",
+		},
+		{
+			Name:     "OldStation",
+			Station:  "2.0.5(rpi/std) 2021-01-28 11:47:53",
+			Package:  "2.0.5",
+			Expected: "2.0.6",
+		},
+		{
+			Name:    "UpToDate",
+			Station: "2.0.6(rpi/std) 2022-01-28 11:47:53",
+			Package: "2.0.7-rc1",
+		},
+		{
+			Name:    "Newer",
+			Station: "2.0.6(rpi/std) 2022-01-28 11:47:53",
+			Package: "2.1.0",
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+
+			stationVersion, err := parseVersion(tc.Station)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			packageVersion, err := parseVersion(tc.Package)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			release, err := latestRelease(releases, stationVersion, packageVersion)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			if tc.Expected == "" {
+				a.So(release, should.BeNil)
+				return
+			}
+			if a.So(release, should.NotBeNil) {
+				a.So(release.Version, should.Equal, tc.Expected)
+			}
+		})
+	}
+
+	t.Run("InvalidVersion", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+
+		_, err := latestRelease(
+			[]FirmwareRelease{{Version: "latest"}}, semver.MustParse("2.0.6"), semver.MustParse("2.0.5"),
+		)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+		_, err = parseVersion("custom")
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+}
+
+func TestInRollout(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	percentage := func(p uint32) *uint32 { return &p }
+	gateways := make([]*ttnpb.GatewayIdentifiers, 1000)
+	for i := range gateways {
+		gateways[i] = &ttnpb.GatewayIdentifiers{
+			GatewayId: fmt.Sprintf("gateway-%d", i),
+			Eui:       []byte{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, byte(i >> 8), byte(i)},
+		}
+	}
+
+	for _, ids := range gateways {
+		a.So(inRollout(ids, &FirmwareRelease{Version: "2.0.6"}), should.BeTrue)
+		a.So(inRollout(ids, &FirmwareRelease{Version: "2.0.6", RolloutPercentage: percentage(0)}), should.BeFalse)
+		a.So(inRollout(ids, &FirmwareRelease{Version: "2.0.6", RolloutPercentage: percentage(100)}), should.BeTrue)
+	}
+
+	// Increasing the rollout percentage only adds gateways to the rollout.
+	var previous int
+	for _, p := range []uint32{10, 25, 50, 75} {
+		var n int
+		for _, ids := range gateways {
+			in := inRollout(ids, &FirmwareRelease{Version: "2.0.6", RolloutPercentage: percentage(p)})
+			if in {
+				n++
+			}
+			if !in {
+				a.So(inRollout(ids, &FirmwareRelease{Version: "2.0.6", RolloutPercentage: percentage(p / 2)}), should.BeFalse)
+			}
+		}
+		a.So(n, should.BeGreaterThan, previous)
+		a.So(n, should.AlmostEqual, int(p)*len(gateways)/100, len(gateways)/10)
+		previous = n
+	}
+}
+
+func TestFirmwareCatalog(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	fetcher := &countingFetcher{Interface: fetch.NewMemFetcher(map[string][]byte{
+		"stable/minihub/releases.yml": []byte(`releases:
+  - version: 2.0.6
+    min-station-version: 2.0.5
+    file: 2.0.6.bin
+    rollout-percentage: 50
+`),
+		"stable/minihub/2.0.6.bin": []byte("firmware image"),
+		"beta/minihub/releases.yml": []byte(`releases:
+  - version: 2.0.7
+    unknown-field: true
+`),
+	})}
+	catalog := NewFirmwareCatalog(fetcher)
+
+	releases, err := catalog.Releases(ctx, "stable", "minihub")
+	if !a.So(err, should.BeNil) || !a.So(releases, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	rollout := uint32(50)
+	a.So(releases[0], should.Resemble, FirmwareRelease{
+		Version:           "2.0.6",
+		MinStationVersion: "2.0.5",
+		File:              "2.0.6.bin",
+		RolloutPercentage: &rollout,
+	})
+	image, err := catalog.Image(ctx, "stable", "minihub", releases[0])
+	a.So(err, should.BeNil)
+	a.So(image, should.Resemble, []byte("firmware image"))
+
+	// The update image is cached per release.
+	fetches := fetcher.count
+	image, err = catalog.Image(ctx, "stable", "minihub", releases[0])
+	a.So(err, should.BeNil)
+	a.So(image, should.Resemble, []byte("firmware image"))
+	a.So(fetcher.count, should.Equal, fetches)
+
+	_, err = catalog.Releases(ctx, "stable", "corecell")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	_, err = catalog.Releases(ctx, "beta", "minihub")
+	a.So(errors.IsDataLoss(err), should.BeTrue)
+}
+
+type countingFetcher struct {
+	fetch.Interface
+	count int
+}
+
+func (f *countingFetcher) File(pathElements ...string) ([]byte, error) {
+	f.count++
+	return f.Interface.File(pathElements...)
+}
@@ -100,6 +100,16 @@ func TokenCredentials(trust *x509.Certificate, authorization string) ([]byte, er
 	return out, nil
 }
 
+// SecretCredentials appends the TLS trust certificate and the client credentials in the given secret.
+// If the secret contains a PEM encoded client certificate and private key, the client authenticates with the
+// client certificate. Otherwise, the secret is used as the contents of the Authorization header.
+func SecretCredentials(trust *x509.Certificate, secret []byte) ([]byte, error) {
+	if client, err := tls.X509KeyPair(secret, secret); err == nil {
+		return TLSCredentials(trust, &client)
+	}
+	return TokenCredentials(trust, string(secret))
+}
+
 var (
 	errFieldLength           = errors.Define("field_length", "length of `{field}` (`{length}`) exceeds maximum `{maximum}`")
 	errUnsupportedPrivateKey = errors.Define("unsupported_private_key", "the private key type `{type}` is not supported")
@@ -16,8 +16,15 @@ package cups_test
 
 import (
 	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/basicstation/cups"
@@ -87,3 +94,47 @@ func TestTokenCredentials(t *testing.T) {
 		})
 	}
 }
+
+func TestSecretCredentials(t *testing.T) {
+	a := assertions.New(t)
+	trust := &x509.Certificate{
+		Raw: []byte("LETS NOT ENCRYPT"),
+	}
+
+	creds, err := cups.SecretCredentials(trust, []byte("token"))
+	a.So(err, should.BeNil)
+	expected, err := cups.TokenCredentials(trust, "token")
+	a.So(err, should.BeNil)
+	a.So(creds, should.Resemble, expected)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "eui-0102030405060708"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "eui-0102030405060708"},
+	}, &key.PublicKey, key)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	secret := append(
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certDER}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})...,
+	)
+
+	creds, err = cups.SecretCredentials(trust, secret)
+	a.So(err, should.BeNil)
+	expected = append(append(append([]byte{}, trust.Raw...), certDER...), keyDER...)
+	a.So(creds, should.Resemble, expected)
+}
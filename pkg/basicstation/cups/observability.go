@@ -26,11 +26,14 @@ type messageMetrics struct {
 	requestReceived  *metrics.ContextualCounterVec
 	requestSucceeded *metrics.ContextualCounterVec
 	requestFailed    *metrics.ContextualCounterVec
+	firmwareUpdate   *metrics.ContextualCounterVec
 }
 
 var (
-	subsystem = "cups"
-	request   = "request"
+	subsystem     = "cups"
+	request       = "request"
+	updateChannel = "update_channel"
+	updateStatus  = "update_status"
 )
 
 var cupsMetrics = &messageMetrics{
@@ -58,6 +61,14 @@ var cupsMetrics = &messageMetrics{
 		},
 		[]string{request, "error"},
 	),
+	firmwareUpdate: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "firmware_update_total",
+			Help:      "Total number of firmware update rollout status changes",
+		},
+		[]string{updateChannel, updateStatus},
+	),
 }
 
 // Describe implements prometheus.Collector.
@@ -65,6 +76,7 @@ func (m *messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.requestReceived.Describe(ch)
 	m.requestSucceeded.Describe(ch)
 	m.requestFailed.Describe(ch)
+	m.firmwareUpdate.Describe(ch)
 }
 
 // Collect implements prometheus.Collector.
@@ -72,6 +84,7 @@ func (m *messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.requestReceived.Collect(ch)
 	m.requestSucceeded.Collect(ch)
 	m.requestFailed.Collect(ch)
+	m.firmwareUpdate.Collect(ch)
 }
 
 func registerUpdateInfoRequestReceived(ctx context.Context, request string) {
@@ -90,6 +103,10 @@ func registerUpdateInfoRequestFailed(ctx context.Context, request string, err er
 	}
 }
 
+func registerFirmwareUpdate(ctx context.Context, channel, status string) {
+	cupsMetrics.firmwareUpdate.WithLabelValues(ctx, channel, status).Inc()
+}
+
 func init() {
 	metrics.MustRegister(cupsMetrics)
 }
@@ -62,6 +62,9 @@ type Server struct {
 	trustCache   map[string]*x509.Certificate
 
 	signers map[uint32]crypto.Signer
+
+	firmware             FirmwareCatalog
+	defaultUpdateChannel string
 }
 
 func (s *Server) getServerAuth(ctx context.Context) grpc.CallOption {
@@ -149,6 +152,22 @@ func WithSigner(keyCRC uint32, signer crypto.Signer) Option {
 	}
 }
 
+// WithFirmwareCatalog configures the CUPS server to distribute firmware updates from the given catalog
+// to gateways that have automatic updates enabled.
+func WithFirmwareCatalog(catalog FirmwareCatalog) Option {
+	return func(s *Server) {
+		s.firmware = catalog
+	}
+}
+
+// WithDefaultUpdateChannel configures the CUPS server with a default update channel to use when
+// no update channel is registered for a gateway.
+func WithDefaultUpdateChannel(channel string) Option {
+	return func(s *Server) {
+		s.defaultUpdateChannel = channel
+	}
+}
+
 // WithRegistries overrides the CUPS server's gateway registries.
 func WithRegistries(registry ttnpb.GatewayRegistryClient, access ttnpb.GatewayAccessClient) Option {
 	return func(s *Server) {
@@ -16,6 +16,10 @@ package cups
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha512"
 	"crypto/tls"
 	"io"
 	"net/http"
@@ -30,6 +34,7 @@ import (
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -187,6 +192,49 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 
 	var kv config.KeyVault //nolint:gosimple
 
+	signer, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const signerKeyCRC = 392840017
+	firmwareImage := []byte("firmware image")
+	firmwareCatalog := func(rollout string) FirmwareCatalog {
+		return NewFirmwareCatalog(fetch.NewMemFetcher(map[string][]byte{
+			"stable/minihub/releases.yml": []byte(`releases:
+  - version: 2.0.5
+    file: 2.0.5.bin
+  - version: 2.0.6
+    file: 2.0.6.bin` + rollout),
+			"stable/minihub/2.0.5.bin": []byte("old firmware image"),
+			"stable/minihub/2.0.6.bin": firmwareImage,
+		}))
+	}
+	assertFirmwareUpdate := func(a *assertions.Assertion, rec *httptest.ResponseRecorder) {
+		var res UpdateInfoResponse
+		err := res.UnmarshalBinary(rec.Body.Bytes())
+		a.So(err, should.BeNil)
+		a.So(res.SignatureKeyCRC, should.Equal, signerKeyCRC)
+		a.So(res.UpdateData, should.Resemble, firmwareImage)
+		hash := sha512.Sum512(firmwareImage)
+		a.So(ecdsa.VerifyASN1(&signer.PublicKey, hash[:], res.Signature), should.BeTrue)
+	}
+	assertNoFirmwareUpdate := func(a *assertions.Assertion, rec *httptest.ResponseRecorder) {
+		var res UpdateInfoResponse
+		err := res.UnmarshalBinary(rec.Body.Bytes())
+		a.So(err, should.BeNil)
+		a.So(res.SignatureKeyCRC, should.BeZeroValue)
+		a.So(res.Signature, should.BeEmpty)
+		a.So(res.UpdateData, should.BeEmpty)
+	}
+	assertUpdateStatus := func(version, status string) func(*assertions.Assertion, *mockGatewayClient) {
+		return func(a *assertions.Assertion, s *mockGatewayClient) {
+			if a.So(s.req.Update, should.NotBeNil) {
+				a.So(s.req.Update.GetGateway().Attributes[cupsUpdateVersionAttribute], should.Equal, version)
+				a.So(s.req.Update.GetGateway().Attributes[cupsUpdateStatusAttribute], should.Equal, status)
+			}
+		}
+	}
+
 	mockGateway := func(hasLNSSecret, redirectCUPS, updateCUPSCreds bool) *ttnpb.Gateway {
 		secret := &ttnpb.Secret{
 			KeyId: "test-key",
@@ -558,6 +606,153 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 				}
 			},
 		},
+		{
+			Name: "Firmware Update",
+			StoreSetup: func(c *mockGatewayClient) {
+				c.res.Get = mockGateway(true, false, false)
+				c.res.Get.AutoUpdate = true
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: []Option{
+				WithSigner(signerKeyCRC, signer),
+				WithFirmwareCatalog(firmwareCatalog("")),
+			},
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: assertFirmwareUpdate,
+			AssertStore:    assertUpdateStatus("2.0.6", FirmwareUpdateOffered),
+		},
+		{
+			Name: "Firmware Update Unknown Package",
+			StoreSetup: func(c *mockGatewayClient) {
+				c.res.Get = mockGateway(true, false, false)
+				c.res.Get.AutoUpdate = true
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: []Option{
+				WithSigner(signerKeyCRC, signer),
+				WithFirmwareCatalog(firmwareCatalog("")),
+			},
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+				req.Body = io.NopCloser(strings.NewReader(
+					strings.Replace(updateInfoRequest, `"package": "2.0.0"`, `"package": "custom"`, 1),
+				))
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: assertNoFirmwareUpdate,
+			AssertStore:    assertUpdateStatus("", ""),
+		},
+		{
+			Name: "Firmware Update Without Auto Update",
+			StoreSetup: func(c *mockGatewayClient) {
+				c.res.Get = mockGateway(true, false, false)
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: []Option{
+				WithSigner(signerKeyCRC, signer),
+				WithFirmwareCatalog(firmwareCatalog("")),
+			},
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: assertNoFirmwareUpdate,
+			AssertStore:    assertUpdateStatus("", ""),
+		},
+		{
+			Name: "Firmware Update Other Channel",
+			StoreSetup: func(c *mockGatewayClient) {
+				c.res.Get = mockGateway(true, false, false)
+				c.res.Get.AutoUpdate = true
+				c.res.Get.UpdateChannel = "beta"
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: []Option{
+				WithSigner(signerKeyCRC, signer),
+				WithFirmwareCatalog(firmwareCatalog("")),
+			},
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: assertNoFirmwareUpdate,
+			AssertStore:    assertUpdateStatus("", ""),
+		},
+		{
+			Name: "Firmware Update Not In Rollout",
+			StoreSetup: func(c *mockGatewayClient) {
+				c.res.Get = mockGateway(true, false, false)
+				c.res.Get.AutoUpdate = true
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: []Option{
+				WithSigner(signerKeyCRC, signer),
+				WithFirmwareCatalog(firmwareCatalog("\n    rollout-percentage: 0")),
+			},
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: assertNoFirmwareUpdate,
+			AssertStore:    assertUpdateStatus("2.0.6", FirmwareUpdatePending),
+		},
+		{
+			Name: "Firmware Update Without Signer",
+			StoreSetup: func(c *mockGatewayClient) {
+				c.res.Get = mockGateway(true, false, false)
+				c.res.Get.AutoUpdate = true
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: []Option{
+				WithSigner(signerKeyCRC+1, signer),
+				WithFirmwareCatalog(firmwareCatalog("")),
+			},
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: assertNoFirmwareUpdate,
+			AssertStore:    assertUpdateStatus("2.0.6", FirmwareUpdatePending),
+		},
+		{
+			Name: "Firmware Update Installed",
+			StoreSetup: func(c *mockGatewayClient) {
+				c.res.Get = mockGateway(true, false, false)
+				c.res.Get.AutoUpdate = true
+				c.res.Get.Attributes[cupsUpdateVersionAttribute] = "2.0.0"
+				c.res.Get.Attributes[cupsUpdateStatusAttribute] = FirmwareUpdateOffered
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: []Option{
+				WithSigner(signerKeyCRC, signer),
+				WithFirmwareCatalog(NewFirmwareCatalog(fetch.NewMemFetcher(map[string][]byte{
+					"stable/minihub/releases.yml": []byte("releases:\n  - version: 2.0.0\n    file: 2.0.0.bin"),
+				}))),
+			},
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: assertNoFirmwareUpdate,
+			AssertStore:    assertUpdateStatus("2.0.0", FirmwareUpdateInstalled),
+		},
 	} {
 		tt := tt
 		t.Run(tt.Name, func(t *testing.T) {
@@ -16,9 +16,6 @@ package cups
 
 import (
 	"context"
-	"crypto"
-	"crypto/rand"
-	"crypto/sha512"
 	"encoding/json"
 	"fmt"
 	"hash/crc32"
@@ -318,7 +315,7 @@ func (s *Server) updateInfo(w http.ResponseWriter, r *http.Request) (err error)
 			if err != nil {
 				return errServerTrust.WithCause(err).WithAttributes("address", gtw.GatewayServerAddress)
 			}
-			lnsCredentials, err := TokenCredentials(lnsTrust, string(gtw.LbsLnsSecret.Value))
+			lnsCredentials, err := SecretCredentials(lnsTrust, gtw.LbsLnsSecret.Value)
 			if err != nil {
 				return err
 			}
@@ -329,30 +326,8 @@ func (s *Server) updateInfo(w http.ResponseWriter, r *http.Request) (err error)
 	}
 
 	if gtw.AutoUpdate {
-		// TODO: Compare the Station, Model, Package, version_ids and update_channel in order to check if any updates are required
-		// (https://github.com/TheThingsNetwork/lorawan-stack/issues/365)
-		var updateData []byte
-		if updateData != nil {
-			var (
-				keyCRC uint32
-				signer crypto.Signer
-			)
-			for _, keyCRC = range req.KeyCRCs {
-				if sig, ok := s.signers[keyCRC]; ok {
-					signer = sig
-					break
-				}
-			}
-			if signer != nil {
-				hash := sha512.Sum512(updateData)
-				sig, err := signer.Sign(rand.Reader, hash[:], nil)
-				if err != nil {
-					return err
-				}
-				res.SignatureKeyCRC = keyCRC
-				res.Signature = sig
-				res.UpdateData = updateData
-			}
+		if err := s.updateFirmware(ctx, gtw, req, &res); err != nil {
+			return err
 		}
 	}
 
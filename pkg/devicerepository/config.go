@@ -16,11 +16,13 @@ package devicerepository
 
 import (
 	"context"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/bleve"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/remote"
 )
 
 // Config represents the DeviceRepository configuration.
@@ -31,6 +33,17 @@ type Config struct {
 	Directory string `name:"directory" description:"OS filesystem directory, which contains the Device Repository"`
 
 	AssetsBaseURL string `name:"assets-base-url" description:"The base URL for Device Repository assets"`
+
+	Formatters FormattersConfig `name:"formatters"`
+}
+
+// FormattersConfig represents the configuration for testing payload formatters.
+// Zero values disable the limits.
+type FormattersConfig struct {
+	MaxParameterLength int           `name:"max-parameter-length" description:"Maximum allowed size for length of tested formatter parameters (payload formatter scripts)"`
+	MaxExamples        int           `name:"max-examples" description:"Maximum number of examples that are tested per request"`
+	Timeout            time.Duration `name:"timeout" description:"Maximum duration of testing the payload formatters per request"`
+	Remote             remote.Config `name:"remote" description:"Remote payload formatters configuration"`
 }
 
 // StoreConfig represents configuration for the Device Repository store.
@@ -23,6 +23,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/payloadformatters"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
@@ -39,7 +41,8 @@ type DeviceRepository struct {
 
 	config *Config
 
-	store store.Store
+	store      store.Store
+	formatters messageprocessors.PayloadProcessor
 }
 
 // New returns a new *DeviceRepository.
@@ -49,7 +52,8 @@ func New(c *component.Component, conf *Config) (*DeviceRepository, error) {
 		ctx:       log.NewContextWithField(c.Context(), "namespace", "devicerepository"),
 		config:    conf,
 
-		store: conf.Store.Store,
+		store:      conf.Store.Store,
+		formatters: payloadformatters.New(c.Context(), c, conf.Formatters.Remote),
 	}
 
 	c.RegisterGRPC(dr)
@@ -0,0 +1,314 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicerepository
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var errFormatterScriptTooLarge = errors.DefineInvalidArgument(
+	"formatter_script_too_large", "formatter script size exceeds maximum allowed size", "size", "max_size",
+)
+
+// examplesLimiter limits the number of examples that are tested per request.
+type examplesLimiter struct {
+	remaining int
+	limited   bool
+}
+
+// take returns the first examples that may be tested.
+func take[T any](l *examplesLimiter, examples []T) []T {
+	if !l.limited {
+		return examples
+	}
+	if len(examples) > l.remaining {
+		examples = examples[:l.remaining]
+	}
+	l.remaining -= len(examples)
+	return examples
+}
+
+// valueMismatches returns the paths of the values that do not match.
+// Structures are compared field by field.
+func valueMismatches(path string, expected, actual *structpb.Value) []string {
+	expectedStruct, expectedOK := expected.GetKind().(*structpb.Value_StructValue)
+	actualStruct, actualOK := actual.GetKind().(*structpb.Value_StructValue)
+	if expectedOK && actualOK {
+		return structMismatches(path, expectedStruct.StructValue, actualStruct.StructValue)
+	}
+	if proto.Equal(expected, actual) {
+		return nil
+	}
+	return []string{path}
+}
+
+// structMismatches returns the paths of the fields that do not match, sorted by path.
+func structMismatches(path string, expected, actual *structpb.Struct) []string {
+	keys := make([]string, 0, len(expected.GetFields())+len(actual.GetFields()))
+	for k := range expected.GetFields() {
+		keys = append(keys, k)
+	}
+	for k := range actual.GetFields() {
+		if _, ok := expected.GetFields()[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	var mismatches []string
+	for _, k := range keys {
+		mismatches = append(mismatches, valueMismatches(path+"."+k, expected.GetFields()[k], actual.GetFields()[k])...)
+	}
+	return mismatches
+}
+
+func stringsEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// testResult completes the result with the error and the mismatches of the payload formatter.
+// If the example expects errors, the payload formatter must fail and the output is not compared.
+func testResult(res *ttnpb.TestPayloadFormattersResponse_Result, err error, expectErrors bool, mismatches func() []string) {
+	if err != nil {
+		if ttnErr, ok := errors.From(err); ok {
+			res.Error = ttnpb.ErrorDetailsToProto(ttnErr)
+		}
+		res.Passed = expectErrors
+		return
+	}
+	if expectErrors {
+		res.Mismatches = []string{"errors"}
+		return
+	}
+	res.Mismatches = mismatches()
+	res.Passed = len(res.Mismatches) == 0
+}
+
+func (dr *DeviceRepository) testDecoder(
+	ctx context.Context,
+	codec ttnpb.TestPayloadFormattersResponse_Result_Codec,
+	version *ttnpb.EndDeviceVersionIdentifiers,
+	examples []*ttnpb.MessagePayloadDecoder_Example,
+	formatter ttnpb.PayloadFormatter,
+	parameter string,
+) []*ttnpb.TestPayloadFormattersResponse_Result {
+	results := make([]*ttnpb.TestPayloadFormattersResponse_Result, 0, len(examples))
+	for _, example := range examples {
+		res := &ttnpb.TestPayloadFormattersResponse_Result{
+			Codec:       codec,
+			Description: example.Description,
+			Formatter:   formatter,
+		}
+		var (
+			decoded  *structpb.Struct
+			warnings []string
+			err      error
+		)
+		start := time.Now()
+		switch codec {
+		case ttnpb.TestPayloadFormattersResponse_Result_UPLINK_DECODER:
+			msg := &ttnpb.ApplicationUplink{
+				FPort:      example.GetInput().GetFPort(),
+				FrmPayload: example.GetInput().GetFrmPayload(),
+			}
+			err = dr.formatters.DecodeUplink(ctx, nil, version, msg, formatter, parameter)
+			decoded, warnings = msg.DecodedPayload, msg.DecodedPayloadWarnings
+		default:
+			msg := &ttnpb.ApplicationDownlink{
+				FPort:      example.GetInput().GetFPort(),
+				FrmPayload: example.GetInput().GetFrmPayload(),
+			}
+			err = dr.formatters.DecodeDownlink(ctx, nil, version, msg, formatter, parameter)
+			decoded, warnings = msg.DecodedPayload, msg.DecodedPayloadWarnings
+		}
+		res.Duration = durationpb.New(time.Since(start))
+		if err == nil {
+			res.DecodedOutput = &ttnpb.DecodedMessagePayload{
+				Data:     decoded,
+				Warnings: warnings,
+			}
+		}
+		expected := example.GetOutput()
+		testResult(res, err, len(expected.GetErrors()) > 0, func() []string {
+			mismatches := structMismatches("data", expected.GetData(), decoded)
+			if !stringsEqual(expected.GetWarnings(), warnings) {
+				mismatches = append(mismatches, "warnings")
+			}
+			return mismatches
+		})
+		results = append(results, res)
+	}
+	return results
+}
+
+func (dr *DeviceRepository) testEncoder(
+	ctx context.Context,
+	version *ttnpb.EndDeviceVersionIdentifiers,
+	examples []*ttnpb.MessagePayloadEncoder_Example,
+	formatter ttnpb.PayloadFormatter,
+	parameter string,
+) []*ttnpb.TestPayloadFormattersResponse_Result {
+	results := make([]*ttnpb.TestPayloadFormattersResponse_Result, 0, len(examples))
+	for _, example := range examples {
+		res := &ttnpb.TestPayloadFormattersResponse_Result{
+			Codec:       ttnpb.TestPayloadFormattersResponse_Result_DOWNLINK_ENCODER,
+			Description: example.Description,
+			Formatter:   formatter,
+		}
+		msg := &ttnpb.ApplicationDownlink{
+			DecodedPayload: example.GetInput().GetData(),
+		}
+		start := time.Now()
+		err := dr.formatters.EncodeDownlink(ctx, nil, version, msg, formatter, parameter)
+		res.Duration = durationpb.New(time.Since(start))
+		if err == nil {
+			res.EncodedOutput = &ttnpb.EncodedMessagePayload{
+				FPort:      msg.FPort,
+				FrmPayload: msg.FrmPayload,
+				Warnings:   msg.DecodedPayloadWarnings,
+			}
+		}
+		expected := example.GetOutput()
+		testResult(res, err, len(expected.GetErrors()) > 0, func() []string {
+			var mismatches []string
+			if expected.GetFPort() != 0 && expected.GetFPort() != msg.FPort {
+				mismatches = append(mismatches, "f_port")
+			}
+			if !bytes.Equal(expected.GetFrmPayload(), msg.FrmPayload) {
+				mismatches = append(mismatches, "frm_payload")
+			}
+			if !stringsEqual(expected.GetWarnings(), msg.DecodedPayloadWarnings) {
+				mismatches = append(mismatches, "warnings")
+			}
+			return mismatches
+		})
+		results = append(results, res)
+	}
+	return results
+}
+
+// TestPayloadFormatters implements the ttnpb.DeviceRepositoryServer interface.
+// Only the first examples are tested if the number of examples exceeds the configured maximum.
+func (dr *DeviceRepository) TestPayloadFormatters(
+	ctx context.Context,
+	req *ttnpb.TestPayloadFormattersRequest,
+) (*ttnpb.TestPayloadFormattersResponse, error) {
+	if err := rights.RequireAuthentication(ctx); err != nil {
+		return nil, err
+	}
+	if err := ratelimit.Require(dr.RateLimiter(), ratelimit.DeviceRepositoryTestPayloadFormattersResource(ctx)); err != nil {
+		return nil, err
+	}
+	conf := dr.config.Formatters
+	if maxSize := conf.MaxParameterLength; maxSize > 0 {
+		for _, parameter := range []string{
+			req.GetFormatters().GetUpFormatterParameter(),
+			req.GetFormatters().GetDownFormatterParameter(),
+		} {
+			if size := len(parameter); size > maxSize {
+				return nil, errFormatterScriptTooLarge.WithAttributes("size", size, "max_size", maxSize)
+			}
+		}
+	}
+	if conf.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, conf.Timeout)
+		defer cancel()
+	}
+	limiter := &examplesLimiter{
+		remaining: conf.MaxExamples,
+		limited:   conf.MaxExamples > 0,
+	}
+	codecReq := &ttnpb.GetPayloadFormatterRequest{
+		VersionIds: req.VersionIds,
+		FieldMask:  ttnpb.FieldMask("formatter", "formatter_parameter", "examples"),
+	}
+	var (
+		res      = &ttnpb.TestPayloadFormattersResponse{}
+		found    bool
+		notFound error
+	)
+	// override returns the payload formatter in the request, or the given payload formatter if it is not set.
+	override := func(
+		formatter ttnpb.PayloadFormatter, parameter string, reqFormatter ttnpb.PayloadFormatter, reqParameter string,
+	) (ttnpb.PayloadFormatter, string) {
+		if reqFormatter != ttnpb.PayloadFormatter_FORMATTER_NONE {
+			return reqFormatter, reqParameter
+		}
+		return formatter, parameter
+	}
+	for _, codec := range []ttnpb.TestPayloadFormattersResponse_Result_Codec{
+		ttnpb.TestPayloadFormattersResponse_Result_UPLINK_DECODER,
+		ttnpb.TestPayloadFormattersResponse_Result_DOWNLINK_DECODER,
+	} {
+		get, reqFormatter, reqParameter := dr.store.GetUplinkDecoder,
+			req.GetFormatters().GetUpFormatter(), req.GetFormatters().GetUpFormatterParameter()
+		if codec == ttnpb.TestPayloadFormattersResponse_Result_DOWNLINK_DECODER {
+			get, reqFormatter, reqParameter = dr.store.GetDownlinkDecoder,
+				req.GetFormatters().GetDownFormatter(), req.GetFormatters().GetDownFormatterParameter()
+		}
+		decoder, err := get(codecReq)
+		if err != nil {
+			if !errors.IsNotFound(err) {
+				return nil, err
+			}
+			notFound = err
+			continue
+		}
+		found = true
+		formatter, parameter := override(decoder.Formatter, decoder.FormatterParameter, reqFormatter, reqParameter)
+		res.Results = append(res.Results,
+			dr.testDecoder(ctx, codec, req.VersionIds, take(limiter, decoder.Examples), formatter, parameter)...,
+		)
+	}
+	encoder, err := dr.store.GetDownlinkEncoder(codecReq)
+	switch {
+	case errors.IsNotFound(err):
+		notFound = err
+	case err != nil:
+		return nil, err
+	default:
+		found = true
+		formatter, parameter := override(
+			encoder.Formatter, encoder.FormatterParameter,
+			req.GetFormatters().GetDownFormatter(), req.GetFormatters().GetDownFormatterParameter(),
+		)
+		res.Results = append(res.Results,
+			dr.testEncoder(ctx, req.VersionIds, take(limiter, encoder.Examples), formatter, parameter)...,
+		)
+	}
+	if !found {
+		return nil, notFound
+	}
+	return res, nil
+}
@@ -16,6 +16,7 @@ package devicerepository_test
 
 import (
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/smartystreets/assertions"
@@ -34,6 +35,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 type mockStore struct {
@@ -162,6 +164,9 @@ func TestGRPC(t *testing.T) {
 			Store: st,
 		},
 		AssetsBaseURL: "https://assets/",
+		Formatters: devicerepository.FormattersConfig{
+			MaxParameterLength: 1024,
+		},
 	}
 	dr, err := devicerepository.New(c, conf)
 	test.Must(dr, err)
@@ -267,6 +272,18 @@ func TestGRPC(t *testing.T) {
 					return response, err
 				},
 			},
+			{
+				name: "TestPayloadFormatters",
+				execute: func(opts ...grpc.CallOption) (any, error) {
+					response, err := cl.TestPayloadFormatters(ctx, &ttnpb.TestPayloadFormattersRequest{
+						VersionIds: &ttnpb.EndDeviceVersionIdentifiers{
+							BrandId: "brand1",
+							ModelId: "model1",
+						},
+					}, opts...)
+					return response, err
+				},
+			},
 		} {
 			t.Run(atc.name, func(t *testing.T) {
 				for _, tc := range []struct {
@@ -783,4 +800,149 @@ func TestGRPC(t *testing.T) {
 			a.So(err, should.BeNil)
 		})
 	})
+
+	t.Run("TestPayloadFormatters", func(t *testing.T) {
+		t.Run("StoreError", func(t *testing.T) {
+			a := assertions.New(t)
+			st.err = fmt.Errorf("store error")
+			res, err := cl.TestPayloadFormatters(ctx, &ttnpb.TestPayloadFormattersRequest{
+				VersionIds: ids,
+			}, creds)
+			a.So(res, should.BeNil)
+			a.So(err.Error(), should.ContainSubstring, st.err.Error())
+		})
+
+		t.Run("Success", func(t *testing.T) {
+			a := assertions.New(t)
+			st.err = nil
+			st.uplinkDecoder = &ttnpb.MessagePayloadDecoder{
+				Formatter: ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+				FormatterParameter: `function decodeUplink(input) {
+					if (input.fPort !== 1) {
+						return { errors: ["invalid port"] };
+					}
+					return { data: { temperature: input.bytes[0], humidity: input.bytes[1] } };
+				}`,
+				Examples: []*ttnpb.MessagePayloadDecoder_Example{
+					{
+						Description: "valid",
+						Input:       &ttnpb.EncodedMessagePayload{FPort: 1, FrmPayload: []byte{21, 50}},
+						Output: &ttnpb.DecodedMessagePayload{
+							Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+								"temperature": structpb.NewNumberValue(21),
+								"humidity":    structpb.NewNumberValue(50),
+							}},
+						},
+					},
+					{
+						Description: "mismatch",
+						Input:       &ttnpb.EncodedMessagePayload{FPort: 1, FrmPayload: []byte{21, 50}},
+						Output: &ttnpb.DecodedMessagePayload{
+							Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+								"temperature": structpb.NewNumberValue(22),
+								"humidity":    structpb.NewNumberValue(50),
+							}},
+						},
+					},
+					{
+						Description: "invalid port",
+						Input:       &ttnpb.EncodedMessagePayload{FPort: 2, FrmPayload: []byte{21, 50}},
+						Output:      &ttnpb.DecodedMessagePayload{Errors: []string{"invalid port"}},
+					},
+				},
+			}
+			st.downlinkDecoder = &ttnpb.MessagePayloadDecoder{
+				Formatter: ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP,
+			}
+			st.downlinkEncoder = &ttnpb.MessagePayloadEncoder{
+				Formatter: ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+				FormatterParameter: `function encodeDownlink(input) {
+					return { fPort: 2, bytes: [input.data.state === "on" ? 1 : 0] };
+				}`,
+				Examples: []*ttnpb.MessagePayloadEncoder_Example{
+					{
+						Description: "on",
+						Input: &ttnpb.DecodedMessagePayload{
+							Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+								"state": structpb.NewStringValue("on"),
+							}},
+						},
+						Output: &ttnpb.EncodedMessagePayload{FPort: 2, FrmPayload: []byte{1}},
+					},
+				},
+			}
+
+			res, err := cl.TestPayloadFormatters(ctx, &ttnpb.TestPayloadFormattersRequest{
+				VersionIds: ids,
+			}, creds)
+			if !a.So(err, should.BeNil) || !a.So(res.Results, should.HaveLength, 4) {
+				t.FailNow()
+			}
+			a.So(st.lastCodecPaths, should.Resemble, []string{"formatter", "formatter_parameter", "examples"})
+			for i, expected := range []struct {
+				codec      ttnpb.TestPayloadFormattersResponse_Result_Codec
+				passed     bool
+				mismatches []string
+				hasError   bool
+			}{
+				{codec: ttnpb.TestPayloadFormattersResponse_Result_UPLINK_DECODER, passed: true},
+				{
+					codec:      ttnpb.TestPayloadFormattersResponse_Result_UPLINK_DECODER,
+					mismatches: []string{"data.temperature"},
+				},
+				{codec: ttnpb.TestPayloadFormattersResponse_Result_UPLINK_DECODER, passed: true, hasError: true},
+				{codec: ttnpb.TestPayloadFormattersResponse_Result_DOWNLINK_ENCODER, passed: true},
+			} {
+				result := res.Results[i]
+				a.So(result.Codec, should.Equal, expected.codec)
+				a.So(result.Formatter, should.Equal, ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT)
+				a.So(result.Passed, should.Equal, expected.passed)
+				a.So(result.Mismatches, should.Resemble, expected.mismatches)
+				a.So(result.Error != nil, should.Equal, expected.hasError)
+				a.So(result.Duration, should.NotBeNil)
+			}
+			a.So(res.Results[3].EncodedOutput.GetFrmPayload(), should.Resemble, []byte{1})
+
+			// The payload formatters in the request override the payload formatters of the codecs.
+			res, err = cl.TestPayloadFormatters(ctx, &ttnpb.TestPayloadFormattersRequest{
+				VersionIds: ids,
+				Formatters: &ttnpb.MessagePayloadFormatters{
+					UpFormatter: ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+					UpFormatterParameter: `function decodeUplink(input) {
+						return { data: { temperature: 22, humidity: 50 } };
+					}`,
+				},
+			}, creds)
+			if a.So(err, should.BeNil) && a.So(res.Results, should.HaveLength, 4) {
+				a.So(res.Results[0].Mismatches, should.Resemble, []string{"data.temperature"})
+				a.So(res.Results[1].Passed, should.BeTrue)
+				a.So(res.Results[2].Mismatches, should.Resemble, []string{"errors"})
+			}
+		})
+
+		t.Run("Limits", func(t *testing.T) {
+			a := assertions.New(t)
+
+			// Payload formatter parameters that exceed the maximum length are rejected.
+			_, err := cl.TestPayloadFormatters(ctx, &ttnpb.TestPayloadFormattersRequest{
+				VersionIds: ids,
+				Formatters: &ttnpb.MessagePayloadFormatters{
+					UpFormatter:          ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+					UpFormatterParameter: strings.Repeat(" ", 1025),
+				},
+			}, creds)
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+			// Only the first examples are tested.
+			conf.Formatters.MaxExamples = 2
+			defer func() { conf.Formatters.MaxExamples = 0 }()
+			res, err := cl.TestPayloadFormatters(ctx, &ttnpb.TestPayloadFormattersRequest{
+				VersionIds: ids,
+			}, creds)
+			if a.So(err, should.BeNil) && a.So(res.Results, should.HaveLength, 2) {
+				a.So(res.Results[0].Description, should.Equal, "valid")
+				a.So(res.Results[1].Description, should.Equal, "mismatch")
+			}
+		})
+	})
 }
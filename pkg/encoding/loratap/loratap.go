@@ -0,0 +1,168 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package loratap provides encoding of LoRa packets in PCAP files with the LoRaTap link type.
+// See https://github.com/eriknl/LoRaTap for the LoRaTap format.
+package loratap
+
+import (
+	"encoding/binary"
+	"io"
+	"math"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// LinkType is the PCAP link type of LoRaTap.
+	LinkType = 270
+
+	headerLength  = 15
+	syncWord      = 0x34
+	rssiOffset    = 139
+	bandwidthUnit = 125000
+
+	pcapMagic        = 0xa1b2c3d4
+	pcapVersionMajor = 2
+	pcapVersionMinor = 4
+	pcapSnapLen      = 65535
+)
+
+// Packet is a LoRa packet.
+type Packet struct {
+	// Time is the time of the packet.
+	Time time.Time
+	// Frequency is the frequency in Hz.
+	Frequency uint64
+	// Bandwidth is the bandwidth in Hz.
+	Bandwidth uint32
+	// SpreadingFactor is the spreading factor.
+	SpreadingFactor uint32
+	// RSSI is the RSSI of the packet in dBm.
+	RSSI float32
+	// SNR is the SNR of the packet in dB.
+	SNR float32
+	// Payload is the PHYPayload.
+	Payload []byte
+}
+
+func clamp(v, min, max float64) float64 {
+	return math.Max(min, math.Min(max, v))
+}
+
+// appendHeader appends the LoRaTap version 0 header of the packet.
+func (p Packet) appendHeader(b []byte) []byte {
+	b = append(b, 0, 0) // Version and padding.
+	b = binary.BigEndian.AppendUint16(b, headerLength)
+	b = binary.BigEndian.AppendUint32(b, uint32(p.Frequency))
+	b = append(b, byte(p.Bandwidth/bandwidthUnit), byte(p.SpreadingFactor))
+	rssi := byte(clamp(math.Round(float64(p.RSSI)+rssiOffset), 0, math.MaxUint8))
+	b = append(b, rssi, rssi, rssi) // Packet, maximum and current RSSI.
+	b = append(b, byte(int8(clamp(math.Round(float64(p.SNR)*4), math.MinInt8, math.MaxInt8))))
+	return append(b, syncWord)
+}
+
+// Writer writes packets in a PCAP file with the LoRaTap link type.
+type Writer struct {
+	w             io.Writer
+	headerWritten bool
+}
+
+// NewWriter returns a new Writer that writes to w.
+// The PCAP file header is written with the first packet.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: w}
+}
+
+// WriteHeader writes the PCAP file header, if it has not been written yet.
+func (w *Writer) WriteHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+	b := make([]byte, 0, 24)
+	b = binary.LittleEndian.AppendUint32(b, pcapMagic)
+	b = binary.LittleEndian.AppendUint16(b, pcapVersionMajor)
+	b = binary.LittleEndian.AppendUint16(b, pcapVersionMinor)
+	b = binary.LittleEndian.AppendUint32(b, 0) // Time zone offset.
+	b = binary.LittleEndian.AppendUint32(b, 0) // Timestamp accuracy.
+	b = binary.LittleEndian.AppendUint32(b, pcapSnapLen)
+	b = binary.LittleEndian.AppendUint32(b, LinkType)
+	if _, err := w.w.Write(b); err != nil {
+		return err
+	}
+	w.headerWritten = true
+	return nil
+}
+
+// WritePacket writes the packet as PCAP record.
+func (w *Writer) WritePacket(p Packet) error {
+	if err := w.WriteHeader(); err != nil {
+		return err
+	}
+	data := p.appendHeader(make([]byte, 0, headerLength+len(p.Payload)))
+	data = append(data, p.Payload...)
+	b := make([]byte, 0, 16+len(data))
+	b = binary.LittleEndian.AppendUint32(b, uint32(p.Time.Unix()))
+	b = binary.LittleEndian.AppendUint32(b, uint32(p.Time.Nanosecond()/1000))
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(data))) // Included length.
+	b = binary.LittleEndian.AppendUint32(b, uint32(len(data))) // Original length.
+	b = append(b, data...)
+	_, err := w.w.Write(b)
+	return err
+}
+
+// FromUplinkMessage returns the packet of the uplink message.
+// The RSSI and SNR are taken from the metadata with the best RSSI.
+// If the uplink message is not LoRa modulated, false is returned.
+func FromUplinkMessage(t time.Time, up *ttnpb.UplinkMessage) (Packet, bool) {
+	settings := up.GetSettings()
+	lora := settings.GetDataRate().GetLora()
+	if lora == nil {
+		return Packet{}, false
+	}
+	p := Packet{
+		Time:            t,
+		Frequency:       settings.Frequency,
+		Bandwidth:       lora.Bandwidth,
+		SpreadingFactor: lora.SpreadingFactor,
+		Payload:         up.RawPayload,
+	}
+	for i, md := range up.RxMetadata {
+		rssi := md.GetRssi()
+		if i == 0 || rssi > p.RSSI {
+			p.RSSI, p.SNR = rssi, md.GetSnr()
+		}
+	}
+	return p, true
+}
+
+// FromDownlinkMessage returns the packet of the scheduled downlink message.
+// The RSSI and SNR are not set for downlink messages.
+// If the downlink message is not scheduled or not LoRa modulated, false is returned.
+func FromDownlinkMessage(t time.Time, down *ttnpb.DownlinkMessage) (Packet, bool) {
+	settings := down.GetScheduled()
+	lora := settings.GetDataRate().GetLora()
+	if lora == nil {
+		return Packet{}, false
+	}
+	return Packet{
+		Time:            t,
+		Frequency:       settings.Frequency,
+		Bandwidth:       lora.Bandwidth,
+		SpreadingFactor: lora.SpreadingFactor,
+		RSSI:            -rssiOffset,
+		Payload:         down.RawPayload,
+	}, true
+}
@@ -0,0 +1,134 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loratap_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/encoding/loratap"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestWriter(t *testing.T) {
+	a := assertions.New(t)
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	a.So(w.WriteHeader(), should.BeNil)
+	a.So(w.WriteHeader(), should.BeNil)
+	a.So(w.WritePacket(Packet{
+		Time:            time.Unix(1700000000, 123456000),
+		Frequency:       868100000,
+		Bandwidth:       125000,
+		SpreadingFactor: 7,
+		RSSI:            -42,
+		SNR:             -7.25,
+		Payload:         []byte{0x40, 0x01, 0x02},
+	}), should.BeNil)
+
+	a.So(buf.Bytes(), should.Resemble, []byte{
+		// PCAP file header.
+		0xd4, 0xc3, 0xb2, 0xa1, // Magic.
+		0x02, 0x00, 0x04, 0x00, // Version 2.4.
+		0x00, 0x00, 0x00, 0x00, // Time zone offset.
+		0x00, 0x00, 0x00, 0x00, // Timestamp accuracy.
+		0xff, 0xff, 0x00, 0x00, // Snapshot length.
+		0x0e, 0x01, 0x00, 0x00, // Link type.
+
+		// PCAP record header.
+		0x00, 0xf1, 0x53, 0x65, // Seconds.
+		0x40, 0xe2, 0x01, 0x00, // Microseconds.
+		0x12, 0x00, 0x00, 0x00, // Included length.
+		0x12, 0x00, 0x00, 0x00, // Original length.
+
+		// LoRaTap header.
+		0x00, 0x00, 0x00, 0x0f, // Version, padding and length.
+		0x33, 0xbe, 0x27, 0xa0, // Frequency.
+		0x01, 0x07, // Bandwidth and spreading factor.
+		0x61, 0x61, 0x61, // RSSI.
+		0xe3, // SNR.
+		0x34, // Sync word.
+
+		// Payload.
+		0x40, 0x01, 0x02,
+	})
+}
+
+func TestFromUplinkMessage(t *testing.T) {
+	a := assertions.New(t)
+	now := time.Now()
+
+	_, ok := FromUplinkMessage(now, &ttnpb.UplinkMessage{
+		Settings: &ttnpb.TxSettings{
+			DataRate: &ttnpb.DataRate{Modulation: &ttnpb.DataRate_Fsk{Fsk: &ttnpb.FSKDataRate{BitRate: 50000}}},
+		},
+	})
+	a.So(ok, should.BeFalse)
+
+	p, ok := FromUplinkMessage(now, &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40},
+		Settings: &ttnpb.TxSettings{
+			DataRate: &ttnpb.DataRate{Modulation: &ttnpb.DataRate_Lora{Lora: &ttnpb.LoRaDataRate{
+				SpreadingFactor: 9,
+				Bandwidth:       125000,
+			}}},
+			Frequency: 868300000,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{Rssi: -100, Snr: -5},
+			{Rssi: -80, Snr: 5},
+			{Rssi: -90, Snr: 0},
+		},
+	})
+	a.So(ok, should.BeTrue)
+	a.So(p, should.Resemble, Packet{
+		Time:            now,
+		Frequency:       868300000,
+		Bandwidth:       125000,
+		SpreadingFactor: 9,
+		RSSI:            -80,
+		SNR:             5,
+		Payload:         []byte{0x40},
+	})
+}
+
+func TestFromDownlinkMessage(t *testing.T) {
+	a := assertions.New(t)
+	now := time.Now()
+
+	_, ok := FromDownlinkMessage(now, &ttnpb.DownlinkMessage{
+		Settings: &ttnpb.DownlinkMessage_Request{Request: &ttnpb.TxRequest{}},
+	})
+	a.So(ok, should.BeFalse)
+
+	p, ok := FromDownlinkMessage(now, &ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x60},
+		Settings: &ttnpb.DownlinkMessage_Scheduled{Scheduled: &ttnpb.TxSettings{
+			DataRate: &ttnpb.DataRate{Modulation: &ttnpb.DataRate_Lora{Lora: &ttnpb.LoRaDataRate{
+				SpreadingFactor: 12,
+				Bandwidth:       125000,
+			}}},
+			Frequency: 869525000,
+		}},
+	})
+	a.So(ok, should.BeTrue)
+	a.So(p.Frequency, should.Equal, 869525000)
+	a.So(p.SpreadingFactor, should.Equal, 12)
+	a.So(p.Payload, should.Resemble, []byte{0x60})
+}
@@ -418,14 +418,24 @@ func (fp FrequencyPlan) Extend(extension FrequencyPlan) FrequencyPlan {
 var (
 	errNoDwellTimeDuration = errors.DefineInvalidArgument("no_dwell_time_duration", "no dwell time duration specified")
 	errInvalidChannel      = errors.Define("channel", "invalid frequency plan channel `{index}`")
+	errUnknownDataRate     = errors.DefineInvalidArgument("unknown_data_rate", "data rate `{index}` is not defined in band `{band_id}`")
 )
 
 // Validate returns an error if the frequency plan is invalid.
 func (fp FrequencyPlan) Validate() error {
-	_, err := band.GetLatest(fp.BandID)
+	phy, err := band.GetLatest(fp.BandID)
 	if err != nil {
 		return err
 	}
+	for i, channel := range fp.UplinkChannels {
+		for _, idx := range []uint8{channel.MinDataRate, channel.MaxDataRate} {
+			if _, ok := phy.DataRates[ttnpb.DataRateIndex(idx)]; !ok {
+				return errInvalidChannel.WithAttributes("index", i).WithCause(
+					errUnknownDataRate.WithAttributes("index", idx, "band_id", fp.BandID),
+				)
+			}
+		}
+	}
 	fpdt := fp.DwellTime
 	if (fpdt.GetUplinks() || fpdt.GetDownlinks()) && fpdt.Duration == nil {
 		return errNoDwellTimeDuration.New()
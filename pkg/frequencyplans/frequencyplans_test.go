@@ -111,6 +111,16 @@ func TestStore(t *testing.T) {
   description: South Africa
   file: AS_923.yml
   base-frequency: 868
+- id: AS_923_LR_FHSS
+  base-id: AS_923
+  description: South East Asia with LR-FHSS
+  base-frequency: 915
+  file: AS_923_LR_FHSS.yml
+- id: AS_923_INVALID_DR
+  base-id: AS_923
+  description: South East Asia with an unknown data rate
+  base-frequency: 915
+  file: AS_923_INVALID_DR.yml
 - id: CA
   base-id: US_915
   description: Canada
@@ -120,6 +130,15 @@ func TestStore(t *testing.T) {
 		"AS_923.yml": []byte(`band-id: AS_923
 uplink-channels:
 - frequency: 923000000
+`),
+		"AS_923_LR_FHSS.yml": []byte(`uplink-channels:
+- frequency: 923000000
+  min-data-rate: 8
+  max-data-rate: 11
+`),
+		"AS_923_INVALID_DR.yml": []byte(`uplink-channels:
+- frequency: 923000000
+  max-data-rate: 12
 `),
 		"US_915.yml": []byte(`invalid-yaml`),
 		"JP.yml": []byte(`sub-bands:
@@ -206,6 +225,20 @@ uplink-channels:
 		a.So(errors.IsDataLoss(err), should.BeTrue)
 	}
 
+	// Frequency plan with LR-FHSS data rates (RP002-1.0.4)
+	{
+		fp, err := store.GetByID("AS_923_LR_FHSS")
+		if a.So(err, should.BeNil) {
+			a.So(fp.UplinkChannels[0].MaxDataRate, should.Equal, 11)
+		}
+	}
+
+	// Invalid frequency plan (data rate not defined in band)
+	{
+		_, err := store.GetByID("AS_923_INVALID_DR")
+		a.So(errors.IsDataLoss(err), should.BeTrue)
+	}
+
 	// Unknown frequency plan
 	{
 		_, err := store.GetByID("Unknown")
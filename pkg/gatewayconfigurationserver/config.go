@@ -27,4 +27,9 @@ type Config struct {
 	TheThingsGateway gcsv2.TheThingsGatewayConfig `name:"the-things-gateway" description:"The Things Gateway CUPS configuration."`
 	// RequreAuth defines if the HTTP endpoints should require authentication or not.
 	RequireAuth bool `name:"require-auth" description:"Require authentication for the HTTP endpoints."`
+	// Templates is the registry of gateway configuration templates. If nil, templates are disabled.
+	Templates TemplateRegistry `name:"-"`
+	// TemplateVersions is the number of versions that are retained per gateway configuration template.
+	// If zero, all versions are retained.
+	TemplateVersions int `name:"template-versions" description:"Number of versions that are retained per gateway configuration template"`
 }
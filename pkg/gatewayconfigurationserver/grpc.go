@@ -21,7 +21,9 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/concentratord"
 	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/cpf"
+	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/mpower"
 	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/semtechudp"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
@@ -40,7 +42,7 @@ func (s *Server) getGateway(ctx context.Context, gtwID *ttnpb.GatewayIdentifiers
 	client := ttnpb.NewGatewayRegistryClient(cc)
 	gtw, err := client.Get(ctx, &ttnpb.GetGatewayRequest{
 		GatewayIds: gtwID,
-		FieldMask:  ttnpb.FieldMask("antennas", "frequency_plan_id", "gateway_server_address"),
+		FieldMask:  ttnpb.FieldMask("antennas", "attributes", "frequency_plan_id", "gateway_server_address"),
 	}, s.WithClusterAuth())
 	if err != nil {
 		return nil, err
@@ -66,25 +68,41 @@ func (s *Server) GetGatewayConfiguration(ctx context.Context, req *ttnpb.GetGate
 		return nil, err
 	}
 
-	var configContent []byte
+	configContent, err := buildConfiguration(gtw, fps, req)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.GetGatewayConfigurationResponse{
+		Contents: configContent,
+	}, nil
+}
+
+func buildConfiguration(
+	gtw *ttnpb.Gateway, fps *frequencyplans.Store, req *ttnpb.GetGatewayConfigurationRequest,
+) ([]byte, error) {
+	// The type is ignored for the formats without types, as in previous versions of the API.
+	typ := req.Type
+	if _, ok := supportedConfigurations[req.Format][""]; ok {
+		typ = ""
+	}
+	if err := validateConfiguration(req.Format, typ, req.Filename); err != nil {
+		return nil, err
+	}
 	switch req.Format {
 	case "semtechudp":
-		configContent, err = handleSemtechUDP(gtw, fps, req)
+		return handleSemtechUDP(gtw, fps)
 	case "kerlink-cpf":
-		configContent, err = handleKerlinkCPF(gtw, fps, req)
+		return handleKerlinkCPF(gtw, fps, req)
+	case "chirpstack-concentratord":
+		return handleChirpStackConcentratord(gtw, fps, req)
+	case "multitech-mpower":
+		return handleMultiTechMPower(gtw, fps)
 	default:
 		return nil, errUnsupportedConfigurationFormat.WithAttributes("format", req.Format)
 	}
-
-	return &ttnpb.GetGatewayConfigurationResponse{
-		Contents: configContent,
-	}, err
 }
 
-func handleSemtechUDP(gtw *ttnpb.Gateway, fps *frequencyplans.Store, req *ttnpb.GetGatewayConfigurationRequest) ([]byte, error) {
-	if req.Filename != "global_conf.json" {
-		return nil, errUnsupportedConfigurationFilename.WithAttributes("filename", req.Filename, "type", req.Type)
-	}
+func handleSemtechUDP(gtw *ttnpb.Gateway, fps *frequencyplans.Store) ([]byte, error) {
 	config, err := semtechudp.Build(gtw, fps)
 	if err != nil {
 		return nil, err
@@ -95,9 +113,6 @@ func handleSemtechUDP(gtw *ttnpb.Gateway, fps *frequencyplans.Store, req *ttnpb.
 func handleKerlinkCPF(gtw *ttnpb.Gateway, fps *frequencyplans.Store, req *ttnpb.GetGatewayConfigurationRequest) ([]byte, error) {
 	switch req.Type {
 	case "lorad":
-		if req.Filename != "lorad.json" {
-			return nil, errUnsupportedConfigurationFilename.WithAttributes("filename", req.Filename, "type", req.Type)
-		}
 		config, err := cpf.BuildLorad(gtw, fps)
 		if err != nil {
 			return nil, err
@@ -105,9 +120,6 @@ func handleKerlinkCPF(gtw *ttnpb.Gateway, fps *frequencyplans.Store, req *ttnpb.
 		return json.Marshal(config)
 
 	case "lorafwd":
-		if req.Filename != "lorafwd.toml" {
-			return nil, errUnsupportedConfigurationFilename.WithAttributes("filename", req.Filename, "type", req.Type)
-		}
 		config, err := cpf.BuildLorafwd(gtw)
 		if err != nil {
 			return nil, err
@@ -118,3 +130,25 @@ func handleKerlinkCPF(gtw *ttnpb.Gateway, fps *frequencyplans.Store, req *ttnpb.
 		return nil, errUnsupportedConfigurationType.WithAttributes("type", req.Type)
 	}
 }
+
+func handleChirpStackConcentratord(
+	gtw *ttnpb.Gateway, fps *frequencyplans.Store, req *ttnpb.GetGatewayConfigurationRequest,
+) ([]byte, error) {
+	// The model is also taken from the template variables, which are not validated as request fields.
+	if err := req.ValidateFields("model"); err != nil {
+		return nil, err
+	}
+	config, err := concentratord.Build(gtw, fps, req.Model)
+	if err != nil {
+		return nil, err
+	}
+	return config.MarshalText()
+}
+
+func handleMultiTechMPower(gtw *ttnpb.Gateway, fps *frequencyplans.Store) ([]byte, error) {
+	config, err := mpower.Build(gtw, fps)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(config)
+}
@@ -0,0 +1,354 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayconfigurationserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errTemplatesDisabled = errors.DefineFailedPrecondition(
+		"templates_disabled", "gateway configuration templates are disabled",
+	)
+	errNoTemplateOwner = errors.DefineInvalidArgument("no_template_owner", "no template owner")
+)
+
+func (s *Server) templates() (TemplateRegistry, error) {
+	if s.config.Templates == nil {
+		return nil, errTemplatesDisabled.New()
+	}
+	return s.config.Templates, nil
+}
+
+// requireTemplateOwner requires the given rights of the user or organization that owns the templates.
+func requireTemplateOwner(
+	ctx context.Context, ids *ttnpb.OrganizationOrUserIdentifiers, usrRight, orgRight ttnpb.Right,
+) error {
+	if usrIDs := ids.GetUserIds(); usrIDs != nil {
+		return rights.RequireUser(ctx, usrIDs, usrRight)
+	}
+	return rights.RequireOrganization(ctx, ids.GetOrganizationIds(), orgRight)
+}
+
+// requireReadTemplates requires the rights to read the templates of the user or organization.
+// These are the rights to list the gateways of the user or organization.
+func requireReadTemplates(ctx context.Context, ids *ttnpb.OrganizationOrUserIdentifiers) error {
+	return requireTemplateOwner(
+		ctx, ids, ttnpb.Right_RIGHT_USER_GATEWAYS_LIST, ttnpb.Right_RIGHT_ORGANIZATION_GATEWAYS_LIST,
+	)
+}
+
+// requireWriteTemplates requires the rights to manage the templates of the user or organization.
+// These are the rights to create gateways of the user or organization.
+func requireWriteTemplates(ctx context.Context, ids *ttnpb.OrganizationOrUserIdentifiers) error {
+	return requireTemplateOwner(
+		ctx, ids, ttnpb.Right_RIGHT_USER_GATEWAYS_CREATE, ttnpb.Right_RIGHT_ORGANIZATION_GATEWAYS_CREATE,
+	)
+}
+
+// renderTemplate renders the configuration of the gateway from the version of the template, with the given variables
+// and overrides.
+func (s *Server) renderTemplate(
+	ctx context.Context,
+	ids *ttnpb.GatewayIdentifiers,
+	tmpl *ttnpb.GatewayConfigurationTemplate,
+	variables map[string]string,
+	overrides []byte,
+) (*ttnpb.RenderedGatewayConfiguration, error) {
+	gtw, err := s.getGateway(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	fps, err := s.FrequencyPlansStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return renderConfiguration(gtw, fps, tmpl, variables, overrides)
+}
+
+// renderAssignedTemplate renders the configuration of the gateway from its assigned template.
+func (s *Server) renderAssignedTemplate(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*ttnpb.RenderedGatewayConfiguration, error) {
+	registry, err := s.templates()
+	if err != nil {
+		return nil, err
+	}
+	assignment, err := registry.GetAssignment(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := registry.GetTemplate(
+		ctx, assignment.TemplateOwnerIds, assignment.TemplateId, assignment.TemplateVersion,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return s.renderTemplate(ctx, ids, tmpl, assignment.Variables, assignment.Overrides)
+}
+
+// PreviewGatewayConfiguration implements ttnpb.GatewayConfigurationServiceServer.
+func (s *Server) PreviewGatewayConfiguration(
+	ctx context.Context, req *ttnpb.PreviewGatewayConfigurationRequest,
+) (*ttnpb.RenderedGatewayConfiguration, error) {
+	if err := rights.RequireGateway(ctx, req.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_INFO); err != nil {
+		return nil, err
+	}
+	if req.TemplateId == "" {
+		return s.renderAssignedTemplate(ctx, req.GatewayIds)
+	}
+	if req.TemplateOwnerIds == nil {
+		return nil, errNoTemplateOwner.New()
+	}
+	if err := requireReadTemplates(ctx, req.TemplateOwnerIds); err != nil {
+		return nil, err
+	}
+	registry, err := s.templates()
+	if err != nil {
+		return nil, err
+	}
+	tmpl, err := registry.GetTemplate(ctx, req.TemplateOwnerIds, req.TemplateId, req.TemplateVersion)
+	if err != nil {
+		return nil, err
+	}
+	return s.renderTemplate(ctx, req.GatewayIds, tmpl, req.Variables, req.Overrides)
+}
+
+// PullRenderedGatewayConfiguration implements ttnpb.GatewayConfigurationServiceServer.
+func (s *Server) PullRenderedGatewayConfiguration(
+	ctx context.Context, req *ttnpb.PullRenderedGatewayConfigurationRequest,
+) (*ttnpb.PullRenderedGatewayConfigurationResponse, error) {
+	if s.config.RequireAuth {
+		if err := rights.RequireGateway(ctx, req.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_INFO); err != nil {
+			return nil, err
+		}
+	}
+	rendered, err := s.renderAssignedTemplate(ctx, req.GatewayIds)
+	if err != nil {
+		return nil, err
+	}
+	if rendered.Revision == req.Revision {
+		return &ttnpb.PullRenderedGatewayConfigurationResponse{}, nil
+	}
+	return &ttnpb.PullRenderedGatewayConfigurationResponse{
+		Modified:      true,
+		Configuration: rendered,
+	}, nil
+}
+
+type templateRegistryServer struct {
+	ttnpb.UnimplementedGatewayConfigurationTemplateRegistryServer
+
+	gcs *Server
+}
+
+// SetTemplate implements ttnpb.GatewayConfigurationTemplateRegistryServer.
+func (srv *templateRegistryServer) SetTemplate(
+	ctx context.Context, req *ttnpb.SetGatewayConfigurationTemplateRequest,
+) (*ttnpb.GatewayConfigurationTemplate, error) {
+	if err := requireWriteTemplates(ctx, req.Template.OwnerIds); err != nil {
+		return nil, err
+	}
+	registry, err := srv.gcs.templates()
+	if err != nil {
+		return nil, err
+	}
+	tmpl := req.Template
+	if err := validateConfiguration(tmpl.Format, tmpl.Type, tmpl.Filename); err != nil {
+		return nil, err
+	}
+	if _, err := parseTemplate(tmpl); err != nil {
+		return nil, err
+	}
+	return registry.AddTemplateVersion(ctx, tmpl, srv.gcs.config.TemplateVersions)
+}
+
+// GetTemplate implements ttnpb.GatewayConfigurationTemplateRegistryServer.
+func (srv *templateRegistryServer) GetTemplate(
+	ctx context.Context, req *ttnpb.GetGatewayConfigurationTemplateRequest,
+) (*ttnpb.GatewayConfigurationTemplate, error) {
+	if err := requireReadTemplates(ctx, req.OwnerIds); err != nil {
+		return nil, err
+	}
+	registry, err := srv.gcs.templates()
+	if err != nil {
+		return nil, err
+	}
+	return registry.GetTemplate(ctx, req.OwnerIds, req.TemplateId, req.Version)
+}
+
+// ListTemplates implements ttnpb.GatewayConfigurationTemplateRegistryServer.
+func (srv *templateRegistryServer) ListTemplates(
+	ctx context.Context, req *ttnpb.ListGatewayConfigurationTemplatesRequest,
+) (*ttnpb.GatewayConfigurationTemplates, error) {
+	if err := requireReadTemplates(ctx, req.OwnerIds); err != nil {
+		return nil, err
+	}
+	registry, err := srv.gcs.templates()
+	if err != nil {
+		return nil, err
+	}
+	templates, err := registry.ListTemplates(ctx, req.OwnerIds)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.GatewayConfigurationTemplates{
+		Templates: templates,
+	}, nil
+}
+
+// DeleteTemplate implements ttnpb.GatewayConfigurationTemplateRegistryServer.
+func (srv *templateRegistryServer) DeleteTemplate(
+	ctx context.Context, req *ttnpb.DeleteGatewayConfigurationTemplateRequest,
+) (*emptypb.Empty, error) {
+	if err := requireWriteTemplates(ctx, req.OwnerIds); err != nil {
+		return nil, err
+	}
+	registry, err := srv.gcs.templates()
+	if err != nil {
+		return nil, err
+	}
+	if err := registry.DeleteTemplate(ctx, req.OwnerIds, req.TemplateId); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// DiffTemplate implements ttnpb.GatewayConfigurationTemplateRegistryServer.
+func (srv *templateRegistryServer) DiffTemplate(
+	ctx context.Context, req *ttnpb.DiffGatewayConfigurationTemplateRequest,
+) (*ttnpb.DiffGatewayConfigurationTemplateResponse, error) {
+	if err := requireReadTemplates(ctx, req.OwnerIds); err != nil {
+		return nil, err
+	}
+	if req.GatewayIds != nil {
+		if err := rights.RequireGateway(ctx, req.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_INFO); err != nil {
+			return nil, err
+		}
+	}
+	registry, err := srv.gcs.templates()
+	if err != nil {
+		return nil, err
+	}
+	from, err := registry.GetTemplate(ctx, req.OwnerIds, req.TemplateId, req.FromVersion)
+	if err != nil {
+		return nil, err
+	}
+	to, err := registry.GetTemplate(ctx, req.OwnerIds, req.TemplateId, req.ToVersion)
+	if err != nil {
+		return nil, err
+	}
+	if req.GatewayIds == nil {
+		return &ttnpb.DiffGatewayConfigurationTemplateResponse{
+			Diff: diffContents(from.Contents, to.Contents),
+		}, nil
+	}
+
+	// Render both versions with the variables and overrides of the gateway, if the template is assigned to it.
+	var (
+		variables map[string]string
+		overrides []byte
+	)
+	assignment, err := registry.GetAssignment(ctx, req.GatewayIds)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, err
+	}
+	if assignment.GetTemplateId() == req.TemplateId &&
+		assignment.GetTemplateOwnerIds().IDString() == req.OwnerIds.IDString() {
+		variables, overrides = assignment.Variables, assignment.Overrides
+	}
+	fromRendered, err := srv.gcs.renderTemplate(ctx, req.GatewayIds, from, variables, overrides)
+	if err != nil {
+		return nil, err
+	}
+	toRendered, err := srv.gcs.renderTemplate(ctx, req.GatewayIds, to, variables, overrides)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.DiffGatewayConfigurationTemplateResponse{
+		Diff: diffContents(fromRendered.Contents, toRendered.Contents),
+	}, nil
+}
+
+// SetAssignment implements ttnpb.GatewayConfigurationTemplateRegistryServer.
+func (srv *templateRegistryServer) SetAssignment(
+	ctx context.Context, req *ttnpb.SetGatewayConfigurationTemplateAssignmentRequest,
+) (*ttnpb.GatewayConfigurationTemplateAssignment, error) {
+	assignment := req.Assignment
+	if err := rights.RequireGateway(
+		ctx, assignment.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_SETTINGS_BASIC,
+	); err != nil {
+		return nil, err
+	}
+	if err := requireReadTemplates(ctx, assignment.TemplateOwnerIds); err != nil {
+		return nil, err
+	}
+	registry, err := srv.gcs.templates()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := registry.GetTemplate(
+		ctx, assignment.TemplateOwnerIds, assignment.TemplateId, assignment.TemplateVersion,
+	); err != nil {
+		return nil, err
+	}
+	if len(assignment.Overrides) > 0 {
+		if _, err := decodeJSON(assignment.Overrides); err != nil {
+			return nil, errOverrides.WithCause(err)
+		}
+	}
+	assignment.UpdatedAt = timestamppb.Now()
+	if err := registry.SetAssignment(ctx, assignment); err != nil {
+		return nil, err
+	}
+	return assignment, nil
+}
+
+// GetAssignment implements ttnpb.GatewayConfigurationTemplateRegistryServer.
+func (srv *templateRegistryServer) GetAssignment(
+	ctx context.Context, req *ttnpb.GetGatewayConfigurationTemplateAssignmentRequest,
+) (*ttnpb.GatewayConfigurationTemplateAssignment, error) {
+	if err := rights.RequireGateway(ctx, req.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_INFO); err != nil {
+		return nil, err
+	}
+	registry, err := srv.gcs.templates()
+	if err != nil {
+		return nil, err
+	}
+	return registry.GetAssignment(ctx, req.GatewayIds)
+}
+
+// DeleteAssignment implements ttnpb.GatewayConfigurationTemplateRegistryServer.
+func (srv *templateRegistryServer) DeleteAssignment(
+	ctx context.Context, req *ttnpb.GetGatewayConfigurationTemplateAssignmentRequest,
+) (*emptypb.Empty, error) {
+	if err := rights.RequireGateway(ctx, req.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	registry, err := srv.gcs.templates()
+	if err != nil {
+		return nil, err
+	}
+	if err := registry.DeleteAssignment(ctx, req.GatewayIds); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
@@ -19,9 +19,14 @@ import (
 	"encoding"
 	"encoding/json"
 	"net/http"
+	"path"
+	"strconv"
+	"strings"
 
 	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/concentratord"
 	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/cpf"
+	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/mpower"
 	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/semtechudp"
 	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -67,10 +72,68 @@ func (s *Server) RegisterRoutes(server *web.Server) {
 	).Methods(http.MethodGet)
 
 	router.Handle("/kerlink-cpf/lorafwd/lorafwd.toml",
-		s.makeTextMarshalerHandler("application/toml", func(ctx context.Context, gtw *ttnpb.Gateway) (encoding.TextMarshaler, error) {
+		s.makeTextMarshalerHandler("application/toml", func(r *http.Request, gtw *ttnpb.Gateway) (encoding.TextMarshaler, error) {
 			return cpf.BuildLorafwd(gtw)
 		}),
 	).Methods(http.MethodGet)
+
+	// The model of the gateway is given with the model query parameter.
+	router.Handle("/chirpstack-concentratord/concentratord.toml",
+		s.makeTextMarshalerHandler("application/toml", func(r *http.Request, gtw *ttnpb.Gateway) (encoding.TextMarshaler, error) {
+			req := &ttnpb.GetGatewayConfigurationRequest{
+				Model: r.URL.Query().Get("model"),
+			}
+			if err := req.ValidateFields("model"); err != nil {
+				return nil, err
+			}
+			fps, err := s.FrequencyPlansStore(r.Context())
+			if err != nil {
+				return nil, err
+			}
+			return concentratord.Build(gtw, fps, req.Model)
+		}),
+	).Methods(http.MethodGet)
+
+	router.Handle("/multitech-mpower/global_conf.json",
+		s.makeJSONHandler(func(ctx context.Context, gtw *ttnpb.Gateway) (any, error) {
+			fps, err := s.FrequencyPlansStore(ctx)
+			if err != nil {
+				return nil, err
+			}
+			return mpower.Build(gtw, fps)
+		}),
+	).Methods(http.MethodGet)
+
+	router.HandleFunc("/template", s.handleTemplate).Methods(http.MethodGet)
+}
+
+// handleTemplate serves the configuration of the gateway that is rendered from its assigned template.
+// The revision of the configuration is the entity tag, so gateways can poll with If-None-Match to check for updates.
+func (s *Server) handleTemplate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	rendered, err := s.renderAssignedTemplate(ctx, gatewayIDFromContext(ctx))
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	etag := strconv.Quote(rendered.Revision)
+	w.Header().Set("ETag", etag)
+	for _, match := range strings.Split(r.Header.Get("If-None-Match"), ",") {
+		if strings.TrimSpace(match) == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+	}
+	contentType := "text/plain; charset=utf-8"
+	switch path.Ext(rendered.Filename) {
+	case ".json":
+		contentType = "application/json"
+	case ".toml":
+		contentType = "application/toml"
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	w.Write(rendered.Contents)
 }
 
 func (s *Server) withGateway(next func(http.ResponseWriter, *http.Request, *ttnpb.Gateway)) http.HandlerFunc {
@@ -110,9 +173,9 @@ func (s *Server) makeJSONHandler(f func(context.Context, *ttnpb.Gateway) (any, e
 	})
 }
 
-func (s *Server) makeTextMarshalerHandler(contentType string, f func(context.Context, *ttnpb.Gateway) (encoding.TextMarshaler, error)) http.HandlerFunc {
+func (s *Server) makeTextMarshalerHandler(contentType string, f func(*http.Request, *ttnpb.Gateway) (encoding.TextMarshaler, error)) http.HandlerFunc {
 	return s.withGateway(func(w http.ResponseWriter, r *http.Request, gtw *ttnpb.Gateway) {
-		msg, err := f(r.Context(), gtw)
+		msg, err := f(r, gtw)
 		if err != nil {
 			webhandlers.Error(w, r, err)
 			return
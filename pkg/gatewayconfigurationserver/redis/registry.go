@@ -0,0 +1,415 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the Gateway Configuration Server template registry with Redis.
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errTemplateNotFound        = errors.DefineNotFound("template_not_found", "template `{template_id}` not found")
+	errTemplateVersionNotFound = errors.DefineNotFound(
+		"template_version_not_found", "version `{version}` of template `{template_id}` not found",
+	)
+	errAssignmentNotFound = errors.DefineNotFound(
+		"assignment_not_found", "no template assigned to gateway `{gateway_uid}`",
+	)
+	errTemplateAssigned = errors.DefineFailedPrecondition(
+		"template_assigned", "template `{template_id}` is assigned to `{gateways}` gateways",
+	)
+)
+
+// TemplateRegistry implements the gateway configuration TemplateRegistry.
+// The versions of a template are stored in a sorted set per template, scored by version.
+// The template IDs are stored in a set per owner, and the assignments are stored per gateway.
+// The assigned template versions are stored in a hash per template, keyed by gateway UID, so that assigned
+// versions are not pruned and assigned templates are not deleted.
+// The template versions and the assigned template versions are changed while holding the mutex of the template.
+type TemplateRegistry struct {
+	Redis   *ttnredis.Client
+	LockTTL time.Duration
+}
+
+// Init initializes the TemplateRegistry.
+func (r *TemplateRegistry) Init(ctx context.Context) error {
+	return ttnredis.InitMutex(ctx, r.Redis)
+}
+
+func (r *TemplateRegistry) templateIDsKey(ownerUID string) string {
+	return r.Redis.Key("templates", ownerUID)
+}
+
+func (r *TemplateRegistry) templateKey(ownerUID, id string) string {
+	return r.Redis.Key("template", ownerUID, id)
+}
+
+func (r *TemplateRegistry) templateAssignmentsKey(ownerUID, id string) string {
+	return r.Redis.Key("template", ownerUID, id, "assignments")
+}
+
+func (r *TemplateRegistry) assignmentKey(uid string) string {
+	return r.Redis.Key("assignment", "uid", uid)
+}
+
+// assignedVersions returns the template versions that are pinned by assignments.
+func (r *TemplateRegistry) assignedVersions(
+	ctx context.Context, tx *redis.Tx, ownerUID, id string,
+) (map[uint32]bool, error) {
+	vals, err := tx.HVals(ctx, r.templateAssignmentsKey(ownerUID, id)).Result()
+	if err != nil {
+		return nil, err
+	}
+	versions := make(map[uint32]bool, len(vals))
+	for _, val := range vals {
+		version, err := strconv.ParseUint(val, 10, 32)
+		if err != nil {
+			return nil, err
+		}
+		versions[uint32(version)] = true
+	}
+	return versions, nil
+}
+
+// AddTemplateVersion stores the template as the next version of the template of the owner.
+// Only the given number of latest versions of the template are retained. If it is zero, all versions are retained.
+// Versions that are assigned to gateways are retained regardless.
+func (r *TemplateRegistry) AddTemplateVersion(
+	ctx context.Context, template *ttnpb.GatewayConfigurationTemplate, retainVersions int,
+) (*ttnpb.GatewayConfigurationTemplate, error) {
+	if err := template.ValidateFields("owner_ids", "template_id"); err != nil {
+		return nil, err
+	}
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "add gateway configuration template version").End()
+
+	ownerUID := unique.ID(ctx, template.OwnerIds)
+	tk := r.templateKey(ownerUID, template.TemplateId)
+	var stored *ttnpb.GatewayConfigurationTemplate
+	err = ttnredis.LockedWatch(ctx, r.Redis, tk, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		latest, err := tx.ZRevRangeWithScores(ctx, tk, 0, 0).Result()
+		if err != nil {
+			return err
+		}
+		var version uint32 = 1
+		if len(latest) > 0 {
+			version = uint32(latest[0].Score) + 1
+		}
+		stored = &ttnpb.GatewayConfigurationTemplate{
+			OwnerIds:    template.OwnerIds,
+			TemplateId:  template.TemplateId,
+			Version:     version,
+			CreatedAt:   timestamppb.Now(),
+			Description: template.Description,
+			Format:      template.Format,
+			Type:        template.Type,
+			Filename:    template.Filename,
+			Contents:    template.Contents,
+			Variables:   template.Variables,
+		}
+		if err := stored.ValidateFields(); err != nil {
+			return err
+		}
+		s, err := ttnredis.MarshalProto(stored)
+		if err != nil {
+			return err
+		}
+		var expired []redis.Z
+		if retainVersions > 0 {
+			// The new version is retained, so only the latest retainVersions-1 stored versions are retained.
+			expired, err = tx.ZRangeWithScores(ctx, tk, 0, int64(-retainVersions)).Result()
+			if err != nil {
+				return err
+			}
+		}
+		var assigned map[uint32]bool
+		if len(expired) > 0 {
+			assigned, err = r.assignedVersions(ctx, tx, ownerUID, template.TemplateId)
+			if err != nil {
+				return err
+			}
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.ZAdd(ctx, tk, redis.Z{Score: float64(version), Member: s})
+			for _, z := range expired {
+				if !assigned[uint32(z.Score)] {
+					p.ZRem(ctx, tk, z.Member)
+				}
+			}
+			p.SAdd(ctx, r.templateIDsKey(ownerUID), template.TemplateId)
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return stored, nil
+}
+
+// GetTemplate returns the version of the template of the owner. If the version is zero, the latest version is
+// returned.
+func (r *TemplateRegistry) GetTemplate(
+	ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers, id string, version uint32,
+) (*ttnpb.GatewayConfigurationTemplate, error) {
+	if err := ownerIDs.ValidateFields(); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "get gateway configuration template").End()
+
+	tk := r.templateKey(unique.ID(ctx, ownerIDs), id)
+	var (
+		vals []string
+		err  error
+	)
+	if version == 0 {
+		vals, err = r.Redis.ZRange(ctx, tk, -1, -1).Result()
+	} else {
+		score := strconv.FormatUint(uint64(version), 10)
+		vals, err = r.Redis.ZRangeByScore(ctx, tk, &redis.ZRangeBy{Min: score, Max: score}).Result()
+	}
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	if len(vals) == 0 {
+		if version == 0 {
+			return nil, errTemplateNotFound.WithAttributes("template_id", id)
+		}
+		return nil, errTemplateVersionNotFound.WithAttributes("template_id", id, "version", version)
+	}
+	template := &ttnpb.GatewayConfigurationTemplate{}
+	if err := ttnredis.UnmarshalProto(vals[0], template); err != nil {
+		return nil, err
+	}
+	return template, nil
+}
+
+// ListTemplates returns the latest versions of the templates of the owner, ordered by template ID.
+func (r *TemplateRegistry) ListTemplates(
+	ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers,
+) ([]*ttnpb.GatewayConfigurationTemplate, error) {
+	if err := ownerIDs.ValidateFields(); err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "list gateway configuration templates").End()
+
+	ownerUID := unique.ID(ctx, ownerIDs)
+	ids, err := r.Redis.SMembers(ctx, r.templateIDsKey(ownerUID)).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	sort.Strings(ids)
+	cmds := make([]*redis.StringSliceCmd, len(ids))
+	_, err = r.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for i, id := range ids {
+			cmds[i] = p.ZRange(ctx, r.templateKey(ownerUID, id), -1, -1)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	templates := make([]*ttnpb.GatewayConfigurationTemplate, 0, len(ids))
+	for _, cmd := range cmds {
+		vals := cmd.Val()
+		if len(vals) == 0 {
+			// The template is deleted.
+			continue
+		}
+		template := &ttnpb.GatewayConfigurationTemplate{}
+		if err := ttnredis.UnmarshalProto(vals[0], template); err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// DeleteTemplate deletes all versions of the template of the owner.
+// Templates that are assigned to gateways are not deleted.
+func (r *TemplateRegistry) DeleteTemplate(
+	ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers, id string,
+) error {
+	if err := ownerIDs.ValidateFields(); err != nil {
+		return err
+	}
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return err
+	}
+
+	defer trace.StartRegion(ctx, "delete gateway configuration template").End()
+
+	ownerUID := unique.ID(ctx, ownerIDs)
+	tk := r.templateKey(ownerUID, id)
+	return ttnredis.LockedWatch(ctx, r.Redis, tk, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		n, err := tx.HLen(ctx, r.templateAssignmentsKey(ownerUID, id)).Result()
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			return errTemplateAssigned.WithAttributes("template_id", id, "gateways", n)
+		}
+		var del *redis.IntCmd
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			del = p.Del(ctx, tk)
+			p.SRem(ctx, r.templateIDsKey(ownerUID), id)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+		if del.Val() == 0 {
+			return errTemplateNotFound.WithAttributes("template_id", id)
+		}
+		return nil
+	})
+}
+
+// GetAssignment returns the template assignment of the gateway.
+func (r *TemplateRegistry) GetAssignment(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*ttnpb.GatewayConfigurationTemplateAssignment, error) {
+	defer trace.StartRegion(ctx, "get gateway configuration template assignment").End()
+
+	uid := unique.ID(ctx, ids)
+	assignment := &ttnpb.GatewayConfigurationTemplateAssignment{}
+	if err := ttnredis.GetProto(ctx, r.Redis, r.assignmentKey(uid)).ScanProto(assignment); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errAssignmentNotFound.WithAttributes("gateway_uid", uid)
+		}
+		return nil, err
+	}
+	return assignment, nil
+}
+
+// getAssignment returns the stored template assignment of the gateway, or nil if there is none.
+func getAssignment(
+	ctx context.Context, r redis.Cmdable, k string,
+) (*ttnpb.GatewayConfigurationTemplateAssignment, error) {
+	assignment := &ttnpb.GatewayConfigurationTemplateAssignment{}
+	if err := ttnredis.GetProto(ctx, r, k).ScanProto(assignment); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return assignment, nil
+}
+
+// SetAssignment sets the template assignment of the gateway.
+// The assigned template version must exist. If the template version is zero, the template must exist.
+func (r *TemplateRegistry) SetAssignment(
+	ctx context.Context, assignment *ttnpb.GatewayConfigurationTemplateAssignment,
+) error {
+	defer trace.StartRegion(ctx, "set gateway configuration template assignment").End()
+
+	if err := assignment.ValidateFields(); err != nil {
+		return err
+	}
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return err
+	}
+	uid := unique.ID(ctx, assignment.GatewayIds)
+	ak := r.assignmentKey(uid)
+	ownerUID := unique.ID(ctx, assignment.TemplateOwnerIds)
+	tk := r.templateKey(ownerUID, assignment.TemplateId)
+	// The mutex of the template is held so that the assigned version is not pruned or deleted concurrently.
+	return ttnredis.LockedWatch(ctx, r.Redis, tk, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		if err := tx.Watch(ctx, ak).Err(); err != nil {
+			return err
+		}
+		if version := assignment.TemplateVersion; version == 0 {
+			n, err := tx.ZCard(ctx, tk).Result()
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				return errTemplateNotFound.WithAttributes("template_id", assignment.TemplateId)
+			}
+		} else {
+			score := strconv.FormatUint(uint64(version), 10)
+			n, err := tx.ZCount(ctx, tk, score, score).Result()
+			if err != nil {
+				return err
+			}
+			if n == 0 {
+				return errTemplateVersionNotFound.WithAttributes(
+					"template_id", assignment.TemplateId, "version", version,
+				)
+			}
+		}
+		current, err := getAssignment(ctx, tx, ak)
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			if _, err := ttnredis.SetProto(ctx, p, ak, assignment, 0); err != nil {
+				return err
+			}
+			if current != nil {
+				p.HDel(ctx, r.templateAssignmentsKey(unique.ID(ctx, current.TemplateOwnerIds), current.TemplateId), uid)
+			}
+			p.HSet(ctx, r.templateAssignmentsKey(ownerUID, assignment.TemplateId), uid, assignment.TemplateVersion)
+			return nil
+		})
+		return err
+	})
+}
+
+// DeleteAssignment deletes the template assignment of the gateway.
+func (r *TemplateRegistry) DeleteAssignment(ctx context.Context, ids *ttnpb.GatewayIdentifiers) error {
+	defer trace.StartRegion(ctx, "delete gateway configuration template assignment").End()
+
+	uid := unique.ID(ctx, ids)
+	ak := r.assignmentKey(uid)
+	err := r.Redis.Watch(ctx, func(tx *redis.Tx) error {
+		current, err := getAssignment(ctx, tx, ak)
+		if err != nil {
+			return err
+		}
+		if current == nil {
+			return errAssignmentNotFound.WithAttributes("gateway_uid", uid)
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.Del(ctx, ak)
+			p.HDel(ctx, r.templateAssignmentsKey(unique.ID(ctx, current.TemplateOwnerIds), current.TemplateId), uid)
+			return nil
+		})
+		return err
+	}, ak)
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
@@ -0,0 +1,174 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTemplateRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	registry := &TemplateRegistry{
+		Redis:   cl,
+		LockTTL: test.Delay << 10,
+	}
+	if err := registry.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	ownerIDs := (&ttnpb.UserIdentifiers{UserId: "test-user"}).GetOrganizationOrUserIdentifiers()
+	otherOwnerIDs := (&ttnpb.OrganizationIdentifiers{OrganizationId: "test-org"}).GetOrganizationOrUserIdentifiers()
+
+	_, err := registry.GetTemplate(ctx, ownerIDs, "test-template", 0)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	for i, contents := range []string{"v1", "v2", "v3"} {
+		stored, err := registry.AddTemplateVersion(ctx, &ttnpb.GatewayConfigurationTemplate{
+			OwnerIds:   ownerIDs,
+			TemplateId: "test-template",
+			Format:     "semtechudp",
+			Filename:   "global_conf.json",
+			Contents:   []byte(contents),
+		}, 2)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(stored.Version, should.Equal, i+1)
+		a.So(stored.CreatedAt, should.NotBeNil)
+	}
+	_, err = registry.AddTemplateVersion(ctx, &ttnpb.GatewayConfigurationTemplate{
+		OwnerIds:   ownerIDs,
+		TemplateId: "other-template",
+		Format:     "kerlink-cpf",
+		Type:       "lorad",
+		Filename:   "lorad.json",
+	}, 2)
+	a.So(err, should.BeNil)
+	_, err = registry.AddTemplateVersion(ctx, &ttnpb.GatewayConfigurationTemplate{
+		TemplateId: "test-template",
+		Format:     "semtechudp",
+		Filename:   "global_conf.json",
+	}, 2)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	latest, err := registry.GetTemplate(ctx, ownerIDs, "test-template", 0)
+	if a.So(err, should.BeNil) {
+		a.So(latest.Version, should.Equal, 3)
+		a.So(latest.Contents, should.Resemble, []byte("v3"))
+	}
+	second, err := registry.GetTemplate(ctx, ownerIDs, "test-template", 2)
+	if a.So(err, should.BeNil) {
+		a.So(second.Version, should.Equal, 2)
+		a.So(second.Contents, should.Resemble, []byte("v2"))
+	}
+	// The first version is pruned, as only two versions are retained.
+	_, err = registry.GetTemplate(ctx, ownerIDs, "test-template", 1)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	_, err = registry.GetTemplate(ctx, ownerIDs, "test-template", 4)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// Templates are scoped to their owner.
+	_, err = registry.GetTemplate(ctx, otherOwnerIDs, "test-template", 0)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	templates, err := registry.ListTemplates(ctx, otherOwnerIDs)
+	a.So(err, should.BeNil)
+	a.So(templates, should.BeEmpty)
+
+	templates, err = registry.ListTemplates(ctx, ownerIDs)
+	if a.So(err, should.BeNil) && a.So(templates, should.HaveLength, 2) {
+		a.So(templates[0].TemplateId, should.Equal, "other-template")
+		a.So(templates[1].TemplateId, should.Equal, "test-template")
+		a.So(templates[1].Version, should.Equal, 3)
+	}
+
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "test-gateway"}
+	_, err = registry.GetAssignment(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	assignment := &ttnpb.GatewayConfigurationTemplateAssignment{
+		GatewayIds:       ids,
+		TemplateOwnerIds: ownerIDs,
+		TemplateId:       "test-template",
+		Variables:        map[string]string{"region": "eu"},
+		UpdatedAt:        timestamppb.New(time.Now().Truncate(time.Millisecond)),
+	}
+	a.So(registry.SetAssignment(ctx, assignment), should.BeNil)
+	stored, err := registry.GetAssignment(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(stored, should.Resemble, assignment)
+
+	// Assigned templates are not deleted.
+	a.So(errors.IsFailedPrecondition(registry.DeleteTemplate(ctx, ownerIDs, "test-template")), should.BeTrue)
+
+	// Assigned template versions are not pruned.
+	assignment.TemplateVersion = 2
+	a.So(registry.SetAssignment(ctx, assignment), should.BeNil)
+	for _, contents := range []string{"v4", "v5"} {
+		_, err := registry.AddTemplateVersion(ctx, &ttnpb.GatewayConfigurationTemplate{
+			OwnerIds:   ownerIDs,
+			TemplateId: "test-template",
+			Format:     "semtechudp",
+			Filename:   "global_conf.json",
+			Contents:   []byte(contents),
+		}, 2)
+		a.So(err, should.BeNil)
+	}
+	second, err = registry.GetTemplate(ctx, ownerIDs, "test-template", 2)
+	if a.So(err, should.BeNil) {
+		a.So(second.Contents, should.Resemble, []byte("v2"))
+	}
+	_, err = registry.GetTemplate(ctx, ownerIDs, "test-template", 3)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	latest, err = registry.GetTemplate(ctx, ownerIDs, "test-template", 0)
+	if a.So(err, should.BeNil) {
+		a.So(latest.Version, should.Equal, 5)
+	}
+
+	// Pruned template versions cannot be assigned.
+	assignment.TemplateVersion = 3
+	a.So(errors.IsNotFound(registry.SetAssignment(ctx, assignment)), should.BeTrue)
+
+	// Assigning another template releases the previous template.
+	assignment.TemplateId = "other-template"
+	assignment.TemplateVersion = 0
+	a.So(registry.SetAssignment(ctx, assignment), should.BeNil)
+	a.So(errors.IsFailedPrecondition(registry.DeleteTemplate(ctx, ownerIDs, "other-template")), should.BeTrue)
+
+	a.So(registry.DeleteAssignment(ctx, ids), should.BeNil)
+	_, err = registry.GetAssignment(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	a.So(errors.IsNotFound(registry.DeleteAssignment(ctx, ids)), should.BeTrue)
+
+	a.So(errors.IsNotFound(registry.DeleteTemplate(ctx, otherOwnerIDs, "test-template")), should.BeTrue)
+	a.So(registry.DeleteTemplate(ctx, ownerIDs, "test-template"), should.BeNil)
+	_, err = registry.GetTemplate(ctx, ownerIDs, "test-template", 2)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	a.So(errors.IsNotFound(registry.DeleteTemplate(ctx, ownerIDs, "test-template")), should.BeTrue)
+	templates, err = registry.ListTemplates(ctx, ownerIDs)
+	if a.So(err, should.BeNil) && a.So(templates, should.HaveLength, 1) {
+		a.So(templates[0].TemplateId, should.Equal, "other-template")
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayconfigurationserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// TemplateRegistry stores the versions of gateway configuration templates and the assignment of templates to gateways.
+// Templates are stored per owner, which is a user or an organization.
+type TemplateRegistry interface {
+	// AddTemplateVersion stores the template as the next version of the template of the owner.
+	// It returns the stored template with the version and creation time set.
+	// Only the given number of latest versions of the template are retained; older versions are pruned, except the
+	// versions that are assigned to gateways.
+	AddTemplateVersion(
+		ctx context.Context, template *ttnpb.GatewayConfigurationTemplate, retainVersions int,
+	) (*ttnpb.GatewayConfigurationTemplate, error)
+	// GetTemplate returns the version of the template of the owner. If the version is zero, the latest version is
+	// returned.
+	GetTemplate(
+		ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers, id string, version uint32,
+	) (*ttnpb.GatewayConfigurationTemplate, error)
+	// ListTemplates returns the latest versions of the templates of the owner, ordered by template ID.
+	ListTemplates(
+		ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers,
+	) ([]*ttnpb.GatewayConfigurationTemplate, error)
+	// DeleteTemplate deletes all versions of the template of the owner.
+	// Templates that are assigned to gateways are not deleted.
+	DeleteTemplate(ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers, id string) error
+	// GetAssignment returns the template assignment of the gateway.
+	GetAssignment(
+		ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+	) (*ttnpb.GatewayConfigurationTemplateAssignment, error)
+	// SetAssignment sets the template assignment of the gateway.
+	// The assigned template version must exist.
+	SetAssignment(ctx context.Context, assignment *ttnpb.GatewayConfigurationTemplateAssignment) error
+	// DeleteAssignment deletes the template assignment of the gateway.
+	DeleteAssignment(ctx context.Context, ids *ttnpb.GatewayIdentifiers) error
+}
@@ -40,11 +40,13 @@ func (s *Server) Roles() []ttnpb.ClusterRole {
 // RegisterServices registers services provided by gcs at s.
 func (s *Server) RegisterServices(grpcServer *grpc.Server) {
 	ttnpb.RegisterGatewayConfigurationServiceServer(grpcServer, s)
+	ttnpb.RegisterGatewayConfigurationTemplateRegistryServer(grpcServer, &templateRegistryServer{gcs: s})
 }
 
 // RegisterHandlers registers gRPC handlers.
 func (s *Server) RegisterHandlers(mux *runtime.ServeMux, conn *grpc.ClientConn) {
 	ttnpb.RegisterGatewayConfigurationServiceHandler(s.Context(), mux, conn)
+	ttnpb.RegisterGatewayConfigurationTemplateRegistryHandler(s.Context(), mux, conn)
 }
 
 // New returns new *Server.
@@ -54,7 +56,10 @@ func New(c *component.Component, conf *Config) (*Server, error) {
 		config:    conf,
 	}
 
-	bsCUPS := conf.BasicStation.NewServer(c)
+	bsCUPS, err := conf.BasicStation.NewServer(c)
+	if err != nil {
+		return nil, err
+	}
 	_ = bsCUPS
 
 	v2GCS := gcsv2.New(c, gcsv2.WithTheThingsGatewayConfig(conf.TheThingsGateway))
@@ -62,6 +67,7 @@ func New(c *component.Component, conf *Config) (*Server, error) {
 
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.GatewayConfigurationService", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("gatewayconfigurationserver"))
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.GatewayConfigurationService", cluster.HookName, c.ClusterAuthUnaryHook())
+	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.GatewayConfigurationTemplateRegistry", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("gatewayconfigurationserver"))
 
 	c.RegisterGRPC(gcs)
 	c.RegisterWeb(gcs)
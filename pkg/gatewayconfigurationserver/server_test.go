@@ -38,7 +38,9 @@ import (
 	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayconfigurationserver"
 	mockis "go.thethings.network/lorawan-stack/v3/pkg/identityserver/mock"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/concentratord"
 	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/cpf"
+	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/mpower"
 	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/semtechudp"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -125,6 +127,12 @@ func TestWeb(t *testing.T) {
 	cpfLorafwdConfig := func(gtw *ttnpb.Gateway) string {
 		return marshalText(test.Must(cpf.BuildLorafwd(gtw)))
 	}
+	concentratordConfig := func(gtw *ttnpb.Gateway) string {
+		return marshalText(test.Must(concentratord.Build(gtw, fps, "")))
+	}
+	mpowerConfig := func(gtw *ttnpb.Gateway) string {
+		return marshalJSON(test.Must(mpower.Build(gtw, fps)))
+	}
 
 	t.Run("Authorization", func(t *testing.T) {
 		for _, tc := range []struct {
@@ -240,6 +248,62 @@ func TestWeb(t *testing.T) {
 						a.So(string(b), should.Equal, cpfLorafwdConfig(gtw))
 					}
 				})
+				t.Run("chirpstack-concentratord/concentratord.toml", func(t *testing.T) {
+					a := assertions.New(t)
+					url := fmt.Sprintf(
+						"/api/v3/gcs/gateways/%s/chirpstack-concentratord/concentratord.toml",
+						tc.ID.GatewayId,
+					)
+					req := httptest.NewRequest(http.MethodGet, url, nil).WithContext(test.Context())
+					req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tc.Key))
+					rec := httptest.NewRecorder()
+					c.ServeHTTP(rec, req)
+					res := rec.Result()
+					if !a.So(res.StatusCode, should.Equal, tc.ExpectCode) {
+						t.FailNow()
+					}
+					switch res.StatusCode {
+					case http.StatusOK:
+						if !a.So(res.Header.Get("Content-Type"), should.Equal, "application/toml") {
+							t.FailNow()
+						}
+						b, err := io.ReadAll(res.Body)
+						if err != nil {
+							t.Fatalf("Failed to read response body: %s", err)
+						}
+						gtw, err := is.GatewayRegistry().Get(ctx, &ttnpb.GetGatewayRequest{GatewayIds: tc.ID})
+						a.So(err, should.BeNil)
+						a.So(string(b), should.Equal, concentratordConfig(gtw))
+					}
+				})
+				t.Run("multitech-mpower/global_conf.json", func(t *testing.T) {
+					a := assertions.New(t)
+					url := fmt.Sprintf(
+						"/api/v3/gcs/gateways/%s/multitech-mpower/global_conf.json",
+						tc.ID.GatewayId,
+					)
+					req := httptest.NewRequest(http.MethodGet, url, nil).WithContext(test.Context())
+					req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", tc.Key))
+					rec := httptest.NewRecorder()
+					c.ServeHTTP(rec, req)
+					res := rec.Result()
+					if !a.So(res.StatusCode, should.Equal, tc.ExpectCode) {
+						t.FailNow()
+					}
+					switch res.StatusCode {
+					case http.StatusOK:
+						if !a.So(res.Header.Get("Content-Type"), should.Equal, "application/json") {
+							t.FailNow()
+						}
+						b, err := io.ReadAll(res.Body)
+						if err != nil {
+							t.Fatalf("Failed to read response body: %s", err)
+						}
+						gtw, err := is.GatewayRegistry().Get(ctx, &ttnpb.GetGatewayRequest{GatewayIds: tc.ID})
+						a.So(err, should.BeNil)
+						a.So(string(b), should.Equal, mpowerConfig(gtw)+"\n")
+					}
+				})
 			})
 		}
 	})
@@ -348,6 +412,12 @@ func TestGRPC(t *testing.T) {
 	cpfLorafwdConfig := func(gtw *ttnpb.Gateway) string {
 		return marshalText(test.Must(cpf.BuildLorafwd(gtw)))
 	}
+	concentratordConfig := func(gtw *ttnpb.Gateway, model string) string {
+		return marshalText(test.Must(concentratord.Build(gtw, fps, model)))
+	}
+	mpowerConfig := func(gtw *ttnpb.Gateway) string {
+		return marshalJSON(test.Must(mpower.Build(gtw, fps)))
+	}
 
 	t.Run("Request", func(t *testing.T) {
 		a := assertions.New(t)
@@ -401,6 +471,18 @@ func TestGRPC(t *testing.T) {
 					return a.So(string(resp.Contents), should.Equal, semtechUDPConfig(testGtw))
 				},
 			},
+			{
+				Name: "semtechudp/type",
+				Req: &ttnpb.GetGatewayConfigurationRequest{
+					GatewayIds: registeredGatewayID,
+					Format:     "semtechudp",
+					Type:       "ignored",
+					Filename:   "global_conf.json",
+				},
+				RespAssertion: func(resp *ttnpb.GetGatewayConfigurationResponse) bool {
+					return a.So(string(resp.Contents), should.Equal, semtechUDPConfig(testGtw))
+				},
+			},
 			{
 				Name: "kerlink-cpf/lorad",
 				Req: &ttnpb.GetGatewayConfigurationRequest{
@@ -425,6 +507,51 @@ func TestGRPC(t *testing.T) {
 					return a.So(string(resp.Contents), should.Equal, cpfLorafwdConfig(testGtw))
 				},
 			},
+			{
+				Name: "chirpstack-concentratord",
+				Req: &ttnpb.GetGatewayConfigurationRequest{
+					GatewayIds: registeredGatewayID,
+					Format:     "chirpstack-concentratord",
+					Filename:   "concentratord.toml",
+				},
+				RespAssertion: func(resp *ttnpb.GetGatewayConfigurationResponse) bool {
+					return a.So(string(resp.Contents), should.Equal, concentratordConfig(testGtw, ""))
+				},
+			},
+			{
+				Name: "chirpstack-concentratord/model",
+				Req: &ttnpb.GetGatewayConfigurationRequest{
+					GatewayIds: registeredGatewayID,
+					Format:     "chirpstack-concentratord",
+					Filename:   "concentratord.toml",
+					Model:      "rak_2287_eu868",
+				},
+				RespAssertion: func(resp *ttnpb.GetGatewayConfigurationResponse) bool {
+					return a.So(string(resp.Contents), should.ContainSubstring, `model="rak_2287_eu868"`) &&
+						a.So(string(resp.Contents), should.Equal, concentratordConfig(testGtw, "rak_2287_eu868"))
+				},
+			},
+			{
+				Name: "chirpstack-concentratord/invalid model",
+				Req: &ttnpb.GetGatewayConfigurationRequest{
+					GatewayIds: registeredGatewayID,
+					Format:     "chirpstack-concentratord",
+					Filename:   "concentratord.toml",
+					Model:      "rak 2287",
+				},
+				ErrAssertion: errors.IsInvalidArgument,
+			},
+			{
+				Name: "multitech-mpower",
+				Req: &ttnpb.GetGatewayConfigurationRequest{
+					GatewayIds: registeredGatewayID,
+					Format:     "multitech-mpower",
+					Filename:   "global_conf.json",
+				},
+				RespAssertion: func(resp *ttnpb.GetGatewayConfigurationResponse) bool {
+					return a.So(string(resp.Contents), should.Equal, mpowerConfig(testGtw))
+				},
+			},
 		} {
 			t.Run(tc.Name, func(t *testing.T) {
 				resp, err := client.GetGatewayConfiguration(ctx, tc.Req, creds)
@@ -0,0 +1,232 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayconfigurationserver
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"text/template"
+
+	"github.com/kylelemons/godebug/diff"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errParseTemplate = errors.DefineInvalidArgument(
+		"parse_template", "parse template `{template_id}`",
+	)
+	errExecuteTemplate = errors.DefineFailedPrecondition(
+		"execute_template", "execute version `{version}` of template `{template_id}`",
+	)
+	errOverrides       = errors.DefineInvalidArgument("overrides", "invalid overrides")
+	errOverridesFormat = errors.DefineFailedPrecondition(
+		"overrides_format", "overrides are only supported for configurations in JSON format",
+	)
+)
+
+// supportedConfigurations are the supported configuration formats, with the supported filenames by type.
+var supportedConfigurations = map[string]map[string]string{
+	"semtechudp": {
+		"": "global_conf.json",
+	},
+	"kerlink-cpf": {
+		"lorad":   "lorad.json",
+		"lorafwd": "lorafwd.toml",
+	},
+	"chirpstack-concentratord": {
+		"": "concentratord.toml",
+	},
+	"multitech-mpower": {
+		"": "global_conf.json",
+	},
+}
+
+func validateConfiguration(format, typ, filename string) error {
+	types, ok := supportedConfigurations[format]
+	if !ok {
+		return errUnsupportedConfigurationFormat.WithAttributes("format", format)
+	}
+	supportedFilename, ok := types[typ]
+	if !ok {
+		return errUnsupportedConfigurationType.WithAttributes("type", typ)
+	}
+	if filename != supportedFilename {
+		return errUnsupportedConfigurationFilename.WithAttributes("filename", filename, "type", typ)
+	}
+	return nil
+}
+
+// templateData is the data with which the gateway configuration templates are executed.
+type templateData struct {
+	// Gateway is the gateway for which the configuration is rendered.
+	Gateway *ttnpb.Gateway
+	// Base is the configuration that is generated for the gateway.
+	Base string
+	// Variables are the template variables, with the gateway values overriding the template defaults.
+	Variables map[string]string
+}
+
+var templateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+func parseTemplate(tmpl *ttnpb.GatewayConfigurationTemplate) (*template.Template, error) {
+	t, err := template.New(tmpl.TemplateId).
+		Option("missingkey=error").
+		Funcs(templateFuncs).
+		Parse(string(tmpl.Contents))
+	if err != nil {
+		return nil, errParseTemplate.WithCause(err).WithAttributes("template_id", tmpl.TemplateId)
+	}
+	return t, nil
+}
+
+// modelVariable is the template variable that contains the model of the gateway, for the formats that configure
+// the model.
+const modelVariable = "model"
+
+// renderConfiguration renders the configuration of the gateway from the template, with the given variables and
+// overrides. The variables override the defaults of the template.
+func renderConfiguration(
+	gtw *ttnpb.Gateway,
+	fps *frequencyplans.Store,
+	tmpl *ttnpb.GatewayConfigurationTemplate,
+	variables map[string]string,
+	overrides []byte,
+) (*ttnpb.RenderedGatewayConfiguration, error) {
+	merged := make(map[string]string, len(tmpl.Variables)+len(variables))
+	for k, v := range tmpl.Variables {
+		merged[k] = v
+	}
+	for k, v := range variables {
+		merged[k] = v
+	}
+	contents, err := buildConfiguration(gtw, fps, &ttnpb.GetGatewayConfigurationRequest{
+		GatewayIds: gtw.GetIds(),
+		Format:     tmpl.Format,
+		Type:       tmpl.Type,
+		Filename:   tmpl.Filename,
+		Model:      merged[modelVariable],
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(tmpl.Contents) > 0 {
+		t, err := parseTemplate(tmpl)
+		if err != nil {
+			return nil, err
+		}
+		data := templateData{
+			Gateway:   gtw,
+			Base:      string(contents),
+			Variables: merged,
+		}
+		var buf bytes.Buffer
+		if err := t.Execute(&buf, data); err != nil {
+			return nil, errExecuteTemplate.WithCause(err).WithAttributes(
+				"template_id", tmpl.TemplateId,
+				"version", tmpl.Version,
+			)
+		}
+		contents = buf.Bytes()
+	}
+	if len(overrides) > 0 {
+		if contents, err = applyMergePatch(contents, overrides); err != nil {
+			return nil, err
+		}
+	}
+	return &ttnpb.RenderedGatewayConfiguration{
+		TemplateOwnerIds: tmpl.OwnerIds,
+		TemplateId:       tmpl.TemplateId,
+		TemplateVersion:  tmpl.Version,
+		Format:           tmpl.Format,
+		Type:             tmpl.Type,
+		Filename:         tmpl.Filename,
+		Revision:         revision(contents),
+		Contents:         contents,
+	}, nil
+}
+
+// revision returns the revision of the configuration contents.
+func revision(contents []byte) string {
+	h := sha256.Sum256(contents)
+	return hex.EncodeToString(h[:])
+}
+
+func decodeJSON(data []byte) (any, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	// Decode numbers as json.Number to avoid losing precision of large integers.
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// applyMergePatch applies the JSON merge patch, as defined in RFC 7396, to the JSON document.
+func applyMergePatch(doc, patch []byte) ([]byte, error) {
+	p, err := decodeJSON(patch)
+	if err != nil {
+		return nil, errOverrides.WithCause(err)
+	}
+	d, err := decodeJSON(doc)
+	if err != nil {
+		return nil, errOverridesFormat.WithCause(err)
+	}
+	return json.Marshal(mergePatch(d, p))
+}
+
+func mergePatch(target, patch any) any {
+	p, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+	t, ok := target.(map[string]any)
+	if !ok {
+		t = make(map[string]any, len(p))
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(t, k)
+			continue
+		}
+		t[k] = mergePatch(t[k], v)
+	}
+	return t
+}
+
+// diffContents returns the line-based diff between the contents.
+// JSON documents are indented first, so that the diff shows the changed values.
+func diffContents(from, to []byte) string {
+	indent := func(b []byte) string {
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, b, "", "\t"); err != nil {
+			return string(b)
+		}
+		return buf.String()
+	}
+	if json.Valid(from) && json.Valid(to) {
+		return diff.Diff(indent(from), indent(to))
+	}
+	return diff.Diff(string(from), string(to))
+}
@@ -0,0 +1,611 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayconfigurationserver_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayconfigurationserver"
+	mockis "go.thethings.network/lorawan-stack/v3/pkg/identityserver/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/semtechudp"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errNotFound = errors.DefineNotFound("not_found", "not found")
+	errAssigned = errors.DefineFailedPrecondition("assigned", "assigned")
+)
+
+// memTemplateRegistry is an in-memory TemplateRegistry.
+type memTemplateRegistry struct {
+	mu          sync.Mutex
+	templates   map[string][]*ttnpb.GatewayConfigurationTemplate
+	assignments map[string]*ttnpb.GatewayConfigurationTemplateAssignment
+}
+
+func newMemTemplateRegistry() *memTemplateRegistry {
+	return &memTemplateRegistry{
+		templates:   make(map[string][]*ttnpb.GatewayConfigurationTemplate),
+		assignments: make(map[string]*ttnpb.GatewayConfigurationTemplateAssignment),
+	}
+}
+
+func memTemplateKey(ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers, id string) string {
+	return unique.ID(ctx, ownerIDs) + "/" + id
+}
+
+func (r *memTemplateRegistry) AddTemplateVersion(
+	ctx context.Context, template *ttnpb.GatewayConfigurationTemplate, retainVersions int,
+) (*ttnpb.GatewayConfigurationTemplate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	k := memTemplateKey(ctx, template.OwnerIds, template.TemplateId)
+	versions := r.templates[k]
+	stored := ttnpb.Clone(template)
+	stored.Version = 1
+	if len(versions) > 0 {
+		stored.Version = versions[len(versions)-1].Version + 1
+	}
+	stored.CreatedAt = timestamppb.Now()
+	versions = append(versions, stored)
+	if retainVersions > 0 && len(versions) > retainVersions {
+		// Assigned versions are retained.
+		retained := make([]*ttnpb.GatewayConfigurationTemplate, 0, retainVersions)
+		for i, v := range versions {
+			if i >= len(versions)-retainVersions || r.assignedLocked(ctx, k, v.Version) {
+				retained = append(retained, v)
+			}
+		}
+		versions = retained
+	}
+	r.templates[k] = versions
+	return stored, nil
+}
+
+// assignedLocked returns whether the version of the template is assigned. If the version is zero, it returns whether
+// any version of the template is assigned. The caller must hold the lock.
+func (r *memTemplateRegistry) assignedLocked(ctx context.Context, k string, version uint32) bool {
+	for _, assignment := range r.assignments {
+		if memTemplateKey(ctx, assignment.TemplateOwnerIds, assignment.TemplateId) != k {
+			continue
+		}
+		if version == 0 || assignment.TemplateVersion == version {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *memTemplateRegistry) GetTemplate(
+	ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers, id string, version uint32,
+) (*ttnpb.GatewayConfigurationTemplate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	versions := r.templates[memTemplateKey(ctx, ownerIDs, id)]
+	if len(versions) == 0 {
+		return nil, errNotFound.New()
+	}
+	if version == 0 {
+		return versions[len(versions)-1], nil
+	}
+	for _, v := range versions {
+		if v.Version == version {
+			return v, nil
+		}
+	}
+	return nil, errNotFound.New()
+}
+
+func (r *memTemplateRegistry) ListTemplates(
+	ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers,
+) ([]*ttnpb.GatewayConfigurationTemplate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var templates []*ttnpb.GatewayConfigurationTemplate
+	for _, versions := range r.templates {
+		latest := versions[len(versions)-1]
+		if unique.ID(ctx, latest.OwnerIds) == unique.ID(ctx, ownerIDs) {
+			templates = append(templates, latest)
+		}
+	}
+	sort.Slice(templates, func(i, j int) bool { return templates[i].TemplateId < templates[j].TemplateId })
+	return templates, nil
+}
+
+func (r *memTemplateRegistry) DeleteTemplate(
+	ctx context.Context, ownerIDs *ttnpb.OrganizationOrUserIdentifiers, id string,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	k := memTemplateKey(ctx, ownerIDs, id)
+	if _, ok := r.templates[k]; !ok {
+		return errNotFound.New()
+	}
+	if r.assignedLocked(ctx, k, 0) {
+		return errAssigned.New()
+	}
+	delete(r.templates, k)
+	return nil
+}
+
+func (r *memTemplateRegistry) GetAssignment(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*ttnpb.GatewayConfigurationTemplateAssignment, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	assignment, ok := r.assignments[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	return assignment, nil
+}
+
+func (r *memTemplateRegistry) SetAssignment(
+	ctx context.Context, assignment *ttnpb.GatewayConfigurationTemplateAssignment,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.assignments[unique.ID(ctx, assignment.GatewayIds)] = ttnpb.Clone(assignment)
+	return nil
+}
+
+func (r *memTemplateRegistry) DeleteAssignment(ctx context.Context, ids *ttnpb.GatewayIdentifiers) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	if _, ok := r.assignments[uid]; !ok {
+		return errNotFound.New()
+	}
+	delete(r.assignments, uid)
+	return nil
+}
+
+const ownerKey = "owner-key"
+
+var templateOwnerIDs = (&ttnpb.UserIdentifiers{UserId: "test-user"}).GetOrganizationOrUserIdentifiers()
+
+// newContextWithTemplateRightsFetcher returns a context with a rights fetcher that grants the gateway rights to the
+// gateway key, and the user and gateway rights to the owner key of the templates.
+func newContextWithTemplateRightsFetcher(ctx context.Context) context.Context {
+	return rights.NewContextWithFetcher(ctx, &rightsFetcher{
+		AuthInfoFetcher: rights.AuthInfoFetcherFunc(func(context.Context) (*ttnpb.AuthInfoResponse, error) {
+			return &ttnpb.AuthInfoResponse{}, nil
+		}),
+		EntityFetcher: rights.EntityFetcherFunc(func(ctx context.Context, ids *ttnpb.EntityIdentifiers) (*ttnpb.Rights, error) {
+			md := rpcmetadata.FromIncomingContext(ctx)
+			if md.AuthType != "Bearer" {
+				return nil, nil
+			}
+			switch uid := unique.ID(ctx, ids); {
+			case uid == registeredGatewayUID && (md.AuthValue == registeredGatewayKey || md.AuthValue == ownerKey):
+				return ttnpb.RightsFrom(
+					ttnpb.Right_RIGHT_GATEWAY_INFO,
+					ttnpb.Right_RIGHT_GATEWAY_SETTINGS_BASIC,
+				), nil
+			case uid == unique.ID(ctx, templateOwnerIDs) && md.AuthValue == ownerKey:
+				return ttnpb.RightsFrom(
+					ttnpb.Right_RIGHT_USER_GATEWAYS_LIST,
+					ttnpb.Right_RIGHT_USER_GATEWAYS_CREATE,
+				), nil
+			default:
+				return nil, nil
+			}
+		}),
+	})
+}
+
+func TestTemplates(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	is, isAddr, closeIS := mockis.New(ctx)
+	defer closeIS()
+
+	testGtw := mockis.DefaultGateway(registeredGatewayID, false, false)
+	is.GatewayRegistry().Add(ctx, registeredGatewayID, registeredGatewayKey, testGtw, ttnpb.Right_RIGHT_GATEWAY_INFO)
+
+	fpConf := config.FrequencyPlansConfig{
+		ConfigSource: "static",
+		Static:       test.StaticFrequencyPlans,
+	}
+	fps := frequencyplans.NewStore(test.Must(fpConf.Fetcher(ctx, config.BlobConfig{}, test.HTTPClientProvider)).(fetch.Interface))
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			HTTP: config.HTTP{
+				Listen: ":0",
+			},
+			FrequencyPlans: fpConf,
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+			},
+		},
+	})
+	c.AddContextFiller(newContextWithTemplateRightsFetcher)
+
+	gcsConf := *testConfig
+	gcsConf.Templates = newMemTemplateRegistry()
+	test.Must(New(c, &gcsConf))
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	credentials := func(key string) grpc.CallOption {
+		return grpc.PerRPCCredentials(rpcmetadata.MD{
+			AuthType:      "Bearer",
+			AuthValue:     key,
+			AllowInsecure: true,
+		})
+	}
+	ownerCreds, gtwCreds := credentials(ownerKey), credentials(registeredGatewayKey)
+	registry := ttnpb.NewGatewayConfigurationTemplateRegistryClient(c.LoopbackConn())
+	gcs := ttnpb.NewGatewayConfigurationServiceClient(c.LoopbackConn())
+
+	base := string(test.Must(json.Marshal(test.Must(semtechudp.Build(testGtw, fps)))))
+	v1 := &ttnpb.GatewayConfigurationTemplate{
+		OwnerIds:   templateOwnerIDs,
+		TemplateId: "test-template",
+		Format:     "semtechudp",
+		Filename:   "global_conf.json",
+	}
+	v2 := &ttnpb.GatewayConfigurationTemplate{
+		OwnerIds:   templateOwnerIDs,
+		TemplateId: "test-template",
+		Format:     "semtechudp",
+		Filename:   "global_conf.json",
+		Contents: []byte(`{"SX1301_conf":{"antenna_gain":{{ .Variables.antenna_gain }}},` +
+			`"gateway_conf":{"gateway_ID":{{ json .Gateway.Ids.GatewayId }},"server_address":{{ json .Variables.server }}}}`),
+		Variables: map[string]string{
+			"antenna_gain": "0",
+			"server":       "eu1.example.com",
+		},
+	}
+
+	// Only the owner manages templates.
+	_, err := registry.SetTemplate(ctx, &ttnpb.SetGatewayConfigurationTemplateRequest{Template: v1}, gtwCreds)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	for _, tmpl := range []*ttnpb.GatewayConfigurationTemplate{
+		{OwnerIds: templateOwnerIDs, TemplateId: "invalid", Format: "unknown", Filename: "global_conf.json"},
+		{OwnerIds: templateOwnerIDs, TemplateId: "invalid", Format: "semtechudp", Filename: "lorad.json"},
+		{
+			OwnerIds:   templateOwnerIDs,
+			TemplateId: "invalid",
+			Format:     "semtechudp",
+			Filename:   "global_conf.json",
+			Contents:   []byte("{{ .Base"),
+		},
+	} {
+		_, err := registry.SetTemplate(ctx, &ttnpb.SetGatewayConfigurationTemplateRequest{Template: tmpl}, ownerCreds)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+
+	for i, tmpl := range []*ttnpb.GatewayConfigurationTemplate{v1, v2} {
+		stored, err := registry.SetTemplate(ctx, &ttnpb.SetGatewayConfigurationTemplateRequest{Template: tmpl}, ownerCreds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(stored.Version, should.Equal, i+1)
+	}
+	stored, err := registry.GetTemplate(ctx, &ttnpb.GetGatewayConfigurationTemplateRequest{
+		OwnerIds:   templateOwnerIDs,
+		TemplateId: "test-template",
+	}, ownerCreds)
+	if a.So(err, should.BeNil) {
+		a.So(stored.Version, should.Equal, 2)
+	}
+	_, err = registry.GetTemplate(ctx, &ttnpb.GetGatewayConfigurationTemplateRequest{
+		OwnerIds:   templateOwnerIDs,
+		TemplateId: "test-template",
+	}, gtwCreds)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	templates, err := registry.ListTemplates(ctx, &ttnpb.ListGatewayConfigurationTemplatesRequest{
+		OwnerIds: templateOwnerIDs,
+	}, ownerCreds)
+	if a.So(err, should.BeNil) {
+		a.So(templates.Templates, should.HaveLength, 1)
+	}
+	_, err = registry.ListTemplates(ctx, &ttnpb.ListGatewayConfigurationTemplatesRequest{
+		OwnerIds: (&ttnpb.OrganizationIdentifiers{OrganizationId: "other-org"}).GetOrganizationOrUserIdentifiers(),
+	}, ownerCreds)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	// Templates are rendered only for gateways with a template assignment.
+	_, err = gcs.PullRenderedGatewayConfiguration(ctx, &ttnpb.PullRenderedGatewayConfigurationRequest{
+		GatewayIds: registeredGatewayID,
+	}, gtwCreds)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	_, err = registry.SetAssignment(ctx, &ttnpb.SetGatewayConfigurationTemplateAssignmentRequest{
+		Assignment: &ttnpb.GatewayConfigurationTemplateAssignment{
+			GatewayIds:       registeredGatewayID,
+			TemplateOwnerIds: templateOwnerIDs,
+			TemplateId:       "unknown-template",
+		},
+	}, ownerCreds)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	assignment := &ttnpb.GatewayConfigurationTemplateAssignment{
+		GatewayIds:       registeredGatewayID,
+		TemplateOwnerIds: templateOwnerIDs,
+		TemplateId:       "test-template",
+		Variables: map[string]string{
+			"antenna_gain": "3",
+		},
+		Overrides: []byte(`{"gateway_conf":{"serv_port_up":1701}}`),
+	}
+	// Assigning a template requires the rights to read the templates of the owner.
+	_, err = registry.SetAssignment(ctx, &ttnpb.SetGatewayConfigurationTemplateAssignmentRequest{
+		Assignment: assignment,
+	}, gtwCreds)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	_, err = registry.SetAssignment(ctx, &ttnpb.SetGatewayConfigurationTemplateAssignmentRequest{
+		Assignment: assignment,
+	}, ownerCreds)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	expected := fmt.Sprintf(
+		`{"SX1301_conf":{"antenna_gain":3},"gateway_conf":{"gateway_ID":%q,"serv_port_up":1701,"server_address":%q}}`,
+		registeredGatewayID.GatewayId, "eu1.example.com",
+	)
+
+	t.Run("Preview", func(t *testing.T) {
+		a := assertions.New(t)
+
+		rendered, err := gcs.PreviewGatewayConfiguration(ctx, &ttnpb.PreviewGatewayConfigurationRequest{
+			GatewayIds: registeredGatewayID,
+		}, gtwCreds)
+		if a.So(err, should.BeNil) {
+			a.So(rendered.TemplateVersion, should.Equal, 2)
+			a.So(string(rendered.Contents), should.Equal, expected)
+		}
+
+		rendered, err = gcs.PreviewGatewayConfiguration(ctx, &ttnpb.PreviewGatewayConfigurationRequest{
+			GatewayIds:       registeredGatewayID,
+			TemplateOwnerIds: templateOwnerIDs,
+			TemplateId:       "test-template",
+			TemplateVersion:  1,
+		}, ownerCreds)
+		if a.So(err, should.BeNil) {
+			a.So(rendered.TemplateVersion, should.Equal, 1)
+			a.So(string(rendered.Contents), should.Equal, base)
+		}
+
+		_, err = gcs.PreviewGatewayConfiguration(ctx, &ttnpb.PreviewGatewayConfigurationRequest{
+			GatewayIds:       registeredGatewayID,
+			TemplateOwnerIds: templateOwnerIDs,
+			TemplateId:       "test-template",
+		}, gtwCreds)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+		_, err = gcs.PreviewGatewayConfiguration(ctx, &ttnpb.PreviewGatewayConfigurationRequest{
+			GatewayIds: registeredGatewayID,
+			TemplateId: "test-template",
+		}, ownerCreds)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+		_, err = gcs.PreviewGatewayConfiguration(ctx, &ttnpb.PreviewGatewayConfigurationRequest{
+			GatewayIds:       registeredGatewayID,
+			TemplateOwnerIds: templateOwnerIDs,
+			TemplateId:       "test-template",
+			Variables: map[string]string{
+				"antenna_gain": "{",
+			},
+			Overrides: []byte(`{}`),
+		}, ownerCreds)
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+		_, err = gcs.PreviewGatewayConfiguration(ctx, &ttnpb.PreviewGatewayConfigurationRequest{
+			GatewayIds: registeredGatewayID,
+		}, credentials("invalid key"))
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+		// The model of ChirpStack Concentratord is the model template variable.
+		_, err = registry.SetTemplate(ctx, &ttnpb.SetGatewayConfigurationTemplateRequest{
+			Template: &ttnpb.GatewayConfigurationTemplate{
+				OwnerIds:   templateOwnerIDs,
+				TemplateId: "concentratord-template",
+				Format:     "chirpstack-concentratord",
+				Filename:   "concentratord.toml",
+				Variables: map[string]string{
+					"model": "rak_2287_eu868",
+				},
+			},
+		}, ownerCreds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		for _, tc := range []struct {
+			Variables map[string]string
+			Model     string
+		}{
+			{Model: "rak_2287_eu868"},
+			{Variables: map[string]string{"model": "multitech_mtcap_lora_868"}, Model: "multitech_mtcap_lora_868"},
+		} {
+			rendered, err := gcs.PreviewGatewayConfiguration(ctx, &ttnpb.PreviewGatewayConfigurationRequest{
+				GatewayIds:       registeredGatewayID,
+				TemplateOwnerIds: templateOwnerIDs,
+				TemplateId:       "concentratord-template",
+				Variables:        tc.Variables,
+			}, ownerCreds)
+			if a.So(err, should.BeNil) {
+				a.So(string(rendered.Contents), should.ContainSubstring, fmt.Sprintf("\nmodel=%q\n", tc.Model))
+			}
+		}
+		_, err = gcs.PreviewGatewayConfiguration(ctx, &ttnpb.PreviewGatewayConfigurationRequest{
+			GatewayIds:       registeredGatewayID,
+			TemplateOwnerIds: templateOwnerIDs,
+			TemplateId:       "concentratord-template",
+			Variables:        map[string]string{"model": "rak 2287"},
+		}, ownerCreds)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+
+	t.Run("Pull", func(t *testing.T) {
+		a := assertions.New(t)
+
+		res, err := gcs.PullRenderedGatewayConfiguration(ctx, &ttnpb.PullRenderedGatewayConfigurationRequest{
+			GatewayIds: registeredGatewayID,
+		}, gtwCreds)
+		if !a.So(err, should.BeNil) || !a.So(res.Modified, should.BeTrue) {
+			t.FailNow()
+		}
+		a.So(string(res.Configuration.Contents), should.Equal, expected)
+		revision := res.Configuration.Revision
+
+		res, err = gcs.PullRenderedGatewayConfiguration(ctx, &ttnpb.PullRenderedGatewayConfigurationRequest{
+			GatewayIds: registeredGatewayID,
+			Revision:   revision,
+		}, gtwCreds)
+		if a.So(err, should.BeNil) {
+			a.So(res.Modified, should.BeFalse)
+			a.So(res.Configuration, should.BeNil)
+		}
+
+		// A new version of the template changes the configuration of the gateway.
+		v3 := ttnpb.Clone(v2)
+		v3.Variables["server"] = "eu2.example.com"
+		_, err = registry.SetTemplate(ctx, &ttnpb.SetGatewayConfigurationTemplateRequest{Template: v3}, ownerCreds)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		res, err = gcs.PullRenderedGatewayConfiguration(ctx, &ttnpb.PullRenderedGatewayConfigurationRequest{
+			GatewayIds: registeredGatewayID,
+			Revision:   revision,
+		}, gtwCreds)
+		if a.So(err, should.BeNil) && a.So(res.Modified, should.BeTrue) {
+			a.So(res.Configuration.TemplateVersion, should.Equal, 3)
+			a.So(res.Configuration.Revision, should.NotEqual, revision)
+			a.So(string(res.Configuration.Contents), should.ContainSubstring, "eu2.example.com")
+		}
+	})
+
+	t.Run("Diff", func(t *testing.T) {
+		a := assertions.New(t)
+
+		res, err := registry.DiffTemplate(ctx, &ttnpb.DiffGatewayConfigurationTemplateRequest{
+			OwnerIds:    templateOwnerIDs,
+			TemplateId:  "test-template",
+			FromVersion: 1,
+			ToVersion:   2,
+		}, ownerCreds)
+		if a.So(err, should.BeNil) {
+			a.So(res.Diff, should.ContainSubstring, `+{"SX1301_conf"`)
+		}
+
+		res, err = registry.DiffTemplate(ctx, &ttnpb.DiffGatewayConfigurationTemplateRequest{
+			OwnerIds:    templateOwnerIDs,
+			TemplateId:  "test-template",
+			FromVersion: 2,
+			GatewayIds:  registeredGatewayID,
+		}, ownerCreds)
+		if a.So(err, should.BeNil) {
+			a.So(res.Diff, should.ContainSubstring, "-\t\t\"server_address\": \"eu1.example.com\"")
+			a.So(res.Diff, should.ContainSubstring, "+\t\t\"server_address\": \"eu2.example.com\"")
+		}
+
+		_, err = registry.DiffTemplate(ctx, &ttnpb.DiffGatewayConfigurationTemplateRequest{
+			OwnerIds:    templateOwnerIDs,
+			TemplateId:  "test-template",
+			FromVersion: 1,
+		}, gtwCreds)
+		a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	})
+
+	t.Run("Web", func(t *testing.T) {
+		a := assertions.New(t)
+
+		get := func(etag string) *http.Response {
+			url := fmt.Sprintf("/api/v3/gcs/gateways/%s/template", registeredGatewayID.GatewayId)
+			req := httptest.NewRequest(http.MethodGet, url, nil).WithContext(test.Context())
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", registeredGatewayKey))
+			if etag != "" {
+				req.Header.Set("If-None-Match", etag)
+			}
+			rec := httptest.NewRecorder()
+			c.ServeHTTP(rec, req)
+			return rec.Result()
+		}
+
+		res := get("")
+		if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+			t.FailNow()
+		}
+		a.So(res.Header.Get("Content-Type"), should.Equal, "application/json")
+		b, err := io.ReadAll(res.Body)
+		a.So(err, should.BeNil)
+		a.So(string(b), should.ContainSubstring, "eu2.example.com")
+		etag := res.Header.Get("ETag")
+		a.So(etag, should.NotBeEmpty)
+
+		res = get(etag)
+		a.So(res.StatusCode, should.Equal, http.StatusNotModified)
+		res = get(`"other"`)
+		a.So(res.StatusCode, should.Equal, http.StatusOK)
+	})
+
+	_, err = registry.DeleteTemplate(ctx, &ttnpb.DeleteGatewayConfigurationTemplateRequest{
+		OwnerIds:   templateOwnerIDs,
+		TemplateId: "test-template",
+	}, ownerCreds)
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+	_, err = registry.DeleteAssignment(ctx, &ttnpb.GetGatewayConfigurationTemplateAssignmentRequest{
+		GatewayIds: registeredGatewayID,
+	}, gtwCreds)
+	a.So(err, should.BeNil)
+	_, err = gcs.PullRenderedGatewayConfiguration(ctx, &ttnpb.PullRenderedGatewayConfigurationRequest{
+		GatewayIds: registeredGatewayID,
+	}, gtwCreds)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	_, err = registry.DeleteTemplate(ctx, &ttnpb.DeleteGatewayConfigurationTemplateRequest{
+		OwnerIds:   templateOwnerIDs,
+		TemplateId: "test-template",
+	}, gtwCreds)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	_, err = registry.DeleteTemplate(ctx, &ttnpb.DeleteGatewayConfigurationTemplateRequest{
+		OwnerIds:   templateOwnerIDs,
+		TemplateId: "test-template",
+	}, ownerCreds)
+	a.So(err, should.BeNil)
+}
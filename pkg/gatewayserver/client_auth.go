@@ -0,0 +1,62 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"bytes"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errClientCertificateGateway = errors.DefinePermissionDenied(
+		"client_certificate_gateway",
+		"client certificate does not identify the gateway",
+	)
+	errClientCertificateFingerprint = errors.DefinePermissionDenied(
+		"client_certificate_fingerprint",
+		"client certificate does not match the registered fingerprint",
+	)
+	errClientCertificateNotTrusted = errors.DefinePermissionDenied(
+		"client_certificate_not_trusted",
+		"client certificate is not trusted",
+	)
+)
+
+// authenticateClientCertificate authenticates the gateway with the client certificate.
+// The client certificate must identify the gateway by its subject common name or DNS names.
+// If the gateway has a registered client certificate fingerprint, the client certificate must match the fingerprint.
+// Otherwise, the client certificate must be signed by one of the configured certificate authorities.
+func (gs *GatewayServer) authenticateClientCertificate(gtw *ttnpb.Gateway, cert *io.ClientCertificate) error {
+	if !cert.Matches(gtw.GetIds()) {
+		return errClientCertificateGateway.New()
+	}
+	if fingerprint := gtw.ClientCertificateFingerprint; len(fingerprint) > 0 {
+		if !bytes.Equal(fingerprint, cert.Fingerprint()) {
+			return errClientCertificateFingerprint.New()
+		}
+		return nil
+	}
+	if gs.clientCAs == nil {
+		return errClientCertificateNotTrusted.New()
+	}
+	if err := cert.Verify(gs.clientCAs, time.Now()); err != nil {
+		return errClientCertificateNotTrusted.WithCause(err)
+	}
+	return nil
+}
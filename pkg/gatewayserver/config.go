@@ -15,11 +15,19 @@
 package gatewayserver
 
 import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
 	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/udp"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/ns"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 )
 
@@ -45,11 +53,137 @@ type PacketBrokerConfig struct {
 	OnlineTTLMargin       time.Duration `name:"online-ttl-margin" description:"Time to extend the online status before it expires"`
 }
 
+// ConnectionStatsHistoryConfig configures the history of gateway connection stats.
+type ConnectionStatsHistoryConfig struct {
+	Interval  time.Duration `name:"interval" description:"Interval at which the gateway connection stats are recorded"`
+	Retention time.Duration `name:"retention" description:"Time to keep the recorded gateway connection stats"`
+	MaxRange  time.Duration `name:"max-range" description:"Maximum time range of a request for the history of gateway connection stats (0 is unlimited)"`
+	MaxPoints int           `name:"max-points" description:"Maximum number of points returned for a request for the history of gateway connection stats (0 is unlimited)"`
+}
+
+var errConnectionStatsHistoryRetention = errors.DefineInvalidArgument(
+	"connection_stats_history_retention",
+	"connection stats history retention `{retention}` must be positive",
+)
+
+// Validate returns an error if the history is recorded without a positive retention.
+func (c ConnectionStatsHistoryConfig) Validate() error {
+	if c.Interval > 0 && c.Retention <= 0 {
+		return errConnectionStatsHistoryRetention.WithAttributes("retention", c.Retention)
+	}
+	return nil
+}
+
+// ClientAuthConfig configures the authentication of gateways with TLS client certificates.
+type ClientAuthConfig struct {
+	Enable  bool   `name:"enable" description:"Request TLS client certificates from gateways on the MQTT and Basic Station TLS listeners"`
+	Require bool   `name:"require" description:"Require TLS client certificates from gateways on the MQTT and Basic Station TLS listeners"`
+	CA      string `name:"ca" description:"Location of the certificate authorities that sign gateway client certificates (PEM)"`
+}
+
+// TLSOptions returns the TLS options for the listeners that support client certificate authentication.
+// The client certificates are not verified during the TLS handshake, but when the gateway connects.
+func (c ClientAuthConfig) TLSOptions() []tlsconfig.Option {
+	switch {
+	case c.Require:
+		return []tlsconfig.Option{tlsconfig.WithTLSClientAuth(tls.RequireAnyClientCert, nil, nil)}
+	case c.Enable:
+		return []tlsconfig.Option{tlsconfig.WithTLSClientAuth(tls.RequestClientCert, nil, nil)}
+	default:
+		return nil
+	}
+}
+
+// RoutingConfig configures the routing rules of uplink messages to upstream hosts.
+type RoutingConfig struct {
+	ConfigSource   string                `name:"config-source" description:"Source of routing.yml (directory, url, blob)"`
+	Directory      string                `name:"directory" description:"OS filesystem directory, which contains routing configuration"`
+	URL            string                `name:"url" description:"URL, which contains routing configuration"`
+	Blob           config.BlobPathConfig `name:"blob"`
+	ReloadInterval time.Duration         `name:"reload-interval" description:"Interval at which the routing configuration is reloaded"`
+}
+
+// Fetcher returns fetch.Interface defined by conf.
+// If no configuration source is set, this method returns nil, nil.
+func (c RoutingConfig) Fetcher(
+	ctx context.Context, blobConf config.BlobConfig, httpClientProvider httpclient.Provider,
+) (fetch.Interface, error) {
+	switch c.ConfigSource {
+	case "directory":
+		return fetch.FromFilesystem(c.Directory), nil
+	case "url":
+		httpClient, err := httpClientProvider.HTTPClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return fetch.FromHTTP(httpClient, c.URL)
+	case "blob":
+		b, err := blobConf.Bucket(ctx, c.Blob.Bucket, httpClientProvider)
+		if err != nil {
+			return nil, err
+		}
+		return fetch.FromBucket(ctx, b, c.Blob.Path), nil
+	default:
+		return nil, nil
+	}
+}
+
+// NetworkServerUpstreamsConfig configures Network Servers outside of the cluster as named upstream hosts.
+// The upstream hosts only receive the uplink messages of the routing rules that refer to them.
+// The upstream hosts cannot schedule downlink messages through the Gateway Server, so they are uplink only.
+type NetworkServerUpstreamsConfig struct {
+	Addresses map[string]string `name:"addresses" description:"Addresses of the Network Servers by upstream name"`
+	Keys      map[string]string `name:"keys" description:"Cluster keys (hex) accepted by the Network Servers by upstream name"`
+	Insecure  []string          `name:"insecure" description:"Names of the upstreams to connect to without TLS"`
+}
+
+var (
+	errNetworkServerUpstreamName = errors.DefineInvalidArgument(
+		"network_server_upstream_name", "invalid Network Server upstream name `{name}`",
+	)
+	errNetworkServerUpstreamKey = errors.DefineInvalidArgument(
+		"network_server_upstream_key", "invalid cluster key of Network Server upstream `{name}`",
+	)
+)
+
+// Remotes returns the configuration of the Network Server upstreams by name.
+// The TLS configuration is used for the upstreams that are not insecure.
+func (c NetworkServerUpstreamsConfig) Remotes(tlsConfig *tls.Config) (map[string]ns.RemoteConfig, error) {
+	insecure := make(map[string]bool, len(c.Insecure))
+	for _, name := range c.Insecure {
+		insecure[name] = true
+	}
+	res := make(map[string]ns.RemoteConfig, len(c.Addresses))
+	for name, address := range c.Addresses {
+		switch name {
+		case "", "cluster", "packetbroker", proprietaryUplinkHost:
+			return nil, errNetworkServerUpstreamName.WithAttributes("name", name)
+		}
+		key, err := hex.DecodeString(c.Keys[name])
+		if err != nil {
+			return nil, errNetworkServerUpstreamKey.WithCause(err).WithAttributes("name", name)
+		}
+		if len(key) == 0 {
+			return nil, errNetworkServerUpstreamKey.WithAttributes("name", name)
+		}
+		remote := ns.RemoteConfig{
+			Address: address,
+			Key:     key,
+		}
+		if !insecure[name] {
+			remote.TLSConfig = tlsConfig.Clone()
+		}
+		res[name] = remote
+	}
+	return res, nil
+}
+
 // Config represents the Gateway Server configuration.
 type Config struct {
 	RequireRegisteredGateways bool `name:"require-registered-gateways" description:"Require the gateways to be registered in the Identity Server"`
 
-	Stats GatewayConnectionStatsRegistry `name:"-"`
+	Stats        GatewayConnectionStatsRegistry        `name:"-"`
+	StatsHistory GatewayConnectionStatsHistoryRegistry `name:"-"`
 
 	FetchGatewayInterval time.Duration `name:"fetch-gateway-interval" description:"Fetch gateway interval"`
 	FetchGatewayJitter   float64       `name:"fetch-gateway-jitter" description:"Jitter (fraction) to apply to the get interval to randomize intervals"`
@@ -60,10 +194,18 @@ type Config struct {
 	ConnectionStatsTTL                time.Duration `name:"connection-stats-ttl" description:"Time to live of the gateway connection stats that are periodically updated by Gateway Server"`
 	ConnectionStatsDisconnectTTL      time.Duration `name:"connection-stats-disconnect-ttl" description:"Time to live of the gateway connection stats after disconnecting"`
 
+	ConnectionStatsHistory ConnectionStatsHistoryConfig `name:"connection-stats-history" description:"History of gateway connection stats"`
+
+	ClientAuth ClientAuthConfig `name:"client-auth" description:"Authentication of gateways with TLS client certificates"`
+
+	DownlinkLoadReportInterval time.Duration `name:"downlink-load-report-interval" description:"Interval in which the downlink load of connected gateways is reported to the Network Server. Zero disables reporting"`
+
 	UpdateVersionInfoDelay time.Duration `name:"update-version-info-delay" description:"Maximum time to wait to update version information. A Jitter of 25% is applied for randomization"`
 
-	Forward      map[string][]string `name:"forward" description:"Forward the DevAddr prefixes to the specified hosts"`
-	PacketBroker PacketBrokerConfig  `name:"packetbroker" description:"Packet Broker upstream configuration"`
+	Forward        map[string][]string          `name:"forward" description:"Forward the DevAddr prefixes to the specified hosts"`
+	Routing        RoutingConfig                `name:"routing" description:"Routing rules of uplink messages to upstream hosts"`
+	NetworkServers NetworkServerUpstreamsConfig `name:"network-servers" description:"Network Servers outside of the cluster that routing rules can refer to"`
+	PacketBroker   PacketBrokerConfig           `name:"packetbroker" description:"Packet Broker upstream configuration"`
 
 	MQTT         config.MQTT        `name:"mqtt"`
 	MQTTV2       config.MQTT        `name:"mqtt-v2"`
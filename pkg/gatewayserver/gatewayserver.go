@@ -16,13 +16,16 @@
 package gatewayserver
 
 import (
+	"bytes"
 	"context"
+	"crypto/x509"
 	"fmt"
 	stdio "io"
 	stdlog "log"
 	"math"
 	"net"
 	"net/http"
+	"os"
 	"strings"
 	"sync"
 	"time"
@@ -45,6 +48,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/ns"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/packetbroker"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/routing"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
@@ -80,10 +84,14 @@ type GatewayServer struct {
 	entityRegistry EntityRegistry
 
 	upstreamHandlers map[string]upstream.Handler
+	router           *routing.Router
 
 	connections sync.Map // string to connectionEntry
 
-	statsRegistry GatewayConnectionStatsRegistry
+	statsRegistry        GatewayConnectionStatsRegistry
+	statsHistoryRegistry GatewayConnectionStatsHistoryRegistry
+
+	clientCAs *x509.CertPool
 }
 
 // Option configures GatewayServer.
@@ -106,9 +114,14 @@ var (
 		"listen_frontend",
 		"failed to start frontend listener `{protocol}` on address `{address}`",
 	)
+	errClientCA = errors.DefineFailedPrecondition(
+		"client_ca",
+		"invalid client certificate authorities in `{ca}`",
+	)
 	errNotConnected        = errors.DefineNotFound("not_connected", "gateway `{gateway_uid}` not connected")
 	errSetupUpstream       = errors.DefineFailedPrecondition("upstream", "failed to setup upstream `{name}`")
 	errInvalidUpstreamName = errors.DefineInvalidArgument("invalid_upstream_name", "upstream `{name}` is invalid")
+	errRouting             = errors.DefineFailedPrecondition("routing", "failed to load routing configuration")
 
 	modelAttribute    = "model"
 	firmwareAttribute = "firmware"
@@ -118,6 +131,10 @@ var (
 func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServer, err error) {
 	ctx := tracer.NewContextWithTracer(c.Context(), tracerNamespace)
 
+	if err := conf.ConnectionStatsHistory.Validate(); err != nil {
+		return nil, err
+	}
+
 	forward, err := conf.ForwardDevAddrPrefixes()
 	if err != nil {
 		return nil, err
@@ -136,12 +153,24 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		forward:                   forward,
 		upstreamHandlers:          make(map[string]upstream.Handler),
 		statsRegistry:             conf.Stats,
+		statsHistoryRegistry:      conf.StatsHistory,
 		entityRegistry:            NewIS(c),
 	}
 	for _, opt := range opts {
 		opt(gs)
 	}
 
+	if ca := conf.ClientAuth.CA; ca != "" {
+		pem, err := os.ReadFile(ca)
+		if err != nil {
+			return nil, errClientCA.WithCause(err).WithAttributes("ca", ca)
+		}
+		gs.clientCAs = x509.NewCertPool()
+		if !gs.clientCAs.AppendCertsFromPEM(pem) {
+			return nil, errClientCA.WithAttributes("ca", ca)
+		}
+	}
+
 	// Setup forwarding table.
 	for name, prefix := range gs.forward {
 		if len(prefix) == 0 {
@@ -153,7 +182,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		var handler upstream.Handler
 		switch name {
 		case "cluster":
-			handler = ns.NewHandler(gs.Context(), c, c, prefix)
+			handler = ns.NewHandler(gs.Context(), c, c, prefix, conf.DownlinkLoadReportInterval)
 		case "packetbroker":
 			handler = packetbroker.NewHandler(gs.Context(), packetbroker.Config{
 				GatewayRegistry: gs.entityRegistry,
@@ -172,6 +201,59 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		gs.upstreamHandlers[name] = handler
 	}
 
+	// Setup Network Server upstreams outside of the cluster.
+	if len(conf.NetworkServers.Addresses) > 0 {
+		tlsConfig, err := c.GetTLSClientConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		remotes, err := conf.NetworkServers.Remotes(tlsConfig)
+		if err != nil {
+			return nil, err
+		}
+		for name, remote := range remotes {
+			if _, ok := gs.upstreamHandlers[name]; ok {
+				return nil, errInvalidUpstreamName.WithAttributes("name", name)
+			}
+			handler := ns.NewRemoteHandler(gs.Context(), remote)
+			if err := handler.Setup(gs.Context()); err != nil {
+				return nil, errSetupUpstream.WithCause(err).WithAttributes("name", name)
+			}
+			gs.upstreamHandlers[name] = handler
+		}
+	}
+
+	// Setup routing rules.
+	routingFetcher, err := conf.Routing.Fetcher(ctx, c.GetBaseConfig(ctx).Blob, c)
+	if err != nil {
+		return nil, err
+	}
+	if routingFetcher != nil {
+		upstreams := make([]routing.Upstream, 0, len(gs.upstreamHandlers))
+		for name := range gs.upstreamHandlers {
+			_, uplinkOnly := conf.NetworkServers.Addresses[name]
+			upstreams = append(upstreams, routing.Upstream{
+				Name:       name,
+				UplinkOnly: uplinkOnly,
+			})
+		}
+		gs.router = routing.NewRouter(routingFetcher, upstreams...)
+		if err := gs.router.Reload(); err != nil {
+			return nil, errRouting.WithCause(err)
+		}
+		if interval := conf.Routing.ReloadInterval; interval > 0 {
+			gs.RegisterTask(&task.Config{
+				Context: gs.Context(),
+				ID:      "reload_routing",
+				Func: func(ctx context.Context) error {
+					return gs.router.Run(ctx, interval)
+				},
+				Restart: task.RestartOnFailure,
+				Backoff: task.DefaultBackoffConfig,
+			})
+		}
+	}
+
 	// Register gRPC services.
 	for _, hook := range []struct {
 		name       string
@@ -193,6 +275,8 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 	c.RegisterGRPC(gs)
 
 	// Start UDP listeners.
+	udpConf := conf.UDP.Config
+	udpConf.Authentication.KeyRegistry = gs
 	for addr, fallbackFrequencyPlanID := range conf.UDP.Listeners {
 		addr := addr
 		fallbackFrequencyPlanID := fallbackFrequencyPlanID
@@ -213,7 +297,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 				if fallbackFrequencyPlanID != "" {
 					lisCtx = frequencyplans.WithFallbackID(ctx, fallbackFrequencyPlanID)
 				}
-				return udp.Serve(lisCtx, gs, conn, conf.UDP.Config)
+				return udp.Serve(lisCtx, gs, conn, udpConf)
 			},
 			Restart: task.RestartOnFailure,
 			Backoff: task.DefaultBackoffConfig,
@@ -236,7 +320,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 	} {
 		for _, endpoint := range []component.Endpoint{
 			component.NewTCPEndpoint(version.Config.Listen, "MQTT"),
-			component.NewTLSEndpoint(version.Config.ListenTLS, "MQTT"),
+			component.NewTLSEndpoint(version.Config.ListenTLS, "MQTT", conf.ClientAuth.TLSOptions()...),
 		} {
 			version := version
 			endpoint := endpoint
@@ -303,7 +387,9 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 		}
 		for _, endpoint := range []component.Endpoint{
 			component.NewTCPEndpoint(version.listenerConfig.listen, version.Name),
-			component.NewTLSEndpoint(version.listenerConfig.listenTLS, version.Name, tlsconfig.WithNextProtos("h2", "http/1.1")),
+			component.NewTLSEndpoint(version.listenerConfig.listenTLS, version.Name, append(
+				conf.ClientAuth.TLSOptions(), tlsconfig.WithNextProtos("h2", "http/1.1"),
+			)...),
 		} {
 			endpoint := endpoint
 			if endpoint.Address() == "" {
@@ -421,6 +507,29 @@ func (gs *GatewayServer) FillGatewayContext(ctx context.Context, ids *ttnpb.Gate
 	return ctx, ids, nil
 }
 
+// GetPreSharedKey implements udp.PreSharedKeyRegistry.
+// The pre-shared key is retrieved from the entity registry with cluster authentication.
+func (gs *GatewayServer) GetPreSharedKey(ctx context.Context, eui types.EUI64) ([]byte, error) {
+	ctx = gs.FillContext(ctx)
+	ids, err := gs.entityRegistry.GetIdentifiersForEUI(ctx, &ttnpb.GetGatewayIdentifiersForEUIRequest{
+		Eui: eui.Bytes(),
+	})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	gtw, err := gs.entityRegistry.Get(ctx, &ttnpb.GetGatewayRequest{
+		GatewayIds: ids,
+		FieldMask:  ttnpb.FieldMask("udp_pre_shared_key"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return gtw.GetUdpPreSharedKey().GetValue(), nil
+}
+
 var (
 	errGatewayNotRegistered = errors.DefineNotFound(
 		"gateway_not_registered",
@@ -476,6 +585,7 @@ func (gs *GatewayServer) Connect(
 		FieldMask: ttnpb.FieldMask(
 			"antennas",
 			"attributes",
+			"client_certificate_fingerprint",
 			"disable_packet_broker_forwarding",
 			"downlink_path_constraint",
 			"enforce_duty_cycle",
@@ -509,7 +619,15 @@ func (gs *GatewayServer) Connect(
 	} else if err != nil {
 		return nil, err
 	}
-	if gtw.RequireAuthenticatedConnection && !isAuthenticated {
+	var isCertificateAuthenticated bool
+	if cert, ok := io.ClientCertificateFromContext(ctx); ok {
+		if err := gs.authenticateClientCertificate(gtw, cert); err != nil {
+			logger.WithError(err).Warn("Failed to authenticate gateway with client certificate")
+			return nil, err
+		}
+		isCertificateAuthenticated = true
+	}
+	if gtw.RequireAuthenticatedConnection && !isAuthenticated && !isCertificateAuthenticated {
 		return nil, errUnauthenticatedGatewayConnection.New()
 	}
 
@@ -552,9 +670,11 @@ func (gs *GatewayServer) Connect(
 	gs.startDisconnectOnChangeTask(connEntry)
 	gs.startHandleUpstreamTask(connEntry)
 	gs.startUpdateConnStatsTask(connEntry)
+	gs.startRecordConnStatsHistoryTask(connEntry)
 	// Unauthenticated connections cannot update the gateway entity.
 	// As such, there is no reason to start these tasks, since they
-	// will perpetually fail.
+	// will perpetually fail. This also applies to connections that are
+	// authenticated with a client certificate, as they carry no credentials.
 	if isAuthenticated {
 		gs.startHandleLocationUpdatesTask(connEntry)
 		gs.startHandleVersionUpdatesTask(connEntry)
@@ -597,6 +717,7 @@ func requireDisconnect(connected, current *ttnpb.Gateway) bool {
 	}
 	if connected.DownlinkPathConstraint != current.DownlinkPathConstraint ||
 		connected.DisablePacketBrokerForwarding != current.DisablePacketBrokerForwarding ||
+		!bytes.Equal(connected.ClientCertificateFingerprint, current.ClientCertificateFingerprint) ||
 		connected.EnforceDutyCycle != current.EnforceDutyCycle ||
 		connected.LocationPublic != current.LocationPublic ||
 		connected.RequireAuthenticatedConnection != current.RequireAuthenticatedConnection ||
@@ -635,6 +756,7 @@ func (gs *GatewayServer) startDisconnectOnChangeTask(conn connectionEntry) {
 				GatewayIds: conn.Gateway().GetIds(),
 				FieldMask: ttnpb.FieldMask(
 					"antennas",
+					"client_certificate_fingerprint",
 					"disable_packet_broker_forwarding",
 					"downlink_path_constraint",
 					"enforce_duty_cycle",
@@ -704,6 +826,24 @@ func (gs *GatewayServer) startUpdateConnStatsTask(conn connectionEntry) {
 	})
 }
 
+func (gs *GatewayServer) startRecordConnStatsHistoryTask(conn connectionEntry) {
+	if gs.statsHistoryRegistry == nil || gs.config.ConnectionStatsHistory.Interval <= 0 {
+		return
+	}
+	conn.tasksDone.Add(1)
+	gs.StartTask(&task.Config{
+		Context: conn.Context(),
+		ID:      fmt.Sprintf("record_connection_stats_history_%s", unique.ID(conn.Context(), conn.Gateway().GetIds())),
+		Func: func(ctx context.Context) error {
+			gs.recordConnStatsHistory(ctx, conn)
+			return nil
+		},
+		Done:    conn.tasksDone.Done,
+		Restart: task.RestartNever,
+		Backoff: task.DialBackoffConfig,
+	})
+}
+
 func (gs *GatewayServer) startHandleLocationUpdatesTask(conn connectionEntry) {
 	if !conn.Gateway().GetUpdateLocationFromStatus() {
 		return
@@ -739,9 +879,18 @@ func (gs *GatewayServer) startHandleVersionUpdatesTask(conn connectionEntry) {
 
 var errHostHandle = errors.Define("host_handle", "host `{host}` failed to handle message")
 
+// routedUplinkMessage is an uplink message that matched a routing rule.
+// It is only forwarded to the upstream hosts of the route.
+type routedUplinkMessage struct {
+	*ttnpb.GatewayUplinkMessage
+	route *routing.Route
+}
+
 type upstreamHost struct {
-	name          string
-	handler       upstream.Handler
+	name    string
+	handler upstream.Handler
+	// routedOnly indicates that the host only handles the uplink messages of the routes that refer to it.
+	routedOnly    bool
 	pool          workerpool.WorkerPool[any]
 	gtw           *ttnpb.Gateway
 	correlationID string
@@ -751,6 +900,10 @@ func (host *upstreamHost) handlePacket(ctx context.Context, item any) {
 	ctx = events.ContextWithCorrelationID(ctx, host.correlationID)
 	logger := log.FromContext(ctx)
 	gtw := host.gtw
+	var route *routing.Route
+	if routed, ok := item.(*routedUplinkMessage); ok {
+		item, route = routed.GatewayUplinkMessage, routed.route
+	}
 	// Each concurrent upstream host will receive the message and edit it in order
 	// to append the correlation IDs. This would be a concurrent write, so we are
 	// creating a shallow message copy in order to safely edit the correlation IDs.
@@ -787,6 +940,9 @@ func (host *upstreamHost) handlePacket(ctx context.Context, item any) {
 		ids := msg.Message.Payload.EndDeviceIdentifiers()
 		var pass bool
 		switch {
+		case route != nil:
+			// The routing rule selected the upstream hosts.
+			pass = true
 		case ids.DevAddr != nil:
 			for _, prefix := range host.handler.DevAddrPrefixes() {
 				if types.MustDevAddr(ids.DevAddr).HasPrefix(prefix) {
@@ -807,6 +963,9 @@ func (host *upstreamHost) handlePacket(ctx context.Context, item any) {
 			drop(ids, errHostHandle.WithCause(err).WithAttributes("host", host.name))
 		default:
 			registerForwardUplink(ctx, gtw, msg, host.name)
+			if route != nil {
+				registerRouteUplink(ctx, route.Name, host.name)
+			}
 		}
 	case *ttnpb.GatewayStatus:
 		if err := host.handler.HandleStatus(ctx, gtw.Ids, msg); err != nil {
@@ -844,7 +1003,15 @@ func (host *upstreamHost) handlePacket(ctx context.Context, item any) {
 	}
 }
 
-var errMessageCRC = errors.DefineInvalidArgument("message_crc", "message CRC failed")
+var (
+	errMessageCRC              = errors.DefineInvalidArgument("message_crc", "message CRC failed")
+	errNoProprietarySubscriber = errors.DefineUnavailable(
+		"no_proprietary_subscriber", "no subscriber for proprietary uplink message",
+	)
+)
+
+// proprietaryUplinkHost is the host name of the subscribers of proprietary uplink messages in events and metrics.
+const proprietaryUplinkHost = "proprietary"
 
 func (gs *GatewayServer) handleUpstream(ctx context.Context, conn connectionEntry) {
 	var (
@@ -863,9 +1030,11 @@ func (gs *GatewayServer) handleUpstream(ctx context.Context, conn connectionEntr
 		if name == "packetbroker" && gtw.DisablePacketBrokerForwarding {
 			continue
 		}
+		_, routedOnly := gs.config.NetworkServers.Addresses[name]
 		host := &upstreamHost{
 			name:          name,
 			handler:       handler,
+			routedOnly:    routedOnly,
 			gtw:           gtw,
 			correlationID: fmt.Sprintf("gs:up:host:%s", events.NewCorrelationID()),
 		}
@@ -897,9 +1066,16 @@ func (gs *GatewayServer) handleUpstream(ctx context.Context, conn connectionEntr
 			correlationIDs = append(correlationIDs, fmt.Sprintf("gs:uplink:%s", events.NewCorrelationID()))
 			ctx = events.ContextWithCorrelationID(ctx, correlationIDs...)
 			msg.Message.CorrelationIds = events.CorrelationIDsFromContext(ctx)
+			proprietary := io.IsProprietary(msg.Message.RawPayload)
 			if msg.Message.Payload == nil {
 				msg.Message.Payload = &ttnpb.Message{}
-				if err := lorawan.UnmarshalMessage(msg.Message.RawPayload, msg.Message.Payload); err != nil {
+				if proprietary {
+					// Proprietary messages have no LoRaWAN payload.
+					msg.Message.Payload.MHdr = &ttnpb.MHDR{}
+					if err := lorawan.UnmarshalMHDR(msg.Message.RawPayload[:1], msg.Message.Payload.MHdr); err != nil {
+						continue
+					}
+				} else if err := lorawan.UnmarshalMessage(msg.Message.RawPayload, msg.Message.Payload); err != nil {
 					continue
 				}
 			}
@@ -908,11 +1084,28 @@ func (gs *GatewayServer) handleUpstream(ctx context.Context, conn connectionEntr
 				registerDropUplink(ctx, gtw, msg, "", errMessageCRC.New())
 				continue
 			}
+			if proprietary {
+				// Proprietary uplink messages are not handled by the upstream hosts. They are only published to the
+				// subscribers of the gateway connection.
+				if conn.PublishProprietaryUp(msg) == 0 {
+					registerDropUplink(ctx, gtw, msg, proprietaryUplinkHost, errNoProprietarySubscriber.New())
+				} else {
+					registerForwardUplink(ctx, gtw, msg, proprietaryUplinkHost)
+				}
+				continue
+			}
 			if err := msg.Message.Payload.ValidateFields(); err != nil {
 				registerDropUplink(ctx, gtw, msg, "", err)
 				continue
 			}
 			val = msg
+			ids := msg.Message.Payload.EndDeviceIdentifiers()
+			if route, ok := gs.router.Match(gtw, ids, time.Now()); ok {
+				val = &routedUplinkMessage{
+					GatewayUplinkMessage: msg,
+					route:                route,
+				}
+			}
 		case msg := <-conn.Status():
 			ctx = events.ContextWithCorrelationID(ctx, fmt.Sprintf("gs:status:%s", events.NewCorrelationID()))
 			val = msg
@@ -933,15 +1126,25 @@ func (gs *GatewayServer) handleUpstream(ctx context.Context, conn connectionEntr
 			} else {
 				registerFailDownlink(ctx, gtw, msg, protocol)
 			}
+			if io.IsProprietary(msg.DownlinkMessage.GetRawPayload()) {
+				// Proprietary downlink messages are scheduled by the Gateway Server, not by the upstream hosts.
+				continue
+			}
 			val = msg
 		}
 		for _, host := range hosts {
+			routed, ok := val.(*routedUplinkMessage)
+			if ok && !routed.route.HasUpstream(host.name) || !ok && host.routedOnly {
+				continue
+			}
 			err := host.pool.Publish(ctx, val)
 			if err == nil {
 				continue
 			}
 			logger.WithField("name", host.name).WithError(err).Warn("Upstream handler publish failed")
 			switch msg := val.(type) {
+			case *routedUplinkMessage:
+				registerDropUplink(ctx, gtw, msg.GatewayUplinkMessage, host.name, err)
 			case *ttnpb.GatewayUplinkMessage:
 				registerDropUplink(ctx, gtw, msg, host.name, err)
 			case *ttnpb.GatewayStatus:
@@ -1067,6 +1270,35 @@ func (gs *GatewayServer) updateConnStats(ctx context.Context, conn connectionEnt
 	}
 }
 
+// recordConnStatsHistory records the connection stats history of the gateway at a fixed interval.
+// The last point is recorded when the gateway disconnects.
+func (gs *GatewayServer) recordConnStatsHistory(ctx context.Context, conn connectionEntry) {
+	var (
+		decoupledCtx = gs.FromRequestContext(ctx)
+		logger       = log.FromContext(ctx)
+		ids          = conn.Gateway().GetIds()
+		historyConf  = gs.config.ConnectionStatsHistory
+		sampler      = io.NewStatsHistorySampler(conn.Connection, time.Now())
+		ticker       = time.NewTicker(historyConf.Interval)
+	)
+	defer ticker.Stop()
+	record := func() {
+		point := sampler.Sample(time.Now())
+		if err := gs.statsHistoryRegistry.Add(decoupledCtx, ids, point, historyConf.Retention); err != nil {
+			logger.WithError(err).Warn("Failed to record connection stats history")
+		}
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			record()
+			return
+		case <-ticker.C:
+			record()
+		}
+	}
+}
+
 const (
 	allowedLocationDelta = 0.00001
 	debounceJitter       = 0.25
@@ -16,8 +16,10 @@ package gatewayserver
 
 import (
 	"context"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"golang.org/x/sync/errgroup"
@@ -50,6 +52,70 @@ func (gs *GatewayServer) GetGatewayConnectionStats(ctx context.Context, ids *ttn
 	return stats, nil
 }
 
+var (
+	errStatsHistoryNotAvailable = errors.DefineFailedPrecondition(
+		"stats_history_not_available", "gateway connection stats history not available",
+	)
+	errStatsHistoryTimeRange = errors.DefineInvalidArgument(
+		"stats_history_time_range", "invalid time range of gateway connection stats history",
+	)
+	errStatsHistoryTimeRangeTooLarge = errors.DefineInvalidArgument(
+		"stats_history_time_range_too_large",
+		"time range `{range}` of gateway connection stats history exceeds the maximum of `{max_range}`",
+	)
+	errStatsHistoryTooManyPoints = errors.DefineInvalidArgument(
+		"stats_history_too_many_points",
+		"gateway connection stats history exceeds the maximum of `{max_points}` points, increase the step",
+	)
+)
+
+// GetGatewayConnectionStatsHistory returns the history of statistics about a gateway connection.
+func (gs *GatewayServer) GetGatewayConnectionStatsHistory(
+	ctx context.Context, req *ttnpb.GetGatewayConnectionStatsHistoryRequest,
+) (*ttnpb.GatewayConnectionStatsHistory, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(
+		ctx, req.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_STATUS_READ,
+	); err != nil {
+		return nil, err
+	}
+	if gs.statsHistoryRegistry == nil {
+		return nil, errStatsHistoryNotAvailable.New()
+	}
+
+	from, to := req.From.AsTime(), time.Now()
+	if req.To != nil {
+		to = req.To.AsTime()
+	}
+	if !to.After(from) {
+		return nil, errStatsHistoryTimeRange.New()
+	}
+	conf := gs.config.ConnectionStatsHistory
+	if r := to.Sub(from); conf.MaxRange > 0 && r > conf.MaxRange {
+		return nil, errStatsHistoryTimeRangeTooLarge.WithAttributes("range", r, "max_range", conf.MaxRange)
+	}
+	step, limit := req.Step.AsDuration(), 0
+	switch {
+	case conf.MaxPoints <= 0:
+	case step > 0:
+		if n := (to.Sub(from) + step - 1) / step; n > time.Duration(conf.MaxPoints) {
+			return nil, errStatsHistoryTooManyPoints.WithAttributes("max_points", conf.MaxPoints)
+		}
+	default:
+		// The recorded points are returned as is, so the stored points in excess of the maximum are not read.
+		limit = conf.MaxPoints + 1
+	}
+	points, err := gs.statsHistoryRegistry.Range(ctx, req.GatewayIds, from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+	if limit > 0 && len(points) >= limit {
+		return nil, errStatsHistoryTooManyPoints.WithAttributes("max_points", conf.MaxPoints)
+	}
+	return &ttnpb.GatewayConnectionStatsHistory{
+		Points: io.AggregateStatsHistory(points, from, step),
+	}, nil
+}
+
 func applyGatewayConnectionStatsFieldMask(
 	dst, src *ttnpb.GatewayConnectionStats,
 	paths ...string,
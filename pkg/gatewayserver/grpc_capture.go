@@ -0,0 +1,63 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// CaptureGatewayTraffic streams the traffic of a gateway that is connected to this Gateway Server until the
+// requested duration elapsed.
+func (gs *GatewayServer) CaptureGatewayTraffic(
+	req *ttnpb.CaptureGatewayTrafficRequest, stream ttnpb.Gs_CaptureGatewayTrafficServer,
+) error {
+	ctx := stream.Context()
+	if err := gs.entityRegistry.AssertGatewayRights(
+		ctx, req.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_READ,
+	); err != nil {
+		return err
+	}
+	conn, ok := gs.GetConnection(ctx, req.GatewayIds)
+	if !ok {
+		return errNotConnected.WithAttributes("gateway_uid", unique.ID(ctx, req.GatewayIds))
+	}
+	ctx, cancel := context.WithTimeout(ctx, req.Duration.AsDuration())
+	defer cancel()
+
+	logger := log.FromContext(ctx).WithField("duration", req.Duration.AsDuration())
+	logger.Debug("Start gateway traffic capture")
+	defer logger.Debug("End gateway traffic capture")
+
+	captureCh := conn.SubscribeCapture(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			if err := stream.Context().Err(); err != nil {
+				return err
+			}
+			return nil
+		case <-conn.Context().Done():
+			return conn.Context().Err()
+		case msg := <-captureCh:
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -43,6 +43,12 @@ func (gs *GatewayServer) ScheduleDownlink(ctx context.Context, down *ttnpb.Downl
 	if err := clusterauth.Authorized(ctx); err != nil {
 		return nil, err
 	}
+	return gs.scheduleDownlink(ctx, down)
+}
+
+func (gs *GatewayServer) scheduleDownlink(
+	ctx context.Context, down *ttnpb.DownlinkMessage,
+) (*ttnpb.ScheduleDownlinkResponse, error) {
 	request := down.GetRequest()
 	if request == nil {
 		return nil, errNotTxRequest.New()
@@ -0,0 +1,112 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errNotProprietary = errors.DefineInvalidArgument(
+		"not_proprietary", "downlink message is not a proprietary message",
+	)
+	errDownlinkPathGateway = errors.DefinePermissionDenied(
+		"downlink_path_gateway", "downlink path does not refer to gateway `{gateway_uid}`",
+	)
+)
+
+// StreamProprietaryUplinks streams the proprietary uplink messages received by a gateway that is connected to this
+// Gateway Server.
+func (gs *GatewayServer) StreamProprietaryUplinks(
+	ids *ttnpb.GatewayIdentifiers, stream ttnpb.Gs_StreamProprietaryUplinksServer,
+) error {
+	ctx := stream.Context()
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_READ); err != nil {
+		return err
+	}
+	conn, ok := gs.GetConnection(ctx, ids)
+	if !ok {
+		return errNotConnected.WithAttributes("gateway_uid", unique.ID(ctx, ids))
+	}
+	upCh := conn.SubscribeProprietaryUp(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-conn.Context().Done():
+			return conn.Context().Err()
+		case msg := <-upCh:
+			if err := stream.Send(msg); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// ScheduleProprietaryDownlink schedules a proprietary downlink message on a gateway that is connected to this
+// Gateway Server.
+func (gs *GatewayServer) ScheduleProprietaryDownlink(
+	ctx context.Context, req *ttnpb.ScheduleProprietaryDownlinkRequest,
+) (*ttnpb.ScheduleDownlinkResponse, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(
+		ctx, req.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_DOWN_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	if !io.IsProprietary(req.RawPayload) {
+		return nil, errNotProprietary.New()
+	}
+
+	request := ttnpb.Clone(req.Request)
+	for _, path := range request.DownlinkPaths {
+		var ids *ttnpb.GatewayIdentifiers
+		switch p := path.Path.(type) {
+		case *ttnpb.DownlinkPath_Fixed:
+			ids = p.Fixed.GetGatewayIds()
+		case *ttnpb.DownlinkPath_UplinkToken:
+			token, err := io.ParseUplinkToken(p.UplinkToken)
+			if err != nil {
+				return nil, errUplinkToken.WithCause(err)
+			}
+			ids = token.GetIds().GetGatewayIds()
+		}
+		if ids.GetGatewayId() != req.GatewayIds.GetGatewayId() {
+			return nil, errDownlinkPathGateway.WithAttributes("gateway_uid", unique.ID(ctx, req.GatewayIds))
+		}
+	}
+	if len(request.DownlinkPaths) == 0 {
+		request.DownlinkPaths = []*ttnpb.DownlinkPath{
+			{
+				Path: &ttnpb.DownlinkPath_Fixed{
+					Fixed: &ttnpb.GatewayAntennaIdentifiers{GatewayIds: req.GatewayIds},
+				},
+			},
+		}
+	}
+	return gs.scheduleDownlink(ctx, &ttnpb.DownlinkMessage{
+		RawPayload: req.RawPayload,
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: request,
+		},
+		CorrelationIds: []string{fmt.Sprintf("gs:proprietary:down:%s", events.NewCorrelationID())},
+	})
+}
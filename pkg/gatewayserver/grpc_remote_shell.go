@@ -0,0 +1,130 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	stdio "io"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var errRemoteShellNotStarted = errors.DefineInvalidArgument(
+	"remote_shell_not_started", "the first message must start the remote shell session",
+)
+
+// RunGatewayCommand runs a command on a gateway that is connected to this Gateway Server.
+func (gs *GatewayServer) RunGatewayCommand(
+	ctx context.Context, req *ttnpb.RunGatewayCommandRequest,
+) (*emptypb.Empty, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(
+		ctx, req.GatewayIds, ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL,
+	); err != nil {
+		return nil, err
+	}
+	conn, ok := gs.GetConnection(ctx, req.GatewayIds)
+	if !ok {
+		return nil, errNotConnected.WithAttributes("gateway_uid", unique.ID(ctx, req.GatewayIds))
+	}
+	if err := conn.RunCommand(&io.RemoteCommand{
+		Command:   req.Command,
+		Arguments: req.Arguments,
+	}); err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).WithField("command", req.Command).Info("Run command on gateway")
+	events.Publish(evtRunGatewayCommand.NewWithIdentifiersAndData(ctx, req.GatewayIds, req))
+	return ttnpb.Empty, nil
+}
+
+// GatewayRemoteShell opens a remote shell session on a gateway that is connected to this Gateway Server.
+func (gs *GatewayServer) GatewayRemoteShell(stream ttnpb.Gs_GatewayRemoteShellServer) (err error) {
+	ctx := stream.Context()
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	start := req.GetStart()
+	if start == nil {
+		return errRemoteShellNotStarted.New()
+	}
+	ids := start.GatewayIds
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL); err != nil {
+		return err
+	}
+	conn, ok := gs.GetConnection(ctx, ids)
+	if !ok {
+		return errNotConnected.WithAttributes("gateway_uid", unique.ID(ctx, ids))
+	}
+
+	var user string
+	if authInfo, err := rights.AuthInfo(ctx); err == nil {
+		user = authInfo.GetEntityIdentifiers().IDString()
+	}
+	shell, err := conn.OpenRemoteShell(user, start.Term)
+	if err != nil {
+		return err
+	}
+	defer shell.Close()
+
+	logger := log.FromContext(ctx).WithField("user", user)
+	logger.Info("Remote shell session started")
+	events.Publish(evtStartGatewayRemoteShell.NewWithIdentifiersAndData(ctx, ids, start))
+	defer func() {
+		logger.WithError(err).Info("Remote shell session ended")
+		events.Publish(evtEndGatewayRemoteShell.NewWithIdentifiersAndData(ctx, ids, err))
+	}()
+
+	recvErrCh := make(chan error, 1)
+	go func() {
+		recvErrCh <- func() error {
+			for {
+				req, err := stream.Recv()
+				if err != nil {
+					if errors.Is(err, stdio.EOF) {
+						return nil
+					}
+					return err
+				}
+				if data := req.GetData(); len(data) > 0 {
+					if err := shell.Write(data); err != nil {
+						return err
+					}
+				}
+			}
+		}()
+	}()
+
+	for {
+		select {
+		case err := <-recvErrCh:
+			return err
+		case <-shell.Context().Done():
+			// The session is closed by the gateway or the gateway disconnected.
+			return conn.Context().Err()
+		case data := <-shell.Output():
+			if err := stream.Send(&ttnpb.GatewayRemoteShellResponse{Data: data}); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// SubscribeCapture subscribes to the traffic of the gateway connection.
+// The subscription ends when the given context is done. The returned channel is never closed.
+// Captured messages are dropped if the subscriber does not keep up.
+func (c *Connection) SubscribeCapture(ctx context.Context) <-chan *ttnpb.GatewayTrafficCapture {
+	return c.captureSubs.subscribe(ctx, c.ctx)
+}
+
+// capture returns a function that publishes the captured message to the capture subscribers.
+// The message is cloned when capture is called, as the original message may be modified once handed off.
+// If there are no subscribers, the message is not cloned and the returned function is a no-op.
+func (c *Connection) capture(f func(*ttnpb.GatewayTrafficCapture)) (publish func()) {
+	if c.captureSubs.empty() {
+		return func() {}
+	}
+	msg := &ttnpb.GatewayTrafficCapture{
+		Time: timestamppb.New(time.Now()),
+	}
+	f(msg)
+	return func() { c.captureSubs.publish(msg) }
+}
+
+func (c *Connection) captureUp(up *ttnpb.UplinkMessage) func() {
+	return c.capture(func(msg *ttnpb.GatewayTrafficCapture) {
+		msg.Message = &ttnpb.GatewayTrafficCapture_UplinkMessage{
+			UplinkMessage: ttnpb.Clone(up),
+		}
+	})
+}
+
+func (c *Connection) captureDown(down *ttnpb.DownlinkMessage) func() {
+	return c.capture(func(msg *ttnpb.GatewayTrafficCapture) {
+		msg.Message = &ttnpb.GatewayTrafficCapture_DownlinkMessage{
+			DownlinkMessage: ttnpb.Clone(down),
+		}
+	})
+}
+
+func (c *Connection) captureTxAck(ack *ttnpb.TxAcknowledgment) func() {
+	return c.capture(func(msg *ttnpb.GatewayTrafficCapture) {
+		msg.Message = &ttnpb.GatewayTrafficCapture_TxAcknowledgment{
+			TxAcknowledgment: ttnpb.Clone(ack),
+		}
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io_test
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCapture(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	conn := newRemoteShellConnection(ctx, t, &mock.Frontend{})
+
+	up := &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x01},
+		Settings: &ttnpb.TxSettings{
+			DataRate: &ttnpb.DataRate{Modulation: &ttnpb.DataRate_Lora{Lora: &ttnpb.LoRaDataRate{
+				SpreadingFactor: 7,
+				Bandwidth:       125000,
+				CodingRate:      "4/5",
+			}}},
+			Frequency: 868100000,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{{GatewayIds: conn.Gateway().GetIds(), Rssi: -42}},
+		ReceivedAt: timestamppb.Now(),
+	}
+
+	// Messages are not captured without subscribers.
+	a.So(conn.HandleUp(up, nil), should.BeNil)
+	<-conn.Up()
+
+	captureCh := conn.SubscribeCapture(ctx)
+
+	up = ttnpb.Clone(up)
+	up.RawPayload = []byte{0x40, 0x02}
+	a.So(conn.HandleUp(up, nil), should.BeNil)
+	msg := <-conn.Up()
+	captured := <-captureCh
+	a.So(captured.Time, should.NotBeNil)
+	// The captured message is a copy of the uplink message.
+	msg.Message.RawPayload = []byte{0x40, 0x03}
+	a.So(captured.GetUplinkMessage().GetRawPayload(), should.Resemble, []byte{0x40, 0x02})
+
+	down := &ttnpb.DownlinkMessage{RawPayload: []byte{0x60, 0x01}}
+	a.So(conn.SendDown(down), should.BeNil)
+	<-conn.Down()
+	captured = <-captureCh
+	a.So(captured.GetDownlinkMessage(), should.Resemble, down)
+
+	ack := &ttnpb.TxAcknowledgment{Result: ttnpb.TxAcknowledgment_TOO_LATE}
+	a.So(conn.HandleTxAck(ack), should.BeNil)
+	<-conn.TxAck()
+	captured = <-captureCh
+	a.So(captured.GetTxAcknowledgment(), should.Resemble, ack)
+
+	select {
+	case msg := <-captureCh:
+		t.Fatalf("Unexpected captured message %v", msg)
+	default:
+	}
+}
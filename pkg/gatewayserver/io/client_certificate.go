@@ -0,0 +1,104 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// ClientCertificate is a TLS client certificate presented by a gateway.
+type ClientCertificate struct {
+	Leaf          *x509.Certificate
+	Intermediates []*x509.Certificate
+}
+
+// ClientCertificateFromTLS returns the client certificate of the TLS connection state.
+// The certificate is not verified.
+func ClientCertificateFromTLS(state *tls.ConnectionState) (*ClientCertificate, bool) {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return nil, false
+	}
+	return &ClientCertificate{
+		Leaf:          state.PeerCertificates[0],
+		Intermediates: state.PeerCertificates[1:],
+	}, true
+}
+
+// Fingerprint returns the SHA-256 fingerprint of the certificate.
+func (c *ClientCertificate) Fingerprint() []byte {
+	sum := sha256.Sum256(c.Leaf.Raw)
+	return sum[:]
+}
+
+// Verify verifies the certificate chain against the given roots for client authentication at the given time.
+func (c *ClientCertificate) Verify(roots *x509.CertPool, t time.Time) error {
+	intermediates := x509.NewCertPool()
+	for _, cert := range c.Intermediates {
+		intermediates.AddCert(cert)
+	}
+	_, err := c.Leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   t,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	return err
+}
+
+var clientCertificateEUIPattern = regexp.MustCompile("^(?:eui-)?([a-fA-F0-9]{16})$")
+
+// Matches returns whether the certificate identifies the gateway.
+// The subject common name and the DNS names are considered. A name identifies the gateway if it is the gateway ID,
+// or if it is the gateway EUI in hexadecimal format, optionally prefixed by `eui-`.
+func (c *ClientCertificate) Matches(ids *ttnpb.GatewayIdentifiers) bool {
+	names := append([]string{c.Leaf.Subject.CommonName}, c.Leaf.DNSNames...)
+	for _, name := range names {
+		if match := clientCertificateEUIPattern.FindStringSubmatch(name); match != nil && len(ids.Eui) > 0 {
+			if eui, err := hex.DecodeString(match[1]); err == nil && bytes.Equal(eui, ids.Eui) {
+				return true
+			}
+		}
+		if ids.GatewayId != "" && strings.EqualFold(name, ids.GatewayId) {
+			return true
+		}
+	}
+	return false
+}
+
+type clientCertificateKeyType struct{}
+
+var clientCertificateKey clientCertificateKeyType
+
+// NewContextWithClientCertificate returns a new context with the client certificate.
+// The Gateway Server authenticates the gateway with the client certificate on connect.
+func NewContextWithClientCertificate(ctx context.Context, cert *ClientCertificate) context.Context {
+	return context.WithValue(ctx, clientCertificateKey, cert)
+}
+
+// ClientCertificateFromContext returns the client certificate from the context.
+func ClientCertificateFromContext(ctx context.Context) (*ClientCertificate, bool) {
+	cert, ok := ctx.Value(clientCertificateKey).(*ClientCertificate)
+	return cert, ok
+}
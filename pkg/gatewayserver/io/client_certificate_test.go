@@ -0,0 +1,141 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func createCertificate(
+	t *testing.T, template *x509.Certificate, parent *x509.Certificate, parentKey *ecdsa.PrivateKey,
+) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func TestClientCertificate(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	now := time.Now()
+	ca, caKey := createCertificate(t, &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}, nil, nil)
+	leaf, _ := createCertificate(t, &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "eui-58A0CBFFFE800001"},
+		DNSNames:     []string{"test-gateway"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}, ca, caKey)
+
+	_, ok := io.ClientCertificateFromTLS(&tls.ConnectionState{})
+	a.So(ok, should.BeFalse)
+	cert, ok := io.ClientCertificateFromTLS(&tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{leaf},
+	})
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+
+	fingerprint := sha256.Sum256(leaf.Raw)
+	a.So(cert.Fingerprint(), should.Resemble, fingerprint[:])
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca)
+	a.So(cert.Verify(roots, now), should.BeNil)
+	a.So(cert.Verify(roots, now.Add(2*time.Hour)), should.NotBeNil)
+	a.So(cert.Verify(x509.NewCertPool(), now), should.NotBeNil)
+
+	for _, tc := range []struct {
+		Name    string
+		IDs     *ttnpb.GatewayIdentifiers
+		Matches bool
+	}{
+		{
+			Name: "EUI",
+			IDs: &ttnpb.GatewayIdentifiers{
+				GatewayId: "other-gateway",
+				Eui:       types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x01}.Bytes(),
+			},
+			Matches: true,
+		},
+		{
+			Name: "GatewayID",
+			IDs: &ttnpb.GatewayIdentifiers{
+				GatewayId: "test-gateway",
+			},
+			Matches: true,
+		},
+		{
+			Name: "Other",
+			IDs: &ttnpb.GatewayIdentifiers{
+				GatewayId: "other-gateway",
+				Eui:       types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x02}.Bytes(),
+			},
+			Matches: false,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(cert.Matches(tc.IDs), should.Equal, tc.Matches)
+		})
+	}
+
+	_, ok = io.ClientCertificateFromContext(ctx)
+	a.So(ok, should.BeFalse)
+	fromCtx, ok := io.ClientCertificateFromContext(io.NewContextWithClientCertificate(ctx, cert))
+	a.So(ok, should.BeTrue)
+	a.So(fromCtx, should.Equal, cert)
+}
@@ -20,6 +20,7 @@ import (
 	"encoding/base64"
 	"fmt"
 	"hash/fnv"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -92,7 +93,8 @@ type Connection struct {
 	// Align for sync/atomic.
 	uplinks,
 	downlinks,
-	txAcknowledgments uint64
+	txAcknowledgments,
+	txFailures uint64
 	lastStatusTime,
 	lastUplinkTime,
 	lastDownlinkTime,
@@ -125,6 +127,15 @@ type Connection struct {
 	statsChangedCh       chan struct{}
 	locChangedCh         chan struct{}
 	versionInfoChangedCh chan struct{}
+
+	remoteShellsMu       sync.Mutex
+	remoteShells         [MaxRemoteShells]*RemoteShell
+	remoteCommandCh      chan *RemoteCommand
+	remoteShellControlCh chan *RemoteShellControl
+	remoteShellInputCh   chan *RemoteShellInput
+
+	proprietaryUpSubs subscriptions[*ttnpb.GatewayUplinkMessage]
+	captureSubs       subscriptions[*ttnpb.GatewayTrafficCapture]
 }
 
 type uplinkMessage struct {
@@ -237,6 +248,10 @@ func NewConnection(
 		statsChangedCh:       make(chan struct{}, 1),
 		locChangedCh:         make(chan struct{}, 1),
 		versionInfoChangedCh: make(chan struct{}, 1),
+
+		remoteCommandCh:      make(chan *RemoteCommand, bufferSize),
+		remoteShellControlCh: make(chan *RemoteShellControl, bufferSize),
+		remoteShellInputCh:   make(chan *RemoteShellInput, bufferSize),
 	}, nil
 }
 
@@ -370,10 +385,12 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage, frontendSync *FrontendClo
 		BandId:  c.band.ID,
 	}
 
+	publishCapture := c.captureUp(up)
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
 	case c.upCh <- msg:
+		publishCapture()
 		atomic.AddUint64(&c.uplinks, 1)
 		atomic.StoreInt64(&c.lastUplinkTime, receivedAt.UnixNano())
 		c.notifyStatsChanged()
@@ -432,11 +449,21 @@ func (c *Connection) HandleTxAck(ack *ttnpb.TxAcknowledgment) (err error) {
 	if err := ack.ValidateFields(); err != nil {
 		return err
 	}
+	publishCapture := c.captureTxAck(ack)
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
 	case c.txAckCh <- ack:
+		publishCapture()
 		atomic.AddUint64(&c.txAcknowledgments, 1)
+		if isTxFailure(ack.Result) {
+			atomic.AddUint64(&c.txFailures, 1)
+		}
+		if ack.Result == ttnpb.TxAcknowledgment_CHANNEL_BUSY && c.scheduler != nil {
+			if settings := ack.GetDownlinkMessage().GetScheduled(); settings != nil {
+				c.scheduler.ReportChannelBusy(settings.Frequency)
+			}
+		}
 		atomic.StoreInt64(&c.lastTxAcknowledgmentTime, time.Now().UnixNano())
 		c.notifyStatsChanged()
 	default:
@@ -491,10 +518,12 @@ func getDownlinkPath(path *ttnpb.DownlinkPath, class ttnpb.Class) (*ttnpb.Gatewa
 
 // SendDown sends the downlink message directly on the downlink channel.
 func (c *Connection) SendDown(msg *ttnpb.DownlinkMessage) error {
+	publishCapture := c.captureDown(msg)
 	select {
 	case <-c.ctx.Done():
 		return c.ctx.Err()
 	case c.downCh <- msg:
+		publishCapture()
 		atomic.AddUint64(&c.downlinks, 1)
 		atomic.StoreInt64(&c.lastDownlinkTime, time.Now().UnixNano())
 		c.notifyStatsChanged()
@@ -807,6 +836,27 @@ func (c *Connection) TxAckStats() (total uint64, t time.Time, ok bool) {
 	return
 }
 
+// isTxFailure returns whether the result reports that the gateway failed to transmit the downlink message.
+// Scheduling conflicts, such as downlink messages that arrive too late or too early, collisions and busy
+// channels, are not transmission failures.
+func isTxFailure(result ttnpb.TxAcknowledgment_Result) bool {
+	switch result {
+	case ttnpb.TxAcknowledgment_UNKNOWN_ERROR,
+		ttnpb.TxAcknowledgment_TX_FREQ,
+		ttnpb.TxAcknowledgment_TX_POWER,
+		ttnpb.TxAcknowledgment_GPS_UNLOCKED:
+		return true
+	default:
+		return false
+	}
+}
+
+// TxFailureStats returns the total number of transmission acknowledgements and the number of those that
+// report a failed transmission.
+func (c *Connection) TxFailureStats() (total, failures uint64) {
+	return atomic.LoadUint64(&c.txAcknowledgments), atomic.LoadUint64(&c.txFailures)
+}
+
 // RTTStats returns the recorded round-trip time statistics.
 func (c *Connection) RTTStats(percentile int, t time.Time) (min, max, median, np time.Duration, count int) {
 	if !c.streamActive(RTTStream) {
@@ -815,6 +865,22 @@ func (c *Connection) RTTStats(percentile int, t time.Time) (min, max, median, np
 	return c.rtts.Stats(percentile, t)
 }
 
+// RTTsBetween returns the round-trip times that are recorded between the given times.
+func (c *Connection) RTTsBetween(from, to time.Time) []time.Duration {
+	if !c.streamActive(RTTStream) {
+		return nil
+	}
+	return c.rtts.Between(from, to)
+}
+
+// SubBandStatsBetween returns the usage statistics of each sub band between the given times.
+func (c *Connection) SubBandStatsBetween(from, to time.Time) []*ttnpb.GatewayConnectionStats_SubBand {
+	if c.scheduler == nil {
+		return nil
+	}
+	return c.scheduler.SubBandStatsBetween(from, to)
+}
+
 // Stats collects and returns the gateway connection statistics and the field mask paths.
 func (c *Connection) Stats() (*ttnpb.GatewayConnectionStats, []string) {
 	stats := &ttnpb.GatewayConnectionStats{
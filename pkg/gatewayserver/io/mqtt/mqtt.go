@@ -16,6 +16,7 @@ package mqtt
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"sync"
@@ -26,6 +27,7 @@ import (
 	"github.com/TheThingsIndustries/mystique/pkg/packet"
 	"github.com/TheThingsIndustries/mystique/pkg/session"
 	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
@@ -60,6 +62,7 @@ func Serve(ctx context.Context, server io.Server, listener net.Listener, format
 }
 
 type connection struct {
+	mqttConn mqttnet.Conn
 	format   Format
 	server   io.Server
 	io       *io.Connection
@@ -75,8 +78,9 @@ func (*connection) DutyCycleStyle() scheduling.DutyCycleStyle {
 
 func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format, server io.Server) error {
 	c := &connection{
-		format: format,
-		server: server,
+		mqttConn: mqttConn,
+		format:   format,
+		server:   server,
 	}
 
 	ctx = auth.NewContextWithInterface(ctx, c)
@@ -148,10 +152,17 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 		return nil, err
 	}
 
+	cert, hasCert := c.clientCertificate()
+	if hasCert {
+		ctx = io.NewContextWithClientCertificate(ctx, cert)
+	}
+
 	md := metadata.New(map[string]string{
-		"id":            ids.GatewayId,
-		"authorization": fmt.Sprintf("Bearer %s", info.Password),
+		"id": ids.GatewayId,
 	})
+	if len(info.Password) > 0 || !hasCert {
+		md.Set("authorization", fmt.Sprintf("Bearer %s", info.Password))
+	}
 	if ctxMd, ok := metadata.FromIncomingContext(ctx); ok {
 		md = metadata.Join(ctxMd, md)
 	}
@@ -163,6 +174,17 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 	}
 
 	uid := unique.ID(ctx, ids)
+	if len(info.Password) == 0 && hasCert {
+		// The gateway authenticates with its client certificate, so we provide the link rights ourselves.
+		// The Gateway Server authenticates the client certificate on connect.
+		ctx = rights.NewContext(ctx, &rights.Rights{
+			GatewayRights: *rights.NewMap(map[string]*ttnpb.Rights{
+				uid: {
+					Rights: []ttnpb.Right{ttnpb.Right_RIGHT_GATEWAY_LINK},
+				},
+			}),
+		})
+	}
 	ctx = log.NewContextWithField(ctx, "gateway_uid", uid)
 
 	addr, _, err := net.SplitHostPort(info.RemoteAddr)
@@ -196,6 +218,16 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 	return c.io.Context(), nil
 }
 
+// clientCertificate returns the client certificate that the gateway presented in the TLS handshake, if any.
+func (c *connection) clientCertificate() (*io.ClientCertificate, bool) {
+	tlsConn, ok := c.mqttConn.NetConn().(interface{ ConnectionState() tls.ConnectionState })
+	if !ok {
+		return nil, false
+	}
+	state := tlsConn.ConnectionState()
+	return io.ClientCertificateFromTLS(&state)
+}
+
 var errNotAuthorized = errors.DefinePermissionDenied("not_authorized", "not authorized")
 
 func (c *connection) Subscribe(info *auth.Info, requestedTopic string, requestedQoS byte) (acceptedTopic string, acceptedQoS byte, err error) {
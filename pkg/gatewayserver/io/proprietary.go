@@ -0,0 +1,39 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// IsProprietary returns true if the given PHYPayload has the proprietary MType.
+func IsProprietary(rawPayload []byte) bool {
+	return len(rawPayload) > 0 && ttnpb.MType(rawPayload[0]>>5) == ttnpb.MType_PROPRIETARY
+}
+
+// SubscribeProprietaryUp subscribes to the proprietary uplink messages of the gateway.
+// The subscription ends when the given context is done. The returned channel is never closed.
+// Proprietary uplink messages are dropped if the subscriber does not keep up.
+func (c *Connection) SubscribeProprietaryUp(ctx context.Context) <-chan *ttnpb.GatewayUplinkMessage {
+	return c.proprietaryUpSubs.subscribe(ctx, c.ctx)
+}
+
+// PublishProprietaryUp publishes the proprietary uplink message to the subscribers.
+// It returns the number of subscribers that received the message.
+func (c *Connection) PublishProprietaryUp(msg *ttnpb.GatewayUplinkMessage) int {
+	return c.proprietaryUpSubs.publish(msg)
+}
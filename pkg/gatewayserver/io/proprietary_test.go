@@ -0,0 +1,64 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestIsProprietary(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	a.So(io.IsProprietary(nil), should.BeFalse)
+	a.So(io.IsProprietary([]byte{0x40, 0x01}), should.BeFalse)
+	a.So(io.IsProprietary([]byte{0x00}), should.BeFalse)
+	a.So(io.IsProprietary([]byte{0xe0}), should.BeTrue)
+	a.So(io.IsProprietary([]byte{0xe0, 0x01, 0x02}), should.BeTrue)
+}
+
+func TestProprietaryUpSubscription(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	conn := newRemoteShellConnection(ctx, t, &mock.Frontend{})
+
+	msg := &ttnpb.GatewayUplinkMessage{
+		Message: &ttnpb.UplinkMessage{RawPayload: []byte{0xe0, 0x01, 0x02}},
+	}
+	a.So(conn.PublishProprietaryUp(msg), should.Equal, 0)
+
+	sub1Ctx, sub1Cancel := context.WithCancel(ctx)
+	sub1 := conn.SubscribeProprietaryUp(sub1Ctx)
+	sub2 := conn.SubscribeProprietaryUp(ctx)
+	a.So(conn.PublishProprietaryUp(msg), should.Equal, 2)
+	a.So(<-sub1, should.Equal, msg)
+	a.So(<-sub2, should.Equal, msg)
+
+	// Canceling the context ends the subscription.
+	sub1Cancel()
+	deadline := time.Now().Add(test.Delay << 4)
+	for conn.PublishProprietaryUp(msg) != 1 && time.Now().Before(deadline) {
+		time.Sleep(test.Delay)
+	}
+	a.So(conn.PublishProprietaryUp(msg), should.Equal, 1)
+}
@@ -0,0 +1,242 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errorcontext"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// MaxRemoteShells is the maximum number of concurrent remote shell sessions per gateway connection.
+const MaxRemoteShells = 4
+
+var (
+	errRemoteShellNotSupported = errors.DefineUnimplemented(
+		"remote_shell_not_supported", "remote shell not supported by frontend `{protocol}`",
+	)
+	errRemoteShellUnavailable = errors.DefineResourceExhausted(
+		"remote_shell_unavailable", "no remote shell session available",
+	)
+	errRemoteShellNotFound = errors.DefineNotFound(
+		"remote_shell_not_found", "remote shell session `{index}` not found",
+	)
+	errRemoteShellClosed         = errors.DefineAborted("remote_shell_closed", "remote shell session closed by gateway")
+	errRemoteShellOutputOverflow = errors.DefineResourceExhausted(
+		"remote_shell_output_overflow", "output of remote shell session `{index}` overflows",
+	)
+)
+
+// RemoteShellFrontend is a Frontend that supports remote shell sessions and remote commands.
+type RemoteShellFrontend interface {
+	Frontend
+	// SupportsRemoteShell returns true if the frontend supports remote shell sessions and remote commands.
+	SupportsRemoteShell() bool
+}
+
+// RemoteCommand is a command to run on the gateway.
+type RemoteCommand struct {
+	Command   string
+	Arguments []string
+}
+
+// RemoteShellControl starts or stops a remote shell session on the gateway.
+type RemoteShellControl struct {
+	Index int
+	Stop  bool
+	// User and Term are only set when starting the session.
+	User string
+	Term string
+}
+
+// RemoteShellInput is input of a remote shell session on the gateway.
+type RemoteShellInput struct {
+	Index int
+	Data  []byte
+}
+
+// RemoteShell is a remote shell session on the gateway.
+type RemoteShell struct {
+	index     int
+	conn      *Connection
+	ctx       context.Context
+	cancelCtx errorcontext.CancelFunc
+	outputCh  chan []byte
+}
+
+// Context returns the context of the remote shell session.
+// The context is done when the session is closed or when the gateway disconnects.
+func (s *RemoteShell) Context() context.Context { return s.ctx }
+
+// Output returns the output channel of the remote shell session.
+func (s *RemoteShell) Output() <-chan []byte { return s.outputCh }
+
+// Write sends the given input to the remote shell session.
+func (s *RemoteShell) Write(data []byte) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case s.conn.remoteShellInputCh <- &RemoteShellInput{Index: s.index, Data: data}:
+		return nil
+	}
+}
+
+// Close stops the remote shell session.
+func (s *RemoteShell) Close() {
+	if !s.conn.removeRemoteShell(s) {
+		return
+	}
+	s.cancelCtx(context.Canceled)
+	select {
+	case <-s.conn.ctx.Done():
+	case s.conn.remoteShellControlCh <- &RemoteShellControl{Index: s.index, Stop: true}:
+	}
+}
+
+func (c *Connection) supportsRemoteShell() bool {
+	frontend, ok := c.frontend.(RemoteShellFrontend)
+	return ok && frontend.SupportsRemoteShell()
+}
+
+func (c *Connection) removeRemoteShell(s *RemoteShell) bool {
+	c.remoteShellsMu.Lock()
+	defer c.remoteShellsMu.Unlock()
+	if c.remoteShells[s.index] != s {
+		return false
+	}
+	c.remoteShells[s.index] = nil
+	return true
+}
+
+func (c *Connection) remoteShell(index int) (*RemoteShell, bool) {
+	if index < 0 || index >= MaxRemoteShells {
+		return nil, false
+	}
+	c.remoteShellsMu.Lock()
+	defer c.remoteShellsMu.Unlock()
+	s := c.remoteShells[index]
+	return s, s != nil
+}
+
+// RunCommand sends the command to run on the gateway.
+func (c *Connection) RunCommand(cmd *RemoteCommand) error {
+	if !c.supportsRemoteShell() {
+		return errRemoteShellNotSupported.WithAttributes("protocol", c.frontend.Protocol())
+	}
+	select {
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	case c.remoteCommandCh <- cmd:
+	default:
+		return errBufferFull.New()
+	}
+	return nil
+}
+
+// OpenRemoteShell starts a remote shell session on the gateway.
+// The user identifies the initiator of the session to the gateway, and term is the terminal type.
+// The caller must close the session when done.
+func (c *Connection) OpenRemoteShell(user, term string) (*RemoteShell, error) {
+	if !c.supportsRemoteShell() {
+		return nil, errRemoteShellNotSupported.WithAttributes("protocol", c.frontend.Protocol())
+	}
+	ctx, cancelCtx := errorcontext.New(c.ctx)
+	s := &RemoteShell{
+		conn:      c,
+		ctx:       ctx,
+		cancelCtx: cancelCtx,
+		outputCh:  make(chan []byte, bufferSize),
+	}
+	c.remoteShellsMu.Lock()
+	s.index = -1
+	for i, existing := range c.remoteShells {
+		if existing == nil {
+			s.index = i
+			c.remoteShells[i] = s
+			break
+		}
+	}
+	c.remoteShellsMu.Unlock()
+	if s.index < 0 {
+		cancelCtx(nil)
+		return nil, errRemoteShellUnavailable.New()
+	}
+	select {
+	case <-c.ctx.Done():
+		c.removeRemoteShell(s)
+		cancelCtx(c.ctx.Err())
+		return nil, c.ctx.Err()
+	case c.remoteShellControlCh <- &RemoteShellControl{Index: s.index, User: user, Term: term}:
+	}
+	return s, nil
+}
+
+// HandleRemoteShellOutput sends the output of a remote shell session to the session.
+// This method does not block: if the session does not keep up with the output, the session is closed.
+func (c *Connection) HandleRemoteShellOutput(index int, data []byte) error {
+	s, ok := c.remoteShell(index)
+	if !ok {
+		return errRemoteShellNotFound.WithAttributes("index", index)
+	}
+	select {
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	case s.outputCh <- data:
+		return nil
+	default:
+	}
+	err := errRemoteShellOutputOverflow.WithAttributes("index", index)
+	if !c.removeRemoteShell(s) {
+		return err
+	}
+	s.cancelCtx(err)
+	select {
+	case c.remoteShellControlCh <- &RemoteShellControl{Index: index, Stop: true}:
+	default:
+	}
+	return err
+}
+
+// HandleRemoteShellStatus handles the status of a remote shell session reported by the gateway.
+// If the session is not running on the gateway, the session is closed.
+func (c *Connection) HandleRemoteShellStatus(index int, running bool) {
+	if running {
+		return
+	}
+	s, ok := c.remoteShell(index)
+	if !ok || !c.removeRemoteShell(s) {
+		return
+	}
+	s.cancelCtx(errRemoteShellClosed.New())
+}
+
+// RemoteCommands returns the channel of commands to run on the gateway.
+func (c *Connection) RemoteCommands() <-chan *RemoteCommand {
+	return c.remoteCommandCh
+}
+
+// RemoteShellControl returns the channel of remote shell sessions to start or stop on the gateway.
+func (c *Connection) RemoteShellControl() <-chan *RemoteShellControl {
+	return c.remoteShellControlCh
+}
+
+// RemoteShellInput returns the channel of input of the remote shell sessions on the gateway.
+func (c *Connection) RemoteShellInput() <-chan *RemoteShellInput {
+	return c.remoteShellInputCh
+}
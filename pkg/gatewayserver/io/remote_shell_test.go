@@ -0,0 +1,158 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io_test
+
+import (
+	"context"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type remoteShellFrontend struct {
+	mock.Frontend
+}
+
+func (*remoteShellFrontend) SupportsRemoteShell() bool { return true }
+
+func newRemoteShellConnection(ctx context.Context, t *testing.T, frontend io.Frontend) *io.Connection {
+	t.Helper()
+	conn, err := io.NewConnection(
+		ctx,
+		frontend,
+		&ttnpb.Gateway{
+			Ids:             &ttnpb.GatewayIdentifiers{GatewayId: "foo-gateway"},
+			FrequencyPlanId: "EU_863_870",
+		},
+		frequencyplans.NewStore(test.FrequencyPlansFetcher),
+		true,
+		nil,
+		&ttnpb.GatewayRemoteAddress{Ip: "127.0.0.1"},
+	)
+	if err != nil {
+		t.Fatalf("Failed to create connection: %v", err)
+	}
+	return conn
+}
+
+func TestRemoteShell(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NotSupported", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		conn := newRemoteShellConnection(ctx, t, &mock.Frontend{})
+
+		err := conn.RunCommand(&io.RemoteCommand{Command: "reboot"})
+		a.So(errors.IsUnimplemented(err), should.BeTrue)
+		_, err = conn.OpenRemoteShell("user", "xterm")
+		a.So(errors.IsUnimplemented(err), should.BeTrue)
+	})
+
+	t.Run("Command", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		conn := newRemoteShellConnection(ctx, t, &remoteShellFrontend{})
+
+		cmd := &io.RemoteCommand{Command: "reboot", Arguments: []string{"-f"}}
+		a.So(conn.RunCommand(cmd), should.BeNil)
+		select {
+		case actual := <-conn.RemoteCommands():
+			a.So(actual, should.Resemble, cmd)
+		default:
+			t.Fatal("Expected remote command")
+		}
+	})
+
+	t.Run("Session", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		conn := newRemoteShellConnection(ctx, t, &remoteShellFrontend{})
+
+		shells := make([]*io.RemoteShell, io.MaxRemoteShells)
+		for i := range shells {
+			shell, err := conn.OpenRemoteShell("user", "xterm")
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(<-conn.RemoteShellControl(), should.Resemble, &io.RemoteShellControl{
+				Index: i,
+				User:  "user",
+				Term:  "xterm",
+			})
+			shells[i] = shell
+		}
+		_, err := conn.OpenRemoteShell("user", "xterm")
+		a.So(errors.IsResourceExhausted(err), should.BeTrue)
+
+		// Input is relayed to the gateway with the session index.
+		a.So(shells[1].Write([]byte("ls\n")), should.BeNil)
+		a.So(<-conn.RemoteShellInput(), should.Resemble, &io.RemoteShellInput{Index: 1, Data: []byte("ls\n")})
+
+		// Output is relayed to the session with the session index.
+		a.So(conn.HandleRemoteShellOutput(1, []byte("bin\n")), should.BeNil)
+		a.So(<-shells[1].Output(), should.Resemble, []byte("bin\n"))
+		a.So(errors.IsNotFound(conn.HandleRemoteShellOutput(io.MaxRemoteShells, []byte("bin\n"))), should.BeTrue)
+
+		// Sessions that do not keep up with the output are closed instead of blocking the connection.
+		for err = nil; err == nil; {
+			err = conn.HandleRemoteShellOutput(3, []byte("bin\n"))
+		}
+		a.So(errors.IsResourceExhausted(err), should.BeTrue)
+		<-shells[3].Context().Done()
+		a.So(errors.IsResourceExhausted(shells[3].Context().Err()), should.BeTrue)
+		a.So(<-conn.RemoteShellControl(), should.Resemble, &io.RemoteShellControl{Index: 3, Stop: true})
+		a.So(errors.IsNotFound(conn.HandleRemoteShellOutput(3, []byte("bin\n"))), should.BeTrue)
+		shells[3], err = conn.OpenRemoteShell("user", "xterm")
+		a.So(err, should.BeNil)
+		a.So(<-conn.RemoteShellControl(), should.Resemble, &io.RemoteShellControl{Index: 3, User: "user", Term: "xterm"})
+
+		// Closing the session stops the session on the gateway and releases the index.
+		shells[0].Close()
+		a.So(<-conn.RemoteShellControl(), should.Resemble, &io.RemoteShellControl{Index: 0, Stop: true})
+		a.So(shells[0].Context().Err(), should.NotBeNil)
+		a.So(errors.IsNotFound(conn.HandleRemoteShellOutput(0, []byte("bin\n"))), should.BeTrue)
+		shell, err := conn.OpenRemoteShell("user", "xterm")
+		a.So(err, should.BeNil)
+		a.So(<-conn.RemoteShellControl(), should.Resemble, &io.RemoteShellControl{Index: 0, User: "user", Term: "xterm"})
+		shells[0] = shell
+
+		// The session ends when the gateway reports that it is no longer running.
+		conn.HandleRemoteShellStatus(2, true)
+		a.So(shells[2].Context().Err(), should.BeNil)
+		conn.HandleRemoteShellStatus(2, false)
+		<-shells[2].Context().Done()
+		a.So(errors.IsAborted(shells[2].Context().Err()), should.BeTrue)
+		shells[2].Close()
+		select {
+		case ctrl := <-conn.RemoteShellControl():
+			t.Fatalf("Unexpected remote shell control %v", ctrl)
+		default:
+		}
+
+		// All sessions end when the gateway disconnects.
+		conn.Disconnect(context.Canceled)
+		for _, i := range []int{0, 1, 3} {
+			<-shells[i].Context().Done()
+			a.So(shells[i].Write([]byte("ls\n")), should.NotBeNil)
+		}
+	})
+}
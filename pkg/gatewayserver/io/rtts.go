@@ -60,6 +60,20 @@ func (r *rtts) Last(ref time.Time) (time.Duration, bool) {
 	return r.items[len(r.items)-1].d, true
 }
 
+// Between returns the round-trip times that are recorded from the given time until, but not including, the given
+// time. Only the last recorded round-trip times are kept.
+func (r *rtts) Between(from, to time.Time) []time.Duration {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var res []time.Duration
+	for _, item := range r.items {
+		if !item.t.Before(from) && item.t.Before(to) {
+			res = append(res, item.d)
+		}
+	}
+	return res
+}
+
 // Stats returns the min, max, median, requested percentile and number of recorded round-trip times.
 func (r *rtts) Stats(percentile int, ref time.Time) (min, max, median, np time.Duration, count int) {
 	r.mu.RLock()
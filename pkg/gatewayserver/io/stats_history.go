@@ -0,0 +1,268 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StatsHistorySampler samples the stats of a gateway connection into points of the connection stats history.
+type StatsHistorySampler struct {
+	conn *Connection
+
+	lastSample                 time.Time
+	uplinks, downlinks, txAcks uint64
+}
+
+// NewStatsHistorySampler returns a new StatsHistorySampler that starts sampling at the given time.
+func NewStatsHistorySampler(conn *Connection, t time.Time) *StatsHistorySampler {
+	stats, _ := conn.Stats()
+	return &StatsHistorySampler{
+		conn:       conn,
+		lastSample: t,
+		uplinks:    stats.UplinkCount,
+		downlinks:  stats.DownlinkCount,
+		txAcks:     stats.TxAcknowledgmentCount,
+	}
+}
+
+// Sample returns the point of the interval between the previous sample and the given time.
+// The round-trip times and sub band utilizations are the ones of the interval.
+func (s *StatsHistorySampler) Sample(t time.Time) *ttnpb.GatewayConnectionStatsHistoryPoint {
+	stats, _ := s.conn.Stats()
+	point := &ttnpb.GatewayConnectionStatsHistoryPoint{
+		Time:                  timestamppb.New(s.lastSample),
+		Duration:              durationpb.New(t.Sub(s.lastSample)),
+		UplinkCount:           stats.UplinkCount - s.uplinks,
+		DownlinkCount:         stats.DownlinkCount - s.downlinks,
+		TxAcknowledgmentCount: stats.TxAcknowledgmentCount - s.txAcks,
+		SubBands:              s.conn.SubBandStatsBetween(s.lastSample, t),
+	}
+	if rtts := s.conn.RTTsBetween(s.lastSample, t); len(rtts) > 0 {
+		point.RoundTripTimes, point.RoundTripTimePercentiles = rttStats(rtts)
+	}
+	// Only include the status metrics if a status message has been received in the interval.
+	if status, at := stats.LastStatus, stats.LastStatusReceivedAt; len(status.GetMetrics()) > 0 &&
+		at != nil && !at.AsTime().Before(s.lastSample) {
+		point.StatusMetrics = make(map[string]float32, len(status.Metrics))
+		for k, v := range status.Metrics {
+			point.StatusMetrics[k] = v
+		}
+	}
+	s.lastSample = t
+	s.uplinks, s.downlinks, s.txAcks = stats.UplinkCount, stats.DownlinkCount, stats.TxAcknowledgmentCount
+	return point
+}
+
+// rttPercentile returns the given percentile of the sorted round-trip times.
+func rttPercentile(sorted []time.Duration, percentile int) time.Duration {
+	i := percentile*len(sorted)/100 - 1
+	if i < 0 {
+		i = 0
+	}
+	return sorted[i]
+}
+
+// rttStats returns the statistics of the given round-trip times. The round-trip times are sorted in place.
+func rttStats(rtts []time.Duration) (
+	*ttnpb.GatewayConnectionStats_RoundTripTimes, *ttnpb.GatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles,
+) {
+	sort.Slice(rtts, func(i, j int) bool { return rtts[i] < rtts[j] })
+	l := len(rtts)
+	median := rtts[l/2]
+	if l%2 == 0 {
+		median = (rtts[l/2-1] + rtts[l/2]) / 2
+	}
+	times := &ttnpb.GatewayConnectionStats_RoundTripTimes{
+		Min:    durationpb.New(rtts[0]),
+		Max:    durationpb.New(rtts[l-1]),
+		Median: durationpb.New(median),
+		Count:  uint32(l),
+	}
+	percentiles := &ttnpb.GatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles{
+		P90: durationpb.New(rttPercentile(rtts, 90)),
+		P95: durationpb.New(rttPercentile(rtts, 95)),
+		P99: durationpb.New(rttPercentile(rtts, 99)),
+	}
+	return times, percentiles
+}
+
+type subBandKey struct {
+	minFrequency, maxFrequency uint64
+}
+
+// average is a weighted average.
+type average struct {
+	sum, weight float64
+}
+
+func (a *average) add(v, weight float64) {
+	a.sum += v * weight
+	a.weight += weight
+}
+
+func (a average) value() float64 {
+	if a.weight == 0 {
+		return 0
+	}
+	return a.sum / a.weight
+}
+
+type statsHistoryAggregate struct {
+	point *ttnpb.GatewayConnectionStatsHistoryPoint
+
+	rttMedians          average
+	rttP90s             average
+	rttP95s             average
+	rttP99s             average
+	hasRTTPercentiles   bool
+	subBands            []subBandKey
+	subBandLimits       map[subBandKey]float32
+	subBandUtilizations map[subBandKey]*average
+	statusMetrics       map[string]*average
+}
+
+func newStatsHistoryAggregate(t time.Time, step time.Duration) *statsHistoryAggregate {
+	return &statsHistoryAggregate{
+		point: &ttnpb.GatewayConnectionStatsHistoryPoint{
+			Time:     timestamppb.New(t),
+			Duration: durationpb.New(step),
+		},
+		subBandLimits:       make(map[subBandKey]float32),
+		subBandUtilizations: make(map[subBandKey]*average),
+		statusMetrics:       make(map[string]*average),
+	}
+}
+
+func (a *statsHistoryAggregate) add(point *ttnpb.GatewayConnectionStatsHistoryPoint) {
+	a.point.UplinkCount += point.UplinkCount
+	a.point.DownlinkCount += point.DownlinkCount
+	a.point.TxAcknowledgmentCount += point.TxAcknowledgmentCount
+
+	if rtt := point.RoundTripTimes; rtt != nil {
+		if agg := a.point.RoundTripTimes; agg == nil {
+			a.point.RoundTripTimes = &ttnpb.GatewayConnectionStats_RoundTripTimes{
+				Min:   rtt.Min,
+				Max:   rtt.Max,
+				Count: rtt.Count,
+			}
+		} else {
+			if rtt.Min.AsDuration() < agg.Min.AsDuration() {
+				agg.Min = rtt.Min
+			}
+			if rtt.Max.AsDuration() > agg.Max.AsDuration() {
+				agg.Max = rtt.Max
+			}
+			agg.Count += rtt.Count
+		}
+		weight := float64(rtt.Count)
+		a.rttMedians.add(float64(rtt.Median.AsDuration()), weight)
+		if p := point.RoundTripTimePercentiles; p != nil {
+			a.hasRTTPercentiles = true
+			a.rttP90s.add(float64(p.P90.AsDuration()), weight)
+			a.rttP95s.add(float64(p.P95.AsDuration()), weight)
+			a.rttP99s.add(float64(p.P99.AsDuration()), weight)
+		}
+	}
+
+	for _, subBand := range point.SubBands {
+		key := subBandKey{subBand.MinFrequency, subBand.MaxFrequency}
+		utilization, ok := a.subBandUtilizations[key]
+		if !ok {
+			utilization = &average{}
+			a.subBands = append(a.subBands, key)
+			a.subBandUtilizations[key] = utilization
+		}
+		utilization.add(float64(subBand.DownlinkUtilization), point.Duration.AsDuration().Seconds())
+		a.subBandLimits[key] = subBand.DownlinkUtilizationLimit
+	}
+
+	for k, v := range point.StatusMetrics {
+		metric, ok := a.statusMetrics[k]
+		if !ok {
+			metric = &average{}
+			a.statusMetrics[k] = metric
+		}
+		metric.add(float64(v), 1)
+	}
+}
+
+func (a *statsHistoryAggregate) result() *ttnpb.GatewayConnectionStatsHistoryPoint {
+	if rtt := a.point.RoundTripTimes; rtt != nil {
+		rtt.Median = durationpb.New(time.Duration(a.rttMedians.value()))
+	}
+	if a.hasRTTPercentiles {
+		a.point.RoundTripTimePercentiles = &ttnpb.GatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles{
+			P90: durationpb.New(time.Duration(a.rttP90s.value())),
+			P95: durationpb.New(time.Duration(a.rttP95s.value())),
+			P99: durationpb.New(time.Duration(a.rttP99s.value())),
+		}
+	}
+	for _, key := range a.subBands {
+		a.point.SubBands = append(a.point.SubBands, &ttnpb.GatewayConnectionStats_SubBand{
+			MinFrequency:             key.minFrequency,
+			MaxFrequency:             key.maxFrequency,
+			DownlinkUtilizationLimit: a.subBandLimits[key],
+			DownlinkUtilization:      float32(a.subBandUtilizations[key].value()),
+		})
+	}
+	if len(a.statusMetrics) > 0 {
+		a.point.StatusMetrics = make(map[string]float32, len(a.statusMetrics))
+		for k, v := range a.statusMetrics {
+			a.point.StatusMetrics[k] = float32(v.value())
+		}
+	}
+	return a.point
+}
+
+// AggregateStatsHistory aggregates the points of the connection stats history in intervals of the given step,
+// starting at the given time. The points must be ordered by time, and points before the start time are ignored.
+// Counters are summed, and utilizations, round-trip times and status metrics are averaged. The utilizations are
+// weighted by the duration of the points, and the round-trip times by the number of round-trip times.
+// If the step is not positive, the points are returned as is.
+func AggregateStatsHistory(
+	points []*ttnpb.GatewayConnectionStatsHistoryPoint, from time.Time, step time.Duration,
+) []*ttnpb.GatewayConnectionStatsHistoryPoint {
+	if step <= 0 {
+		return points
+	}
+	var (
+		res []*ttnpb.GatewayConnectionStatsHistoryPoint
+		agg *statsHistoryAggregate
+	)
+	for _, point := range points {
+		t := point.Time.AsTime()
+		if t.Before(from) {
+			continue
+		}
+		start := from.Add(t.Sub(from) / step * step)
+		if agg == nil || !agg.point.Time.AsTime().Equal(start) {
+			if agg != nil {
+				res = append(res, agg.result())
+			}
+			agg = newStatsHistoryAggregate(start, step)
+		}
+		agg.add(point)
+	}
+	if agg != nil {
+		res = append(res, agg.result())
+	}
+	return res
+}
@@ -0,0 +1,189 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestStatsHistorySampler(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	conn := newRemoteShellConnection(ctx, t, &mock.Frontend{})
+
+	start := time.Now()
+	sampler := io.NewStatsHistorySampler(conn, start)
+
+	a.So(conn.HandleStatus(&ttnpb.GatewayStatus{
+		Time:    timestamppb.New(start),
+		Metrics: map[string]float32{"temp": 42},
+	}), should.BeNil)
+	for i := 0; i < 2; i++ {
+		a.So(conn.HandleUp(&ttnpb.UplinkMessage{
+			RawPayload: []byte{0x40, byte(i)},
+			Settings: &ttnpb.TxSettings{
+				DataRate: &ttnpb.DataRate{Modulation: &ttnpb.DataRate_Lora{Lora: &ttnpb.LoRaDataRate{
+					SpreadingFactor: 7,
+					Bandwidth:       125000,
+					CodingRate:      "4/5",
+				}}},
+				Frequency: 868100000,
+				Timestamp: uint32(i),
+			},
+			RxMetadata: []*ttnpb.RxMetadata{{GatewayIds: conn.Gateway().GetIds()}},
+			ReceivedAt: timestamppb.Now(),
+		}, nil), should.BeNil)
+	}
+
+	for i := 1; i <= 10; i++ {
+		conn.RecordRTT(time.Duration(i)*10*time.Millisecond, start.Add(time.Duration(i)*time.Second))
+	}
+
+	point := sampler.Sample(start.Add(time.Minute))
+	a.So(point.Time.AsTime(), should.Equal, start)
+	a.So(point.Duration.AsDuration(), should.Equal, time.Minute)
+	a.So(point.UplinkCount, should.Equal, 2)
+	a.So(point.DownlinkCount, should.Equal, 0)
+	a.So(point.StatusMetrics, should.Resemble, map[string]float32{"temp": 42})
+	a.So(point.RoundTripTimes, should.Resemble, &ttnpb.GatewayConnectionStats_RoundTripTimes{
+		Min:    durationpb.New(10 * time.Millisecond),
+		Max:    durationpb.New(100 * time.Millisecond),
+		Median: durationpb.New(55 * time.Millisecond),
+		Count:  10,
+	})
+	a.So(point.RoundTripTimePercentiles, should.Resemble, &ttnpb.GatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles{
+		P90: durationpb.New(90 * time.Millisecond),
+		P95: durationpb.New(90 * time.Millisecond),
+		P99: durationpb.New(90 * time.Millisecond),
+	})
+	if a.So(point.SubBands, should.NotBeEmpty) {
+		a.So(point.SubBands[0].DownlinkUtilization, should.Equal, 0)
+	}
+
+	// The counters are reset, and the status and round-trip times are not repeated in the next interval.
+	point = sampler.Sample(start.Add(2 * time.Minute))
+	a.So(point.Time.AsTime(), should.Equal, start.Add(time.Minute))
+	a.So(point.UplinkCount, should.Equal, 0)
+	a.So(point.StatusMetrics, should.BeNil)
+	a.So(point.RoundTripTimes, should.BeNil)
+	a.So(point.RoundTripTimePercentiles, should.BeNil)
+}
+
+func TestAggregateStatsHistory(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	from := time.Unix(1700000000, 0).UTC()
+	point := func(
+		offset time.Duration, uplinks uint64, rttMedian time.Duration, rttCount uint32, utilization, temp float32,
+	) *ttnpb.GatewayConnectionStatsHistoryPoint {
+		return &ttnpb.GatewayConnectionStatsHistoryPoint{
+			Time:        timestamppb.New(from.Add(offset)),
+			Duration:    durationpb.New(5 * time.Minute),
+			UplinkCount: uplinks,
+			RoundTripTimes: &ttnpb.GatewayConnectionStats_RoundTripTimes{
+				Min:    durationpb.New(rttMedian / 2),
+				Max:    durationpb.New(rttMedian * 2),
+				Median: durationpb.New(rttMedian),
+				Count:  rttCount,
+			},
+			RoundTripTimePercentiles: &ttnpb.GatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles{
+				P90: durationpb.New(rttMedian * 3 / 2),
+				P95: durationpb.New(rttMedian * 7 / 4),
+				P99: durationpb.New(rttMedian * 2),
+			},
+			SubBands: []*ttnpb.GatewayConnectionStats_SubBand{
+				{
+					MinFrequency:             863000000,
+					MaxFrequency:             865000000,
+					DownlinkUtilizationLimit: 0.001,
+					DownlinkUtilization:      utilization,
+				},
+			},
+			StatusMetrics: map[string]float32{"temp": temp},
+		}
+	}
+	points := []*ttnpb.GatewayConnectionStatsHistoryPoint{
+		point(-5*time.Minute, 100, time.Second, 10, 1, 1),
+		point(0, 1, 10*time.Millisecond, 30, 0.5, 20),
+		point(5*time.Minute, 2, 30*time.Millisecond, 10, 0.25, 30),
+		point(15*time.Minute, 3, 40*time.Millisecond, 10, 0.125, 40),
+	}
+
+	a.So(io.AggregateStatsHistory(points, from, 0), should.Resemble, points)
+
+	aggregated := io.AggregateStatsHistory(points, from, 10*time.Minute)
+	a.So(aggregated, should.Resemble, []*ttnpb.GatewayConnectionStatsHistoryPoint{
+		{
+			Time:        timestamppb.New(from),
+			Duration:    durationpb.New(10 * time.Minute),
+			UplinkCount: 3,
+			RoundTripTimes: &ttnpb.GatewayConnectionStats_RoundTripTimes{
+				Min:    durationpb.New(5 * time.Millisecond),
+				Max:    durationpb.New(60 * time.Millisecond),
+				Median: durationpb.New(15 * time.Millisecond),
+				Count:  40,
+			},
+			RoundTripTimePercentiles: &ttnpb.GatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles{
+				P90: durationpb.New(22500 * time.Microsecond),
+				P95: durationpb.New(26250 * time.Microsecond),
+				P99: durationpb.New(30 * time.Millisecond),
+			},
+			SubBands: []*ttnpb.GatewayConnectionStats_SubBand{
+				{
+					MinFrequency:             863000000,
+					MaxFrequency:             865000000,
+					DownlinkUtilizationLimit: 0.001,
+					DownlinkUtilization:      0.375,
+				},
+			},
+			StatusMetrics: map[string]float32{"temp": 25},
+		},
+		{
+			Time:        timestamppb.New(from.Add(10 * time.Minute)),
+			Duration:    durationpb.New(10 * time.Minute),
+			UplinkCount: 3,
+			RoundTripTimes: &ttnpb.GatewayConnectionStats_RoundTripTimes{
+				Min:    durationpb.New(20 * time.Millisecond),
+				Max:    durationpb.New(80 * time.Millisecond),
+				Median: durationpb.New(40 * time.Millisecond),
+				Count:  10,
+			},
+			RoundTripTimePercentiles: &ttnpb.GatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles{
+				P90: durationpb.New(60 * time.Millisecond),
+				P95: durationpb.New(70 * time.Millisecond),
+				P99: durationpb.New(80 * time.Millisecond),
+			},
+			SubBands: []*ttnpb.GatewayConnectionStats_SubBand{
+				{
+					MinFrequency:             863000000,
+					MaxFrequency:             865000000,
+					DownlinkUtilizationLimit: 0.001,
+					DownlinkUtilization:      0.125,
+				},
+			},
+			StatusMetrics: map[string]float32{"temp": 40},
+		},
+	})
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"context"
+	"sync"
+)
+
+// subscriptions is a set of subscribers to values of type T.
+// The zero value is ready to use.
+type subscriptions[T any] struct {
+	mu   sync.RWMutex
+	subs map[chan T]struct{}
+}
+
+// subscribe adds a subscriber until either of the given contexts is done.
+// The returned channel is never closed.
+func (s *subscriptions[T]) subscribe(ctx, connCtx context.Context) <-chan T {
+	ch := make(chan T, bufferSize)
+	s.mu.Lock()
+	if s.subs == nil {
+		s.subs = make(map[chan T]struct{})
+	}
+	s.subs[ch] = struct{}{}
+	s.mu.Unlock()
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-connCtx.Done():
+		}
+		s.mu.Lock()
+		delete(s.subs, ch)
+		s.mu.Unlock()
+	}()
+	return ch
+}
+
+// empty returns true if there are no subscribers.
+func (s *subscriptions[T]) empty() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.subs) == 0
+}
+
+// publish sends the value to the subscribers. Subscribers that do not keep up miss the value.
+// It returns the number of subscribers that received the value.
+func (s *subscriptions[T]) publish(v T) (n int) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for ch := range s.subs {
+		select {
+		case ch <- v:
+			n++
+		default:
+		}
+	}
+	return n
+}
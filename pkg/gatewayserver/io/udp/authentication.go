@@ -0,0 +1,235 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	encoding "go.thethings.network/lorawan-stack/v3/pkg/ttnpb/udp"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// Authenticated packets have a trailer with the time at which the packet was sent and the MAC of the packet.
+// The time is the number of milliseconds since the Unix epoch as 64-bit unsigned big endian integer.
+// The MAC is the HMAC-SHA256 of the packet and the time, truncated to 16 bytes.
+const (
+	packetTimeLength    = 8
+	packetMACLength     = 16
+	packetTrailerLength = packetTimeLength + packetMACLength
+	packetHeaderLength  = 12
+)
+
+// Authenticator authenticates the raw packets received from gateways.
+type Authenticator interface {
+	// Authenticate authenticates the raw packet received at the given time.
+	// It returns the packet without the authentication trailer.
+	Authenticate(ctx context.Context, data []byte, receivedAt time.Time) ([]byte, error)
+}
+
+type noopAuthenticator struct{}
+
+// Authenticate implements Authenticator.
+func (noopAuthenticator) Authenticate(_ context.Context, data []byte, _ time.Time) ([]byte, error) {
+	return data, nil
+}
+
+// PreSharedKeyRegistry provides the pre-shared keys of gateways.
+type PreSharedKeyRegistry interface {
+	// GetPreSharedKey returns the pre-shared key of the gateway with the given EUI.
+	// It returns an empty key if the gateway has no pre-shared key.
+	GetPreSharedKey(ctx context.Context, eui types.EUI64) ([]byte, error)
+}
+
+var (
+	errNoPreSharedKey = errors.DefineUnauthenticated(
+		"no_pre_shared_key", "no pre-shared key for gateway `{eui}`",
+	)
+	errPreSharedKey = errors.DefineUnauthenticated(
+		"pre_shared_key", "invalid pre-shared key for gateway `{eui}`",
+	)
+	errUnauthenticatedPacket = errors.DefineUnauthenticated(
+		"unauthenticated_packet", "packet of gateway `{eui}` is not authenticated",
+	)
+	errPacketMAC  = errors.DefineUnauthenticated("packet_mac", "invalid MAC in packet of gateway `{eui}`")
+	errPacketTime = errors.DefineUnauthenticated(
+		"packet_time", "time `{time}` of packet of gateway `{eui}` is outside the replay window",
+	)
+	errPacketReplay = errors.DefineUnauthenticated("packet_replay", "replayed packet of gateway `{eui}`")
+)
+
+func packetMAC(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data)
+	return h.Sum(nil)[:packetMACLength]
+}
+
+// AuthenticatePacket appends the authentication trailer to the raw packet, sent at the given time, with the pre-shared
+// key of the gateway. This is the counterpart of the pre-shared key Authenticator, as implemented by the forwarder.
+func AuthenticatePacket(data, key []byte, t time.Time) []byte {
+	res := make([]byte, len(data), len(data)+packetTrailerLength)
+	copy(res, data)
+	res = binary.BigEndian.AppendUint64(res, uint64(t.UnixMilli()))
+	return append(res, packetMAC(key, res)...)
+}
+
+const (
+	// minPreSharedKeyLength is the minimum length of pre-shared keys in bytes.
+	minPreSharedKeyLength = 16
+	// preSharedKeyCacheSize is the number of gateways of which the pre-shared key is cached.
+	preSharedKeyCacheSize = 1 << 14
+	// replayShards is the number of shards of the replay cache.
+	replayShards = 64
+)
+
+type replayEntry struct {
+	eui   types.EUI64
+	time  int64
+	token [2]byte
+}
+
+// replayShard contains the packets of a subset of the gateways that are accepted within the replay window.
+// The packets are bucketed by the time at which they are sent, so that expired packets are removed per bucket.
+type replayShard struct {
+	mu      sync.Mutex
+	buckets map[int64]map[replayEntry]struct{}
+}
+
+type preSharedKeyAuthenticator struct {
+	registry PreSharedKeyRegistry
+	keys     gcache.Cache
+	require  bool
+	window   time.Duration
+
+	shards [replayShards]replayShard
+}
+
+// NewPreSharedKeyAuthenticator returns an Authenticator that authenticates PUSH_DATA, PULL_DATA and TX_ACK packets
+// with the pre-shared keys of the gateways. The pre-shared keys are retrieved from the registry and cached for the
+// given time to live.
+// Packets of gateways without a pre-shared key are only accepted if authentication is not required.
+// Packets are rejected if their time differs more than the replay window from the time the packet is received, or if a
+// packet of the gateway with the same time and token has been accepted before.
+func NewPreSharedKeyAuthenticator(
+	registry PreSharedKeyRegistry, keyTTL time.Duration, require bool, window time.Duration,
+) Authenticator {
+	a := &preSharedKeyAuthenticator{
+		registry: registry,
+		keys:     gcache.New(preSharedKeyCacheSize).LRU().Expiration(keyTTL).Build(),
+		require:  require,
+		window:   window,
+	}
+	for i := range a.shards {
+		a.shards[i].buckets = make(map[int64]map[replayEntry]struct{})
+	}
+	return a
+}
+
+func (a *preSharedKeyAuthenticator) preSharedKey(ctx context.Context, eui types.EUI64) ([]byte, error) {
+	if v, err := a.keys.Get(eui); err == nil {
+		return v.([]byte), nil
+	}
+	key, err := a.registry.GetPreSharedKey(ctx, eui)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) > 0 && len(key) < minPreSharedKeyLength {
+		return nil, errPreSharedKey.WithAttributes("eui", eui)
+	}
+	a.keys.Set(eui, key) //nolint:errcheck
+	return key, nil
+}
+
+// Authenticate implements Authenticator.
+func (a *preSharedKeyAuthenticator) Authenticate(ctx context.Context, data []byte, receivedAt time.Time) ([]byte, error) {
+	if len(data) < packetHeaderLength {
+		// The packet is invalid and will not be unmarshaled.
+		return data, nil
+	}
+	switch encoding.PacketType(data[3]) {
+	case encoding.PushData, encoding.PullData, encoding.TxAck:
+	default:
+		return data, nil
+	}
+	var eui types.EUI64
+	copy(eui[:], data[4:packetHeaderLength])
+	key, err := a.preSharedKey(ctx, eui)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) == 0 {
+		if a.require {
+			return nil, errNoPreSharedKey.WithAttributes("eui", eui)
+		}
+		return data, nil
+	}
+	if len(data) < packetHeaderLength+packetTrailerLength {
+		return nil, errUnauthenticatedPacket.WithAttributes("eui", eui)
+	}
+	payload, mac := data[:len(data)-packetMACLength], data[len(data)-packetMACLength:]
+	if !hmac.Equal(mac, packetMAC(key, payload)) {
+		return nil, errPacketMAC.WithAttributes("eui", eui)
+	}
+	sentAt := time.UnixMilli(int64(binary.BigEndian.Uint64(payload[len(payload)-packetTimeLength:])))
+	if d := receivedAt.Sub(sentAt); d > a.window || d < -a.window {
+		return nil, errPacketTime.WithAttributes("eui", eui, "time", sentAt)
+	}
+	entry := replayEntry{eui: eui, time: sentAt.UnixMilli(), token: [2]byte{data[1], data[2]}}
+	if !a.accept(entry, receivedAt) {
+		return nil, errPacketReplay.WithAttributes("eui", eui)
+	}
+	return payload[:len(payload)-packetTimeLength], nil
+}
+
+// accept returns whether the packet is not replayed, and remembers the packet until it is outside the replay window.
+func (a *preSharedKeyAuthenticator) accept(entry replayEntry, receivedAt time.Time) bool {
+	h := fnv.New32a()
+	h.Write(entry.eui[:])
+	shard := &a.shards[h.Sum32()%replayShards]
+
+	// A packet is accepted if it is sent at most one window before it is received, so the packets in the bucket of the
+	// window before that cannot be replayed anymore. An additional bucket is kept as packets are handled concurrently
+	// and may not be handled in the order they are received.
+	windowMs := a.window.Milliseconds()
+	if windowMs <= 0 {
+		windowMs = 1
+	}
+	bucket, expired := entry.time/windowMs, receivedAt.UnixMilli()/windowMs-2
+
+	shard.mu.Lock()
+	defer shard.mu.Unlock()
+	for b := range shard.buckets {
+		if b < expired {
+			delete(shard.buckets, b)
+		}
+	}
+	seen, ok := shard.buckets[bucket]
+	if !ok {
+		seen = make(map[replayEntry]struct{})
+		shard.buckets[bucket] = seen
+	}
+	if _, ok := seen[entry]; ok {
+		return false
+	}
+	seen[entry] = struct{}{}
+	return true
+}
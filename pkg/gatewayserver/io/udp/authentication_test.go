@@ -0,0 +1,298 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package udp_test
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/mock"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/udp"
+	mockis "go.thethings.network/lorawan-stack/v3/pkg/identityserver/mock"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	encoding "go.thethings.network/lorawan-stack/v3/pkg/ttnpb/udp"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockPreSharedKeyRegistry struct {
+	keys  map[types.EUI64][]byte
+	err   error
+	calls int32
+}
+
+// GetPreSharedKey implements PreSharedKeyRegistry.
+func (r *mockPreSharedKeyRegistry) GetPreSharedKey(_ context.Context, eui types.EUI64) ([]byte, error) {
+	atomic.AddInt32(&r.calls, 1)
+	if r.err != nil {
+		return nil, r.err
+	}
+	return r.keys[eui], nil
+}
+
+func TestPreSharedKeyAuthenticator(t *testing.T) {
+	var (
+		keyedEUI   = types.EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+		unkeyedEUI = types.EUI64{0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02}
+		key        = bytes.Repeat([]byte{0x42}, 32)
+		window     = 10 * time.Second
+		now        = time.Unix(1700000000, 0)
+	)
+	marshal := func(packet encoding.Packet) []byte {
+		buf, err := packet.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return buf
+	}
+	pull := marshal(generatePullData(keyedEUI))
+	push := marshal(generatePushData(keyedEUI, true))
+	unkeyedPull := marshal(generatePullData(unkeyedEUI))
+	txAck := marshal(generateTxAck(keyedEUI, encoding.TxErrNone))
+	unkeyedTxAck := marshal(generateTxAck(unkeyedEUI, encoding.TxErrNone))
+
+	for _, tc := range []struct {
+		Name           string
+		Require        bool
+		Key            []byte
+		RegistryError  error
+		Packets        [][]byte
+		ReceivedAt     []time.Duration
+		Expected       []byte
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:     "AuthenticatedPull",
+			Packets:  [][]byte{AuthenticatePacket(pull, key, now)},
+			Expected: pull,
+		},
+		{
+			Name:     "AuthenticatedPush",
+			Packets:  [][]byte{AuthenticatePacket(push, key, now.Add(-window/2))},
+			Expected: push,
+		},
+		{
+			Name:           "UnauthenticatedPull",
+			Packets:        [][]byte{pull},
+			ErrorAssertion: errors.IsUnauthenticated,
+		},
+		{
+			Name:           "UnauthenticatedPush",
+			Packets:        [][]byte{push},
+			ErrorAssertion: errors.IsUnauthenticated,
+		},
+		{
+			Name:           "OtherKey",
+			Packets:        [][]byte{AuthenticatePacket(pull, bytes.Repeat([]byte{0x43}, 32), now)},
+			ErrorAssertion: errors.IsUnauthenticated,
+		},
+		{
+			Name:           "Tampered",
+			Packets:        [][]byte{append([]byte{byte(encoding.Version2)}, AuthenticatePacket(pull, key, now)[1:]...)},
+			ErrorAssertion: errors.IsUnauthenticated,
+		},
+		{
+			Name:           "Expired",
+			Packets:        [][]byte{AuthenticatePacket(pull, key, now.Add(-2*window))},
+			ErrorAssertion: errors.IsUnauthenticated,
+		},
+		{
+			Name:           "FromFuture",
+			Packets:        [][]byte{AuthenticatePacket(pull, key, now.Add(2*window))},
+			ErrorAssertion: errors.IsUnauthenticated,
+		},
+		{
+			Name: "Replay",
+			Packets: [][]byte{
+				AuthenticatePacket(pull, key, now.Add(-time.Second)),
+				AuthenticatePacket(pull, key, now.Add(-time.Second)),
+			},
+			ErrorAssertion: errors.IsUnauthenticated,
+		},
+		{
+			Name: "NoReplay",
+			Packets: [][]byte{
+				AuthenticatePacket(pull, key, now.Add(-time.Second)),
+				AuthenticatePacket(pull, key, now),
+			},
+			Expected: pull,
+		},
+		{
+			Name:     "Unkeyed",
+			Packets:  [][]byte{unkeyedPull},
+			Expected: unkeyedPull,
+		},
+		{
+			Name:           "UnkeyedRequired",
+			Require:        true,
+			Packets:        [][]byte{unkeyedPull},
+			ErrorAssertion: errors.IsUnauthenticated,
+		},
+		{
+			Name:     "AuthenticatedTxAck",
+			Packets:  [][]byte{AuthenticatePacket(txAck, key, now)},
+			Expected: txAck,
+		},
+		{
+			Name:           "UnauthenticatedTxAck",
+			Packets:        [][]byte{txAck},
+			ErrorAssertion: errors.IsUnauthenticated,
+		},
+		{
+			Name:     "UnkeyedTxAck",
+			Packets:  [][]byte{unkeyedTxAck},
+			Expected: unkeyedTxAck,
+		},
+		{
+			Name: "ReplayLater",
+			Packets: [][]byte{
+				AuthenticatePacket(pull, key, now.Add(-time.Second)),
+				AuthenticatePacket(pull, key, now.Add(-time.Second)),
+			},
+			ReceivedAt:     []time.Duration{0, window - 2*time.Second},
+			ErrorAssertion: errors.IsUnauthenticated,
+		},
+		{
+			Name:           "ShortKey",
+			Key:            bytes.Repeat([]byte{0x42}, 8),
+			Packets:        [][]byte{AuthenticatePacket(pull, bytes.Repeat([]byte{0x42}, 8), now)},
+			ErrorAssertion: errors.IsUnauthenticated,
+		},
+		{
+			Name:           "RegistryError",
+			RegistryError:  errors.DefineUnavailable("test_registry", "test registry").New(),
+			Packets:        [][]byte{AuthenticatePacket(pull, key, now)},
+			ErrorAssertion: errors.IsUnavailable,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			registry := &mockPreSharedKeyRegistry{
+				keys: map[types.EUI64][]byte{keyedEUI: key},
+				err:  tc.RegistryError,
+			}
+			if tc.Key != nil {
+				registry.keys[keyedEUI] = tc.Key
+			}
+			authenticator := NewPreSharedKeyAuthenticator(registry, time.Minute, tc.Require, window)
+			var (
+				res []byte
+				err error
+			)
+			for i, packet := range tc.Packets {
+				receivedAt := now
+				if i < len(tc.ReceivedAt) {
+					receivedAt = now.Add(tc.ReceivedAt[i])
+				}
+				if res, err = authenticator.Authenticate(test.Context(), packet, receivedAt); err != nil {
+					break
+				}
+			}
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			a.So(err, should.BeNil)
+			a.So(res, should.Resemble, tc.Expected)
+			// The pre-shared key of the gateway is retrieved once.
+			a.So(atomic.LoadInt32(&registry.calls), should.Equal, 1)
+		})
+	}
+}
+
+func TestAuthenticatedTraffic(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	is, _, closeIS := mockis.New(ctx)
+	defer closeIS()
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			FrequencyPlans: config.FrequencyPlansConfig{
+				ConfigSource: "static",
+				Static:       test.StaticFrequencyPlans,
+			},
+		},
+	})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+
+	gs := mock.NewServer(c, is)
+	addr, _ := net.ResolveUDPAddr("udp", ":0")
+	lis, err := net.ListenUDP("udp", addr)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	eui := types.EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	key := bytes.Repeat([]byte{0x42}, 16)
+	conf := testConfig
+	conf.Authentication = AuthenticationConfig{
+		Enable:       true,
+		KeyTTL:       time.Minute,
+		ReplayWindow: time.Minute,
+		KeyRegistry: &mockPreSharedKeyRegistry{
+			keys: map[types.EUI64][]byte{eui: key},
+		},
+	}
+	go Serve(ctx, gs, lis, conf)
+
+	conn, err := net.Dial("udp", lis.LocalAddr().String())
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	send := func(buf []byte) {
+		t.Helper()
+		if _, err := conn.Write(buf); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	packet := generatePullData(eui)
+	packet.Token = [2]byte{0x00, 0x01}
+	buf, err := packet.MarshalBinary()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// Unauthenticated packets are not acknowledged.
+	send(buf)
+	expectAck(t, conn, false, encoding.PullAck, packet.Token)
+
+	// Authenticated packets are acknowledged.
+	authenticated := AuthenticatePacket(buf, key, time.Now())
+	send(authenticated)
+	expectAck(t, conn, true, encoding.PullAck, packet.Token)
+
+	// Replayed packets are not acknowledged.
+	send(authenticated)
+	expectAck(t, conn, false, encoding.PullAck, packet.Token)
+}
@@ -24,6 +24,18 @@ type RateLimitingConfig struct {
 	Threshold time.Duration `name:"threshold" description:"Filter packet if timestamp is not newer than the older timestamps of the previous messages by this threshold"`
 }
 
+// AuthenticationConfig contains configuration settings for the authentication of
+// packets of gateways with pre-shared keys.
+type AuthenticationConfig struct {
+	Enable       bool          `name:"enable" description:"Authenticate packets of gateways that have a pre-shared key"`
+	Require      bool          `name:"require" description:"Require all gateways to authenticate packets with a pre-shared key"`
+	KeyTTL       time.Duration `name:"key-ttl" description:"Time for which the pre-shared key of a gateway is cached"`
+	ReplayWindow time.Duration `name:"replay-window" description:"Maximum time difference between sending and receiving authenticated packets"`
+
+	// KeyRegistry provides the pre-shared keys of gateways.
+	KeyRegistry PreSharedKeyRegistry `name:"-"`
+}
+
 // Config contains configuration settings for the UDP gateway frontend.
 // Use DefaultConfig for recommended settings.
 type Config struct {
@@ -49,6 +61,8 @@ type Config struct {
 	AddrChangeBlock time.Duration `name:"addr-change-block" description:"Time to block traffic when a gateway's address changes"`
 	// RateLimitingConfig is the configuration for the rate limiting firewall capabilities.
 	RateLimiting RateLimitingConfig `name:"rate-limiting"`
+	// Authentication is the configuration for the authentication of packets with pre-shared keys.
+	Authentication AuthenticationConfig `name:"authentication"`
 }
 
 // DefaultConfig contains the default configuration.
@@ -67,4 +81,8 @@ var DefaultConfig = Config{
 		Messages:  10,
 		Threshold: 10 * time.Millisecond,
 	},
+	Authentication: AuthenticationConfig{
+		KeyTTL:       time.Minute,
+		ReplayWindow: 30 * time.Second,
+	},
 }
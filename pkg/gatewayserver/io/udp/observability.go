@@ -50,6 +50,14 @@ var udpMetrics = &messageMetrics{
 		},
 		[]string{"error"},
 	),
+	authenticationRejected: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "authentication_rejected_total",
+			Help:      "Total number of UDP messages that failed authentication",
+		},
+		[]string{"error"},
+	),
 
 	unmarshalTypeErrors: prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -70,6 +78,8 @@ type messageMetrics struct {
 	messageForwarded *prometheus.CounterVec
 	messageDropped   *prometheus.CounterVec
 
+	authenticationRejected *prometheus.CounterVec
+
 	unmarshalTypeErrors *prometheus.CounterVec
 }
 
@@ -78,6 +88,7 @@ func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.messageReceived.Describe(ch)
 	m.messageForwarded.Describe(ch)
 	m.messageDropped.Describe(ch)
+	m.authenticationRejected.Describe(ch)
 
 	m.unmarshalTypeErrors.Describe(ch)
 }
@@ -87,6 +98,7 @@ func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.messageReceived.Collect(ch)
 	m.messageForwarded.Collect(ch)
 	m.messageDropped.Collect(ch)
+	m.authenticationRejected.Collect(ch)
 
 	m.unmarshalTypeErrors.Collect(ch)
 }
@@ -111,3 +123,11 @@ func registerMessageDropped(_ context.Context, err error) {
 	}
 	udpMetrics.messageDropped.WithLabelValues(errorLabel).Inc()
 }
+
+func registerAuthenticationRejected(_ context.Context, err error) {
+	errorLabel := "unknown"
+	if ttnErr, ok := errors.From(err); ok {
+		errorLabel = ttnErr.FullName()
+	}
+	udpMetrics.authenticationRejected.WithLabelValues(errorLabel).Inc()
+}
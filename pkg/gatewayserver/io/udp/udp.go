@@ -42,10 +42,11 @@ type srv struct {
 	ctx    context.Context
 	config Config
 
-	server      io.Server
-	conn        *net.UDPConn
-	connections sync.Map
-	firewall    Firewall
+	server        io.Server
+	conn          *net.UDPConn
+	connections   sync.Map
+	firewall      Firewall
+	authenticator Authenticator
 
 	limitLogs ratelimit.Interface
 }
@@ -59,6 +60,10 @@ var (
 	limitLogsSize   uint = 1 << 13
 )
 
+var errNoPreSharedKeyRegistry = errors.DefineFailedPrecondition(
+	"no_pre_shared_key_registry", "no pre-shared key registry for authentication",
+)
+
 // Serve serves the UDP frontend.
 func Serve(ctx context.Context, server io.Server, conn *net.UDPConn, conf Config) error {
 	ctx = log.NewContextWithField(ctx, "namespace", "gatewayserver/io/udp")
@@ -71,24 +76,32 @@ func Serve(ctx context.Context, server io.Server, conn *net.UDPConn, conf Config
 	if conf.RateLimiting.Enable {
 		firewall = NewRateLimitingFirewall(firewall, conf.RateLimiting.Messages, conf.RateLimiting.Threshold)
 	}
+	var authenticator Authenticator = noopAuthenticator{}
+	if auth := conf.Authentication; auth.Enable || auth.Require {
+		if auth.KeyRegistry == nil {
+			return errNoPreSharedKeyRegistry.New()
+		}
+		authenticator = NewPreSharedKeyAuthenticator(auth.KeyRegistry, auth.KeyTTL, auth.Require, auth.ReplayWindow)
+	}
 	limitLogs, err := ratelimit.NewProfile(ctx, limitLogsConfig, limitLogsSize)
 	if err != nil {
 		return err
 	}
 	s := &srv{
-		ctx:      ctx,
-		config:   conf,
-		server:   server,
-		conn:     conn,
-		firewall: firewall,
+		ctx:           ctx,
+		config:        conf,
+		server:        server,
+		conn:          conn,
+		firewall:      firewall,
+		authenticator: authenticator,
 
 		limitLogs: limitLogs,
 	}
-	wp := workerpool.NewWorkerPool(workerpool.Config[encoding.Packet]{
+	wp := workerpool.NewWorkerPool(workerpool.Config[rawPacket]{
 		Component:  server,
 		Context:    ctx,
 		Name:       "udp",
-		Handler:    s.handlePacket,
+		Handler:    s.handleRawPacket,
 		MaxWorkers: conf.PacketHandlers,
 		QueueSize:  conf.PacketBuffer,
 	})
@@ -102,7 +115,14 @@ func Serve(ctx context.Context, server io.Server, conn *net.UDPConn, conf Config
 
 var errPacketType = errors.DefineInvalidArgument("packet_type", "invalid packet type")
 
-func (s *srv) read(wp workerpool.WorkerPool[encoding.Packet]) error {
+// rawPacket is a packet received from a gateway that is not authenticated and unmarshaled yet.
+type rawPacket struct {
+	data       []byte
+	addr       *net.UDPAddr
+	receivedAt time.Time
+}
+
+func (s *srv) read(wp workerpool.WorkerPool[rawPacket]) error {
 	var buf [65507]byte
 	for {
 		n, addr, err := s.conn.ReadFromUDP(buf[:])
@@ -125,37 +145,57 @@ func (s *srv) read(wp workerpool.WorkerPool[encoding.Packet]) error {
 			continue
 		}
 
-		packetBuf := slices.Clone(buf[:n])
-
-		packet := encoding.Packet{
-			GatewayAddr: addr,
-			ReceivedAt:  now,
-		}
-		if err := packet.UnmarshalBinary(packetBuf); err != nil {
-			logger.WithError(err).Debug("Failed to unmarshal packet")
+		// Authentication may require the pre-shared key of the gateway to be retrieved, so the packet is authenticated
+		// and unmarshaled by the packet handlers instead of blocking the read loop.
+		if err := wp.Publish(ctx, rawPacket{
+			data:       slices.Clone(buf[:n]),
+			addr:       addr,
+			receivedAt: now,
+		}); err != nil {
+			logger.WithError(err).Warn("UDP packet publishing failed")
 			registerMessageDropped(ctx, err)
 			continue
 		}
-		switch packet.PacketType {
-		case encoding.PullData, encoding.PushData, encoding.TxAck:
-		default:
-			logger.WithField("packet_type", packet.PacketType).Debug("Invalid packet type for uplink")
-			registerMessageDropped(ctx, errPacketType)
-			continue
-		}
-		if packet.GatewayEUI == nil {
-			logger.Debug("No gateway EUI in uplink message")
-			registerMessageDropped(ctx, errNoEUI)
-			continue
-		}
+	}
+}
 
-		if err := wp.Publish(ctx, packet); err != nil {
-			logger.WithError(err).Warn("UDP packet publishing failed")
-			registerMessageDropped(ctx, err)
-			continue
+func (s *srv) handleRawPacket(ctx context.Context, raw rawPacket) {
+	logger := log.FromContext(ctx)
+
+	packetBuf, err := s.authenticator.Authenticate(ctx, raw.data, raw.receivedAt)
+	if err != nil {
+		if ratelimit.Require(s.limitLogs, ratelimit.NewCustomResource(raw.addr.IP.String())) == nil {
+			logger.WithError(err).Warn("Failed to authenticate packet")
 		}
-		registerMessageForwarded(ctx, packet.PacketType)
+		registerAuthenticationRejected(ctx, err)
+		registerMessageDropped(ctx, err)
+		return
+	}
+
+	packet := encoding.Packet{
+		GatewayAddr: raw.addr,
+		ReceivedAt:  raw.receivedAt,
 	}
+	if err := packet.UnmarshalBinary(packetBuf); err != nil {
+		logger.WithError(err).Debug("Failed to unmarshal packet")
+		registerMessageDropped(ctx, err)
+		return
+	}
+	switch packet.PacketType {
+	case encoding.PullData, encoding.PushData, encoding.TxAck:
+	default:
+		logger.WithField("packet_type", packet.PacketType).Debug("Invalid packet type for uplink")
+		registerMessageDropped(ctx, errPacketType)
+		return
+	}
+	if packet.GatewayEUI == nil {
+		logger.Debug("No gateway EUI in uplink message")
+		registerMessageDropped(ctx, errNoEUI)
+		return
+	}
+	registerMessageForwarded(ctx, packet.PacketType)
+
+	s.handlePacket(ctx, packet)
 }
 
 func (s *srv) handlePacket(ctx context.Context, packet encoding.Packet) {
@@ -50,3 +50,16 @@ type Formatter interface {
 	// TransferTime generates a spurious time transfer message for a particular server time.
 	TransferTime(ctx context.Context, serverTime time.Time, gpsTime *time.Time, concentratorTime *scheduling.ConcentratorTime) ([]byte, error)
 }
+
+// RemoteShellFormatter is a Formatter that supports remote shell sessions and remote commands.
+type RemoteShellFormatter interface {
+	Formatter
+	// FromRemoteCommand generates a message that runs the command on the gateway.
+	FromRemoteCommand(ctx context.Context, cmd *io.RemoteCommand) ([]byte, error)
+	// FromRemoteShellControl generates a message that starts or stops a remote shell session on the gateway.
+	FromRemoteShellControl(ctx context.Context, ctrl *io.RemoteShellControl) ([]byte, error)
+	// FromRemoteShellInput generates a binary message that contains input of a remote shell session.
+	FromRemoteShellInput(ctx context.Context, input *io.RemoteShellInput) ([]byte, error)
+	// HandleRemoteShellOutput handles binary messages that contain output of remote shell sessions.
+	HandleRemoteShellOutput(ctx context.Context, raw []byte, conn *io.Connection) error
+}
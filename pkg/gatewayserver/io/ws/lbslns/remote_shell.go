@@ -0,0 +1,117 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lbslns
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+)
+
+var errRemoteShellData = errors.DefineInvalidArgument("remote_shell_data", "invalid remote shell data")
+
+// RemoteCommand is the command that the LoRa Basics Station runs.
+type RemoteCommand struct {
+	Command   string   `json:"command"`
+	Arguments []string `json:"arguments,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (cmd RemoteCommand) MarshalJSON() ([]byte, error) {
+	type Alias RemoteCommand
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeDownstreamRemoteCommand,
+		Alias: Alias(cmd),
+	})
+}
+
+// RemoteShell starts or stops a remote shell session on the LoRa Basics Station.
+// If neither Start nor Stop is set, the LoRa Basics Station reports the status of the sessions.
+type RemoteShell struct {
+	User  string `json:"user,omitempty"`
+	Term  string `json:"term,omitempty"`
+	Start *int   `json:"start,omitempty"`
+	Stop  *int   `json:"stop,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (rmtsh RemoteShell) MarshalJSON() ([]byte, error) {
+	type Alias RemoteShell
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeDownstreamRemoteShell,
+		Alias: Alias(rmtsh),
+	})
+}
+
+// RemoteShellSession is the status of a remote shell session on the LoRa Basics Station.
+type RemoteShellSession struct {
+	User    string `json:"user"`
+	Started bool   `json:"started"`
+	Age     int    `json:"age"`
+	PID     int    `json:"pid"`
+}
+
+// RemoteShellStatus is the status of the remote shell sessions on the LoRa Basics Station.
+// The index of the session corresponds to the session index.
+type RemoteShellStatus struct {
+	Sessions []RemoteShellSession `json:"rmtsh"`
+}
+
+// FromRemoteCommand implements ws.RemoteShellFormatter.
+func (*lbsLNS) FromRemoteCommand(_ context.Context, cmd *io.RemoteCommand) ([]byte, error) {
+	return RemoteCommand{
+		Command:   cmd.Command,
+		Arguments: cmd.Arguments,
+	}.MarshalJSON()
+}
+
+// FromRemoteShellControl implements ws.RemoteShellFormatter.
+func (*lbsLNS) FromRemoteShellControl(_ context.Context, ctrl *io.RemoteShellControl) ([]byte, error) {
+	index := ctrl.Index
+	if ctrl.Stop {
+		return RemoteShell{Stop: &index}.MarshalJSON()
+	}
+	return RemoteShell{
+		User:  ctrl.User,
+		Term:  ctrl.Term,
+		Start: &index,
+	}.MarshalJSON()
+}
+
+// FromRemoteShellInput implements ws.RemoteShellFormatter.
+// The first byte of the binary message is the session index, followed by the input.
+func (*lbsLNS) FromRemoteShellInput(_ context.Context, input *io.RemoteShellInput) ([]byte, error) {
+	if input.Index < 0 || input.Index > 0xff {
+		return nil, errRemoteShellData.New()
+	}
+	return append([]byte{byte(input.Index)}, input.Data...), nil
+}
+
+// HandleRemoteShellOutput implements ws.RemoteShellFormatter.
+// The first byte of the binary message is the session index, followed by the output.
+func (*lbsLNS) HandleRemoteShellOutput(_ context.Context, raw []byte, conn *io.Connection) error {
+	if len(raw) < 1 {
+		return errRemoteShellData.New()
+	}
+	return conn.HandleRemoteShellOutput(int(raw[0]), raw[1:])
+}
@@ -0,0 +1,89 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lbslns
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRemoteShellMessages(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	var lbsLNS lbsLNS
+
+	for _, tc := range []struct {
+		Name     string
+		Marshal  func() ([]byte, error)
+		Expected string
+	}{
+		{
+			Name: "Command",
+			Marshal: func() ([]byte, error) {
+				return lbsLNS.FromRemoteCommand(ctx, &io.RemoteCommand{
+					Command:   "/sbin/reboot",
+					Arguments: []string{"-f"},
+				})
+			},
+			Expected: `{"msgtype":"runcmd","command":"/sbin/reboot","arguments":["-f"]}`,
+		},
+		{
+			Name: "Start",
+			Marshal: func() ([]byte, error) {
+				return lbsLNS.FromRemoteShellControl(ctx, &io.RemoteShellControl{
+					Index: 1,
+					User:  "admin",
+					Term:  "xterm",
+				})
+			},
+			Expected: `{"msgtype":"rmtsh","user":"admin","term":"xterm","start":1}`,
+		},
+		{
+			Name: "Stop",
+			Marshal: func() ([]byte, error) {
+				return lbsLNS.FromRemoteShellControl(ctx, &io.RemoteShellControl{Index: 1, Stop: true})
+			},
+			Expected: `{"msgtype":"rmtsh","stop":1}`,
+		},
+	} {
+		data, err := tc.Marshal()
+		if a.So(err, should.BeNil) {
+			a.So(string(data), should.Equal, tc.Expected)
+		}
+	}
+
+	data, err := lbsLNS.FromRemoteShellInput(ctx, &io.RemoteShellInput{Index: 2, Data: []byte("ls\n")})
+	a.So(err, should.BeNil)
+	a.So(data, should.Resemble, []byte{0x02, 'l', 's', '\n'})
+	_, err = lbsLNS.FromRemoteShellInput(ctx, &io.RemoteShellInput{Index: 256})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	var status RemoteShellStatus
+	err = json.Unmarshal([]byte(`{"msgtype":"rmtsh","rmtsh":[`+
+		`{"user":"admin","started":true,"age":10,"pid":1234},`+
+		`{"user":"","started":false,"age":0,"pid":0}]}`), &status)
+	a.So(err, should.BeNil)
+	a.So(status, should.Resemble, RemoteShellStatus{
+		Sessions: []RemoteShellSession{
+			{User: "admin", Started: true, Age: 10, PID: 1234},
+			{},
+		},
+	})
+}
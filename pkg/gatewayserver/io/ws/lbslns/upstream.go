@@ -37,6 +37,7 @@ import (
 var (
 	errJoinRequestMessage = errors.Define("join_request_message", "invalid join-request message received")
 	errUplinkDataFrame    = errors.Define("uplink_data_frame", "invalid uplink data frame received")
+	errProprietaryFrame   = errors.Define("proprietary_data_frame", "invalid proprietary data frame received")
 	errUplinkMessage      = errors.Define("uplink_message", "invalid uplink message received")
 	errMDHR               = errors.Define("mhdr", "invalid MHDR `{mhdr}` received")
 	errDataRate           = errors.Define("data_rate", "invalid data rate")
@@ -108,6 +109,26 @@ func (updf UplinkDataFrame) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// ProprietaryDataFrame is a frame with the proprietary MType from LoRa Basics Station protocol.
+// The FRMPayload contains the whole frame, including the MHDR.
+type ProprietaryDataFrame struct {
+	FRMPayload string  `json:"FRMPayload"`
+	RefTime    float64 `json:"RefTime"`
+	RadioMetaData
+}
+
+// MarshalJSON implements json.Marshaler.
+func (propdf ProprietaryDataFrame) MarshalJSON() ([]byte, error) {
+	type Alias ProprietaryDataFrame
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeUpstreamProprietaryDataFrame,
+		Alias: Alias(propdf),
+	})
+}
+
 // TxConfirmation is the LoRaWAN Join Request message from the BasicStation.
 type TxConfirmation struct {
 	Diid    int64   `json:"diid"`
@@ -400,6 +421,54 @@ func (updf *UplinkDataFrame) toUplinkMessage(ids *ttnpb.GatewayIdentifiers, band
 	return &up, nil
 }
 
+// toUplinkMessage converts the LoRa Basics Station Proprietary Data Frame "propdf" message into an UplinkMessage.
+func (propdf *ProprietaryDataFrame) toUplinkMessage(
+	ids *ttnpb.GatewayIdentifiers, bandID string, receivedAt time.Time,
+) (*ttnpb.UplinkMessage, error) {
+	rawPayload, err := hex.DecodeString(propdf.FRMPayload)
+	if err != nil {
+		return nil, errProprietaryFrame.WithCause(err)
+	}
+	if !io.IsProprietary(rawPayload) {
+		return nil, errProprietaryFrame.New()
+	}
+
+	phy, err := band.GetLatest(bandID)
+	if err != nil {
+		return nil, err
+	}
+	bandDR, ok := phy.DataRates[ttnpb.DataRateIndex(propdf.DataRate)]
+	if !ok {
+		return nil, errDataRate.New()
+	}
+
+	timestamp := ws.TimestampFromXTime(propdf.UpInfo.XTime)
+	gpsTime := ws.TimePtrFromGPSTime(propdf.UpInfo.GPSTime)
+	tm := ws.TimePtrFromUpInfo(propdf.UpInfo.GPSTime, propdf.UpInfo.RxTime)
+	return &ttnpb.UplinkMessage{
+		RawPayload: rawPayload,
+		ReceivedAt: timestamppb.New(receivedAt),
+		RxMetadata: []*ttnpb.RxMetadata{
+			{
+				GatewayIds:   ids,
+				Time:         ttnpb.ProtoTime(tm),
+				GpsTime:      ttnpb.ProtoTime(gpsTime),
+				Timestamp:    timestamp,
+				Rssi:         propdf.UpInfo.RSSI,
+				ChannelRssi:  propdf.UpInfo.RSSI,
+				Snr:          propdf.UpInfo.SNR,
+				AntennaIndex: uint32(propdf.UpInfo.RCtx),
+			},
+		},
+		Settings: &ttnpb.TxSettings{
+			Frequency: propdf.Frequency,
+			DataRate:  bandDR.Rate,
+			Timestamp: timestamp,
+			Time:      ttnpb.ProtoTime(tm),
+		},
+	}, nil
+}
+
 func getFCtrlAsUint(fCtrl *ttnpb.FCtrl) uint {
 	var ret uint
 	if fCtrl.GetAdr() {
@@ -629,8 +698,34 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids *ttnpb.GatewayIde
 		}
 		return req.Response(receivedAt).MarshalJSON()
 
-	case TypeUpstreamProprietaryDataFrame, TypeUpstreamRemoteShell:
-		logger.WithField("message_type", typ).Debug("Message type not implemented")
+	case TypeUpstreamRemoteShell:
+		var status RemoteShellStatus
+		if err := json.Unmarshal(raw, &status); err != nil {
+			return nil, err
+		}
+		for i, session := range status.Sessions {
+			conn.HandleRemoteShellStatus(i, session.Started)
+		}
+
+	case TypeUpstreamProprietaryDataFrame:
+		var propdf ProprietaryDataFrame
+		if err := json.Unmarshal(raw, &propdf); err != nil {
+			return nil, err
+		}
+		if propdf.UpInfo.XTime == 0 {
+			logger.Warn("Received proprietary data frame without xtime, drop message")
+			return nil, nil
+		}
+		up, err := propdf.toUplinkMessage(ids, conn.BandID(), receivedAt)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to parse proprietary data frame")
+			return nil, err
+		}
+		ws.UpdateSessionID(ctx, ws.SessionIDFromXTime(propdf.UpInfo.XTime))
+		ct := recordTime(propdf.RefTime, propdf.UpInfo.XTime, propdf.UpInfo.GPSTime)
+		if err := conn.HandleUp(up, ct); err != nil {
+			logger.WithError(err).Warn("Failed to handle upstream message")
+		}
 
 	default:
 		logger.WithField("message_type", typ).Debug("Unknown message type")
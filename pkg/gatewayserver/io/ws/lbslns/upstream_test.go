@@ -444,6 +444,100 @@ func TestUplinkDataFrame(t *testing.T) {
 	}
 }
 
+func TestProprietaryDataFrame(t *testing.T) {
+	t.Parallel()
+	gtwID := &ttnpb.GatewayIdentifiers{
+		GatewayId: "eui-1122334455667788",
+		Eui:       types.EUI64{0x11, 0x22, 0x33, 0x44, 0x55, 0x66, 0x77, 0x88}.Bytes(),
+	}
+	radioMetaData := RadioMetaData{
+		DataRate:  1,
+		Frequency: 868300000,
+		UpInfo: UpInfo{
+			RxTime: 1548059982,
+			XTime:  12666373963464220,
+			RSSI:   89,
+			SNR:    9.25,
+		},
+	}
+
+	for _, tc := range []struct {
+		Name                  string
+		ProprietaryDataFrame  ProprietaryDataFrame
+		ExpectedUplinkMessage *ttnpb.UplinkMessage
+		ErrorAssertion        func(err error) bool
+	}{
+		{
+			Name: "InvalidHex",
+			ProprietaryDataFrame: ProprietaryDataFrame{
+				FRMPayload:    "e0zz",
+				RadioMetaData: radioMetaData,
+			},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errProprietaryFrame)
+			},
+		},
+		{
+			Name: "NotProprietary",
+			ProprietaryDataFrame: ProprietaryDataFrame{
+				FRMPayload:    "40112233",
+				RadioMetaData: radioMetaData,
+			},
+			ErrorAssertion: func(err error) bool {
+				return errors.Resemble(err, errProprietaryFrame)
+			},
+		},
+		{
+			Name: "ValidFrame",
+			ProprietaryDataFrame: ProprietaryDataFrame{
+				FRMPayload:    "e0010203",
+				RadioMetaData: radioMetaData,
+			},
+			ExpectedUplinkMessage: &ttnpb.UplinkMessage{
+				RawPayload: []byte{0xe0, 0x01, 0x02, 0x03},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIds:  gtwID,
+						Time:        timestamppb.New(time.Unix(1548059982, 0)),
+						Timestamp:   (uint32)(12666373963464220 & 0xFFFFFFFF),
+						Rssi:        89,
+						ChannelRssi: 89,
+						Snr:         9.25,
+					},
+				},
+				Settings: &ttnpb.TxSettings{
+					Frequency: 868300000,
+					Timestamp: (uint32)(12666373963464220 & 0xFFFFFFFF),
+					Time:      timestamppb.New(time.Unix(1548059982, 0)),
+					DataRate: &ttnpb.DataRate{Modulation: &ttnpb.DataRate_Lora{Lora: &ttnpb.LoRaDataRate{
+						SpreadingFactor: 11,
+						Bandwidth:       125000,
+						CodingRate:      band.Cr4_5,
+					}}},
+				},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			msg, err := tc.ProprietaryDataFrame.toUplinkMessage(gtwID, band.EU_863_870, time.Time{})
+			if err != nil {
+				if tc.ErrorAssertion == nil || !a.So(tc.ErrorAssertion(err), should.BeTrue) {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			} else if tc.ErrorAssertion != nil {
+				t.Fatalf("Expected error")
+			} else {
+				expected := ttnpb.Clone(tc.ExpectedUplinkMessage)
+				expected.ReceivedAt = msg.ReceivedAt
+				a.So(msg, should.Resemble, expected)
+			}
+		})
+	}
+}
+
 func TestFromUplinkDataFrame(t *testing.T) {
 	t.Parallel()
 	gtwID := ttnpb.GatewayIdentifiers{
@@ -65,6 +65,11 @@ func (*srv) DutyCycleStyle() scheduling.DutyCycleStyle {
 	return scheduling.DutyCycleStyleBlockingWindow
 }
 
+func (s *srv) SupportsRemoteShell() bool {
+	_, ok := s.formatter.(RemoteShellFormatter)
+	return ok
+}
+
 // New creates a new WebSocket frontend.
 func New(ctx context.Context, server io.Server, formatter Formatter, cfg Config) (*web.Server, error) {
 	ctx = log.NewContextWithField(ctx, "namespace", "gatewayserver/io/ws")
@@ -241,12 +246,19 @@ func (s *srv) handleTraffic(w http.ResponseWriter, r *http.Request) (err error)
 		ctx = frequencyplans.WithFallbackID(ctx, fallback)
 	}
 
+	cert, hasCert := io.ClientCertificateFromTLS(r.TLS)
+	if hasCert {
+		ctx = io.NewContextWithClientCertificate(ctx, cert)
+	}
+
 	if !hasAuth {
-		if !s.cfg.AllowUnauthenticated {
+		if !hasCert && !s.cfg.AllowUnauthenticated {
 			// We error here directly as there is no auth.
 			return errNoAuthProvided.WithAttributes("uid", uid)
 		}
-		// If the server allows unauthenticated connections (for local testing), we provide the link rights ourselves.
+		// If the gateway presents a client certificate, or if the server allows unauthenticated connections (for local
+		// testing), we provide the link rights ourselves. The Gateway Server authenticates the client certificate on
+		// connect.
 		ctx = rights.NewContext(ctx, &rights.Rights{
 			GatewayRights: *rights.NewMap(map[string]*ttnpb.Rights{
 				uid: {
@@ -309,6 +321,18 @@ func (s *srv) handleTraffic(w http.ResponseWriter, r *http.Request) (err error)
 		defer ticker.Stop()
 	}
 
+	var (
+		remoteCommandCh      <-chan *io.RemoteCommand
+		remoteShellControlCh <-chan *io.RemoteShellControl
+		remoteShellInputCh   <-chan *io.RemoteShellInput
+	)
+	remoteShellFormatter, supportsRemoteShell := s.formatter.(RemoteShellFormatter)
+	if supportsRemoteShell {
+		remoteCommandCh = conn.RemoteCommands()
+		remoteShellControlCh = conn.RemoteShellControl()
+		remoteShellInputCh = conn.RemoteShellInput()
+	}
+
 	go func() (err error) {
 		defer ws.Close()
 		defer func() {
@@ -368,21 +392,68 @@ func (s *srv) handleTraffic(w http.ResponseWriter, r *http.Request) (err error)
 					logger.WithError(err).Warn("Failed to send message downstream")
 					return err
 				}
+			case cmd := <-remoteCommandCh:
+				b, err := remoteShellFormatter.FromRemoteCommand(ctx, cmd)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to marshal remote command")
+					continue
+				}
+				if err := ws.WriteMessage(websocket.TextMessage, b); err != nil {
+					logger.WithError(err).Warn("Failed to send remote command")
+					return err
+				}
+			case ctrl := <-remoteShellControlCh:
+				b, err := remoteShellFormatter.FromRemoteShellControl(ctx, ctrl)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to marshal remote shell control message")
+					continue
+				}
+				if err := ws.WriteMessage(websocket.TextMessage, b); err != nil {
+					logger.WithError(err).Warn("Failed to send remote shell control message")
+					return err
+				}
+			case input := <-remoteShellInputCh:
+				b, err := remoteShellFormatter.FromRemoteShellInput(ctx, input)
+				if err != nil {
+					logger.WithError(err).Warn("Failed to marshal remote shell input")
+					continue
+				}
+				if err := ws.WriteMessage(websocket.BinaryMessage, b); err != nil {
+					logger.WithError(err).Warn("Failed to send remote shell input")
+					return err
+				}
 			}
 		}
 	}()
 
 	resource := ratelimit.GatewayUpResource(ctx, ids)
+	remoteShellResource := ratelimit.GatewayRemoteShellResource(ctx, ids)
 	for {
-		if err := ratelimit.Require(s.server.RateLimiter(), resource); err != nil {
-			logger.WithError(err).Warn("Terminate connection")
-			return err
-		}
-		_, data, err := ws.ReadMessage()
+		typ, data, err := ws.ReadMessage()
 		if err != nil {
 			logger.WithError(err).Debug("Failed to read message")
 			return err
 		}
+		if typ == websocket.BinaryMessage {
+			if !supportsRemoteShell {
+				logger.Debug("Drop unexpected binary message")
+				continue
+			}
+			// The remote shell output is rate limited separately from the other messages of the gateway, so that
+			// remote shell sessions do not terminate the connection.
+			if err := ratelimit.Require(s.server.RateLimiter(), remoteShellResource); err != nil {
+				logger.WithError(err).Debug("Drop remote shell output")
+				continue
+			}
+			if err := remoteShellFormatter.HandleRemoteShellOutput(ctx, data, conn); err != nil {
+				logger.WithError(err).Debug("Failed to handle remote shell output")
+			}
+			continue
+		}
+		if err := ratelimit.Require(s.server.RateLimiter(), resource); err != nil {
+			logger.WithError(err).Warn("Terminate connection")
+			return err
+		}
 		downstream, err := s.formatter.HandleUp(ctx, data, ids, conn, time.Now())
 		if err != nil {
 			return err
@@ -1922,4 +1922,41 @@ func TestRateLimit(t *testing.T) {
 			}
 		})
 	})
+
+	t.Run("BinaryMessages", func(t *testing.T) {
+		maxRate := uint(3)
+		conf := config.RateLimiting{
+			Profiles: []config.RateLimitingProfile{{
+				Name:         "upstream messages",
+				MaxPerMin:    maxRate,
+				MaxBurst:     maxRate,
+				Associations: []string{"gs:up", "gs:remote-shell"},
+			}},
+		}
+		withServer(t, defaultConfig, conf, func(t *testing.T, _ *mockis.MockDefinition, serverAddress string) {
+			a := assertions.New(t)
+			conn, _, err := websocket.DefaultDialer.Dial(serverAddress+testTrafficEndPoint, nil)
+			if !a.So(err, should.BeNil) {
+				t.Fatalf("Connection failed: %v", err)
+			}
+			defer conn.Close()
+
+			// Binary messages are rate limited separately from the other messages of the gateway, and exceeding
+			// the rate limit drops the binary messages instead of terminating the connection.
+			for i := uint(0); i <= maxRate; i++ {
+				if !a.So(conn.WriteMessage(websocket.BinaryMessage, []byte{0x00, 0x01}), should.BeNil) {
+					t.FailNow()
+				}
+			}
+			conn.SetReadDeadline(time.Now().Add(timeout)) //nolint:errcheck
+			for {
+				if _, _, err := conn.ReadMessage(); err != nil {
+					// The connection remains open until the read deadline.
+					netErr, ok := err.(net.Error)
+					a.So(ok && netErr.Timeout(), should.BeTrue)
+					break
+				}
+			}
+		})
+	})
 }
@@ -118,6 +118,27 @@ var (
 		"gs.txack.forward", "forward transmission acknowledgement",
 		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_READ),
 	)
+	evtRunGatewayCommand = events.Define(
+		"gs.gateway.command.run", "run command on gateway",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL),
+		events.WithDataType(&ttnpb.RunGatewayCommandRequest{}),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtStartGatewayRemoteShell = events.Define(
+		"gs.gateway.remote_shell.start", "start remote shell session on gateway",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL),
+		events.WithDataType(&ttnpb.GatewayRemoteShellStart{}),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtEndGatewayRemoteShell = events.Define(
+		"gs.gateway.remote_shell.end", "end remote shell session on gateway",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL),
+		events.WithErrorDataType(),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
 )
 
 const (
@@ -184,6 +205,14 @@ var gsMetrics = &messageMetrics{
 		},
 		[]string{host, "error"},
 	),
+	uplinkRouted: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "uplink_routed_total",
+			Help:      "Total number of uplinks forwarded by routing rules",
+		},
+		[]string{"route", host},
+	),
 	downlinkScheduleAttempted: metrics.NewContextualCounterVec(
 		prometheus.CounterOpts{
 			Subsystem: subsystem,
@@ -262,6 +291,7 @@ type messageMetrics struct {
 	uplinkReceived            *metrics.ContextualCounterVec
 	uplinkForwarded           *metrics.ContextualCounterVec
 	uplinkDropped             *metrics.ContextualCounterVec
+	uplinkRouted              *metrics.ContextualCounterVec
 	downlinkScheduleAttempted *metrics.ContextualCounterVec
 	downlinkScheduleFailed    *metrics.ContextualCounterVec
 	downlinkSent              *metrics.ContextualCounterVec
@@ -280,6 +310,7 @@ func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.uplinkReceived.Describe(ch)
 	m.uplinkForwarded.Describe(ch)
 	m.uplinkDropped.Describe(ch)
+	m.uplinkRouted.Describe(ch)
 	m.downlinkScheduleAttempted.Describe(ch)
 	m.downlinkScheduleFailed.Describe(ch)
 	m.downlinkSent.Describe(ch)
@@ -298,6 +329,7 @@ func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.uplinkReceived.Collect(ch)
 	m.uplinkForwarded.Collect(ch)
 	m.uplinkDropped.Collect(ch)
+	m.uplinkRouted.Collect(ch)
 	m.downlinkScheduleAttempted.Collect(ch)
 	m.downlinkScheduleFailed.Collect(ch)
 	m.downlinkSent.Collect(ch)
@@ -363,6 +395,10 @@ func registerDropUplink(ctx context.Context, gtw *ttnpb.Gateway, msg *ttnpb.Gate
 	gsMetrics.uplinkDropped.WithLabelValues(ctx, host, errorLabel).Inc()
 }
 
+func registerRouteUplink(ctx context.Context, route, host string) {
+	gsMetrics.uplinkRouted.WithLabelValues(ctx, route, host).Inc()
+}
+
 func registerScheduleDownlinkAttempt(ctx context.Context, gtw *ttnpb.Gateway, msg *ttnpb.DownlinkMessage, protocol string) {
 	events.Publish(evtScheduleDownAttempt.NewWithIdentifiersAndData(ctx, gtw, msg))
 	gsMetrics.downlinkScheduleAttempted.WithLabelValues(ctx, protocol).Inc()
@@ -0,0 +1,106 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// GatewayConnectionStatsHistoryRegistry implements the GatewayConnectionStatsHistoryRegistry interface.
+// The points are stored in a sorted set per gateway, scored by the time of the point in milliseconds.
+type GatewayConnectionStatsHistoryRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *GatewayConnectionStatsHistoryRegistry) key(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+func scoreOf(t time.Time) float64 {
+	return float64(t.UnixMilli())
+}
+
+// Add adds the point to the history of the gateway. Points that are older than the retention are removed.
+func (r *GatewayConnectionStatsHistoryRegistry) Add(
+	ctx context.Context,
+	ids *ttnpb.GatewayIdentifiers,
+	point *ttnpb.GatewayConnectionStatsHistoryPoint,
+	retention time.Duration,
+) error {
+	defer trace.StartRegion(ctx, "add gateway connection stats history point").End()
+
+	if err := point.ValidateFields(); err != nil {
+		return err
+	}
+	s, err := ttnredis.MarshalProto(point)
+	if err != nil {
+		return err
+	}
+	uk := r.key(unique.ID(ctx, ids))
+	cutoff := scoreOf(time.Now().Add(-retention))
+	_, err = r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.ZAdd(ctx, uk, redis.Z{
+			Score:  scoreOf(point.Time.AsTime()),
+			Member: s,
+		})
+		p.ZRemRangeByScore(ctx, uk, "-inf", "("+strconv.FormatFloat(cutoff, 'f', -1, 64))
+		p.PExpire(ctx, uk, retention)
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Range returns the points in the history of the gateway between from and to, ordered by time.
+// If limit is positive, at most limit points are returned.
+func (r *GatewayConnectionStatsHistoryRegistry) Range(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, from, to time.Time, limit int,
+) ([]*ttnpb.GatewayConnectionStatsHistoryPoint, error) {
+	defer trace.StartRegion(ctx, "range gateway connection stats history").End()
+
+	uk := r.key(unique.ID(ctx, ids))
+	opt := &redis.ZRangeBy{
+		Min: strconv.FormatFloat(scoreOf(from), 'f', -1, 64),
+		Max: strconv.FormatFloat(scoreOf(to), 'f', -1, 64),
+	}
+	if limit > 0 {
+		opt.Count = int64(limit)
+	}
+	vals, err := r.Redis.ZRangeByScore(ctx, uk, opt).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	points := make([]*ttnpb.GatewayConnectionStatsHistoryPoint, 0, len(vals))
+	for _, val := range vals {
+		point := &ttnpb.GatewayConnectionStatsHistoryPoint{}
+		if err := ttnredis.UnmarshalProto(val, point); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to decode stats history point")
+			continue
+		}
+		points = append(points, point)
+	}
+	return points, nil
+}
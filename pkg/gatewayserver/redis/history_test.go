@@ -0,0 +1,77 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestHistoryRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	registry := &GatewayConnectionStatsHistoryRegistry{
+		Redis: cl,
+	}
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "gtw1"}
+	otherIDs := &ttnpb.GatewayIdentifiers{GatewayId: "gtw2"}
+
+	now := time.Now().Truncate(time.Millisecond)
+	points := make([]*ttnpb.GatewayConnectionStatsHistoryPoint, 4)
+	for i := range points {
+		points[i] = &ttnpb.GatewayConnectionStatsHistoryPoint{
+			Time:        timestamppb.New(now.Add(time.Duration(i-len(points)) * time.Hour)),
+			Duration:    durationpb.New(time.Hour),
+			UplinkCount: uint64(i),
+		}
+	}
+
+	// The oldest point is outside of the retention.
+	retention := time.Duration(len(points)-1)*time.Hour + time.Minute
+	for _, point := range points {
+		if !a.So(registry.Add(ctx, ids, point, retention), should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	res, err := registry.Range(ctx, ids, now.Add(-24*time.Hour), now, 0)
+	a.So(err, should.BeNil)
+	a.So(res, should.Resemble, points[1:])
+
+	// The oldest points are returned up to the limit.
+	res, err = registry.Range(ctx, ids, now.Add(-24*time.Hour), now, 2)
+	a.So(err, should.BeNil)
+	a.So(res, should.Resemble, points[1:3])
+
+	res, err = registry.Range(ctx, ids, points[2].Time.AsTime(), points[2].Time.AsTime(), 0)
+	a.So(err, should.BeNil)
+	a.So(res, should.Resemble, points[2:3])
+
+	res, err = registry.Range(ctx, otherIDs, now.Add(-24*time.Hour), now, 0)
+	a.So(err, should.BeNil)
+	a.So(res, should.BeEmpty)
+
+	err = registry.Add(ctx, ids, &ttnpb.GatewayConnectionStatsHistoryPoint{}, retention)
+	a.So(err, should.NotBeNil)
+}
@@ -41,6 +41,22 @@ type GatewayConnectionStatsRegistry interface {
 	) error
 }
 
+// GatewayConnectionStatsHistoryRegistry stores the history of gateway connection stats.
+type GatewayConnectionStatsHistoryRegistry interface {
+	// Add adds the point to the history of the gateway. Points that are older than the retention are removed.
+	Add(
+		ctx context.Context,
+		ids *ttnpb.GatewayIdentifiers,
+		point *ttnpb.GatewayConnectionStatsHistoryPoint,
+		retention time.Duration,
+	) error
+	// Range returns the points in the history of the gateway between from and to, ordered by time.
+	// If limit is positive, at most limit points are returned.
+	Range(
+		ctx context.Context, ids *ttnpb.GatewayIdentifiers, from, to time.Time, limit int,
+	) ([]*ttnpb.GatewayConnectionStatsHistoryPoint, error)
+}
+
 // EntityRegistry abstracts the Identity server gateway functions.
 type EntityRegistry interface {
 	// AssertGatewayRights checks whether the gateway authentication (provied in the context) contains the required rights.
@@ -30,19 +30,31 @@ func NewEmission(starts ConcentratorTime, duration time.Duration) Emission {
 
 // Emission contains the scheduled time and duration of an emission.
 type Emission struct {
-	t ConcentratorTime
-	d time.Duration
+	t    ConcentratorTime
+	d    time.Duration
+	scan time.Duration
 }
 
 // Starts returns the time when the emission starts.
 func (em Emission) Starts() ConcentratorTime { return em.t }
 
+// ScanTime returns the listen-before-talk scan time that precedes the emission.
+func (em Emission) ScanTime() time.Duration { return em.scan }
+
+// ScanStarts returns the time when the listen-before-talk scan of the emission starts.
+// If the emission does not require listen-before-talk, this is the time when the emission starts.
+func (em Emission) ScanStarts() ConcentratorTime { return em.t - ConcentratorTime(em.scan) }
+
 // Ends returns the time when the emission ends.
 func (em Emission) Ends() ConcentratorTime { return em.t + ConcentratorTime(em.d) }
 
 // Duration returns the duration of the emission.
 func (em Emission) Duration() time.Duration { return em.d }
 
+// occupied returns the duration of the emission including the listen-before-talk scan, which counts toward the
+// duty-cycle.
+func (em Emission) occupied() time.Duration { return em.scan + em.d }
+
 // OffAir returns the time-off-air of the emission.
 func (em Emission) OffAir(toa frequencyplans.TimeOffAir) time.Duration {
 	d := time.Duration(float32(em.d) * toa.Fraction)
@@ -52,15 +64,16 @@ func (em Emission) OffAir(toa frequencyplans.TimeOffAir) time.Duration {
 	return d
 }
 
-// Within returns the duration of the emission that happens within the given window.
+// Within returns the duration of the emission, including the listen-before-talk scan, that happens within the given
+// window.
 func (em Emission) Within(from, to ConcentratorTime) time.Duration {
-	if em.Ends() < from || em.t > to {
+	if em.Ends() < from || em.ScanStarts() > to {
 		return 0
 	}
-	if em.t < from {
+	if em.ScanStarts() < from {
 		return time.Duration(em.Ends() - from)
 	}
-	return em.d
+	return em.occupied()
 }
 
 // EndsWithOffAir returns the time when the emission ends plus the time-off-air.
@@ -68,20 +81,23 @@ func (em Emission) EndsWithOffAir(toa frequencyplans.TimeOffAir) ConcentratorTim
 	return em.Ends() + ConcentratorTime(em.OffAir(toa))
 }
 
-// BeforeWithOffAir returns the time between the end of this emission to the start of the given other emission, considering time-off-air.
+// BeforeWithOffAir returns the time between the end of this emission to the start of the given other emission, considering time-off-air
+// and listen-before-talk scan time.
 func (em Emission) BeforeWithOffAir(other Emission, toa frequencyplans.TimeOffAir) time.Duration {
-	return time.Duration(other.Starts() - em.EndsWithOffAir(toa))
+	return time.Duration(other.ScanStarts() - em.EndsWithOffAir(toa))
 }
 
-// AfterWithOffAir returns the time between the end of the given other emission to the start of this emission, considering time-off-air.
+// AfterWithOffAir returns the time between the end of the given other emission to the start of this emission, considering time-off-air
+// and listen-before-talk scan time.
 func (em Emission) AfterWithOffAir(other Emission, toa frequencyplans.TimeOffAir) time.Duration {
-	return time.Duration(em.Starts() - other.EndsWithOffAir(toa))
+	return time.Duration(em.ScanStarts() - other.EndsWithOffAir(toa))
 }
 
-// OverlapsWithOffAir returns whether the given emission overlaps with this emission, considering time-off-air.
+// OverlapsWithOffAir returns whether the given emission overlaps with this emission, considering time-off-air
+// and listen-before-talk scan time.
 func (em Emission) OverlapsWithOffAir(other Emission, toa frequencyplans.TimeOffAir) bool {
-	emBegins, emEnds := em.Starts(), em.EndsWithOffAir(toa)
-	otherBegins, otherEnds := other.Starts(), other.EndsWithOffAir(toa)
+	emBegins, emEnds := em.ScanStarts(), em.EndsWithOffAir(toa)
+	otherBegins, otherEnds := other.ScanStarts(), other.EndsWithOffAir(toa)
 	return emEnds > otherBegins && emBegins < otherEnds ||
 		emBegins < otherEnds && emEnds > otherEnds
 }
@@ -52,6 +52,10 @@ var (
 	// scheduleLateRTTPercentile is the percentile of round-trip times that is considered for determining whether
 	// scheduling is too late.
 	scheduleLateRTTPercentile = 90
+
+	// ChannelBusyBackoff is the time that a channel is not used for downlink after the gateway reported that
+	// listen-before-talk detected activity on the channel.
+	ChannelBusyBackoff = 2 * time.Second
 )
 
 // TimeSource is a source for getting a current time.
@@ -75,6 +79,7 @@ type RTTs interface {
 var (
 	errFrequencyPlansTimeOffAir     = errors.DefineInvalidArgument("frequency_plans_time_off_air", "frequency plans must have the same time off air value")
 	errFrequencyPlansOverlapSubBand = errors.DefineInvalidArgument("frequency_plans_overlap_sub_band", "frequency plans must not have overlapping sub bands")
+	errFrequencyPlansLBT            = errors.DefineInvalidArgument("frequency_plans_lbt", "frequency plans must have the same listen-before-talk settings")
 )
 
 // NewScheduler instantiates a new Scheduler for the given frequency plan.
@@ -102,12 +107,19 @@ func NewScheduler(
 		scheduleAnytimeDelay = &ScheduleTimeShort
 	}
 
-	var timeOffAir *frequencyplans.TimeOffAir
+	var (
+		timeOffAir *frequencyplans.TimeOffAir
+		lbt        *frequencyplans.LBT
+	)
 	for _, fp := range fps {
 		if timeOffAir != nil && fp.TimeOffAir != *timeOffAir {
 			return nil, errFrequencyPlansTimeOffAir.New()
 		}
+		if timeOffAir != nil && !lbtEqual(lbt, fp.LBT) {
+			return nil, errFrequencyPlansLBT.New()
+		}
 		timeOffAir = fp.TimeOffAir.Clone()
+		lbt = fp.LBT.Clone()
 	}
 
 	if timeOffAir.Duration < QueueDelay {
@@ -117,6 +129,8 @@ func NewScheduler(
 	s := &Scheduler{
 		clock:                &RolloverClock{},
 		timeOffAir:           *timeOffAir,
+		lbt:                  lbt,
+		channelBusyUntil:     make(map[uint64]ConcentratorTime),
 		fps:                  fps,
 		timeSource:           timeSource,
 		scheduleAnytimeDelay: *scheduleAnytimeDelay,
@@ -185,11 +199,21 @@ func NewScheduler(
 	return s, nil
 }
 
-// Scheduler is a packet scheduler that takes time conflicts and sub-band restrictions into account.
+func lbtEqual(a, b *frequencyplans.LBT) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// Scheduler is a packet scheduler that takes time conflicts, sub-band restrictions and listen-before-talk
+// requirements into account.
 type Scheduler struct {
 	clock                *RolloverClock
 	fps                  map[string]*frequencyplans.FrequencyPlan
 	timeOffAir           frequencyplans.TimeOffAir
+	lbt                  *frequencyplans.LBT
+	channelBusyUntil     map[uint64]ConcentratorTime
 	timeSource           TimeSource
 	subBands             []*SubBand
 	mu                   sync.RWMutex
@@ -228,6 +252,11 @@ func (s *Scheduler) gc(ctx context.Context) error {
 			}
 			s.mu.Lock()
 			s.emissions = s.emissions.GreaterThan(to)
+			for frequency, busyUntil := range s.channelBusyUntil {
+				if busyUntil < serverTime {
+					delete(s.channelBusyUntil, frequency)
+				}
+			}
 			s.mu.Unlock()
 		}
 	}
@@ -242,12 +271,57 @@ func (s *Scheduler) newEmission(payloadSize int, settings *ttnpb.TxSettings, sta
 	}
 	for _, fp := range s.fps {
 		if fp.RespectsDwellTime(true, settings.Frequency, d) {
-			return NewEmission(starts, d), nil
+			em := NewEmission(starts, d)
+			em.scan = s.scanTime()
+			return em, nil
 		}
 	}
 	return Emission{}, errDwellTime.New()
 }
 
+// scanTime returns the listen-before-talk scan time that precedes each emission.
+func (s *Scheduler) scanTime() time.Duration {
+	if s.lbt == nil {
+		return 0
+	}
+	return s.lbt.ScanTime
+}
+
+var errChannelBusy = errors.DefineUnavailable(
+	"channel_busy", "listen-before-talk detected activity on `{frequency}` Hz, retry in `{duration}`",
+	"rssi_target",
+)
+
+// checkChannelBusy verifies that the listen-before-talk scan of the emission on the given frequency starts after the
+// channel busy backoff.
+// This method assumes that the mutex is held.
+func (s *Scheduler) checkChannelBusy(em Emission, frequency uint64) error {
+	busyUntil, ok := s.channelBusyUntil[frequency]
+	if !ok || em.ScanStarts() >= busyUntil {
+		return nil
+	}
+	err := errChannelBusy.WithAttributes(
+		"frequency", frequency,
+		"duration", time.Duration(busyUntil-em.ScanStarts()),
+	)
+	if s.lbt != nil {
+		err = err.WithAttributes("rssi_target", s.lbt.RSSITarget)
+	}
+	return err
+}
+
+// ReportChannelBusy reports that the gateway did not transmit on the given frequency because listen-before-talk
+// detected activity on the channel. The channel is not used for ChannelBusyBackoff.
+func (s *Scheduler) ReportChannelBusy(frequency uint64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now, ok := s.clock.FromServerTime(s.timeSource.Now())
+	if !ok {
+		return
+	}
+	s.channelBusyUntil[frequency] = now + ConcentratorTime(ChannelBusyBackoff)
+}
+
 // SubBandCount returns the number of sub bands in the scheduler.
 func (s *Scheduler) SubBandCount() int {
 	return len(s.subBands)
@@ -307,6 +381,8 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, opts Options) (res Emission,
 			medianRTT = &median
 		}
 	}
+	// The gateway needs to receive the downlink before the listen-before-talk scan starts.
+	minScheduleTime += s.scanTime()
 	log.FromContext(ctx).WithFields(log.Fields(
 		"median_rtt", medianRTT,
 		"min_schedule_time", minScheduleTime,
@@ -352,6 +428,9 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, opts Options) (res Emission,
 	if err != nil {
 		return Emission{}, 0, err
 	}
+	if err := s.checkChannelBusy(em, opts.Frequency); err != nil {
+		return Emission{}, 0, err
+	}
 	for _, other := range s.emissions {
 		if em.OverlapsWithOffAir(other, s.timeOffAir) {
 			return Emission{}, 0, errConflict.New()
@@ -389,13 +468,16 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, opts Options) (res Emis
 			minScheduleTime = np/2 + QueueDelay
 		}
 	}
+	// The gateway needs to receive the downlink before the listen-before-talk scan starts.
+	scan := s.scanTime()
+	minScheduleTime += scan
 	var starts ConcentratorTime
 	now, ok := s.clock.FromServerTime(s.timeSource.Now())
 	if !ok {
 		panic("clock is synced without server time")
 	}
 	if opts.Timestamp == 0 {
-		starts = now + ConcentratorTime(s.scheduleAnytimeDelay)
+		starts = now + ConcentratorTime(s.scheduleAnytimeDelay+scan)
 		opts.Timestamp = uint32(time.Duration(starts) / time.Microsecond)
 	} else {
 		starts = s.clock.FromTimestampTime(opts.Timestamp)
@@ -408,6 +490,9 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, opts Options) (res Emis
 	if err != nil {
 		return Emission{}, 0, err
 	}
+	if busyUntil, ok := s.channelBusyUntil[opts.Frequency]; ok && starts-ConcentratorTime(scan) < busyUntil {
+		starts = busyUntil + ConcentratorTime(scan)
+	}
 	em, err := s.newEmission(opts.PayloadSize, opts.TxSettings, starts)
 	if err != nil {
 		return Emission{}, 0, err
@@ -422,11 +507,11 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, opts Options) (res Emis
 			// Find a window between two emissions that does not conflict with either side.
 			if em.OverlapsWithOffAir(s.emissions[i], s.timeOffAir) {
 				// Schedule right after previous to resolve conflict.
-				em.t = s.emissions[i].EndsWithOffAir(s.timeOffAir)
+				em.t = s.emissions[i].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(scan)
 			}
 			if em.OverlapsWithOffAir(s.emissions[i+1], s.timeOffAir) {
 				// Schedule right after next to resolve conflict.
-				em.t = s.emissions[i+1].EndsWithOffAir(s.timeOffAir)
+				em.t = s.emissions[i+1].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(scan)
 				i++
 				continue
 			}
@@ -436,13 +521,13 @@ func (s *Scheduler) ScheduleAnytime(ctx context.Context, opts Options) (res Emis
 			return em.t
 		}
 		// No emissions to schedule in between; schedule at timestamp or last transmission, whichever comes first.
-		afterLast := s.emissions[len(s.emissions)-1].EndsWithOffAir(s.timeOffAir)
+		afterLast := s.emissions[len(s.emissions)-1].EndsWithOffAir(s.timeOffAir) + ConcentratorTime(scan)
 		if afterLast > em.t {
 			return afterLast
 		}
 		return em.t
 	}
-	em, err = sb.ScheduleAnytime(em.d, next, opts.Priority)
+	em, err = sb.ScheduleAnytime(em, next, opts.Priority)
 	if err != nil {
 		return Emission{}, 0, err
 	}
@@ -528,3 +613,20 @@ func (s *Scheduler) SubBandStats() []*ttnpb.GatewayConnectionStats_SubBand {
 
 	return res
 }
+
+// SubBandStatsBetween returns the usage stats of each sub band between the given server times.
+// Unlike SubBandStats, the utilization is the utilization between the given times, not in the duty-cycle window.
+func (s *Scheduler) SubBandStatsBetween(from, to time.Time) []*ttnpb.GatewayConnectionStats_SubBand {
+	var res []*ttnpb.GatewayConnectionStats_SubBand
+
+	for _, sb := range s.subBands {
+		res = append(res, &ttnpb.GatewayConnectionStats_SubBand{
+			MaxFrequency:             sb.MaxFrequency,
+			MinFrequency:             sb.MinFrequency,
+			DownlinkUtilizationLimit: sb.DutyCycle,
+			DownlinkUtilization:      sb.UtilizationBetween(from, to),
+		})
+	}
+
+	return res
+}
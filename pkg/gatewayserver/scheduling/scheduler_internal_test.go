@@ -14,9 +14,19 @@
 
 package scheduling
 
+import "time"
+
 var (
-	ErrConflict  = errConflict
-	ErrDwellTime = errDwellTime
-	ErrTooLate   = errTooLate
-	ErrDutyCycle = errDutyCycle
+	ErrConflict    = errConflict
+	ErrDwellTime   = errDwellTime
+	ErrTooLate     = errTooLate
+	ErrDutyCycle   = errDutyCycle
+	ErrChannelBusy = errChannelBusy
 )
+
+// NewEmissionWithScanTime returns a new Emission with the given values, preceded by a listen-before-talk scan.
+func NewEmissionWithScanTime(starts ConcentratorTime, duration, scan time.Duration) Emission {
+	em := NewEmission(starts, duration)
+	em.scan = scan
+	return em
+}
@@ -1249,3 +1249,169 @@ func TestScheduleSyncViaUplinkToken(t *testing.T) {
 		a.So(err, should.BeNil)
 	}
 }
+
+func TestScheduleWithListenBeforeTalk(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	lbt := &frequencyplans.LBT{
+		RSSITarget: -80,
+		ScanTime:   5 * time.Millisecond,
+	}
+	fps := map[string]*frequencyplans.FrequencyPlan{test.KRFrequencyPlanID: {
+		BandID: band.KR_920_923,
+		LBT:    lbt,
+	}}
+	timeSource := &mockTimeSource{
+		Time: time.Unix(0, 0),
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fps, false, scheduling.DefaultDutyCycleStyle, nil, timeSource)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	scheduler.Sync(0, timeSource.Time)
+
+	settingsAt := func(frequency uint64, t uint32) *ttnpb.TxSettings {
+		return &ttnpb.TxSettings{
+			DataRate: &ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_Lora{
+					Lora: &ttnpb.LoRaDataRate{
+						Bandwidth:       125000,
+						SpreadingFactor: 7,
+						CodingRate:      band.Cr4_5,
+					},
+				},
+			},
+			Frequency: frequency,
+			Timestamp: t,
+		}
+	}
+
+	// The scan time is reserved before the emission.
+	// Time-on-air is 41216 us, time-off-air is 30000 us, scan time is 5000 us.
+	// 1: [995000, 1071216]
+	em, _, err := scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(922100000, 1000000),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(em.Starts(), should.Equal, 1000000*time.Microsecond)
+	a.So(em.ScanStarts(), should.Equal, 995000*time.Microsecond)
+	a.So(em.ScanTime(), should.Equal, lbt.ScanTime)
+
+	// The emission would fit without scan time, but the scan overlaps with the time-off-air of the previous emission.
+	_, _, err = scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(922300000, 1073216),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrConflict)
+
+	// The gateway needs to receive the downlink before the scan starts.
+	_, _, err = scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(922300000, uint32((scheduling.ScheduleTimeShort+2*time.Millisecond)/time.Microsecond)),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrTooLate)
+
+	// Scheduling any time considers the scan time after the previous emission.
+	// 2: [1071216, 1117432]
+	em, _, err = scheduler.ScheduleAnytime(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(922300000, 1000000),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(em.ScanStarts(), should.Equal, 1071216*time.Microsecond)
+	a.So(em.Starts(), should.Equal, 1076216*time.Microsecond)
+
+	// The gateway reports that the channel is busy, so it cannot be used for ChannelBusyBackoff.
+	scheduler.ReportChannelBusy(922500000)
+	_, _, err = scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(922500000, 1500000),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	if a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrChannelBusy) {
+		a.So(errors.Attributes(err)["rssi_target"], should.Equal, lbt.RSSITarget)
+	}
+	// Other channels are not affected.
+	_, _, err = scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(922700000, 1500000),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	a.So(err, should.BeNil)
+	// Scheduling any time on the busy channel is delayed until the backoff passed.
+	em, _, err = scheduler.ScheduleAnytime(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settingsAt(922500000, 1500000),
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	if a.So(err, should.BeNil) {
+		a.So(em.ScanStarts(), should.Equal, scheduling.ChannelBusyBackoff)
+	}
+}
+
+func TestSchedulerWithDifferentListenBeforeTalk(t *testing.T) {
+	ctx := test.Context()
+	for _, tc := range []struct {
+		Name string
+		LBT  [2]*frequencyplans.LBT
+		OK   bool
+	}{
+		{
+			Name: "None",
+			OK:   true,
+		},
+		{
+			Name: "Same",
+			LBT: [2]*frequencyplans.LBT{
+				{RSSITarget: -80, ScanTime: 5 * time.Millisecond},
+				{RSSITarget: -80, ScanTime: 5 * time.Millisecond},
+			},
+			OK: true,
+		},
+		{
+			Name: "OneMissing",
+			LBT: [2]*frequencyplans.LBT{
+				{RSSITarget: -80, ScanTime: 5 * time.Millisecond},
+				nil,
+			},
+		},
+		{
+			Name: "Different",
+			LBT: [2]*frequencyplans.LBT{
+				{RSSITarget: -80, ScanTime: 5 * time.Millisecond},
+				{RSSITarget: -80, ScanTime: 128 * time.Microsecond},
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			fps := map[string]*frequencyplans.FrequencyPlan{
+				"KR_1": {
+					BandID:   band.KR_920_923,
+					SubBands: []frequencyplans.SubBandParameters{{MinFrequency: 920900000, MaxFrequency: 922000000}},
+					LBT:      tc.LBT[0],
+				},
+				"KR_2": {
+					BandID:   band.KR_920_923,
+					SubBands: []frequencyplans.SubBandParameters{{MinFrequency: 922000001, MaxFrequency: 923300000}},
+					LBT:      tc.LBT[1],
+				},
+			}
+			_, err := scheduling.NewScheduler(ctx, fps, true, scheduling.DefaultDutyCycleStyle, nil, nil)
+			if tc.OK {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+		})
+	}
+}
@@ -125,6 +125,25 @@ func (sb *SubBand) DutyCycleUtilization() float32 {
 	return float32(val) / float32(DutyCycleWindow)
 }
 
+// UtilizationBetween returns the utilization between the given server times, as a fraction of the time between them.
+func (sb *SubBand) UtilizationBetween(from, to time.Time) float32 {
+	if !to.After(from) {
+		return 0
+	}
+	fromTime, ok := sb.clock.FromServerTime(from)
+	if !ok {
+		return 0
+	}
+	toTime, ok := sb.clock.FromServerTime(to)
+	if !ok {
+		return 0
+	}
+	sb.mu.RLock()
+	val := sb.sum(fromTime, toTime)
+	sb.mu.RUnlock()
+	return float32(val) / float32(to.Sub(from))
+}
+
 // prioritizedDutyCycle returns the duty-cycle given the scheduling priority.
 // This is calculated as the available duty-cycle for the sub-band times the priority ceiling.
 func (sb *SubBand) prioritizedDutyCycle(p ttnpb.TxSchedulePriority) float32 {
@@ -147,6 +166,7 @@ var (
 )
 
 // checkDutyCycle verifies if the emission complies with the duty cycle limitations, based on the style.
+// The listen-before-talk scan time of the emissions counts toward the duty cycle.
 //
 // For the simple window style, it verifies if the emission will not exceed the usable amount of the duty
 // cycle in the [t + toa - window, t + toa] and [t, t + window] windows, where `t` is the start of the
@@ -161,7 +181,7 @@ var (
 func (sb *SubBand) checkDutyCycle(em Emission, p ttnpb.TxSchedulePriority) error {
 	usable := sb.prioritizedDutyCycle(p)
 	for _, to := range []ConcentratorTime{em.Ends(), em.t + ConcentratorTime(DutyCycleWindow)} {
-		used := float32(sb.sum(to-ConcentratorTime(DutyCycleWindow), to)+em.d) / float32(DutyCycleWindow)
+		used := float32(sb.sum(to-ConcentratorTime(DutyCycleWindow), to)+em.occupied()) / float32(DutyCycleWindow)
 		if used <= usable {
 			continue
 		}
@@ -180,10 +200,10 @@ func (sb *SubBand) checkDutyCycle(em Emission, p ttnpb.TxSchedulePriority) error
 		lastEmission := sb.emissions[len(sb.emissions)-1]
 		// NOTE: The priority is intentionally elided here, as the blocking algorithm does not consider
 		// the emission priority for duty cycle purposes.
-		blockedUntil := lastEmission.t + ConcentratorTime(lastEmission.d*time.Duration(1.0/sb.DutyCycle))
-		if em.t < blockedUntil {
+		blockedUntil := lastEmission.ScanStarts() + ConcentratorTime(lastEmission.occupied()*time.Duration(1.0/sb.DutyCycle))
+		if em.ScanStarts() < blockedUntil {
 			return errBlocked.WithAttributes(
-				"duration", time.Duration(blockedUntil-em.t),
+				"duration", time.Duration(blockedUntil-em.ScanStarts()),
 			)
 		}
 	default:
@@ -208,19 +228,20 @@ func (sb *SubBand) Schedule(em Emission, p ttnpb.TxSchedulePriority) error {
 	return nil
 }
 
-// ScheduleAnytime schedules the given duration at a time when there is availability by accounting for duty-cycle.
-// The given next callback should return the next option that does not conflict with other scheduled downlinks.
+// ScheduleAnytime schedules the given emission at a time when there is availability by accounting for duty-cycle.
+// The start time of the emission is ignored; the given next callback should return the next option that does not
+// conflict with other scheduled downlinks.
 // If there is no duty-cycle limitation, this method returns the first option.
-func (sb *SubBand) ScheduleAnytime(d time.Duration, next func() ConcentratorTime, p ttnpb.TxSchedulePriority) (Emission, error) {
+func (sb *SubBand) ScheduleAnytime(em Emission, next func() ConcentratorTime, p ttnpb.TxSchedulePriority) (Emission, error) {
 	sb.mu.Lock()
 	defer sb.mu.Unlock()
-	em := NewEmission(next(), d)
+	em.t = next()
 	if sb.DutyCycle >= 1 {
 		sb.emissions = sb.emissions.Insert(em)
 		return em, nil
 	}
 	usable := sb.prioritizedDutyCycle(p)
-	used := float32(em.d) / float32(DutyCycleWindow)
+	used := float32(em.occupied()) / float32(DutyCycleWindow)
 	if used > usable {
 		return Emission{}, errDutyCycle.WithAttributes(
 			"used", fmt.Sprintf("%.1f", used*100),
@@ -239,7 +260,7 @@ func (sb *SubBand) ScheduleAnytime(d time.Duration, next func() ConcentratorTime
 		// The caller has no later option; find the last emission after which we consider the duty-cycle window.
 		for i := len(sb.emissions) - 1; i >= 0; i-- {
 			other := sb.emissions[i]
-			used += float32(other.d) / float32(DutyCycleWindow)
+			used += float32(other.occupied()) / float32(DutyCycleWindow)
 			if used > usable {
 				em.t = other.Ends() + ConcentratorTime(DutyCycleWindow) - ConcentratorTime(em.d)
 				break
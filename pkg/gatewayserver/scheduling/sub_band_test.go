@@ -90,6 +90,38 @@ func TestSubBandScheduleUnrestricted(t *testing.T) {
 	}
 }
 
+// serverClock is a mockClock that converts server times relative to the Unix epoch.
+type serverClock struct {
+	mockClock
+}
+
+func (*serverClock) FromServerTime(t time.Time) (scheduling.ConcentratorTime, bool) {
+	return scheduling.ConcentratorTime(t.Sub(time.Unix(0, 0))), true
+}
+
+func TestSubBandUtilizationBetween(t *testing.T) {
+	a := assertions.New(t)
+	params := scheduling.SubBandParameters{
+		MinFrequency: 0,
+		MaxFrequency: math.MaxUint64,
+		DutyCycle:    1,
+	}
+	clock := &serverClock{}
+	sb := scheduling.NewSubBand(params, clock, nil, scheduling.DefaultDutyCycleStyle)
+	for _, em := range []scheduling.Emission{
+		scheduling.NewEmission(scheduling.ConcentratorTime(1*time.Second), 2*time.Second),
+		scheduling.NewEmission(scheduling.ConcentratorTime(5*time.Second), 1*time.Second),
+	} {
+		a.So(sb.Schedule(em, ttnpb.TxSchedulePriority_NORMAL), should.BeNil)
+	}
+
+	at := func(d time.Duration) time.Time { return time.Unix(0, 0).Add(d) }
+	a.So(sb.UtilizationBetween(at(0), at(4*time.Second)), should.Equal, 0.5)
+	a.So(sb.UtilizationBetween(at(4*time.Second), at(8*time.Second)), should.Equal, 0.25)
+	a.So(sb.UtilizationBetween(at(2*time.Second), at(6*time.Second)), should.Equal, 0.5)
+	a.So(sb.UtilizationBetween(at(8*time.Second), at(4*time.Second)), should.Equal, 0)
+}
+
 func TestSubBandScheduleRestricted(t *testing.T) {
 	params := scheduling.SubBandParameters{
 		MinFrequency: 0,
@@ -171,6 +203,30 @@ func TestSubBandScheduleRestricted(t *testing.T) {
 	}
 }
 
+func TestScanTimeDutyCycle(t *testing.T) {
+	a := assertions.New(t)
+	params := scheduling.SubBandParameters{
+		MinFrequency: 0,
+		MaxFrequency: math.MaxUint64,
+		DutyCycle:    0.1,
+	}
+	clock := &mockClock{}
+	sb := scheduling.NewSubBand(params, clock, nil, scheduling.DefaultDutyCycleStyle)
+
+	// The emission fits the duty-cycle, but not with the scan time that precedes it.
+	em := scheduling.NewEmissionWithScanTime(scheduling.ConcentratorTime(time.Second), 900*time.Millisecond, 200*time.Millisecond)
+	err := sb.Schedule(em, ttnpb.TxSchedulePriority_HIGHEST)
+	a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrDutyCycle)
+
+	em = scheduling.NewEmissionWithScanTime(scheduling.ConcentratorTime(time.Second), 800*time.Millisecond, 100*time.Millisecond)
+	err = sb.Schedule(em, ttnpb.TxSchedulePriority_HIGHEST)
+	a.So(err, should.BeNil)
+	a.So(em.Within(0, scheduling.ConcentratorTime(2*time.Second)), should.Equal, 900*time.Millisecond)
+
+	clock.t = em.Ends()
+	a.So(sb.DutyCycleUtilization(), should.AlmostEqual, 0.09, 1e-6)
+}
+
 func TestScheduleAnytimeRestricted(t *testing.T) {
 	a := assertions.New(t)
 	params := scheduling.SubBandParameters{
@@ -204,7 +260,7 @@ func TestScheduleAnytimeRestricted(t *testing.T) {
 			from += scheduling.ConcentratorTime(time.Second)
 			return res
 		}
-		em, err := sb.ScheduleAnytime(time.Second, next, ttnpb.TxSchedulePriority_NORMAL)
+		em, err := sb.ScheduleAnytime(scheduling.NewEmission(0, time.Second), next, ttnpb.TxSchedulePriority_NORMAL)
 		a.So(err, should.BeNil)
 		a.So(em.Starts(), should.Equal, 16*time.Second)
 		// [     1       2 4 3        ]
@@ -217,7 +273,7 @@ func TestScheduleAnytimeRestricted(t *testing.T) {
 		next := func() scheduling.ConcentratorTime {
 			return scheduling.ConcentratorTime(19 * time.Second)
 		}
-		em, err := sb.ScheduleAnytime(time.Second, next, ttnpb.TxSchedulePriority_NORMAL)
+		em, err := sb.ScheduleAnytime(scheduling.NewEmission(0, time.Second), next, ttnpb.TxSchedulePriority_NORMAL)
 		a.So(err, should.BeNil)
 		a.So(em.Starts(), should.Equal, 26*time.Second)
 		// [     1       2 4 3       5]
@@ -229,7 +285,7 @@ func TestScheduleAnytimeRestricted(t *testing.T) {
 		next := func() scheduling.ConcentratorTime {
 			return scheduling.ConcentratorTime(19 * time.Second)
 		}
-		_, err := sb.ScheduleAnytime(5*time.Second, next, ttnpb.TxSchedulePriority_NORMAL)
+		_, err := sb.ScheduleAnytime(scheduling.NewEmission(0, 5*time.Second), next, ttnpb.TxSchedulePriority_NORMAL)
 		a.So(err, should.HaveSameErrorDefinitionAs, scheduling.ErrDutyCycle)
 	}
 }
@@ -30,6 +30,7 @@ type NS struct {
 
 	upCh    chan *ttnpb.UplinkMessage
 	txAckCh chan *ttnpb.GatewayTxAcknowledgment
+	loadCh  chan *ttnpb.GatewayDownlinkLoad
 }
 
 // StartNS starts the mock NS.
@@ -37,6 +38,7 @@ func StartNS(ctx context.Context) (*NS, string) {
 	ns := &NS{
 		upCh:    make(chan *ttnpb.UplinkMessage, 1),
 		txAckCh: make(chan *ttnpb.GatewayTxAcknowledgment, 1),
+		loadCh:  make(chan *ttnpb.GatewayDownlinkLoad, 1),
 	}
 	srv := rpcserver.New(ctx)
 	ttnpb.RegisterGsNsServer(srv.Server, ns)
@@ -60,6 +62,15 @@ func (ns *NS) ReportTxAcknowledgment(ctx context.Context, msg *ttnpb.GatewayTxAc
 	return ttnpb.Empty, nil
 }
 
+// ReportDownlinkLoad implements ttnpb.GsNsServer
+func (ns *NS) ReportDownlinkLoad(ctx context.Context, msg *ttnpb.GatewayDownlinkLoad) (*emptypb.Empty, error) {
+	select {
+	case ns.loadCh <- msg:
+	default:
+	}
+	return ttnpb.Empty, nil
+}
+
 // Up returns the upstream channel.
 func (ns *NS) Up() <-chan *ttnpb.UplinkMessage {
 	return ns.upCh
@@ -69,3 +80,8 @@ func (ns *NS) Up() <-chan *ttnpb.UplinkMessage {
 func (ns *NS) TxAck() <-chan *ttnpb.GatewayTxAcknowledgment {
 	return ns.txAckCh
 }
+
+// DownlinkLoad returns the downlink load channel.
+func (ns *NS) DownlinkLoad() <-chan *ttnpb.GatewayDownlinkLoad {
+	return ns.loadCh
+}
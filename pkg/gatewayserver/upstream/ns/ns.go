@@ -17,6 +17,7 @@ package ns
 
 import (
 	"context"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -42,19 +43,30 @@ type ContextDecoupler interface {
 
 // Handler is the upstream handler.
 type Handler struct {
-	ctx              context.Context
-	cluster          Cluster
-	contextDecoupler ContextDecoupler
-	devAddrPrefixes  []types.DevAddrPrefix
+	ctx                        context.Context
+	cluster                    Cluster
+	contextDecoupler           ContextDecoupler
+	devAddrPrefixes            []types.DevAddrPrefix
+	downlinkLoadReportInterval time.Duration
+	remote                     *remote
 }
 
 // NewHandler returns a new upstream handler.
-func NewHandler(ctx context.Context, cluster Cluster, contextDecoupler ContextDecoupler, devAddrPrefixes []types.DevAddrPrefix) *Handler {
+// The downlink load of connected gateways is reported to the Network Server every downlinkLoadReportInterval.
+// If downlinkLoadReportInterval is zero, the downlink load is not reported.
+func NewHandler(
+	ctx context.Context,
+	cluster Cluster,
+	contextDecoupler ContextDecoupler,
+	devAddrPrefixes []types.DevAddrPrefix,
+	downlinkLoadReportInterval time.Duration,
+) *Handler {
 	return &Handler{
-		ctx:              ctx,
-		cluster:          cluster,
-		contextDecoupler: contextDecoupler,
-		devAddrPrefixes:  devAddrPrefixes,
+		ctx:                        ctx,
+		cluster:                    cluster,
+		contextDecoupler:           contextDecoupler,
+		devAddrPrefixes:            devAddrPrefixes,
+		downlinkLoadReportInterval: downlinkLoadReportInterval,
 	}
 }
 
@@ -64,43 +76,106 @@ func (h *Handler) DevAddrPrefixes() []types.DevAddrPrefix {
 }
 
 // Setup implements upstream.Handler.
-func (h *Handler) Setup(context.Context) error {
+func (h *Handler) Setup(ctx context.Context) error {
+	if h.remote != nil {
+		return h.remote.dial(ctx)
+	}
 	return nil
 }
 
+// networkServer returns the client of the Network Server and the call options to authenticate with it.
+func (h *Handler) networkServer(ctx context.Context) (ttnpb.GsNsClient, []grpc.CallOption, error) {
+	if h.remote != nil {
+		return ttnpb.NewGsNsClient(h.remote.conn), []grpc.CallOption{h.remote.auth()}, nil
+	}
+	nsConn, err := h.cluster.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+	if err != nil {
+		return nil, nil, errNetworkServerNotFound.WithCause(err)
+	}
+	return ttnpb.NewGsNsClient(nsConn), []grpc.CallOption{h.cluster.WithClusterAuth()}, nil
+}
+
 // ConnectGateway implements upstream.Handler.
 func (h *Handler) ConnectGateway(ctx context.Context, ids *ttnpb.GatewayIdentifiers, conn *io.Connection) error {
+	if h.remote != nil {
+		// The Network Server is outside of the cluster, so it cannot schedule downlink messages on this gateway.
+		<-ctx.Done()
+		return ctx.Err()
+	}
 	// If the frontend can claim downlinks, don't claim automatically on connection.
-	if conn.Frontend().SupportsDownlinkClaim() {
-		return nil
+	if !conn.Frontend().SupportsDownlinkClaim() {
+		decoupledCtx := h.contextDecoupler.FromRequestContext(ctx)
+		logger := log.FromContext(ctx)
+		if err := h.cluster.ClaimIDs(decoupledCtx, ids); err != nil {
+			logger.WithError(err).Error("Failed to claim downlink path")
+			return err
+		}
+		logger.Info("Downlink path claimed")
+		defer func() {
+			if err := h.cluster.UnclaimIDs(decoupledCtx, ids); err != nil {
+				logger.WithError(err).Error("Failed to unclaim downlink path")
+				return
+			}
+			logger.Info("Downlink path unclaimed")
+		}()
 	}
-	decoupledCtx := h.contextDecoupler.FromRequestContext(ctx)
-	logger := log.FromContext(ctx)
-	if err := h.cluster.ClaimIDs(decoupledCtx, ids); err != nil {
-		logger.WithError(err).Error("Failed to claim downlink path")
-		return err
+	return h.reportDownlinkLoad(ctx, ids, conn)
+}
+
+// reportDownlinkLoad periodically reports the downlink load of the gateway to the Network Server,
+// until the context is done.
+func (h *Handler) reportDownlinkLoad(ctx context.Context, ids *ttnpb.GatewayIdentifiers, conn *io.Connection) error {
+	if h.downlinkLoadReportInterval <= 0 {
+		<-ctx.Done()
+		return ctx.Err()
 	}
-	logger.Info("Downlink path claimed")
-	defer func() {
-		if err := h.cluster.UnclaimIDs(decoupledCtx, ids); err != nil {
-			logger.WithError(err).Error("Failed to unclaim downlink path")
-			return
+	ticker := time.NewTicker(h.downlinkLoadReportInterval)
+	defer ticker.Stop()
+	var lastTotal, lastFailures uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		stats, _ := conn.Stats()
+		if len(stats.SubBands) == 0 {
+			// The gateway does not have an active downlink stream.
+			continue
+		}
+		load := &ttnpb.GatewayDownlinkLoad{
+			GatewayIds: ids,
+			SubBands:   stats.SubBands,
 		}
-		logger.Info("Downlink path unclaimed")
-	}()
-	<-ctx.Done()
-	return ctx.Err()
+		total, failures := conn.TxFailureStats()
+		if total > lastTotal {
+			load.TxFailureRate = float32(failures-lastFailures) / float32(total-lastTotal)
+		}
+		lastTotal, lastFailures = total, failures
+		if err := h.sendDownlinkLoad(ctx, load); err != nil {
+			log.FromContext(ctx).WithError(err).Debug("Failed to report downlink load")
+		}
+	}
+}
+
+func (h *Handler) sendDownlinkLoad(ctx context.Context, load *ttnpb.GatewayDownlinkLoad) error {
+	client, opts, err := h.networkServer(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = client.ReportDownlinkLoad(ctx, load, opts...)
+	return err
 }
 
 var errNetworkServerNotFound = errors.DefineNotFound("network_server_not_found", "Network Server not found")
 
 // HandleUplink implements upstream.Handler.
 func (h *Handler) HandleUplink(ctx context.Context, _ *ttnpb.GatewayIdentifiers, ids *ttnpb.EndDeviceIdentifiers, msg *ttnpb.GatewayUplinkMessage) error {
-	nsConn, err := h.cluster.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+	client, opts, err := h.networkServer(ctx)
 	if err != nil {
-		return errNetworkServerNotFound.WithCause(err)
+		return err
 	}
-	_, err = ttnpb.NewGsNsClient(nsConn).HandleUplink(ctx, msg.Message, h.cluster.WithClusterAuth())
+	_, err = client.HandleUplink(ctx, msg.Message, opts...)
 	return err
 }
 
@@ -111,13 +186,13 @@ func (h *Handler) HandleStatus(context.Context, *ttnpb.GatewayIdentifiers, *ttnp
 
 // HandleTxAck implements upstream.Handler.
 func (h *Handler) HandleTxAck(ctx context.Context, ids *ttnpb.GatewayIdentifiers, msg *ttnpb.TxAcknowledgment) error {
-	nsConn, err := h.cluster.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+	client, opts, err := h.networkServer(ctx)
 	if err != nil {
-		return errNetworkServerNotFound.WithCause(err)
+		return err
 	}
-	_, err = ttnpb.NewGsNsClient(nsConn).ReportTxAcknowledgment(ctx, &ttnpb.GatewayTxAcknowledgment{
+	_, err = client.ReportTxAcknowledgment(ctx, &ttnpb.GatewayTxAcknowledgment{
 		TxAck:      msg,
 		GatewayIds: ids,
-	}, h.cluster.WithClusterAuth())
+	}, opts...)
 	return err
 }
@@ -55,7 +55,7 @@ func TestNSHandler(t *testing.T) {
 	componenttest.StartComponent(t, c)
 	defer c.Close()
 	mustHavePeer(ctx, c, ttnpb.ClusterRole_NETWORK_SERVER)
-	h := NewHandler(ctx, c, c, nil)
+	h := NewHandler(ctx, c, c, nil, 0)
 
 	for _, tc := range []struct {
 		Name                 string
@@ -113,4 +113,79 @@ func TestNSHandler(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("DownlinkLoad", func(t *testing.T) {
+		a := assertions.New(t)
+		load := &ttnpb.GatewayDownlinkLoad{
+			GatewayIds: &gtwIDs,
+			SubBands: []*ttnpb.GatewayConnectionStats_SubBand{{
+				MinFrequency:             863000000,
+				MaxFrequency:             870000000,
+				DownlinkUtilizationLimit: 0.01,
+				DownlinkUtilization:      0.005,
+			}},
+			TxFailureRate: 0.25,
+		}
+		if !a.So(h.sendDownlinkLoad(ctx, load), should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case msg := <-ns.DownlinkLoad():
+			a.So(msg, should.Resemble, load)
+		case <-time.After(timeout):
+			t.Fatal("Expected downlink load timeout")
+		}
+	})
+}
+
+func TestRemoteHandler(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	gtwIDs := &ttnpb.GatewayIdentifiers{GatewayId: "test-gateway"}
+	ns, nsAddr := mock.StartNS(ctx)
+	h := NewRemoteHandler(ctx, RemoteConfig{
+		Address: nsAddr,
+		Key:     []byte{0x01, 0x02, 0x03, 0x04},
+	})
+	if !a.So(h.Setup(ctx), should.BeNil) {
+		t.FailNow()
+	}
+
+	up := &ttnpb.GatewayUplinkMessage{
+		BandId: band.EU_863_870,
+		Message: &ttnpb.UplinkMessage{
+			RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+			RxMetadata: []*ttnpb.RxMetadata{{
+				GatewayIds: gtwIDs,
+				Rssi:       -42,
+			}},
+			Settings: &ttnpb.TxSettings{
+				Frequency: 868100000,
+				DataRate: &ttnpb.DataRate{Modulation: &ttnpb.DataRate_Lora{Lora: &ttnpb.LoRaDataRate{
+					SpreadingFactor: 7,
+					Bandwidth:       125000,
+					CodingRate:      band.Cr4_5,
+				}}},
+			},
+		},
+	}
+	a.So(h.HandleUplink(ctx, gtwIDs, nil, up), should.BeNil)
+	select {
+	case msg := <-ns.Up():
+		a.So(msg, should.Resemble, up.Message)
+	case <-time.After(timeout):
+		t.Fatal("Expected uplink message timeout")
+	}
+
+	txAck := &ttnpb.TxAcknowledgment{Result: ttnpb.TxAcknowledgment_SUCCESS}
+	a.So(h.HandleTxAck(ctx, gtwIDs, txAck), should.BeNil)
+	select {
+	case msg := <-ns.TxAck():
+		a.So(msg.TxAck, should.Resemble, txAck)
+		a.So(msg.GatewayIds, should.Resemble, gtwIDs)
+	case <-time.After(timeout):
+		t.Fatal("Expected transmission acknowledgment timeout")
+	}
 }
@@ -0,0 +1,83 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ns
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+
+	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// RemoteConfig configures a Network Server outside of the cluster.
+type RemoteConfig struct {
+	// Address is the gRPC address of the Network Server.
+	Address string
+	// Key is the cluster key that the Network Server accepts.
+	Key []byte
+	// TLSConfig is the TLS configuration of the connection. If nil, the connection is insecure.
+	TLSConfig *tls.Config
+}
+
+type remote struct {
+	config RemoteConfig
+	conn   *grpc.ClientConn
+}
+
+var errDialRemote = errors.DefineUnavailable("dial_remote", "dial Network Server at `{address}`")
+
+func (r *remote) dial(ctx context.Context) error {
+	creds := insecure.NewCredentials()
+	if r.config.TLSConfig != nil {
+		creds = credentials.NewTLS(r.config.TLSConfig)
+	}
+	conn, err := grpc.DialContext(ctx, r.config.Address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return errDialRemote.WithCause(err).WithAttributes("address", r.config.Address)
+	}
+	r.conn = conn
+	go func() {
+		<-ctx.Done()
+		conn.Close()
+	}()
+	return nil
+}
+
+func (r *remote) auth() grpc.CallOption {
+	return grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:      clusterauth.AuthType,
+		AuthValue:     hex.EncodeToString(r.config.Key),
+		AllowInsecure: r.config.TLSConfig == nil,
+	})
+}
+
+// NewRemoteHandler returns a new upstream handler for a Network Server outside of the cluster.
+// The connection is established when the handler is set up, and closed when the setup context is done.
+// As the Network Server cannot reach the Gateway Server through its cluster, the handler does not claim the
+// downlink path of connected gateways and does not report their downlink load. The Network Server can therefore
+// not schedule downlink messages, including join-accepts, so routing rules may not route join-requests only to
+// remote Network Servers.
+func NewRemoteHandler(ctx context.Context, conf RemoteConfig) *Handler {
+	return &Handler{
+		ctx:    ctx,
+		remote: &remote{config: conf},
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// FileName is the name of the routing configuration file.
+const FileName = "routing.yml"
+
+var errFetch = errors.DefineUnavailable("fetch", "fetch routing configuration")
+
+// Router routes uplink messages with the routing table of the routing configuration file.
+// The routing table is replaced when the configuration is reloaded. The zero value routes no uplink messages.
+type Router struct {
+	fetcher   fetch.Interface
+	upstreams []Upstream
+	table     atomic.Pointer[Table]
+}
+
+// NewRouter returns a new Router that fetches the routing configuration with the given fetcher.
+// The routing rules may only refer to the given upstream hosts.
+// The routing configuration is not loaded until Reload is called.
+func NewRouter(fetcher fetch.Interface, upstreams ...Upstream) *Router {
+	return &Router{
+		fetcher:   fetcher,
+		upstreams: upstreams,
+	}
+}
+
+// Reload fetches and parses the routing configuration, and replaces the routing table.
+// If the routing configuration cannot be fetched or is invalid, the current routing table is kept.
+func (r *Router) Reload() error {
+	data, err := r.fetcher.File(FileName)
+	if err != nil {
+		return errFetch.WithCause(err)
+	}
+	table, err := Parse(data, r.upstreams...)
+	if err != nil {
+		return err
+	}
+	r.table.Store(table)
+	return nil
+}
+
+// Run reloads the routing configuration in the given interval until the context is done.
+// Failures to reload are logged and do not stop the router.
+func (r *Router) Run(ctx context.Context, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		if err := r.Reload(); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to reload routing configuration")
+		}
+	}
+}
+
+// Table returns the current routing table.
+func (r *Router) Table() *Table {
+	if r == nil {
+		return nil
+	}
+	return r.table.Load()
+}
+
+// Match returns the first route of the current routing table that matches the uplink message of the end device,
+// received by the gateway at the given time. If no route matches, Match returns nil, false.
+func (r *Router) Match(gtw *ttnpb.Gateway, ids *ttnpb.EndDeviceIdentifiers, at time.Time) (*Route, bool) {
+	return r.Table().Match(gtw, ids, at)
+}
@@ -0,0 +1,325 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package routing implements the routing rules of uplink messages to upstream hosts.
+package routing
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"gopkg.in/yaml.v2"
+)
+
+// EUIRange is an inclusive range of EUIs.
+type EUIRange struct {
+	From string `yaml:"from"`
+	To   string `yaml:"to"`
+}
+
+// TimeWindow is a daily time window. The window wraps around midnight if the end is before the start.
+type TimeWindow struct {
+	// From is the start of the window in the format 15:04.
+	From string `yaml:"from"`
+	// To is the end of the window in the format 15:04.
+	To string `yaml:"to"`
+	// TimeZone is the IANA time zone of the window. The default is UTC.
+	TimeZone string `yaml:"time-zone"`
+}
+
+// Rule is a routing rule.
+// An uplink message matches the rule if it matches all the configured conditions of the rule.
+type Rule struct {
+	// Name is the name of the rule. It is used in logs and metrics.
+	Name string `yaml:"name"`
+	// Upstreams are the names of the upstream hosts that the matching uplink messages are forwarded to.
+	Upstreams []string `yaml:"upstreams"`
+	// DevAddrPrefixes are the DevAddr prefixes of data uplink messages.
+	DevAddrPrefixes []string `yaml:"dev-addr-prefixes"`
+	// JoinEUIRanges are the JoinEUI ranges of join-request messages.
+	JoinEUIRanges []EUIRange `yaml:"join-eui-ranges"`
+	// GatewayAttributes are the attributes that the gateway must have.
+	GatewayAttributes map[string]string `yaml:"gateway-attributes"`
+	// FrequencyPlanIDs are the frequency plans of which the gateway must use at least one.
+	FrequencyPlanIDs []string `yaml:"frequency-plan-ids"`
+	// TimeWindow is the time window in which the uplink message must be received.
+	TimeWindow *TimeWindow `yaml:"time-window"`
+}
+
+// Upstream is an upstream host that routing rules can refer to.
+type Upstream struct {
+	// Name is the name of the upstream host.
+	Name string
+	// UplinkOnly indicates that the upstream host cannot schedule downlink messages through the Gateway Server.
+	UplinkOnly bool
+}
+
+// Config is the routing configuration, as defined in routing.yml.
+type Config struct {
+	Rules []Rule `yaml:"rules"`
+}
+
+var (
+	errRuleName        = errors.DefineInvalidArgument("rule_name", "rule `{index}` has no name")
+	errDuplicateRule   = errors.DefineInvalidArgument("duplicate_rule", "duplicate rule `{name}`")
+	errNoUpstreams     = errors.DefineInvalidArgument("no_upstreams", "rule `{name}` has no upstreams")
+	errUnknownUpstream = errors.DefineInvalidArgument(
+		"unknown_upstream", "unknown upstream `{upstream}` in rule `{name}`",
+	)
+	errUplinkOnlyJoinRequests = errors.DefineInvalidArgument(
+		"uplink_only_join_requests",
+		"rule `{name}` routes join-requests only to upstreams that cannot schedule downlink messages",
+	)
+	errDevAddrPrefix = errors.DefineInvalidArgument(
+		"dev_addr_prefix", "invalid DevAddr prefix `{prefix}` in rule `{name}`",
+	)
+	errJoinEUIRange = errors.DefineInvalidArgument(
+		"join_eui_range", "invalid JoinEUI range from `{from}` to `{to}` in rule `{name}`",
+	)
+	errTimeWindow = errors.DefineInvalidArgument("time_window", "invalid time window in rule `{name}`")
+	errParse      = errors.DefineInvalidArgument("parse", "parse routing configuration")
+)
+
+type euiRange struct {
+	from, to uint64
+}
+
+type timeWindow struct {
+	from, to time.Duration
+	location *time.Location
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+func (w timeWindow) contains(t time.Time) bool {
+	t = t.In(w.location)
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+	if w.from <= w.to {
+		return offset >= w.from && offset < w.to
+	}
+	return offset >= w.from || offset < w.to
+}
+
+// Route is a compiled routing rule.
+type Route struct {
+	// Name is the name of the rule.
+	Name string
+	// Upstreams are the names of the upstream hosts.
+	Upstreams []string
+
+	devAddrPrefixes   []types.DevAddrPrefix
+	joinEUIRanges     []euiRange
+	gatewayAttributes map[string]string
+	frequencyPlanIDs  []string
+	timeWindow        *timeWindow
+}
+
+func newRoute(rule Rule, upstreams map[string]Upstream) (*Route, error) {
+	if len(rule.Upstreams) == 0 {
+		return nil, errNoUpstreams.WithAttributes("name", rule.Name)
+	}
+	uplinkOnly := true
+	for _, name := range rule.Upstreams {
+		upstream, ok := upstreams[name]
+		if !ok {
+			return nil, errUnknownUpstream.WithAttributes("name", rule.Name, "upstream", name)
+		}
+		uplinkOnly = uplinkOnly && upstream.UplinkOnly
+	}
+	// The join-accept of a join-request can only be delivered by an upstream host that schedules downlink messages
+	// through the Gateway Server. Rules without DevAddr prefixes also match join-requests.
+	if uplinkOnly && (len(rule.JoinEUIRanges) > 0 || len(rule.DevAddrPrefixes) == 0) {
+		return nil, errUplinkOnlyJoinRequests.WithAttributes("name", rule.Name)
+	}
+	route := &Route{
+		Name:              rule.Name,
+		Upstreams:         rule.Upstreams,
+		gatewayAttributes: rule.GatewayAttributes,
+		frequencyPlanIDs:  rule.FrequencyPlanIDs,
+	}
+	for _, s := range rule.DevAddrPrefixes {
+		var prefix types.DevAddrPrefix
+		if err := prefix.UnmarshalText([]byte(s)); err != nil {
+			return nil, errDevAddrPrefix.WithCause(err).WithAttributes("name", rule.Name, "prefix", s)
+		}
+		route.devAddrPrefixes = append(route.devAddrPrefixes, prefix)
+	}
+	for _, r := range rule.JoinEUIRanges {
+		var from, to types.EUI64
+		if err := from.UnmarshalText([]byte(r.From)); err != nil {
+			return nil, errJoinEUIRange.WithCause(err).WithAttributes("name", rule.Name, "from", r.From, "to", r.To)
+		}
+		if err := to.UnmarshalText([]byte(r.To)); err != nil {
+			return nil, errJoinEUIRange.WithCause(err).WithAttributes("name", rule.Name, "from", r.From, "to", r.To)
+		}
+		if from.MarshalNumber() > to.MarshalNumber() {
+			return nil, errJoinEUIRange.WithAttributes("name", rule.Name, "from", r.From, "to", r.To)
+		}
+		route.joinEUIRanges = append(route.joinEUIRanges, euiRange{from.MarshalNumber(), to.MarshalNumber()})
+	}
+	if w := rule.TimeWindow; w != nil {
+		from, err := parseTimeOfDay(w.From)
+		if err != nil {
+			return nil, errTimeWindow.WithCause(err).WithAttributes("name", rule.Name)
+		}
+		to, err := parseTimeOfDay(w.To)
+		if err != nil {
+			return nil, errTimeWindow.WithCause(err).WithAttributes("name", rule.Name)
+		}
+		location := time.UTC
+		if w.TimeZone != "" {
+			if location, err = time.LoadLocation(w.TimeZone); err != nil {
+				return nil, errTimeWindow.WithCause(err).WithAttributes("name", rule.Name)
+			}
+		}
+		route.timeWindow = &timeWindow{from: from, to: to, location: location}
+	}
+	return route, nil
+}
+
+// HasUpstream returns whether the route forwards to the upstream host with the given name.
+func (r *Route) HasUpstream(name string) bool {
+	for _, upstream := range r.Upstreams {
+		if upstream == name {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesDevice returns whether the end device identifiers match the DevAddr prefixes or JoinEUI ranges.
+// If neither are configured, all end devices match.
+func (r *Route) matchesDevice(ids *ttnpb.EndDeviceIdentifiers) bool {
+	if len(r.devAddrPrefixes) == 0 && len(r.joinEUIRanges) == 0 {
+		return true
+	}
+	if devAddr := ids.GetDevAddr(); devAddr != nil {
+		for _, prefix := range r.devAddrPrefixes {
+			if types.MustDevAddr(devAddr).HasPrefix(prefix) {
+				return true
+			}
+		}
+	}
+	if joinEUI := ids.GetJoinEui(); joinEUI != nil {
+		n := types.MustEUI64(joinEUI).MarshalNumber()
+		for _, r := range r.joinEUIRanges {
+			if n >= r.from && n <= r.to {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *Route) matchesGateway(gtw *ttnpb.Gateway) bool {
+	for k, v := range r.gatewayAttributes {
+		if actual, ok := gtw.GetAttributes()[k]; !ok || actual != v {
+			return false
+		}
+	}
+	if len(r.frequencyPlanIDs) == 0 {
+		return true
+	}
+	gtwFrequencyPlanIDs := gtw.GetFrequencyPlanIds()
+	if len(gtwFrequencyPlanIDs) == 0 {
+		gtwFrequencyPlanIDs = []string{gtw.GetFrequencyPlanId()}
+	}
+	for _, id := range r.frequencyPlanIDs {
+		for _, gtwID := range gtwFrequencyPlanIDs {
+			if id == gtwID {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Matches returns whether the uplink message of the end device, received by the gateway at the given time,
+// matches the route.
+func (r *Route) Matches(gtw *ttnpb.Gateway, ids *ttnpb.EndDeviceIdentifiers, t time.Time) bool {
+	return r.matchesDevice(ids) &&
+		r.matchesGateway(gtw) &&
+		(r.timeWindow == nil || r.timeWindow.contains(t))
+}
+
+// Table is an ordered list of routes.
+type Table struct {
+	routes []*Route
+}
+
+// NewTable returns a new routing table with the routes of the given rules.
+// The rules may only refer to the given upstream hosts. Rules that match join-requests must refer to at least one
+// upstream host that is not uplink only, as the join-accept cannot be delivered otherwise.
+func NewTable(rules []Rule, upstreams ...Upstream) (*Table, error) {
+	knownUpstreams := make(map[string]Upstream, len(upstreams))
+	for _, upstream := range upstreams {
+		knownUpstreams[upstream.Name] = upstream
+	}
+	names := make(map[string]bool, len(rules))
+	table := &Table{
+		routes: make([]*Route, 0, len(rules)),
+	}
+	for i, rule := range rules {
+		if rule.Name == "" {
+			return nil, errRuleName.WithAttributes("index", i)
+		}
+		if names[rule.Name] {
+			return nil, errDuplicateRule.WithAttributes("name", rule.Name)
+		}
+		names[rule.Name] = true
+		route, err := newRoute(rule, knownUpstreams)
+		if err != nil {
+			return nil, err
+		}
+		table.routes = append(table.routes, route)
+	}
+	return table, nil
+}
+
+// Parse parses the routing configuration in YAML format and returns the routing table.
+func Parse(data []byte, upstreams ...Upstream) (*Table, error) {
+	var config Config
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, errParse.WithCause(err)
+	}
+	return NewTable(config.Rules, upstreams...)
+}
+
+// Routes returns the routes in the table.
+func (t *Table) Routes() []*Route {
+	if t == nil {
+		return nil
+	}
+	return t.routes
+}
+
+// Match returns the first route that matches the uplink message of the end device, received by the gateway at the
+// given time. If no route matches, Match returns nil, false.
+func (t *Table) Match(gtw *ttnpb.Gateway, ids *ttnpb.EndDeviceIdentifiers, at time.Time) (*Route, bool) {
+	for _, route := range t.Routes() {
+		if route.Matches(gtw, ids, at) {
+			return route, true
+		}
+	}
+	return nil, false
+}
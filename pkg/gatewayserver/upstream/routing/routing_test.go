@@ -0,0 +1,318 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package routing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/routing"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var testUpstreams = []routing.Upstream{
+	{Name: "cluster"},
+	{Name: "packetbroker"},
+	{Name: "remote", UplinkOnly: true},
+}
+
+const testConfig = `rules:
+- name: eu-joins
+  upstreams: [cluster]
+  join-eui-ranges:
+  - from: 70B3D57ED0000000
+    to: 70B3D57ED0FFFFFF
+  gateway-attributes:
+    region: eu
+- name: eu-data
+  upstreams: [cluster, packetbroker]
+  dev-addr-prefixes: [26000000/7]
+  frequency-plan-ids: [EU_863_870, EU_863_870_TTN]
+- name: night
+  upstreams: [packetbroker]
+  time-window:
+    from: "22:00"
+    to: "06:00"
+    time-zone: Europe/Amsterdam
+`
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Name   string
+		Config string
+		Routes []string
+		Error  bool
+	}{
+		{
+			Name:   "Empty",
+			Config: "",
+		},
+		{
+			Name:   "Valid",
+			Config: testConfig,
+			Routes: []string{"eu-joins", "eu-data", "night"},
+		},
+		{
+			Name:   "UnknownField",
+			Config: "rules:\n- name: test\n  upstreams: [cluster]\n  unknown: true\n",
+			Error:  true,
+		},
+		{
+			Name:   "NoName",
+			Config: "rules:\n- upstreams: [cluster]\n",
+			Error:  true,
+		},
+		{
+			Name:   "DuplicateName",
+			Config: "rules:\n- name: test\n  upstreams: [cluster]\n- name: test\n  upstreams: [cluster]\n",
+			Error:  true,
+		},
+		{
+			Name:   "NoUpstreams",
+			Config: "rules:\n- name: test\n",
+			Error:  true,
+		},
+		{
+			Name:   "UnknownUpstream",
+			Config: "rules:\n- name: test\n  upstreams: [other]\n",
+			Error:  true,
+		},
+		{
+			Name:   "UplinkOnlyData",
+			Config: "rules:\n- name: test\n  upstreams: [remote]\n  dev-addr-prefixes: [26000000/7]\n",
+			Routes: []string{"test"},
+		},
+		{
+			Name: "UplinkOnlyJoinRequests",
+			Config: "rules:\n- name: test\n  upstreams: [remote]\n  join-eui-ranges:\n" +
+				"  - from: 70B3D57ED0000000\n    to: 70B3D57ED0FFFFFF\n",
+			Error: true,
+		},
+		{
+			Name:   "UplinkOnlyAllDevices",
+			Config: "rules:\n- name: test\n  upstreams: [remote]\n",
+			Error:  true,
+		},
+		{
+			Name: "UplinkOnlyJoinRequestsWithCluster",
+			Config: "rules:\n- name: test\n  upstreams: [cluster, remote]\n  join-eui-ranges:\n" +
+				"  - from: 70B3D57ED0000000\n    to: 70B3D57ED0FFFFFF\n",
+			Routes: []string{"test"},
+		},
+		{
+			Name:   "InvalidDevAddrPrefix",
+			Config: "rules:\n- name: test\n  upstreams: [cluster]\n  dev-addr-prefixes: [invalid]\n",
+			Error:  true,
+		},
+		{
+			Name: "InvalidJoinEUIRange",
+			Config: "rules:\n- name: test\n  upstreams: [cluster]\n  join-eui-ranges:\n" +
+				"  - from: 70B3D57ED0FFFFFF\n    to: 70B3D57ED0000000\n",
+			Error: true,
+		},
+		{
+			Name:   "InvalidTimeWindow",
+			Config: "rules:\n- name: test\n  upstreams: [cluster]\n  time-window:\n    from: \"25:00\"\n    to: \"06:00\"\n",
+			Error:  true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			table, err := routing.Parse([]byte(tc.Config), testUpstreams...)
+			if tc.Error {
+				a.So(err, should.NotBeNil)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			var names []string
+			for _, route := range table.Routes() {
+				names = append(names, route.Name)
+			}
+			a.So(names, should.Resemble, tc.Routes)
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	table, err := routing.Parse([]byte(testConfig), testUpstreams...)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	euGateway := &ttnpb.Gateway{
+		Ids:              &ttnpb.GatewayIdentifiers{GatewayId: "eu-gateway"},
+		Attributes:       map[string]string{"region": "eu"},
+		FrequencyPlanId:  "EU_863_870",
+		FrequencyPlanIds: []string{"EU_863_870"},
+	}
+	usGateway := &ttnpb.Gateway{
+		Ids:             &ttnpb.GatewayIdentifiers{GatewayId: "us-gateway"},
+		Attributes:      map[string]string{"region": "us"},
+		FrequencyPlanId: "US_902_928_FSB_2",
+	}
+	join := func(joinEUI types.EUI64) *ttnpb.EndDeviceIdentifiers {
+		return &ttnpb.EndDeviceIdentifiers{
+			JoinEui: joinEUI.Bytes(),
+			DevEui:  types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}.Bytes(),
+		}
+	}
+	data := func(devAddr types.DevAddr) *ttnpb.EndDeviceIdentifiers {
+		return &ttnpb.EndDeviceIdentifiers{
+			DevAddr: devAddr.Bytes(),
+		}
+	}
+	day := time.Date(2023, time.March, 1, 12, 0, 0, 0, time.UTC)
+	night := time.Date(2023, time.March, 1, 23, 30, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		Name    string
+		Gateway *ttnpb.Gateway
+		IDs     *ttnpb.EndDeviceIdentifiers
+		Time    time.Time
+		Route   string
+	}{
+		{
+			Name:    "JoinInRange",
+			Gateway: euGateway,
+			IDs:     join(types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x12, 0x34, 0x56}),
+			Time:    day,
+			Route:   "eu-joins",
+		},
+		{
+			Name:    "JoinOutOfRange",
+			Gateway: euGateway,
+			IDs:     join(types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd1, 0x00, 0x00, 0x00}),
+			Time:    day,
+		},
+		{
+			Name:    "JoinOtherGatewayAttributes",
+			Gateway: usGateway,
+			IDs:     join(types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x12, 0x34, 0x56}),
+			Time:    day,
+		},
+		{
+			Name:    "DataInPrefix",
+			Gateway: euGateway,
+			IDs:     data(types.DevAddr{0x27, 0x00, 0x00, 0x01}),
+			Time:    day,
+			Route:   "eu-data",
+		},
+		{
+			Name:    "DataOtherFrequencyPlan",
+			Gateway: usGateway,
+			IDs:     data(types.DevAddr{0x27, 0x00, 0x00, 0x01}),
+			Time:    day,
+		},
+		{
+			Name:    "DataNotInPrefix",
+			Gateway: euGateway,
+			IDs:     data(types.DevAddr{0x01, 0x00, 0x00, 0x01}),
+			Time:    day,
+		},
+		{
+			Name:    "NightFallback",
+			Gateway: usGateway,
+			IDs:     data(types.DevAddr{0x01, 0x00, 0x00, 0x01}),
+			Time:    night,
+			Route:   "night",
+		},
+		{
+			Name:    "NightOrder",
+			Gateway: euGateway,
+			IDs:     data(types.DevAddr{0x27, 0x00, 0x00, 0x01}),
+			Time:    night,
+			Route:   "eu-data",
+		},
+		{
+			Name:    "EarlyMorning",
+			Gateway: usGateway,
+			IDs:     data(types.DevAddr{0x01, 0x00, 0x00, 0x01}),
+			Time:    time.Date(2023, time.March, 1, 4, 59, 0, 0, time.UTC),
+			Route:   "night",
+		},
+		{
+			Name:    "Morning",
+			Gateway: usGateway,
+			IDs:     data(types.DevAddr{0x01, 0x00, 0x00, 0x01}),
+			Time:    time.Date(2023, time.March, 1, 5, 0, 0, 0, time.UTC),
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			route, ok := table.Match(tc.Gateway, tc.IDs, tc.Time)
+			if tc.Route == "" {
+				a.So(ok, should.BeFalse)
+				return
+			}
+			if a.So(ok, should.BeTrue) {
+				a.So(route.Name, should.Equal, tc.Route)
+			}
+		})
+	}
+
+	route, _ := table.Match(euGateway, data(types.DevAddr{0x27, 0x00, 0x00, 0x01}), day)
+	a.So(route.HasUpstream("cluster"), should.BeTrue)
+	a.So(route.HasUpstream("packetbroker"), should.BeTrue)
+	route, _ = table.Match(usGateway, data(types.DevAddr{0x01, 0x00, 0x00, 0x01}), night)
+	a.So(route.HasUpstream("cluster"), should.BeFalse)
+}
+
+func TestRouter(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	files := map[string][]byte{}
+	router := routing.NewRouter(fetch.NewMemFetcher(files), routing.Upstream{Name: "cluster"})
+	gtw := &ttnpb.Gateway{Ids: &ttnpb.GatewayIdentifiers{GatewayId: "test-gateway"}}
+	ids := &ttnpb.EndDeviceIdentifiers{DevAddr: types.DevAddr{0x01, 0x02, 0x03, 0x04}.Bytes()}
+
+	// No routing configuration.
+	a.So(router.Reload(), should.NotBeNil)
+	_, ok := router.Match(gtw, ids, time.Now())
+	a.So(ok, should.BeFalse)
+
+	files[routing.FileName] = []byte("rules:\n- name: all\n  upstreams: [cluster]\n")
+	a.So(router.Reload(), should.BeNil)
+	route, ok := router.Match(gtw, ids, time.Now())
+	if a.So(ok, should.BeTrue) {
+		a.So(route.Name, should.Equal, "all")
+	}
+
+	// Invalid routing configuration keeps the current routing table.
+	files[routing.FileName] = []byte("rules:\n- name: all\n  upstreams: [packetbroker]\n")
+	a.So(router.Reload(), should.NotBeNil)
+	route, ok = router.Match(gtw, ids, time.Now())
+	if a.So(ok, should.BeTrue) {
+		a.So(route.Name, should.Equal, "all")
+	}
+
+	files[routing.FileName] = []byte("rules:\n- name: other\n  upstreams: [cluster]\n  dev-addr-prefixes: [26000000/7]\n")
+	a.So(router.Reload(), should.BeNil)
+	_, ok = router.Match(gtw, ids, time.Now())
+	a.So(ok, should.BeFalse)
+}
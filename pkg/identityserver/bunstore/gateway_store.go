@@ -96,6 +96,10 @@ type Gateway struct {
 	SupportsLRFHSS bool `bun:"supports_lrfhss,notnull"`
 
 	DisablePacketBrokerForwarding bool `bun:"disable_packet_broker_forwarding,notnull"`
+
+	ClientCertificateFingerprint []byte `bun:"client_certificate_fingerprint,nullzero"`
+
+	UDPPreSharedKey []byte `bun:"udp_pre_shared_key,nullzero"`
 }
 
 // BeforeAppendModel is a hook that modifies the model on SELECT and UPDATE queries.
@@ -176,6 +180,10 @@ func gatewayToPB(m *Gateway, fieldMask ...string) (*ttnpb.Gateway, error) {
 		}(),
 
 		DisablePacketBrokerForwarding: m.DisablePacketBrokerForwarding,
+
+		ClientCertificateFingerprint: m.ClientCertificateFingerprint,
+
+		UdpPreSharedKey: secretFromBytes(m.UDPPreSharedKey),
 	}
 
 	if len(m.Attributes) > 0 {
@@ -286,6 +294,10 @@ func (s *gatewayStore) CreateGateway(
 		SupportsLRFHSS: pb.Lrfhss.GetSupported(),
 
 		DisablePacketBrokerForwarding: pb.DisablePacketBrokerForwarding,
+
+		ClientCertificateFingerprint: pb.ClientCertificateFingerprint,
+
+		UDPPreSharedKey: secretToBytes(pb.UdpPreSharedKey),
 	}
 
 	if contact := pb.AdministrativeContact; contact != nil {
@@ -377,7 +389,9 @@ func (*gatewayStore) selectWithFields(q *bun.SelectQuery, fieldMask store.FieldM
 				"lbs_lns_secret",
 				"target_cups_uri", "target_cups_key",
 				"require_authenticated_connection",
-				"disable_packet_broker_forwarding":
+				"disable_packet_broker_forwarding",
+				"client_certificate_fingerprint",
+				"udp_pre_shared_key":
 				// Proto name equals model name.
 				columns = append(columns, f)
 			case "version_ids":
@@ -754,6 +768,14 @@ func (s *gatewayStore) updateGatewayModel( //nolint:gocyclo
 		case "disable_packet_broker_forwarding":
 			model.DisablePacketBrokerForwarding = pb.DisablePacketBrokerForwarding
 			columns = append(columns, "disable_packet_broker_forwarding")
+
+		case "client_certificate_fingerprint":
+			model.ClientCertificateFingerprint = pb.ClientCertificateFingerprint
+			columns = append(columns, "client_certificate_fingerprint")
+
+		case "udp_pre_shared_key":
+			model.UDPPreSharedKey = secretToBytes(pb.UdpPreSharedKey)
+			columns = append(columns, "udp_pre_shared_key")
 		}
 	}
 
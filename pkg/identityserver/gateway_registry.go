@@ -18,6 +18,7 @@ import (
 	"context"
 	"time"
 
+	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
@@ -69,8 +70,13 @@ var (
 	errGatewayEUITaken         = errors.DefineAlreadyExists("gateway_eui_taken", "a gateway with EUI `{gateway_eui}` is already registered (by you or someone else) as `{gateway_id}`", "administrative_contact")
 	errAdminsPurgeGateways     = errors.DefinePermissionDenied("admins_purge_gateways", "gateways may only be purged by admins")
 	errClaimAuthenticationCode = errors.DefineInvalidArgument("claim_authentication_code", "invalid claim authentication code")
+	errUDPPreSharedKeyLength   = errors.DefineInvalidArgument("udp_pre_shared_key_length", "UDP pre-shared key must be at least `{min}` bytes long")
 )
 
+// minUDPPreSharedKeyLength is the minimum length of UDP pre-shared keys in bytes.
+// The Gateway Server rejects the packets of gateways with shorter keys.
+const minUDPPreSharedKeyLength = 16
+
 func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGatewayRequest) (gtw *ttnpb.Gateway, err error) {
 	reqGtw := req.GetGateway()
 	if err = blocklist.Check(ctx, reqGtw.GetIds().GetGatewayId()); err != nil {
@@ -135,6 +141,23 @@ func (is *IdentityServer) createGateway(ctx context.Context, req *ttnpb.CreateGa
 		reqGtw.TargetCupsKey.KeyId = is.config.Gateways.EncryptionKeyID
 	}
 
+	if reqGtw.UdpPreSharedKey != nil {
+		if err = validateUDPPreSharedKey(reqGtw.UdpPreSharedKey); err != nil {
+			return nil, err
+		}
+		value := reqGtw.UdpPreSharedKey.Value
+		if is.config.Gateways.EncryptionKeyID != "" {
+			value, err = is.KeyService().Encrypt(ctx, reqGtw.UdpPreSharedKey.Value, is.config.Gateways.EncryptionKeyID)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			log.FromContext(ctx).Warn("No encryption key defined, store UDP Pre-Shared Key in plaintext")
+		}
+		reqGtw.UdpPreSharedKey.Value = value
+		reqGtw.UdpPreSharedKey.KeyId = is.config.Gateways.EncryptionKeyID
+	}
+
 	if reqGtw.ClaimAuthenticationCode != nil {
 		if err = validateClaimAuthenticationCode(reqGtw.ClaimAuthenticationCode); err != nil {
 			return nil, err
@@ -225,6 +248,13 @@ func (is *IdentityServer) getGateway(ctx context.Context, req *ttnpb.GetGatewayR
 		}
 	}
 
+	// The Gateway Server reads the UDP pre-shared key with cluster authentication when the gateway connects.
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "udp_pre_shared_key") && clusterauth.Authorized(ctx) != nil {
+		if err = rights.RequireGateway(ctx, req.GetGatewayIds(), ttnpb.Right_RIGHT_GATEWAY_READ_SECRETS); err != nil {
+			return nil, err
+		}
+	}
+
 	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
 		gtw, err = st.GetGateway(ctx, req.GetGatewayIds(), req.FieldMask.GetPaths())
 		if err != nil {
@@ -289,6 +319,20 @@ func (is *IdentityServer) getGateway(ctx context.Context, req *ttnpb.GetGatewayR
 		gtw.TargetCupsKey.KeyId = is.config.Gateways.EncryptionKeyID
 	}
 
+	if gtw.UdpPreSharedKey != nil {
+		value := gtw.UdpPreSharedKey.Value
+		if gtw.UdpPreSharedKey.KeyId != "" {
+			value, err = is.KeyService().Decrypt(ctx, gtw.UdpPreSharedKey.Value, gtw.UdpPreSharedKey.KeyId)
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			log.FromContext(ctx).Warn("No encryption key defined, return stored UDP Pre-Shared Key value")
+		}
+		gtw.UdpPreSharedKey.Value = value
+		gtw.UdpPreSharedKey.KeyId = is.config.Gateways.EncryptionKeyID
+	}
+
 	return gtw, nil
 }
 
@@ -439,6 +483,25 @@ func (is *IdentityServer) listGateways(ctx context.Context, req *ttnpb.ListGatew
 			}
 		}
 
+		if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "udp_pre_shared_key") {
+			if !entityRights.IncludesAll(ttnpb.Right_RIGHT_GATEWAY_READ_SECRETS) {
+				gtws.Gateways[i].UdpPreSharedKey = nil
+			} else if gtws.Gateways[i].UdpPreSharedKey != nil {
+				value := gtws.Gateways[i].UdpPreSharedKey.Value
+				if gtws.Gateways[i].UdpPreSharedKey.KeyId != "" {
+					value, err = is.KeyService().Decrypt(ctx, gtws.Gateways[i].UdpPreSharedKey.Value, gtws.Gateways[i].UdpPreSharedKey.KeyId)
+					if err != nil {
+						return nil, err
+					}
+				} else {
+					logger := log.FromContext(ctx)
+					logger.Warn("No encryption key defined, return stored UDP Pre-Shared Key value")
+				}
+				gtws.Gateways[i].UdpPreSharedKey.Value = value
+				gtws.Gateways[i].UdpPreSharedKey.KeyId = is.config.Gateways.EncryptionKeyID
+			}
+		}
+
 		if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "claim_authentication_code") {
 			if !entityRights.IncludesAll(ttnpb.Right_RIGHT_GATEWAY_READ_SECRETS) {
 				gtws.Gateways[i].ClaimAuthenticationCode = nil
@@ -472,7 +535,7 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 	}
 
 	// Store plaintext values to return in the response to clients.
-	var ptLBSLNSSecret, ptCACSecret, ptTargetCUPSKeySecret []byte
+	var ptLBSLNSSecret, ptCACSecret, ptTargetCUPSKeySecret, ptUDPPreSharedKey []byte
 
 	// Backwards compatibility for frequency_plan_id field.
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "frequency_plan_id") {
@@ -495,6 +558,12 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 	}
 	req.FieldMask.Paths = ttnpb.FlattenPaths(req.FieldMask.Paths, []string{"administrative_contact", "technical_contact"})
 
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "client_certificate_fingerprint") {
+		if err := rights.RequireGateway(ctx, reqGtw.GetIds(), ttnpb.Right_RIGHT_GATEWAY_WRITE_SECRETS); err != nil {
+			return nil, err
+		}
+	}
+
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "lbs_lns_secret") {
 		if err := rights.RequireGateway(ctx, reqGtw.GetIds(), ttnpb.Right_RIGHT_GATEWAY_WRITE_SECRETS); err != nil {
 			return nil, err
@@ -535,6 +604,29 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 		}
 	}
 
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "udp_pre_shared_key") {
+		if err := rights.RequireGateway(ctx, reqGtw.GetIds(), ttnpb.Right_RIGHT_GATEWAY_WRITE_SECRETS); err != nil {
+			return nil, err
+		} else if reqGtw.UdpPreSharedKey != nil {
+			if err := validateUDPPreSharedKey(reqGtw.UdpPreSharedKey); err != nil {
+				return nil, err
+			}
+			value := reqGtw.UdpPreSharedKey.Value
+			ptUDPPreSharedKey = reqGtw.UdpPreSharedKey.Value
+			if is.config.Gateways.EncryptionKeyID != "" {
+				value, err = is.KeyService().Encrypt(ctx, reqGtw.UdpPreSharedKey.Value, is.config.Gateways.EncryptionKeyID)
+				if err != nil {
+					return nil, err
+				}
+			} else {
+				logger := log.FromContext(ctx)
+				logger.Warn("No encryption key defined, store UDP Pre-Shared Key in plaintext")
+			}
+			reqGtw.UdpPreSharedKey.Value = value
+			reqGtw.UdpPreSharedKey.KeyId = is.config.Gateways.EncryptionKeyID
+		}
+	}
+
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "claim_authentication_code") {
 		if err := rights.RequireGateway(ctx, reqGtw.GetIds(), ttnpb.Right_RIGHT_GATEWAY_WRITE_SECRETS); err != nil {
 			return nil, err
@@ -592,6 +684,9 @@ func (is *IdentityServer) updateGateway(ctx context.Context, req *ttnpb.UpdateGa
 	if len(ptTargetCUPSKeySecret) != 0 {
 		gtw.TargetCupsKey.Value = ptTargetCUPSKeySecret
 	}
+	if len(ptUDPPreSharedKey) != 0 {
+		gtw.UdpPreSharedKey.Value = ptUDPPreSharedKey
+	}
 
 	return gtw, nil
 }
@@ -677,6 +772,13 @@ func validateClaimAuthenticationCode(authCode *ttnpb.GatewayClaimAuthenticationC
 	return nil
 }
 
+func validateUDPPreSharedKey(key *ttnpb.Secret) error {
+	if len(key.Value) < minUDPPreSharedKeyLength {
+		return errUDPPreSharedKeyLength.WithAttributes("min", minUDPPreSharedKeyLength)
+	}
+	return nil
+}
+
 type gatewayRegistry struct {
 	ttnpb.UnimplementedGatewayRegistryServer
 
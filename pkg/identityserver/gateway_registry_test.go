@@ -207,6 +207,28 @@ func TestGatewaysCRUD(t *testing.T) {
 			a.So(updated.Name, should.Equal, "Updated Name")
 		}
 
+		_, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: &ttnpb.Gateway{
+				Ids:             created.GetIds(),
+				UdpPreSharedKey: &ttnpb.Secret{Value: []byte("too short")},
+			},
+			FieldMask: ttnpb.FieldMask("udp_pre_shared_key"),
+		}, creds)
+		if a.So(err, should.NotBeNil) {
+			a.So(err, should.HaveSameErrorDefinitionAs, errUDPPreSharedKeyLength)
+		}
+
+		updated, err = reg.Update(ctx, &ttnpb.UpdateGatewayRequest{
+			Gateway: &ttnpb.Gateway{
+				Ids:             created.GetIds(),
+				UdpPreSharedKey: &ttnpb.Secret{Value: []byte("0123456789ABCDEF")},
+			},
+			FieldMask: ttnpb.FieldMask("udp_pre_shared_key"),
+		}, creds)
+		if a.So(err, should.BeNil) && a.So(updated, should.NotBeNil) {
+			a.So(updated.UdpPreSharedKey.GetValue(), should.Resemble, []byte("0123456789ABCDEF"))
+		}
+
 		for _, collaborator := range []*ttnpb.OrganizationOrUserIdentifiers{nil, usr1.GetOrganizationOrUserIdentifiers()} {
 			list, err := reg.List(ctx, &ttnpb.ListGatewaysRequest{
 				FieldMask:    ttnpb.FieldMask("name"),
@@ -0,0 +1 @@
+ALTER TABLE gateways DROP COLUMN client_certificate_fingerprint;
@@ -0,0 +1,2 @@
+ALTER TABLE gateways
+ADD COLUMN client_certificate_fingerprint BYTEA NULL;
@@ -0,0 +1 @@
+ALTER TABLE gateways DROP COLUMN udp_pre_shared_key;
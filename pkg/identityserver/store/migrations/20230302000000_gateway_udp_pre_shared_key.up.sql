@@ -0,0 +1,2 @@
+ALTER TABLE gateways
+ADD COLUMN udp_pre_shared_key BYTEA NULL;
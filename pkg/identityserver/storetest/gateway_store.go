@@ -15,6 +15,7 @@
 package storetest
 
 import (
+	"bytes"
 	"fmt"
 	. "testing"
 	"time"
@@ -100,6 +101,8 @@ func (st *StoreTest) TestGatewayStoreCRUD(t *T) {
 			RequireAuthenticatedConnection: true,
 			Lrfhss:                         &ttnpb.Gateway_LRFHSS{Supported: true},
 			DisablePacketBrokerForwarding:  true,
+			ClientCertificateFingerprint:   bytes.Repeat([]byte{0x01}, 32),
+			UdpPreSharedKey:                secret,
 		})
 
 		if a.So(err, should.BeNil) && a.So(created, should.NotBeNil) {
@@ -137,6 +140,8 @@ func (st *StoreTest) TestGatewayStoreCRUD(t *T) {
 			a.So(created.RequireAuthenticatedConnection, should.BeTrue)
 			a.So(created.Lrfhss.Supported, should.BeTrue)
 			a.So(created.DisablePacketBrokerForwarding, should.BeTrue)
+			a.So(created.ClientCertificateFingerprint, should.Resemble, bytes.Repeat([]byte{0x01}, 32))
+			a.So(created.UdpPreSharedKey, should.Resemble, secret)
 			a.So(*ttnpb.StdTime(created.CreatedAt), should.HappenWithin, 5*time.Second, start)
 			a.So(*ttnpb.StdTime(created.UpdatedAt), should.HappenWithin, 5*time.Second, start)
 		}
@@ -258,6 +263,8 @@ func (st *StoreTest) TestGatewayStoreCRUD(t *T) {
 			RequireAuthenticatedConnection: false,
 			Lrfhss:                         &ttnpb.Gateway_LRFHSS{Supported: false},
 			DisablePacketBrokerForwarding:  false,
+			ClientCertificateFingerprint:   bytes.Repeat([]byte{0x02}, 32),
+			UdpPreSharedKey:                updatedSecret,
 		}, append(mask, "ids.eui"))
 		if a.So(err, should.BeNil) && a.So(updated, should.NotBeNil) {
 			a.So(updated.GetIds().GetGatewayId(), should.Equal, "foo")
@@ -295,6 +302,8 @@ func (st *StoreTest) TestGatewayStoreCRUD(t *T) {
 			a.So(updated.RequireAuthenticatedConnection, should.BeFalse)
 			a.So(updated.Lrfhss.GetSupported(), should.BeFalse)
 			a.So(updated.DisablePacketBrokerForwarding, should.BeFalse)
+			a.So(updated.ClientCertificateFingerprint, should.Resemble, bytes.Repeat([]byte{0x02}, 32))
+			a.So(updated.UdpPreSharedKey, should.Resemble, updatedSecret)
 			a.So(*ttnpb.StdTime(updated.CreatedAt), should.Equal, *ttnpb.StdTime(created.CreatedAt))
 			a.So(*ttnpb.StdTime(updated.UpdatedAt), should.HappenWithin, 5*time.Second, start)
 		}
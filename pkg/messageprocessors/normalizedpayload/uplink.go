@@ -51,12 +51,80 @@ type Wind struct {
 	Direction *float64
 }
 
+// Water is a water measurement.
+type Water struct {
+	Temperature *float64
+	Leak        *bool
+}
+
+// Meter is a cumulative meter reading.
+type Meter struct {
+	Total *float64
+}
+
+// Metering is a metering measurement.
+type Metering struct {
+	// Water is the water volume meter in liters.
+	Water Meter
+	// Electricity is the electrical energy meter in kWh.
+	Electricity Meter
+	// Gas is the gas volume meter in cubic meters.
+	Gas Meter
+}
+
+// Battery is a battery measurement.
+type Battery struct {
+	Level   *float64
+	Voltage *float64
+}
+
+// Position is a position measurement.
+type Position struct {
+	Latitude  *float64
+	Longitude *float64
+	Altitude  *float64
+	Accuracy  *float64
+}
+
+// Motion is a motion measurement.
+type Motion struct {
+	Detected *bool
+	Count    *float64
+}
+
+// ContactState is the state of a contact.
+type ContactState string
+
+// Contact states.
+const (
+	ContactStateOpen   ContactState = "open"
+	ContactStateClosed ContactState = "closed"
+)
+
+// Action is an action measurement.
+type Action struct {
+	Motion       Motion
+	ContactState *ContactState
+}
+
+// Light is a light measurement.
+type Light struct {
+	Intensity *float64
+	UVIndex   *float64
+}
+
 // Measurement is a measurement.
 type Measurement struct {
-	Time *time.Time
-	Soil Soil
-	Air  Air
-	Wind Wind
+	Time     *time.Time
+	Soil     Soil
+	Air      Air
+	Wind     Wind
+	Water    Water
+	Metering Metering
+	Battery  Battery
+	Position Position
+	Action   Action
+	Light    Light
 }
 
 var (
@@ -78,6 +146,10 @@ var (
 		"field_exclusive_maximum",
 		"`{path}` should be less than `{maximum}`",
 	)
+	errFieldValue = errors.DefineDataLoss(
+		"field_value",
+		"`{path}` should be one of `{values}`",
+	)
 	errUnknownField = errors.DefineInvalidArgument("unknown_field", "unknown field `{path}`")
 )
 
@@ -141,6 +213,40 @@ func parseNumber(selector func(dst *Measurement) **float64, vals ...fieldValidat
 	}
 }
 
+// parseBool parses a boolean.
+func parseBool(selector func(dst *Measurement) **bool) fieldParser {
+	return func(dst *Measurement, src *structpb.Value, path string) []error {
+		val, ok := src.Kind.(*structpb.Value_BoolValue)
+		if !ok {
+			return []error{errFieldType.WithAttributes("path", path)}
+		}
+		b := val.BoolValue
+		*selector(dst) = &b
+		return nil
+	}
+}
+
+// parseEnum parses and validates a string that must be one of the given values.
+func parseEnum[T ~string](selector func(dst *Measurement) **T, values ...T) fieldParser {
+	return func(dst *Measurement, src *structpb.Value, path string) []error {
+		val, ok := src.Kind.(*structpb.Value_StringValue)
+		if !ok {
+			return []error{errFieldType.WithAttributes("path", path)}
+		}
+		v := T(val.StringValue)
+		for _, allowed := range values {
+			if v == allowed {
+				*selector(dst) = &v
+				return nil
+			}
+		}
+		return []error{errFieldValue.WithAttributes(
+			"path", path,
+			"values", fmt.Sprint(values),
+		)}
+	}
+}
+
 // parsePercentage parses and validates a percentage.
 func parsePercentage(selector func(dst *Measurement) **float64) fieldParser {
 	return parseNumber(
@@ -322,6 +428,151 @@ var fieldParsers = map[string]fieldParser{
 		minimum(0.0),
 		exclusiveMaximum(360.0),
 	),
+	"water": object(
+		func(dst *Measurement) *Water {
+			return &dst.Water
+		},
+	),
+	"water.temperature": parseNumber(
+		func(dst *Measurement) **float64 {
+			return &dst.Water.Temperature
+		},
+		minimum(-273.15),
+	),
+	"water.leak": parseBool(
+		func(dst *Measurement) **bool {
+			return &dst.Water.Leak
+		},
+	),
+	"metering": object(
+		func(dst *Measurement) *Metering {
+			return &dst.Metering
+		},
+	),
+	"metering.water": object(
+		func(dst *Measurement) *Meter {
+			return &dst.Metering.Water
+		},
+	),
+	"metering.water.total": parseNumber(
+		func(dst *Measurement) **float64 {
+			return &dst.Metering.Water.Total
+		},
+		minimum(0.0),
+	),
+	"metering.electricity": object(
+		func(dst *Measurement) *Meter {
+			return &dst.Metering.Electricity
+		},
+	),
+	"metering.electricity.total": parseNumber(
+		func(dst *Measurement) **float64 {
+			return &dst.Metering.Electricity.Total
+		},
+		minimum(0.0),
+	),
+	"metering.gas": object(
+		func(dst *Measurement) *Meter {
+			return &dst.Metering.Gas
+		},
+	),
+	"metering.gas.total": parseNumber(
+		func(dst *Measurement) **float64 {
+			return &dst.Metering.Gas.Total
+		},
+		minimum(0.0),
+	),
+	"battery": object(
+		func(dst *Measurement) *Battery {
+			return &dst.Battery
+		},
+	),
+	"battery.level": parsePercentage(
+		func(dst *Measurement) **float64 {
+			return &dst.Battery.Level
+		},
+	),
+	"battery.voltage": parseNumber(
+		func(dst *Measurement) **float64 {
+			return &dst.Battery.Voltage
+		},
+		minimum(0.0),
+	),
+	"position": object(
+		func(dst *Measurement) *Position {
+			return &dst.Position
+		},
+	),
+	"position.latitude": parseNumber(
+		func(dst *Measurement) **float64 {
+			return &dst.Position.Latitude
+		},
+		minimum(-90.0),
+		maximum(90.0),
+	),
+	"position.longitude": parseNumber(
+		func(dst *Measurement) **float64 {
+			return &dst.Position.Longitude
+		},
+		minimum(-180.0),
+		maximum(180.0),
+	),
+	"position.altitude": parseNumber(
+		func(dst *Measurement) **float64 {
+			return &dst.Position.Altitude
+		},
+	),
+	"position.accuracy": parseNumber(
+		func(dst *Measurement) **float64 {
+			return &dst.Position.Accuracy
+		},
+		minimum(0.0),
+	),
+	"action": object(
+		func(dst *Measurement) *Action {
+			return &dst.Action
+		},
+	),
+	"action.motion": object(
+		func(dst *Measurement) *Motion {
+			return &dst.Action.Motion
+		},
+	),
+	"action.motion.detected": parseBool(
+		func(dst *Measurement) **bool {
+			return &dst.Action.Motion.Detected
+		},
+	),
+	"action.motion.count": parseNumber(
+		func(dst *Measurement) **float64 {
+			return &dst.Action.Motion.Count
+		},
+		minimum(0.0),
+	),
+	"action.contactState": parseEnum(
+		func(dst *Measurement) **ContactState {
+			return &dst.Action.ContactState
+		},
+		ContactStateOpen,
+		ContactStateClosed,
+	),
+	"light": object(
+		func(dst *Measurement) *Light {
+			return &dst.Light
+		},
+	),
+	"light.intensity": parseNumber(
+		func(dst *Measurement) **float64 {
+			return &dst.Light.Intensity
+		},
+		minimum(0.0),
+	),
+	"light.uvIndex": parseNumber(
+		func(dst *Measurement) **float64 {
+			return &dst.Light.UVIndex
+		},
+		minimum(0.0),
+	),
 }
 
 // ParsedMeasurement is the result of parsing measurements with Parse.
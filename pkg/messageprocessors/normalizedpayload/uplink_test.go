@@ -33,6 +33,14 @@ func float64Ptr(f float64) *float64 {
 	return &f
 }
 
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func contactStatePtr(s normalizedpayload.ContactState) *normalizedpayload.ContactState {
+	return &s
+}
+
 func TestUplink(t *testing.T) {
 	t.Parallel()
 
@@ -250,6 +258,166 @@ func TestUplink(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "water, metering, battery and light",
+			normalizedPayload: []*structpb.Struct{
+				{
+					Fields: map[string]*structpb.Value{
+						"water": structpb.NewStructValue(&structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"temperature": structpb.NewNumberValue(12.5),
+								"leak":        structpb.NewBoolValue(true),
+							},
+						}),
+						"metering": structpb.NewStructValue(&structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"water": structpb.NewStructValue(&structpb.Struct{
+									Fields: map[string]*structpb.Value{
+										"total": structpb.NewNumberValue(1234.5),
+									},
+								}),
+								"electricity": structpb.NewStructValue(&structpb.Struct{
+									Fields: map[string]*structpb.Value{
+										"total": structpb.NewNumberValue(42),
+									},
+								}),
+							},
+						}),
+						"battery": structpb.NewStructValue(&structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"level":   structpb.NewNumberValue(87),
+								"voltage": structpb.NewNumberValue(3.6),
+							},
+						}),
+						"light": structpb.NewStructValue(&structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"intensity": structpb.NewNumberValue(350),
+								"uvIndex":   structpb.NewNumberValue(3),
+							},
+						}),
+					},
+				},
+			},
+			expected: []normalizedpayload.Measurement{
+				{
+					Water: normalizedpayload.Water{
+						Temperature: float64Ptr(12.5),
+						Leak:        boolPtr(true),
+					},
+					Metering: normalizedpayload.Metering{
+						Water: normalizedpayload.Meter{
+							Total: float64Ptr(1234.5),
+						},
+						Electricity: normalizedpayload.Meter{
+							Total: float64Ptr(42),
+						},
+					},
+					Battery: normalizedpayload.Battery{
+						Level:   float64Ptr(87),
+						Voltage: float64Ptr(3.6),
+					},
+					Light: normalizedpayload.Light{
+						Intensity: float64Ptr(350),
+						UVIndex:   float64Ptr(3),
+					},
+				},
+			},
+		},
+		{
+			name: "position and action",
+			normalizedPayload: []*structpb.Struct{
+				{
+					Fields: map[string]*structpb.Value{
+						"position": structpb.NewStructValue(&structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"latitude":  structpb.NewNumberValue(52.3676),
+								"longitude": structpb.NewNumberValue(4.9041),
+								"altitude":  structpb.NewNumberValue(-2),
+								"accuracy":  structpb.NewNumberValue(5),
+							},
+						}),
+						"action": structpb.NewStructValue(&structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"motion": structpb.NewStructValue(&structpb.Struct{
+									Fields: map[string]*structpb.Value{
+										"detected": structpb.NewBoolValue(true),
+										"count":    structpb.NewNumberValue(3),
+									},
+								}),
+								"contactState": structpb.NewStringValue("closed"),
+							},
+						}),
+					},
+				},
+			},
+			expected: []normalizedpayload.Measurement{
+				{
+					Position: normalizedpayload.Position{
+						Latitude:  float64Ptr(52.3676),
+						Longitude: float64Ptr(4.9041),
+						Altitude:  float64Ptr(-2),
+						Accuracy:  float64Ptr(5),
+					},
+					Action: normalizedpayload.Action{
+						Motion: normalizedpayload.Motion{
+							Detected: boolPtr(true),
+							Count:    float64Ptr(3),
+						},
+						ContactState: contactStatePtr(normalizedpayload.ContactStateClosed),
+					},
+				},
+			},
+		},
+		{
+			name: "invalid latitude",
+			normalizedPayload: []*structpb.Struct{
+				{
+					Fields: map[string]*structpb.Value{
+						"position": structpb.NewStructValue(&structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"latitude": structpb.NewNumberValue(91),
+							},
+						}),
+					},
+				},
+			},
+			expected: []normalizedpayload.Measurement{
+				{},
+			},
+			expectedValidationErrors: [][]error{
+				{
+					normalizedpayload.ErrFieldMaximum.WithAttributes(
+						"path", "position.latitude",
+						"maximum", 90.0,
+					),
+				},
+			},
+		},
+		{
+			name: "invalid contact state",
+			normalizedPayload: []*structpb.Struct{
+				{
+					Fields: map[string]*structpb.Value{
+						"action": structpb.NewStructValue(&structpb.Struct{
+							Fields: map[string]*structpb.Value{
+								"contactState": structpb.NewStringValue("ajar"),
+							},
+						}),
+					},
+				},
+			},
+			expected: []normalizedpayload.Measurement{
+				{},
+			},
+			expectedValidationErrors: [][]error{
+				{
+					normalizedpayload.ErrFieldValue.WithAttributes(
+						"path", "action.contactState",
+						"values", "[open closed]",
+					),
+				},
+			},
+		},
 		{
 			name: "invalid type",
 			normalizedPayload: []*structpb.Struct{
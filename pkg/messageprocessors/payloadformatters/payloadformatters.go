@@ -0,0 +1,40 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package payloadformatters provides the payload formatters that are supported by the Application Server.
+package payloadformatters
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/cayennelpp"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/javascript"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/remote"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/wasm"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// New returns a payload processor with the JavaScript, CayenneLPP, WebAssembly and gRPC service payload formatters.
+// The connections to remote payload formatters are closed when the context is done.
+func New(
+	ctx context.Context, component remote.Component, remoteConfig remote.Config,
+) messageprocessors.MapPayloadProcessor {
+	return messageprocessors.MapPayloadProcessor{
+		ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT:   javascript.New(),
+		ttnpb.PayloadFormatter_FORMATTER_CAYENNELPP:   cayennelpp.New(),
+		ttnpb.PayloadFormatter_FORMATTER_WASM:         wasm.New(),
+		ttnpb.PayloadFormatter_FORMATTER_GRPC_SERVICE: remote.New(ctx, component, remoteConfig),
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"sync"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// breaker is a circuit breaker for a remote service.
+// The circuit opens after threshold consecutive failures, and requests fail fast until the cooldown has passed.
+// After the cooldown, requests are attempted again, and the circuit opens again on the next failure.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+}
+
+// allow returns whether a request may be made at the given time.
+func (b *breaker) allow(now time.Time) bool {
+	if b.threshold <= 0 {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return !now.Before(b.openUntil)
+}
+
+// report reports the result of a request made at the given time.
+func (b *breaker) report(now time.Time, err error) {
+	if b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !isServiceFailure(err) {
+		b.failures = 0
+		return
+	}
+	b.failures++
+	if b.failures >= b.threshold {
+		b.openUntil = now.Add(b.cooldown)
+	}
+}
+
+// isServiceFailure returns whether the error indicates that the remote service failed.
+// Errors that the service returns for invalid payloads do not count as failures.
+func isServiceFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	return errors.IsUnavailable(err) ||
+		errors.IsDeadlineExceeded(err) ||
+		errors.IsInternal(err) ||
+		errors.IsUnknown(err) ||
+		errors.IsUnimplemented(err) ||
+		errors.IsResourceExhausted(err)
+}
+
+// breakers contains the circuit breakers of the remote services.
+// The number of circuit breakers is bounded, and the least recently used circuit breakers are removed.
+type breakers struct {
+	threshold int
+	cooldown  time.Duration
+
+	mu       sync.Mutex
+	breakers gcache.Cache
+}
+
+// get returns the circuit breaker for the given URL.
+func (bs *breakers) get(url string) *breaker {
+	bs.mu.Lock()
+	defer bs.mu.Unlock()
+	if b, err := bs.breakers.Get(url); err == nil {
+		return b.(*breaker)
+	}
+	b := &breaker{
+		threshold: bs.threshold,
+		cooldown:  bs.cooldown,
+	}
+	bs.breakers.Set(url, b) //nolint:errcheck
+	return b
+}
@@ -0,0 +1,275 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	httpScheme  = "http"
+	httpsScheme = "https"
+	grpcScheme  = "grpc"
+	grpcsScheme = "grpcs"
+
+	// maxResponseSize is the maximum size of HTTP response bodies.
+	maxResponseSize = 1 << 20
+)
+
+var (
+	errURL           = errors.DefineInvalidArgument("url", "invalid URL `{url}`")
+	errScheme        = errors.DefineInvalidArgument("scheme", "unsupported URL scheme `{scheme}`")
+	errInsecure      = errors.DefineInvalidArgument("insecure", "insecure URL scheme `{scheme}` not allowed")
+	errHost          = errors.DefinePermissionDenied("host", "host `{host}` not allowed")
+	errRequest       = errors.DefineUnavailable("request", "request to `{url}` failed")
+	errRequestStatus = errors.DefineUnavailable("request_status", "request to `{url}` failed with status `{code}`")
+	errRejected      = errors.DefineAborted("rejected", "request to `{url}` rejected with status `{code}`")
+	errResponse      = errors.DefineUnavailable("response", "invalid response from `{url}`")
+)
+
+// httpClient is a ttnpb.RemotePayloadFormatterClient which uses HTTP POST requests with JSON bodies.
+// The request paths are relative to the base URL: /down/encode, /up/decode and /down/decode.
+type httpClient struct {
+	client  *http.Client
+	baseURL string
+}
+
+func (c *httpClient) do(ctx context.Context, path string, req, res proto.Message) error {
+	body, err := jsonpb.TTN().Marshal(req)
+	if err != nil {
+		return err
+	}
+	u := strings.TrimSuffix(c.baseURL, "/") + path
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return errURL.WithAttributes("url", c.baseURL).WithCause(err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set("Accept", "application/json")
+	httpRes, err := c.client.Do(httpReq)
+	if err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return errRequest.WithAttributes("url", u).WithCause(err)
+	}
+	defer httpRes.Body.Close()
+	defer io.Copy(io.Discard, httpRes.Body) //nolint:errcheck
+	switch code := httpRes.StatusCode; {
+	case code >= 200 && code <= 299:
+	case code >= 400 && code <= 499 && code != http.StatusTooManyRequests && code != http.StatusRequestTimeout:
+		return errRejected.WithAttributes("url", u, "code", code)
+	default:
+		return errRequestStatus.WithAttributes("url", u, "code", code)
+	}
+	b, err := io.ReadAll(io.LimitReader(httpRes.Body, maxResponseSize))
+	if err != nil {
+		return errRequest.WithAttributes("url", u).WithCause(err)
+	}
+	if err := jsonpb.TTN().Unmarshal(b, res); err != nil {
+		return errResponse.WithAttributes("url", u).WithCause(err)
+	}
+	return nil
+}
+
+// EncodeDownlink implements ttnpb.RemotePayloadFormatterClient.
+func (c *httpClient) EncodeDownlink(
+	ctx context.Context, req *ttnpb.EncodeDownlinkRequest, _ ...grpc.CallOption,
+) (*ttnpb.EncodeDownlinkResponse, error) {
+	res := &ttnpb.EncodeDownlinkResponse{}
+	if err := c.do(ctx, "/down/encode", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DecodeUplink implements ttnpb.RemotePayloadFormatterClient.
+func (c *httpClient) DecodeUplink(
+	ctx context.Context, req *ttnpb.DecodeUplinkRequest, _ ...grpc.CallOption,
+) (*ttnpb.DecodeUplinkResponse, error) {
+	res := &ttnpb.DecodeUplinkResponse{}
+	if err := c.do(ctx, "/up/decode", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// DecodeDownlink implements ttnpb.RemotePayloadFormatterClient.
+func (c *httpClient) DecodeDownlink(
+	ctx context.Context, req *ttnpb.DecodeDownlinkRequest, _ ...grpc.CallOption,
+) (*ttnpb.DecodeDownlinkResponse, error) {
+	res := &ttnpb.DecodeDownlinkResponse{}
+	if err := c.do(ctx, "/down/decode", req, res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// parseURL parses the formatter parameter. A parameter without scheme is the host:port of a gRPC service,
+// which is called with TLS.
+func parseURL(parameter string) (*url.URL, error) {
+	rawURL := strings.TrimSpace(parameter)
+	if !strings.Contains(rawURL, "://") {
+		rawURL = grpcsScheme + "://" + rawURL
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errURL.WithAttributes("url", parameter).WithCause(err)
+	}
+	if u.Host == "" {
+		return nil, errURL.WithAttributes("url", parameter)
+	}
+	return u, nil
+}
+
+// hostAllowed returns whether the host of the URL matches any of the patterns.
+// Patterns with a port match the host and port of the URL, and patterns without a port match the host name.
+// Patterns may contain * wildcards.
+func hostAllowed(patterns []string, u *url.URL) bool {
+	for _, pattern := range patterns {
+		host := u.Hostname()
+		if strings.Contains(pattern, ":") {
+			host = u.Host
+		}
+		if ok, err := path.Match(strings.ToLower(pattern), strings.ToLower(host)); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+// clients provides ttnpb.RemotePayloadFormatterClients for URLs.
+// gRPC client connections are shared between requests to the same target. The number of connections is bounded,
+// and the least recently used connections are closed, which cancels the requests that are in flight on them.
+// All connections are closed when the context is done.
+type clients struct {
+	ctx           context.Context
+	component     Component
+	allowedHosts  []string
+	allowInsecure bool
+
+	httpOnce   sync.Once
+	httpClient *http.Client
+	httpErr    error
+
+	connsMu sync.Mutex
+	conns   gcache.Cache
+}
+
+func newClients(ctx context.Context, component Component, config Config) *clients {
+	c := &clients{
+		ctx:           ctx,
+		component:     component,
+		allowedHosts:  config.AllowedHosts,
+		allowInsecure: config.AllowInsecure,
+		conns: gcache.New(maxServices(config)).LRU().
+			EvictedFunc(func(_, value any) {
+				value.(*grpc.ClientConn).Close() //nolint:errcheck
+			}).
+			Build(),
+	}
+	go func() {
+		<-ctx.Done()
+		c.connsMu.Lock()
+		defer c.connsMu.Unlock()
+		c.conns.Purge()
+	}()
+	return c
+}
+
+// get returns the client for the given formatter parameter.
+// The host of the service must be allowed, and insecure schemes must be allowed explicitly.
+func (c *clients) get(ctx context.Context, parameter string) (ttnpb.RemotePayloadFormatterClient, error) {
+	u, err := parseURL(parameter)
+	if err != nil {
+		return nil, err
+	}
+	switch u.Scheme {
+	case httpScheme, grpcScheme:
+		if !c.allowInsecure {
+			return nil, errInsecure.WithAttributes("scheme", u.Scheme)
+		}
+	case httpsScheme, grpcsScheme:
+	default:
+		return nil, errScheme.WithAttributes("scheme", u.Scheme)
+	}
+	if !hostAllowed(c.allowedHosts, u) {
+		return nil, errHost.WithAttributes("host", u.Host)
+	}
+	switch u.Scheme {
+	case httpScheme, httpsScheme:
+		c.httpOnce.Do(func() {
+			// The HTTP client is shared, so that connections are pooled.
+			c.httpClient, c.httpErr = c.component.HTTPClient(c.ctx)
+		})
+		if c.httpErr != nil {
+			return nil, c.httpErr
+		}
+		return &httpClient{client: c.httpClient, baseURL: u.String()}, nil
+	default:
+		conn, err := c.conn(ctx, u.Scheme, u.Host)
+		if err != nil {
+			return nil, err
+		}
+		return ttnpb.NewRemotePayloadFormatterClient(conn), nil
+	}
+}
+
+func (c *clients) conn(ctx context.Context, scheme, target string) (*grpc.ClientConn, error) {
+	key := fmt.Sprintf("%s://%s", scheme, target)
+	c.connsMu.Lock()
+	defer c.connsMu.Unlock()
+	if conn, err := c.conns.Get(key); err == nil {
+		return conn.(*grpc.ClientConn), nil
+	}
+	if err := c.ctx.Err(); err != nil {
+		return nil, err
+	}
+	opts := rpcclient.DefaultDialOptions(c.ctx)
+	if scheme == grpcsScheme {
+		tlsConfig, err := c.component.GetTLSClientConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
+	} else {
+		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+	// The connection is established in the background, so this does not block.
+	conn, err := grpc.DialContext(c.ctx, target, opts...)
+	if err != nil {
+		return nil, errRequest.WithAttributes("url", key).WithCause(err)
+	}
+	c.conns.Set(key, conn) //nolint:errcheck
+	return conn, nil
+}
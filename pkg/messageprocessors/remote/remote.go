@@ -0,0 +1,347 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package remote contains the payload formatter message processors that delegate to remote services.
+//
+// The formatter parameter is the host:port of a service that implements the ttnpb.RemotePayloadFormatter gRPC
+// service, or the URL of the service. Services with the grpcs:// or grpc:// (insecure) scheme implement the gRPC
+// service. Services with the https:// or http:// (insecure) scheme are called with HTTP POST requests with JSON
+// bodies to the /down/encode, /up/decode and /down/decode paths relative to the URL.
+//
+// Only services of which the host is allowed in the configuration are called, and insecure schemes are only allowed
+// if configured.
+//
+// The results are cached by the URL, the version identifiers of the end device, the FPort and the payload, so
+// services must not return results that depend on other fields of the message.
+package remote
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"runtime/trace"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/goproto"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/normalizedpayload"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Config is the configuration of the remote payload formatters.
+type Config struct {
+	AllowedHosts     []string      `name:"allowed-hosts" description:"Hosts of remote payload formatters that may be called, optionally with port and with * wildcards (none if empty)"`
+	AllowInsecure    bool          `name:"allow-insecure" description:"Allow remote payload formatters with insecure http:// and grpc:// URLs"`
+	MaxServices      int           `name:"max-services" description:"Maximum number of remote payload formatters for which connections and circuit breakers are kept"`
+	Timeout          time.Duration `name:"timeout" description:"Timeout of requests to remote payload formatters"`
+	CacheSize        int           `name:"cache-size" description:"Number of cached remote payload formatter results (0 disables the cache)"`
+	CacheTTL         time.Duration `name:"cache-ttl" description:"TTL of cached remote payload formatter results"`
+	BreakerThreshold int           `name:"breaker-threshold" description:"Number of consecutive failures after which requests to a remote payload formatter fail fast (0 disables circuit breaking)"`
+	BreakerCooldown  time.Duration `name:"breaker-cooldown" description:"Duration for which requests to a failing remote payload formatter fail fast"`
+}
+
+// Component provides the HTTP clients and TLS configuration of the remote payload formatters.
+type Component interface {
+	httpclient.Provider
+	GetTLSClientConfig(context.Context, ...tlsconfig.Option) (*tls.Config, error)
+}
+
+// defaultMaxServices is the maximum number of remote services, if not configured.
+const defaultMaxServices = 1024
+
+func maxServices(config Config) int {
+	if config.MaxServices <= 0 {
+		return defaultMaxServices
+	}
+	return config.MaxServices
+}
+
+type host struct {
+	config   Config
+	clients  *clients
+	breakers *breakers
+	cache    gcache.Cache
+}
+
+// New creates and returns a new remote payload encoder and decoder.
+// The connections to remote services are closed when the context is done.
+func New(ctx context.Context, component Component, config Config) messageprocessors.PayloadEncoderDecoder {
+	h := &host{
+		config:  config,
+		clients: newClients(ctx, component, config),
+		breakers: &breakers{
+			threshold: config.BreakerThreshold,
+			cooldown:  config.BreakerCooldown,
+			breakers:  gcache.New(maxServices(config)).LRU().Build(),
+		},
+	}
+	if config.CacheSize > 0 {
+		h.cache = gcache.New(config.CacheSize).LRU().Expiration(config.CacheTTL).Build()
+	}
+	return h
+}
+
+var (
+	errCircuitOpen = errors.DefineUnavailable("circuit_open", "remote payload formatter `{url}` is unavailable")
+	errOutput      = errors.DefineAborted("output", "invalid output")
+)
+
+type operation string
+
+const (
+	encodeDownlink operation = "encode_downlink"
+	decodeUplink   operation = "decode_uplink"
+	decodeDownlink operation = "decode_downlink"
+)
+
+// cacheKey returns the cache key of the result of the operation on the given message.
+// The message only contains the fields that are sent to the remote service.
+func cacheKey(
+	op operation, url string, version *ttnpb.EndDeviceVersionIdentifiers, msg proto.Message,
+) (string, error) {
+	marshal := proto.MarshalOptions{Deterministic: true}
+	h := sha256.New()
+	h.Write([]byte(op))
+	h.Write([]byte{0})
+	h.Write([]byte(url))
+	h.Write([]byte{0})
+	b, err := marshal.Marshal(version)
+	if err != nil {
+		return "", err
+	}
+	h.Write(b)
+	h.Write([]byte{0})
+	if b, err = marshal.Marshal(msg); err != nil {
+		return "", err
+	}
+	h.Write(b)
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// call calls the remote service with the given URL, using the result cache and circuit breaker.
+// The result is cached if the key is not empty.
+func call[Res proto.Message](
+	ctx context.Context,
+	h *host,
+	url, key string,
+	f func(context.Context, ttnpb.RemotePayloadFormatterClient) (Res, error),
+) (res Res, err error) {
+	if h.cache != nil && key != "" {
+		if cached, err := h.cache.Get(key); err == nil {
+			return cached.(Res), nil
+		}
+	}
+	client, err := h.clients.get(ctx, url)
+	if err != nil {
+		return res, err
+	}
+	b := h.breakers.get(url)
+	if !b.allow(time.Now()) {
+		return res, errCircuitOpen.WithAttributes("url", url)
+	}
+	if h.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, h.config.Timeout)
+		defer cancel()
+	}
+	res, err = f(ctx, client)
+	b.report(time.Now(), err)
+	if err != nil {
+		return res, err
+	}
+	if h.cache != nil && key != "" {
+		h.cache.Set(key, res) //nolint:errcheck
+	}
+	return res, nil
+}
+
+// EncodeDownlink encodes the message's DecodedPayload to FRMPayload using the remote service.
+func (h *host) EncodeDownlink(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	version *ttnpb.EndDeviceVersionIdentifiers,
+	msg *ttnpb.ApplicationDownlink,
+	parameter string,
+) error {
+	defer trace.StartRegion(ctx, "encode downlink message").End()
+
+	if msg.DecodedPayload == nil {
+		return nil
+	}
+	down := &ttnpb.ApplicationDownlink{
+		FPort:          msg.FPort,
+		DecodedPayload: msg.DecodedPayload,
+	}
+	key, err := cacheKey(encodeDownlink, parameter, version, down)
+	if err != nil {
+		return err
+	}
+	res, err := call(ctx, h, parameter, key,
+		func(ctx context.Context, client ttnpb.RemotePayloadFormatterClient) (*ttnpb.EncodeDownlinkResponse, error) {
+			return client.EncodeDownlink(ctx, &ttnpb.EncodeDownlinkRequest{
+				EndDeviceIds: ids,
+				VersionIds:   version,
+				Downlink:     down,
+			})
+		},
+	)
+	if err != nil {
+		return err
+	}
+	encoded := res.GetDownlink()
+	if encoded == nil {
+		return errOutput.New()
+	}
+	msg.FrmPayload = append([]byte(nil), encoded.FrmPayload...)
+	msg.DecodedPayloadWarnings = append([]string(nil), encoded.DecodedPayloadWarnings...)
+	if encoded.FPort != 0 {
+		msg.FPort = encoded.FPort
+	} else if msg.FPort == 0 {
+		msg.FPort = 1
+	}
+	return nil
+}
+
+func appendValidationErrors(dst []string, measurements []normalizedpayload.ParsedMeasurement) []string {
+	for i, m := range measurements {
+		for _, err := range m.ValidationErrors {
+			var (
+				errString string
+				ttnErr    *errors.Error
+			)
+			if errors.As(err, &ttnErr) {
+				errString = ttnErr.FormatMessage(ttnErr.PublicAttributes())
+			} else {
+				errString = err.Error()
+			}
+			dst = append(dst, fmt.Sprintf("measurement %d: %s", i+1, errString))
+		}
+	}
+	return dst
+}
+
+// validMeasurements returns the valid fields of the parsed measurements, omitting empty measurements.
+func validMeasurements(measurements []normalizedpayload.ParsedMeasurement) []*structpb.Struct {
+	res := make([]*structpb.Struct, 0, len(measurements))
+	for _, measurement := range measurements {
+		if len(measurement.Valid.GetFields()) == 0 {
+			continue
+		}
+		res = append(res, measurement.Valid)
+	}
+	return res
+}
+
+// DecodeUplink decodes the message's FRMPayload to DecodedPayload using the remote service.
+// The normalized payload returned by the service is validated.
+func (h *host) DecodeUplink(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	version *ttnpb.EndDeviceVersionIdentifiers,
+	msg *ttnpb.ApplicationUplink,
+	parameter string,
+) error {
+	defer trace.StartRegion(ctx, "decode uplink message").End()
+
+	up := &ttnpb.ApplicationUplink{
+		FPort:      msg.FPort,
+		FrmPayload: msg.FrmPayload,
+	}
+	key, err := cacheKey(decodeUplink, parameter, version, up)
+	if err != nil {
+		return err
+	}
+	res, err := call(ctx, h, parameter, key,
+		func(ctx context.Context, client ttnpb.RemotePayloadFormatterClient) (*ttnpb.DecodeUplinkResponse, error) {
+			return client.DecodeUplink(ctx, &ttnpb.DecodeUplinkRequest{
+				EndDeviceIds: ids,
+				VersionIds:   version,
+				Uplink:       up,
+			})
+		},
+	)
+	if err != nil {
+		return err
+	}
+	decoded := res.GetUplink()
+	if decoded == nil {
+		return errOutput.New()
+	}
+	// The result may be cached, so the fields are copied.
+	msg.DecodedPayload = proto.Clone(decoded.DecodedPayload).(*structpb.Struct)
+	msg.DecodedPayloadWarnings = append([]string(nil), decoded.DecodedPayloadWarnings...)
+	msg.NormalizedPayload, msg.NormalizedPayloadWarnings = nil, nil
+	if len(decoded.NormalizedPayload) > 0 {
+		normalized := make([]*structpb.Struct, len(decoded.NormalizedPayload))
+		for i, measurement := range decoded.NormalizedPayload {
+			normalized[i] = proto.Clone(measurement).(*structpb.Struct)
+		}
+		parsed, err := normalizedpayload.Parse(normalized)
+		if err != nil {
+			return errOutput.WithCause(err)
+		}
+		msg.NormalizedPayload = validMeasurements(parsed)
+		msg.NormalizedPayloadWarnings = append([]string(nil), decoded.NormalizedPayloadWarnings...)
+		msg.NormalizedPayloadWarnings = appendValidationErrors(msg.NormalizedPayloadWarnings, parsed)
+	}
+	msg.DecodedPayloadWarnings = append(msg.DecodedPayloadWarnings, goproto.ValidateStruct(msg.DecodedPayload)...)
+	return nil
+}
+
+// DecodeDownlink decodes the message's FRMPayload to DecodedPayload using the remote service.
+func (h *host) DecodeDownlink(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	version *ttnpb.EndDeviceVersionIdentifiers,
+	msg *ttnpb.ApplicationDownlink,
+	parameter string,
+) error {
+	defer trace.StartRegion(ctx, "decode downlink message").End()
+
+	down := &ttnpb.ApplicationDownlink{
+		FPort:      msg.FPort,
+		FrmPayload: msg.FrmPayload,
+	}
+	key, err := cacheKey(decodeDownlink, parameter, version, down)
+	if err != nil {
+		return err
+	}
+	res, err := call(ctx, h, parameter, key,
+		func(ctx context.Context, client ttnpb.RemotePayloadFormatterClient) (*ttnpb.DecodeDownlinkResponse, error) {
+			return client.DecodeDownlink(ctx, &ttnpb.DecodeDownlinkRequest{
+				EndDeviceIds: ids,
+				VersionIds:   version,
+				Downlink:     down,
+			})
+		},
+	)
+	if err != nil {
+		return err
+	}
+	decoded := res.GetDownlink()
+	if decoded == nil {
+		return errOutput.New()
+	}
+	msg.DecodedPayload = proto.Clone(decoded.DecodedPayload).(*structpb.Struct)
+	msg.DecodedPayloadWarnings = append([]string(nil), decoded.DecodedPayloadWarnings...)
+	return nil
+}
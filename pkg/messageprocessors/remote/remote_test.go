@@ -0,0 +1,326 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote_test
+
+import (
+	"context"
+	"crypto/tls"
+	stdio "io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/config/tlsconfig"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/remote"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type mockComponent struct{}
+
+func (mockComponent) HTTPClient(context.Context, ...httpclient.Option) (*http.Client, error) {
+	return &http.Client{}, nil
+}
+
+func (mockComponent) GetTLSClientConfig(context.Context, ...tlsconfig.Option) (*tls.Config, error) {
+	return &tls.Config{}, nil
+}
+
+var (
+	registeredDeviceIDs = &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+		DeviceId:       "foo-device",
+	}
+	registeredVersionIDs = &ttnpb.EndDeviceVersionIdentifiers{
+		BrandId: "the-things-industries",
+		ModelId: "generic-node",
+	}
+)
+
+var errFPort = errors.DefineInvalidArgument("f_port", "invalid FPort")
+
+type mockFormatter struct {
+	ttnpb.UnimplementedRemotePayloadFormatterServer
+
+	calls atomic.Int32
+}
+
+func (f *mockFormatter) EncodeDownlink(
+	_ context.Context, req *ttnpb.EncodeDownlinkRequest,
+) (*ttnpb.EncodeDownlinkResponse, error) {
+	f.calls.Add(1)
+	state := req.Downlink.DecodedPayload.GetFields()["state"].GetStringValue()
+	return &ttnpb.EncodeDownlinkResponse{
+		Downlink: &ttnpb.ApplicationDownlink{
+			FPort:      2,
+			FrmPayload: []byte(state),
+		},
+	}, nil
+}
+
+func (f *mockFormatter) DecodeUplink(
+	_ context.Context, req *ttnpb.DecodeUplinkRequest,
+) (*ttnpb.DecodeUplinkResponse, error) {
+	f.calls.Add(1)
+	if req.Uplink.FPort == 0 {
+		return nil, errFPort.New()
+	}
+	temperature := float64(req.Uplink.FrmPayload[0])
+	return &ttnpb.DecodeUplinkResponse{
+		Uplink: &ttnpb.ApplicationUplink{
+			DecodedPayload: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"temperature": structpb.NewNumberValue(temperature),
+				},
+			},
+			DecodedPayloadWarnings: []string{req.EndDeviceIds.DeviceId},
+			NormalizedPayload: []*structpb.Struct{{
+				Fields: map[string]*structpb.Value{
+					"air": structpb.NewStructValue(&structpb.Struct{
+						Fields: map[string]*structpb.Value{
+							"temperature": structpb.NewNumberValue(temperature),
+						},
+					}),
+				},
+			}},
+		},
+	}, nil
+}
+
+func (f *mockFormatter) DecodeDownlink(
+	_ context.Context, req *ttnpb.DecodeDownlinkRequest,
+) (*ttnpb.DecodeDownlinkResponse, error) {
+	f.calls.Add(1)
+	return &ttnpb.DecodeDownlinkResponse{
+		Downlink: &ttnpb.ApplicationDownlink{
+			DecodedPayload: &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"state": structpb.NewStringValue(string(req.Downlink.FrmPayload)),
+				},
+			},
+		},
+	}, nil
+}
+
+func handle[Req, Res proto.Message](
+	newReq func() Req, fn func(context.Context, Req) (Res, error),
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		b, err := stdio.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		req := newReq()
+		if err := jsonpb.TTN().Unmarshal(b, req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		res, err := fn(r.Context(), req)
+		if err != nil {
+			w.WriteHeader(errors.ToHTTPStatusCode(err))
+			return
+		}
+		b, err = jsonpb.TTN().Marshal(res)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(b) //nolint:errcheck
+	}
+}
+
+func serveHTTP(t *testing.T, f *mockFormatter) string {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.Handle("/formatter/down/encode", handle(
+		func() *ttnpb.EncodeDownlinkRequest { return &ttnpb.EncodeDownlinkRequest{} }, f.EncodeDownlink,
+	))
+	mux.Handle("/formatter/up/decode", handle(
+		func() *ttnpb.DecodeUplinkRequest { return &ttnpb.DecodeUplinkRequest{} }, f.DecodeUplink,
+	))
+	mux.Handle("/formatter/down/decode", handle(
+		func() *ttnpb.DecodeDownlinkRequest { return &ttnpb.DecodeDownlinkRequest{} }, f.DecodeDownlink,
+	))
+	srv := httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+	return srv.URL + "/formatter"
+}
+
+func serveGRPC(t *testing.T, f *mockFormatter) string {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	ttnpb.RegisterRemotePayloadFormatterServer(srv, f)
+	go srv.Serve(lis) //nolint:errcheck
+	t.Cleanup(srv.Stop)
+	return "grpc://" + lis.Addr().String()
+}
+
+func TestRemote(t *testing.T) {
+	t.Parallel()
+
+	for name, serve := range map[string]func(*testing.T, *mockFormatter) string{
+		"HTTP": serveHTTP,
+		"gRPC": serveGRPC,
+	} {
+		serve := serve
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			a, ctx := test.New(t)
+			f := &mockFormatter{}
+			url := serve(t, f)
+			host := remote.New(ctx, mockComponent{}, remote.Config{
+				AllowedHosts:  []string{"127.0.0.1"},
+				AllowInsecure: true,
+				Timeout:       test.Delay << 8,
+				CacheSize:     16,
+				CacheTTL:      time.Hour,
+			})
+
+			// Identical payloads are decoded once.
+			for i := 0; i < 2; i++ {
+				up := &ttnpb.ApplicationUplink{
+					FPort:      1,
+					FrmPayload: []byte{21},
+				}
+				err := host.DecodeUplink(ctx, registeredDeviceIDs, registeredVersionIDs, up, url)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(up.DecodedPayload.AsMap(), should.Resemble, map[string]any{"temperature": 21.0})
+				a.So(up.DecodedPayloadWarnings, should.Resemble, []string{"foo-device"})
+				if a.So(up.NormalizedPayload, should.HaveLength, 1) {
+					a.So(up.NormalizedPayload[0].AsMap(), should.Resemble, map[string]any{
+						"air": map[string]any{"temperature": 21.0},
+					})
+				}
+			}
+			a.So(f.calls.Load(), should.Equal, 1)
+
+			down := &ttnpb.ApplicationDownlink{
+				DecodedPayload: &structpb.Struct{
+					Fields: map[string]*structpb.Value{
+						"state": structpb.NewStringValue("on"),
+					},
+				},
+			}
+			if a.So(host.EncodeDownlink(ctx, registeredDeviceIDs, registeredVersionIDs, down, url), should.BeNil) {
+				a.So(down.FrmPayload, should.Resemble, []byte("on"))
+				a.So(down.FPort, should.Equal, 2)
+			}
+
+			down = &ttnpb.ApplicationDownlink{
+				FPort:      2,
+				FrmPayload: []byte("off"),
+			}
+			if a.So(host.DecodeDownlink(ctx, registeredDeviceIDs, registeredVersionIDs, down, url), should.BeNil) {
+				a.So(down.DecodedPayload.AsMap(), should.Resemble, map[string]any{"state": "off"})
+			}
+
+			// Errors for invalid payloads are not cached.
+			for i := 0; i < 2; i++ {
+				up := &ttnpb.ApplicationUplink{FrmPayload: []byte{21}}
+				err := host.DecodeUplink(ctx, registeredDeviceIDs, registeredVersionIDs, up, url)
+				a.So(err, should.NotBeNil)
+			}
+			a.So(f.calls.Load(), should.Equal, 5)
+		})
+	}
+}
+
+func TestRemoteCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	host := remote.New(ctx, mockComponent{}, remote.Config{
+		AllowedHosts:     []string{"127.0.0.1"},
+		AllowInsecure:    true,
+		Timeout:          test.Delay << 8,
+		BreakerThreshold: 2,
+		BreakerCooldown:  time.Hour,
+	})
+	for i := 0; i < 4; i++ {
+		up := &ttnpb.ApplicationUplink{FPort: 1, FrmPayload: []byte{0x01}}
+		err := host.DecodeUplink(ctx, registeredDeviceIDs, registeredVersionIDs, up, srv.URL)
+		a.So(errors.IsUnavailable(err), should.BeTrue)
+	}
+	// The circuit opens after two failures, so the service is not called anymore.
+	a.So(calls.Load(), should.Equal, 2)
+
+	err := host.DecodeUplink(ctx, registeredDeviceIDs, registeredVersionIDs, &ttnpb.ApplicationUplink{}, "ftp://example.com")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestRemoteAllowedHosts(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	var calls atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(srv.Close)
+
+	decode := func(host messageprocessors.PayloadEncoderDecoder, url string) error {
+		up := &ttnpb.ApplicationUplink{FPort: 1, FrmPayload: []byte{0x01}}
+		return host.DecodeUplink(ctx, registeredDeviceIDs, registeredVersionIDs, up, url)
+	}
+
+	// Insecure schemes are not allowed by default.
+	host := remote.New(ctx, mockComponent{}, remote.Config{
+		AllowedHosts: []string{"127.0.0.1"},
+	})
+	a.So(errors.IsInvalidArgument(decode(host, srv.URL)), should.BeTrue)
+	a.So(errors.IsInvalidArgument(decode(host, "grpc://127.0.0.1:1234")), should.BeTrue)
+
+	// Only the allowed hosts are called.
+	host = remote.New(ctx, mockComponent{}, remote.Config{
+		AllowedHosts:  []string{"*.example.com", "localhost:1234"},
+		AllowInsecure: true,
+	})
+	a.So(errors.IsPermissionDenied(decode(host, srv.URL)), should.BeTrue)
+	a.So(errors.IsPermissionDenied(decode(host, "localhost:4321")), should.BeTrue)
+	a.So(errors.IsPermissionDenied(decode(host, "https://example.com")), should.BeTrue)
+	a.So(calls.Load(), should.Equal, 0)
+
+	// No hosts are allowed by default.
+	host = remote.New(ctx, mockComponent{}, remote.Config{AllowInsecure: true})
+	a.So(errors.IsPermissionDenied(decode(host, srv.URL)), should.BeTrue)
+	a.So(calls.Load(), should.Equal, 0)
+}
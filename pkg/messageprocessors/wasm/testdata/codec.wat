@@ -0,0 +1,31 @@
+;; Source of codec.wasm.
+(module
+  (memory (export "memory") 1)
+  (global $heap (mut i32) (i32.const 4096))
+  (data (i32.const 1024) "{\"data\":{\"temperature\":21.5},\"warnings\":[\"low battery\"]}")
+  (data (i32.const 1280) "{\"data\":{\"air\":{\"temperature\":21.5}}}")
+  (data (i32.const 1536) "{\"bytes\":[1,2,3],\"fPort\":2,\"warnings\":[\"default value\"]}")
+  (data (i32.const 1792) "{\"errors\":[\"unknown command\"]}")
+
+  (func (export "alloc") (param $size i32) (result i32)
+    global.get $heap
+    global.get $heap
+    local.get $size
+    i32.add
+    global.set $heap)
+
+  ;; Returns {"data":{"temperature":21.5},"warnings":["low battery"]}.
+  (func (export "decodeUplink") (param i32) (param i32) (result i64)
+    i64.const 4398046511160) ;; 1024 << 32 | 56
+
+  ;; Returns {"data":{"air":{"temperature":21.5}}}.
+  (func (export "normalizeUplink") (param i32) (param i32) (result i64)
+    i64.const 5497558138917) ;; 1280 << 32 | 37
+
+  ;; Returns {"bytes":[1,2,3],"fPort":2,"warnings":["default value"]}.
+  (func (export "encodeDownlink") (param i32) (param i32) (result i64)
+    i64.const 6597069766712) ;; 1536 << 32 | 56
+
+  ;; Returns {"errors":["unknown command"]}.
+  (func (export "decodeDownlink") (param i32) (param i32) (result i64)
+    i64.const 7696581394462)) ;; 1792 << 32 | 30
@@ -0,0 +1,475 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wasm contains the WebAssembly payload formatter message processors.
+//
+// The formatter parameter is the base64 encoded WebAssembly module. The module exports the functions of the
+// LoRaWAN Payload Codec API (TS013) as entrypoints of the WebAssembly scripting engine: `decodeUplink`,
+// `normalizeUplink` (optional), `encodeDownlink` and `decodeDownlink`. The input and output of the functions
+// are the JSON representation of the input and output of the Payload Codec API.
+package wasm
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"runtime/trace"
+	"strconv"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/goproto"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/normalizedpayload"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting/wasm"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+type host struct {
+	engine scripting.AheadOfTimeEngine
+}
+
+// New creates and returns a new WebAssembly payload encoder and decoder.
+func New() messageprocessors.CompilablePayloadEncoderDecoder {
+	return &host{
+		engine: wasm.New(scripting.DefaultOptions),
+	}
+}
+
+const (
+	decodeUplinkEntrypoint    = "decodeUplink"
+	normalizeUplinkEntrypoint = "normalizeUplink"
+	encodeDownlinkEntrypoint  = "encodeDownlink"
+	decodeDownlinkEntrypoint  = "decodeDownlink"
+)
+
+var (
+	errModule       = errors.DefineInvalidArgument("module", "invalid module encoding")
+	errInput        = errors.DefineInvalidArgument("input", "invalid input")
+	errOutput       = errors.Define("output", "invalid output")
+	errOutputErrors = errors.DefineAborted("output_errors", "{errors}")
+)
+
+// decodeModule decodes the base64 encoded module in the parameter.
+func decodeModule(parameter string) (string, error) {
+	module, err := base64.StdEncoding.DecodeString(strings.TrimSpace(parameter))
+	if err != nil {
+		return "", errModule.WithCause(err)
+	}
+	return string(module), nil
+}
+
+type runFunc func(context.Context, string, ...any) (func(any) error, error)
+
+func (h *host) compile(ctx context.Context, parameter string) (runFunc, error) {
+	module, err := decodeModule(parameter)
+	if err != nil {
+		return nil, err
+	}
+	return h.engine.Compile(ctx, module)
+}
+
+func (h *host) runner(parameter string) runFunc {
+	return func(ctx context.Context, fn string, params ...any) (func(any) error, error) {
+		module, err := decodeModule(parameter)
+		if err != nil {
+			return nil, err
+		}
+		return h.engine.Run(ctx, module, fn, params...)
+	}
+}
+
+// byteArray is encoded as a JSON array of numbers, as the Payload Codec API specifies.
+type byteArray []uint8
+
+// MarshalJSON implements json.Marshaler.
+func (b byteArray) MarshalJSON() ([]byte, error) {
+	buf := make([]byte, 0, 2+4*len(b))
+	buf = append(buf, '[')
+	for i, v := range b {
+		if i > 0 {
+			buf = append(buf, ',')
+		}
+		buf = strconv.AppendUint(buf, uint64(v), 10)
+	}
+	return append(buf, ']'), nil
+}
+
+type encodeDownlinkInput struct {
+	Data  map[string]any `json:"data"`
+	FPort *uint8         `json:"fPort"`
+}
+
+type encodeDownlinkOutput struct {
+	Bytes    []uint8  `json:"bytes"`
+	FPort    *uint8   `json:"fPort"`
+	Warnings []string `json:"warnings"`
+	Errors   []string `json:"errors"`
+}
+
+// CompileDownlinkEncoder generates a downlink encoder from the provided module.
+func (h *host) CompileDownlinkEncoder(
+	ctx context.Context, parameter string,
+) (
+	func(
+		context.Context,
+		*ttnpb.EndDeviceIdentifiers,
+		*ttnpb.EndDeviceVersionIdentifiers,
+		*ttnpb.ApplicationDownlink,
+	) error,
+	error,
+) {
+	defer trace.StartRegion(ctx, "compile downlink encoder").End()
+
+	run, err := h.compile(ctx, parameter)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(
+		ctx context.Context,
+		ids *ttnpb.EndDeviceIdentifiers,
+		version *ttnpb.EndDeviceVersionIdentifiers,
+		msg *ttnpb.ApplicationDownlink,
+	) error {
+		return h.encodeDownlink(ctx, msg, run)
+	}, nil
+}
+
+// EncodeDownlink encodes the message's DecodedPayload to FRMPayload using the given module.
+func (h *host) EncodeDownlink(
+	ctx context.Context,
+	_ *ttnpb.EndDeviceIdentifiers,
+	_ *ttnpb.EndDeviceVersionIdentifiers,
+	msg *ttnpb.ApplicationDownlink,
+	parameter string,
+) error {
+	return h.encodeDownlink(ctx, msg, h.runner(parameter))
+}
+
+func (*host) encodeDownlink(ctx context.Context, msg *ttnpb.ApplicationDownlink, run runFunc) error {
+	defer trace.StartRegion(ctx, "encode downlink message").End()
+
+	decoded := msg.DecodedPayload
+	if decoded == nil {
+		return nil
+	}
+	data, err := goproto.Map(decoded)
+	if err != nil {
+		return errInput.WithCause(err)
+	}
+	fPort := uint8(msg.FPort)
+	input := encodeDownlinkInput{
+		Data:  data,
+		FPort: &fPort,
+	}
+
+	valueAs, err := run(ctx, encodeDownlinkEntrypoint, input)
+	if err != nil {
+		return err
+	}
+
+	var output encodeDownlinkOutput
+	if err := valueAs(&output); err != nil {
+		return errOutput.WithCause(err)
+	}
+	if len(output.Errors) > 0 {
+		return errOutputErrors.WithAttributes("errors", strings.Join(output.Errors, ", "))
+	}
+
+	msg.FrmPayload = output.Bytes
+	msg.DecodedPayloadWarnings = output.Warnings
+	if output.FPort != nil {
+		msg.FPort = uint32(*output.FPort)
+	} else if msg.FPort == 0 {
+		msg.FPort = 1
+	}
+	return nil
+}
+
+type decodeUplinkInput struct {
+	Bytes byteArray `json:"bytes"`
+	FPort uint8     `json:"fPort"`
+}
+
+type decodeUplinkOutput struct {
+	Data     map[string]any `json:"data"`
+	Warnings []string       `json:"warnings"`
+	Errors   []string       `json:"errors"`
+}
+
+type normalizeUplinkInput struct {
+	Data map[string]any `json:"data"`
+}
+
+type normalizeUplinkOutput struct {
+	Data     any      `json:"data"`
+	Warnings []string `json:"warnings"`
+	Errors   []string `json:"errors"`
+}
+
+// CompileUplinkDecoder generates an uplink decoder from the provided module.
+func (h *host) CompileUplinkDecoder(
+	ctx context.Context, parameter string,
+) (
+	func(
+		context.Context,
+		*ttnpb.EndDeviceIdentifiers,
+		*ttnpb.EndDeviceVersionIdentifiers,
+		*ttnpb.ApplicationUplink,
+	) error,
+	error,
+) {
+	defer trace.StartRegion(ctx, "compile uplink decoder").End()
+
+	run, err := h.compile(ctx, parameter)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(
+		ctx context.Context,
+		ids *ttnpb.EndDeviceIdentifiers,
+		version *ttnpb.EndDeviceVersionIdentifiers,
+		msg *ttnpb.ApplicationUplink,
+	) error {
+		return h.decodeUplink(ctx, msg, run)
+	}, nil
+}
+
+// DecodeUplink decodes the message's FRMPayload to DecodedPayload using the given module.
+func (h *host) DecodeUplink(
+	ctx context.Context,
+	_ *ttnpb.EndDeviceIdentifiers,
+	_ *ttnpb.EndDeviceVersionIdentifiers,
+	msg *ttnpb.ApplicationUplink,
+	parameter string,
+) error {
+	return h.decodeUplink(ctx, msg, h.runner(parameter))
+}
+
+func appendValidationErrors(dst []string, measurements []normalizedpayload.ParsedMeasurement) []string {
+	for i, m := range measurements {
+		for _, err := range m.ValidationErrors {
+			var (
+				errString string
+				ttnErr    *errors.Error
+			)
+			if errors.As(err, &ttnErr) {
+				errString = ttnErr.FormatMessage(ttnErr.PublicAttributes())
+			} else {
+				errString = err.Error()
+			}
+			dst = append(dst, fmt.Sprintf("measurement %d: %s", i+1, errString))
+		}
+	}
+	return dst
+}
+
+// validMeasurements returns the valid fields of the parsed measurements, omitting empty measurements.
+func validMeasurements(measurements []normalizedpayload.ParsedMeasurement) []*structpb.Struct {
+	res := make([]*structpb.Struct, 0, len(measurements))
+	for _, measurement := range measurements {
+		if len(measurement.Valid.GetFields()) == 0 {
+			continue
+		}
+		res = append(res, measurement.Valid)
+	}
+	return res
+}
+
+// normalizedMeasurements converts the normalized output data, which can be an array of measurements or a single
+// measurement object, to measurements.
+func normalizedMeasurements(data any) ([]*structpb.Struct, error) {
+	var measurements []any
+	switch data := data.(type) {
+	case []any:
+		measurements = data
+	default:
+		measurements = []any{data}
+	}
+	res := make([]*structpb.Struct, len(measurements))
+	for i, measurement := range measurements {
+		m, ok := measurement.(map[string]any)
+		if !ok {
+			return nil, errOutput.New()
+		}
+		pb, err := goproto.Struct(m)
+		if err != nil {
+			return nil, errOutput.WithCause(err)
+		}
+		res[i] = pb
+	}
+	return res, nil
+}
+
+func (*host) decodeUplink(ctx context.Context, msg *ttnpb.ApplicationUplink, run runFunc) error {
+	defer trace.StartRegion(ctx, "decode uplink message").End()
+
+	input := decodeUplinkInput{
+		Bytes: msg.FrmPayload,
+		FPort: uint8(msg.FPort),
+	}
+
+	valueAs, err := run(ctx, decodeUplinkEntrypoint, input)
+	if err != nil {
+		return err
+	}
+	var decoded decodeUplinkOutput
+	if err := valueAs(&decoded); err != nil {
+		return errOutput.WithCause(err)
+	}
+	if errs := decoded.Errors; len(errs) > 0 {
+		return errOutputErrors.WithAttributes("errors", strings.Join(errs, ", "))
+	}
+	decodedPayload, err := goproto.Struct(decoded.Data)
+	if err != nil {
+		return errOutput.WithCause(err)
+	}
+	msg.DecodedPayload, msg.DecodedPayloadWarnings = decodedPayload, decoded.Warnings
+	msg.NormalizedPayload, msg.NormalizedPayloadWarnings = nil, nil
+
+	var normalized *normalizeUplinkOutput
+	if decoded.Data != nil {
+		valueAs, err := run(ctx, normalizeUplinkEntrypoint, normalizeUplinkInput{Data: decoded.Data})
+		switch {
+		case errors.IsNotFound(err):
+			// The module does not export a normalizer.
+		case err != nil:
+			return err
+		default:
+			normalized = &normalizeUplinkOutput{}
+			if err := valueAs(normalized); err != nil {
+				return errOutput.WithCause(err)
+			}
+		}
+	}
+
+	if normalized != nil {
+		if errs := normalized.Errors; len(errs) > 0 {
+			return errOutputErrors.WithAttributes("errors", strings.Join(errs, ", "))
+		}
+		if normalized.Data == nil {
+			return nil
+		}
+		normalizedPayload, err := normalizedMeasurements(normalized.Data)
+		if err != nil {
+			return err
+		}
+		// Validate the normalized payload.
+		parsed, err := normalizedpayload.Parse(normalizedPayload)
+		if err != nil {
+			return errOutput.WithCause(err)
+		}
+		msg.NormalizedPayload = validMeasurements(parsed)
+		msg.NormalizedPayloadWarnings = make([]string, 0, len(normalized.Warnings))
+		msg.NormalizedPayloadWarnings = append(msg.NormalizedPayloadWarnings, normalized.Warnings...)
+		msg.NormalizedPayloadWarnings = appendValidationErrors(msg.NormalizedPayloadWarnings, parsed)
+	} else {
+		// If the normalizer is not set, the decoder may return already normalized payload.
+		// This is a best effort attempt to parse the decoded payload as normalized payload.
+		// If that does not return an error, the decoded payload is assumed to be normalized.
+		parsed, err := normalizedpayload.Parse([]*structpb.Struct{decodedPayload})
+		if err == nil {
+			msg.NormalizedPayload = validMeasurements(parsed)
+			msg.NormalizedPayloadWarnings = appendValidationErrors(msg.NormalizedPayloadWarnings, parsed)
+		}
+	}
+
+	msg.DecodedPayloadWarnings = append(msg.DecodedPayloadWarnings, goproto.ValidateStruct(decodedPayload)...)
+	return nil
+}
+
+type decodeDownlinkInput struct {
+	Bytes byteArray `json:"bytes"`
+	FPort uint8     `json:"fPort"`
+}
+
+type decodeDownlinkOutput struct {
+	Data     map[string]any `json:"data"`
+	Warnings []string       `json:"warnings"`
+	Errors   []string       `json:"errors"`
+}
+
+// CompileDownlinkDecoder generates a downlink decoder from the provided module.
+func (h *host) CompileDownlinkDecoder(
+	ctx context.Context, parameter string,
+) (
+	func(
+		context.Context,
+		*ttnpb.EndDeviceIdentifiers,
+		*ttnpb.EndDeviceVersionIdentifiers,
+		*ttnpb.ApplicationDownlink,
+	) error,
+	error,
+) {
+	defer trace.StartRegion(ctx, "compile downlink decoder").End()
+
+	run, err := h.compile(ctx, parameter)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(
+		ctx context.Context,
+		ids *ttnpb.EndDeviceIdentifiers,
+		version *ttnpb.EndDeviceVersionIdentifiers,
+		msg *ttnpb.ApplicationDownlink,
+	) error {
+		return h.decodeDownlink(ctx, msg, run)
+	}, nil
+}
+
+// DecodeDownlink decodes the message's FRMPayload to DecodedPayload using the given module.
+func (h *host) DecodeDownlink(
+	ctx context.Context,
+	_ *ttnpb.EndDeviceIdentifiers,
+	_ *ttnpb.EndDeviceVersionIdentifiers,
+	msg *ttnpb.ApplicationDownlink,
+	parameter string,
+) error {
+	return h.decodeDownlink(ctx, msg, h.runner(parameter))
+}
+
+func (*host) decodeDownlink(ctx context.Context, msg *ttnpb.ApplicationDownlink, run runFunc) error {
+	defer trace.StartRegion(ctx, "decode downlink message").End()
+
+	input := decodeDownlinkInput{
+		Bytes: msg.FrmPayload,
+		FPort: uint8(msg.FPort),
+	}
+
+	valueAs, err := run(ctx, decodeDownlinkEntrypoint, input)
+	if err != nil {
+		return err
+	}
+
+	var output decodeDownlinkOutput
+	if err := valueAs(&output); err != nil {
+		return errOutput.WithCause(err)
+	}
+	if len(output.Errors) > 0 {
+		return errOutputErrors.WithAttributes("errors", strings.Join(output.Errors, ", "))
+	}
+
+	s, err := goproto.Struct(output.Data)
+	if err != nil {
+		return errOutput.WithCause(err)
+	}
+	msg.DecodedPayload = s
+	msg.DecodedPayloadWarnings = append(output.Warnings, goproto.ValidateStruct(s)...)
+	return nil
+}
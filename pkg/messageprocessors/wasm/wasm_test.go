@@ -0,0 +1,127 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasm_test
+
+import (
+	"context"
+	"encoding/base64"
+	"os"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/wasm"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func readModule(t *testing.T) string {
+	t.Helper()
+	b, err := os.ReadFile("testdata/codec.wasm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return base64.StdEncoding.EncodeToString(b)
+}
+
+func TestUplinkDecoder(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	host := wasm.New()
+	parameter := readModule(t)
+
+	compiled, err := host.CompileUplinkDecoder(ctx, parameter)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	for name, decode := range map[string]func(context.Context, *ttnpb.ApplicationUplink) error{
+		"Run": func(ctx context.Context, msg *ttnpb.ApplicationUplink) error {
+			return host.DecodeUplink(ctx, nil, nil, msg, parameter)
+		},
+		"Compiled": func(ctx context.Context, msg *ttnpb.ApplicationUplink) error {
+			return compiled(ctx, nil, nil, msg)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			a := assertions.New(t)
+			msg := &ttnpb.ApplicationUplink{
+				FrmPayload: []byte{0x01, 0x02},
+				FPort:      1,
+			}
+			if !a.So(decode(ctx, msg), should.BeNil) {
+				t.FailNow()
+			}
+			a.So(msg.DecodedPayload, should.Resemble, &structpb.Struct{
+				Fields: map[string]*structpb.Value{
+					"temperature": structpb.NewNumberValue(21.5),
+				},
+			})
+			a.So(msg.DecodedPayloadWarnings, should.Resemble, []string{"low battery"})
+			if !a.So(msg.NormalizedPayload, should.HaveLength, 1) {
+				t.FailNow()
+			}
+			a.So(msg.NormalizedPayload[0].AsMap(), should.Resemble, map[string]any{
+				"air": map[string]any{
+					"temperature": 21.5,
+				},
+			})
+		})
+	}
+
+	err = host.DecodeUplink(ctx, nil, nil, &ttnpb.ApplicationUplink{}, "not base64")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestDownlinkEncoder(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	host := wasm.New()
+
+	msg := &ttnpb.ApplicationDownlink{
+		DecodedPayload: &structpb.Struct{
+			Fields: map[string]*structpb.Value{
+				"state": structpb.NewStringValue("on"),
+			},
+		},
+	}
+	if !a.So(host.EncodeDownlink(ctx, nil, nil, msg, readModule(t)), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(msg.FrmPayload, should.Resemble, []byte{0x01, 0x02, 0x03})
+	a.So(msg.FPort, should.Equal, 2)
+	a.So(msg.DecodedPayloadWarnings, should.Resemble, []string{"default value"})
+}
+
+func TestDownlinkDecoder(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	host := wasm.New()
+
+	decode, err := host.CompileDownlinkDecoder(ctx, readModule(t))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	msg := &ttnpb.ApplicationDownlink{
+		FrmPayload: []byte{0xff},
+		FPort:      1,
+	}
+	err = decode(ctx, nil, nil, msg)
+	a.So(errors.IsAborted(err), should.BeTrue)
+}
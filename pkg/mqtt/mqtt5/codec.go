@@ -0,0 +1,249 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt5
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"unicode/utf8"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// maxVarint is the maximum value of a variable byte integer.
+const maxVarint = 268435455
+
+var (
+	errMalformedPacket   = errors.DefineInvalidArgument("malformed_packet", "malformed packet")
+	errPacketTooLarge    = errors.DefineInvalidArgument("packet_too_large", "packet size `{size}` exceeds maximum `{max_size}`")
+	errUnknownPacketType = errors.DefineInvalidArgument("unknown_packet_type", "unknown packet type `{type}`")
+	errInvalidFlags      = errors.DefineInvalidArgument("invalid_flags", "invalid flags `{flags}` for packet type `{type}`")
+	errUnknownProperty   = errors.DefineInvalidArgument("unknown_property", "unknown property `{property}`")
+	errDuplicateProperty = errors.DefineInvalidArgument("duplicate_property", "duplicate property")
+	errInvalidString     = errors.DefineInvalidArgument("invalid_string", "invalid UTF-8 string")
+)
+
+type encoder struct {
+	bytes.Buffer
+	// version is the protocol level of the packet.
+	version byte
+}
+
+func (e *encoder) byte(b byte) { e.WriteByte(b) } //nolint:errcheck
+
+func (e *encoder) uint16(v uint16) {
+	var b [2]byte
+	binary.BigEndian.PutUint16(b[:], v)
+	e.Write(b[:]) //nolint:errcheck
+}
+
+func (e *encoder) uint32(v uint32) {
+	var b [4]byte
+	binary.BigEndian.PutUint32(b[:], v)
+	e.Write(b[:]) //nolint:errcheck
+}
+
+func (e *encoder) varint(v uint32) {
+	for {
+		b := byte(v % 128)
+		v /= 128
+		if v > 0 {
+			b |= 0x80
+		}
+		e.byte(b)
+		if v == 0 {
+			return
+		}
+	}
+}
+
+func (e *encoder) string(s string) { e.binary([]byte(s)) }
+
+func (e *encoder) binary(b []byte) {
+	e.uint16(uint16(len(b)))
+	e.Write(b) //nolint:errcheck
+}
+
+type decoder struct {
+	b   []byte
+	err error
+	// version is the protocol level of the packet.
+	version byte
+}
+
+func (d *decoder) fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+}
+
+func (d *decoder) remaining() int { return len(d.b) }
+
+func (d *decoder) next(n int) []byte {
+	if d.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(d.b) {
+		d.fail(errMalformedPacket.New())
+		return nil
+	}
+	b := d.b[:n]
+	d.b = d.b[n:]
+	return b
+}
+
+func (d *decoder) sub(n int) *decoder {
+	b := d.next(n)
+	return &decoder{b: b, err: d.err, version: d.version}
+}
+
+func (d *decoder) rest() []byte { return d.next(len(d.b)) }
+
+func (d *decoder) byte() byte {
+	if b := d.next(1); b != nil {
+		return b[0]
+	}
+	return 0
+}
+
+func (d *decoder) uint16() uint16 {
+	if b := d.next(2); b != nil {
+		return binary.BigEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (d *decoder) uint32() uint32 {
+	if b := d.next(4); b != nil {
+		return binary.BigEndian.Uint32(b)
+	}
+	return 0
+}
+
+func (d *decoder) varint() uint32 {
+	var v, multiplier uint32 = 0, 1
+	for i := 0; i < 4; i++ {
+		b := d.byte()
+		if d.err != nil {
+			return 0
+		}
+		v += uint32(b&0x7F) * multiplier
+		if b&0x80 == 0 {
+			return v
+		}
+		multiplier *= 128
+	}
+	d.fail(errMalformedPacket.New())
+	return 0
+}
+
+func (d *decoder) binary() []byte {
+	n := d.uint16()
+	b := d.next(int(n))
+	if b == nil {
+		return nil
+	}
+	return append([]byte{}, b...)
+}
+
+func (d *decoder) string() string {
+	b := d.binary()
+	if !utf8.Valid(b) || bytes.IndexByte(b, 0) >= 0 {
+		d.fail(errInvalidString.New())
+		return ""
+	}
+	return string(b)
+}
+
+func readVarint(r io.Reader) (uint32, error) {
+	var (
+		v, multiplier uint32 = 0, 1
+		b             [1]byte
+	)
+	for i := 0; i < 4; i++ {
+		if _, err := io.ReadFull(r, b[:]); err != nil {
+			return 0, err
+		}
+		v += uint32(b[0]&0x7F) * multiplier
+		if b[0]&0x80 == 0 {
+			return v, nil
+		}
+		multiplier *= 128
+	}
+	return 0, errMalformedPacket.New()
+}
+
+// Read reads a control packet of the protocol level from the reader.
+// The protocol level of CONNECT packets is read from the packet itself.
+// Packets that exceed the maximum packet size are rejected. A maximum packet size of 0 means no limit.
+func Read(r io.Reader, version byte, maxPacketSize uint32) (Packet, error) {
+	var header [1]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		return nil, err
+	}
+	length, err := readVarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if maxPacketSize > 0 && length > maxPacketSize {
+		return nil, errPacketTooLarge.WithAttributes("size", length, "max_size", maxPacketSize)
+	}
+	pkt, err := newPacket(PacketType(header[0]>>4), version)
+	if err != nil {
+		return nil, err
+	}
+	flags := header[0] & 0x0F
+	if expected, ok := fixedFlags[pkt.Type()]; ok && flags != expected {
+		return nil, errInvalidFlags.WithAttributes("flags", flags, "type", pkt.Type().String())
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return nil, err
+	}
+	d := &decoder{b: body, version: version}
+	pkt.decode(flags, d)
+	if d.err == nil && d.remaining() > 0 {
+		d.fail(errMalformedPacket.New())
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return pkt, nil
+}
+
+// Marshal returns the binary encoding of the control packet in the protocol level.
+func Marshal(pkt Packet, version byte) ([]byte, error) {
+	body := encoder{version: version}
+	flags := pkt.encode(&body)
+	if body.Len() > maxVarint {
+		return nil, errPacketTooLarge.WithAttributes("size", body.Len(), "max_size", maxVarint)
+	}
+	var e encoder
+	e.byte(byte(pkt.Type())<<4 | flags)
+	e.varint(uint32(body.Len()))
+	e.Write(body.Bytes()) //nolint:errcheck
+	return e.Bytes(), nil
+}
+
+// Write writes the control packet in the protocol level to the writer.
+func Write(w io.Writer, pkt Packet, version byte) error {
+	buf, err := Marshal(pkt, version)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(buf)
+	return err
+}
@@ -0,0 +1,138 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt5
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type memorySession struct {
+	owner         string
+	expires       time.Time
+	seq           uint64
+	subscriptions map[string]byte
+	messages      []StoredMessage
+}
+
+type memorySessionStore struct {
+	maxMessages int
+	mu          sync.Mutex
+	sessions    map[SessionID]*memorySession
+}
+
+// NewMemorySessionStore returns a SessionStore that stores the sessions in memory.
+// Sessions are not shared between processes and do not survive restarts, which makes this store useful for testing.
+// The number of stored messages per session is limited to maxMessages.
+func NewMemorySessionStore(maxMessages int) SessionStore {
+	return &memorySessionStore{
+		maxMessages: maxMessages,
+		sessions:    make(map[SessionID]*memorySession),
+	}
+}
+
+// get returns the session if it is not expired. The mutex must be held.
+func (m *memorySessionStore) get(id SessionID) *memorySession {
+	session, ok := m.sessions[id]
+	if !ok {
+		return nil
+	}
+	if time.Now().After(session.expires) {
+		delete(m.sessions, id)
+		return nil
+	}
+	return session
+}
+
+// Resume implements SessionStore.
+func (m *memorySessionStore) Resume(
+	_ context.Context, id SessionID, owner string, clean bool, expiry time.Duration,
+) (*SessionState, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	session := m.get(id)
+	if session == nil || clean {
+		m.sessions[id] = &memorySession{
+			owner:         owner,
+			expires:       time.Now().Add(expiry),
+			subscriptions: make(map[string]byte),
+		}
+		return nil, nil
+	}
+	session.owner, session.expires = owner, time.Now().Add(expiry)
+	state := &SessionState{
+		Subscriptions: make(map[string]byte, len(session.subscriptions)),
+		Messages:      append([]StoredMessage(nil), session.messages...),
+	}
+	for filter, qos := range session.subscriptions {
+		state.Subscriptions[filter] = qos
+	}
+	return state, nil
+}
+
+// Update implements SessionStore.
+func (m *memorySessionStore) Update(
+	_ context.Context, id SessionID, owner string, expiry time.Duration, update *SessionUpdate,
+) ([]uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	session := m.get(id)
+	if session == nil || session.owner != owner {
+		return nil, ErrSessionTakenOver.New()
+	}
+	session.expires = time.Now().Add(expiry)
+	for filter, qos := range update.SetSubscriptions {
+		session.subscriptions[filter] = qos
+	}
+	for _, filter := range update.DeleteSubscriptions {
+		delete(session.subscriptions, filter)
+	}
+	if len(update.DeleteMessages) > 0 {
+		deleted := make(map[uint64]bool, len(update.DeleteMessages))
+		for _, seq := range update.DeleteMessages {
+			deleted[seq] = true
+		}
+		messages := session.messages[:0]
+		for _, msg := range session.messages {
+			if !deleted[msg.Seq] {
+				messages = append(messages, msg)
+			}
+		}
+		session.messages = messages
+	}
+	seqs := make([]uint64, len(update.AddMessages))
+	for i, data := range update.AddMessages {
+		session.seq++
+		seqs[i] = session.seq
+		session.messages = append(session.messages, StoredMessage{Seq: session.seq, Data: data})
+	}
+	if n := len(session.messages) - m.maxMessages; n > 0 {
+		session.messages = append(session.messages[:0:0], session.messages[n:]...)
+	}
+	return seqs, nil
+}
+
+// Delete implements SessionStore.
+func (m *memorySessionStore) Delete(_ context.Context, id SessionID, owner string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	session := m.get(id)
+	if session == nil || session.owner != owner {
+		return ErrSessionTakenOver.New()
+	}
+	delete(m.sessions, id)
+	return nil
+}
@@ -0,0 +1,587 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mqtt5 implements the MQTT 5 control packets and server sessions.
+//
+// The sessions also serve MQTT 3.1.1 clients, so that MQTT 3.1.1 clients can use persistent sessions.
+package mqtt5
+
+import (
+	"fmt"
+
+	mqttpacket "github.com/TheThingsIndustries/mystique/pkg/packet"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt"
+)
+
+const protocolName = "MQTT"
+
+var (
+	errUnsupportedPacketType      = errors.DefineUnimplemented("unsupported_packet_type", "unsupported packet type `{type}`")
+	errUnsupportedProtocolVersion = errors.DefineInvalidArgument(
+		"unsupported_protocol_version", "unsupported protocol `{name}` version `{level}`",
+	)
+)
+
+// PacketType is the type of a control packet.
+type PacketType byte
+
+// Control packet types.
+const (
+	CONNECT     PacketType = 1
+	CONNACK     PacketType = 2
+	PUBLISH     PacketType = 3
+	PUBACK      PacketType = 4
+	PUBREC      PacketType = 5
+	PUBREL      PacketType = 6
+	PUBCOMP     PacketType = 7
+	SUBSCRIBE   PacketType = 8
+	SUBACK      PacketType = 9
+	UNSUBSCRIBE PacketType = 10
+	UNSUBACK    PacketType = 11
+	PINGREQ     PacketType = 12
+	PINGRESP    PacketType = 13
+	DISCONNECT  PacketType = 14
+	AUTH        PacketType = 15
+)
+
+var packetTypeNames = map[PacketType]string{
+	CONNECT:     "CONNECT",
+	CONNACK:     "CONNACK",
+	PUBLISH:     "PUBLISH",
+	PUBACK:      "PUBACK",
+	PUBREC:      "PUBREC",
+	PUBREL:      "PUBREL",
+	PUBCOMP:     "PUBCOMP",
+	SUBSCRIBE:   "SUBSCRIBE",
+	SUBACK:      "SUBACK",
+	UNSUBSCRIBE: "UNSUBSCRIBE",
+	UNSUBACK:    "UNSUBACK",
+	PINGREQ:     "PINGREQ",
+	PINGRESP:    "PINGRESP",
+	DISCONNECT:  "DISCONNECT",
+	AUTH:        "AUTH",
+}
+
+// String implements fmt.Stringer.
+func (t PacketType) String() string {
+	if name, ok := packetTypeNames[t]; ok {
+		return name
+	}
+	return fmt.Sprintf("%d", byte(t))
+}
+
+// fixedFlags are the flags of the packet types that have fixed flags.
+var fixedFlags = map[PacketType]byte{
+	CONNECT:     0,
+	CONNACK:     0,
+	PUBACK:      0,
+	SUBSCRIBE:   0x02,
+	SUBACK:      0,
+	UNSUBSCRIBE: 0x02,
+	UNSUBACK:    0,
+	PINGREQ:     0,
+	PINGRESP:    0,
+	DISCONNECT:  0,
+	AUTH:        0,
+}
+
+// Packet is an MQTT 5 control packet.
+type Packet interface {
+	Type() PacketType
+	encode(e *encoder) (flags byte)
+	decode(flags byte, d *decoder)
+}
+
+func newPacket(t PacketType, version byte) (Packet, error) {
+	switch t {
+	case CONNECT:
+		return &Connect{}, nil
+	case CONNACK:
+		return &Connack{}, nil
+	case PUBLISH:
+		return &Publish{}, nil
+	case PUBACK:
+		return &Puback{}, nil
+	case SUBSCRIBE:
+		return &Subscribe{}, nil
+	case SUBACK:
+		return &Suback{}, nil
+	case UNSUBSCRIBE:
+		return &Unsubscribe{}, nil
+	case UNSUBACK:
+		return &Unsuback{}, nil
+	case PINGREQ:
+		return &Pingreq{}, nil
+	case PINGRESP:
+		return &Pingresp{}, nil
+	case DISCONNECT:
+		return &Disconnect{}, nil
+	case AUTH:
+		if version == mqtt.ProtocolLevel311 {
+			return nil, errUnknownPacketType.WithAttributes("type", byte(t))
+		}
+		return &Auth{}, nil
+	case PUBREC, PUBREL, PUBCOMP:
+		return nil, errUnsupportedPacketType.WithAttributes("type", t.String())
+	default:
+		return nil, errUnknownPacketType.WithAttributes("type", byte(t))
+	}
+}
+
+// Will is the will message of a CONNECT packet.
+type Will struct {
+	QoS        byte
+	Retain     bool
+	Properties Properties
+	Topic      string
+	Payload    []byte
+}
+
+// Connect is the CONNECT packet.
+type Connect struct {
+	// ProtocolLevel is the protocol level of the connection. The zero value is MQTT 5.
+	ProtocolLevel byte
+	CleanStart    bool
+	KeepAlive     uint16
+	Properties    Properties
+	ClientID      string
+	Will          *Will
+	Username      string
+	Password      []byte
+}
+
+// Type implements Packet.
+func (*Connect) Type() PacketType { return CONNECT }
+
+func (p *Connect) encode(e *encoder) byte {
+	e.version = p.ProtocolLevel
+	if e.version == 0 {
+		e.version = mqtt.ProtocolLevel5
+	}
+	e.string(protocolName)
+	e.byte(e.version)
+	var flags byte
+	if p.CleanStart {
+		flags |= 0x02
+	}
+	if p.Will != nil {
+		flags |= 0x04 | p.Will.QoS<<3
+		if p.Will.Retain {
+			flags |= 0x20
+		}
+	}
+	if p.Password != nil {
+		flags |= 0x40
+	}
+	if p.Username != "" {
+		flags |= 0x80
+	}
+	e.byte(flags)
+	e.uint16(p.KeepAlive)
+	p.Properties.encode(e)
+	e.string(p.ClientID)
+	if p.Will != nil {
+		p.Will.Properties.encode(e)
+		e.string(p.Will.Topic)
+		e.binary(p.Will.Payload)
+	}
+	if p.Username != "" {
+		e.string(p.Username)
+	}
+	if p.Password != nil {
+		e.binary(p.Password)
+	}
+	return 0
+}
+
+func (p *Connect) decode(_ byte, d *decoder) {
+	name, level := d.string(), d.byte()
+	if d.err != nil {
+		return
+	}
+	if name != protocolName || level != mqtt.ProtocolLevel5 && level != mqtt.ProtocolLevel311 {
+		d.fail(errUnsupportedProtocolVersion.WithAttributes("name", name, "level", level))
+		return
+	}
+	p.ProtocolLevel, d.version = level, level
+	flags := d.byte()
+	if flags&0x01 != 0 {
+		d.fail(errMalformedPacket.New())
+		return
+	}
+	p.CleanStart = flags&0x02 != 0
+	p.KeepAlive = d.uint16()
+	p.Properties.decode(d)
+	p.ClientID = d.string()
+	if flags&0x04 != 0 {
+		p.Will = &Will{
+			QoS:    flags >> 3 & 0x03,
+			Retain: flags&0x20 != 0,
+		}
+		if p.Will.QoS > 2 {
+			d.fail(errMalformedPacket.New())
+			return
+		}
+		p.Will.Properties.decode(d)
+		p.Will.Topic = d.string()
+		p.Will.Payload = d.binary()
+	} else if flags&0x38 != 0 {
+		d.fail(errMalformedPacket.New())
+		return
+	}
+	if flags&0x80 != 0 {
+		p.Username = d.string()
+	}
+	if flags&0x40 != 0 {
+		p.Password = d.binary()
+		if p.Password == nil {
+			p.Password = []byte{}
+		}
+	}
+}
+
+// Connack is the CONNACK packet.
+type Connack struct {
+	SessionPresent bool
+	ReasonCode     ReasonCode
+	Properties     Properties
+}
+
+// Type implements Packet.
+func (*Connack) Type() PacketType { return CONNACK }
+
+func (p *Connack) encode(e *encoder) byte {
+	if p.SessionPresent {
+		e.byte(0x01)
+	} else {
+		e.byte(0x00)
+	}
+	if e.version == mqtt.ProtocolLevel311 {
+		e.byte(byte(connectReturnCode(p.ReasonCode)))
+		return 0
+	}
+	e.byte(byte(p.ReasonCode))
+	p.Properties.encode(e)
+	return 0
+}
+
+func (p *Connack) decode(_ byte, d *decoder) {
+	p.SessionPresent = d.byte()&0x01 != 0
+	if d.version == mqtt.ProtocolLevel311 {
+		if code := mqttpacket.ConnectReturnCode(d.byte()); code != mqttpacket.ConnectAccepted {
+			p.ReasonCode = ReasonCodeFromError(code)
+		}
+		return
+	}
+	p.ReasonCode = ReasonCode(d.byte())
+	p.Properties.decode(d)
+}
+
+// Publish is the PUBLISH packet.
+type Publish struct {
+	Duplicate  bool
+	QoS        byte
+	Retain     bool
+	TopicName  string
+	PacketID   uint16
+	Properties Properties
+	Payload    []byte
+}
+
+// Type implements Packet.
+func (*Publish) Type() PacketType { return PUBLISH }
+
+func (p *Publish) encode(e *encoder) byte {
+	e.string(p.TopicName)
+	if p.QoS > 0 {
+		e.uint16(p.PacketID)
+	}
+	p.Properties.encode(e)
+	e.Write(p.Payload) //nolint:errcheck
+	flags := p.QoS << 1
+	if p.Duplicate {
+		flags |= 0x08
+	}
+	if p.Retain {
+		flags |= 0x01
+	}
+	return flags
+}
+
+func (p *Publish) decode(flags byte, d *decoder) {
+	p.Duplicate = flags&0x08 != 0
+	p.QoS = flags >> 1 & 0x03
+	p.Retain = flags&0x01 != 0
+	if p.QoS > 2 {
+		d.fail(errMalformedPacket.New())
+		return
+	}
+	p.TopicName = d.string()
+	if p.QoS > 0 {
+		p.PacketID = d.uint16()
+	}
+	p.Properties.decode(d)
+	p.Payload = append([]byte{}, d.rest()...)
+}
+
+// Puback is the PUBACK packet.
+type Puback struct {
+	PacketID   uint16
+	ReasonCode ReasonCode
+	Properties Properties
+}
+
+// Type implements Packet.
+func (*Puback) Type() PacketType { return PUBACK }
+
+func (p *Puback) encode(e *encoder) byte {
+	e.uint16(p.PacketID)
+	if e.version == mqtt.ProtocolLevel311 {
+		return 0
+	}
+	e.byte(byte(p.ReasonCode))
+	p.Properties.encode(e)
+	return 0
+}
+
+func (p *Puback) decode(_ byte, d *decoder) {
+	p.PacketID = d.uint16()
+	if d.remaining() > 0 {
+		p.ReasonCode = ReasonCode(d.byte())
+	}
+	if d.remaining() > 0 {
+		p.Properties.decode(d)
+	}
+}
+
+// Subscription is a topic filter with its subscription options.
+type Subscription struct {
+	Filter            string
+	QoS               byte
+	NoLocal           bool
+	RetainAsPublished bool
+	RetainHandling    byte
+}
+
+// Subscribe is the SUBSCRIBE packet.
+type Subscribe struct {
+	PacketID      uint16
+	Properties    Properties
+	Subscriptions []Subscription
+}
+
+// Type implements Packet.
+func (*Subscribe) Type() PacketType { return SUBSCRIBE }
+
+func (p *Subscribe) encode(e *encoder) byte {
+	e.uint16(p.PacketID)
+	p.Properties.encode(e)
+	for _, sub := range p.Subscriptions {
+		e.string(sub.Filter)
+		options := sub.QoS | sub.RetainHandling<<4
+		if sub.NoLocal {
+			options |= 0x04
+		}
+		if sub.RetainAsPublished {
+			options |= 0x08
+		}
+		e.byte(options)
+	}
+	return fixedFlags[SUBSCRIBE]
+}
+
+func (p *Subscribe) decode(_ byte, d *decoder) {
+	p.PacketID = d.uint16()
+	p.Properties.decode(d)
+	for d.err == nil && d.remaining() > 0 {
+		filter, options := d.string(), d.byte()
+		if d.version == mqtt.ProtocolLevel311 && options&0xFC != 0 || options&0xC0 != 0 || options&0x03 > 2 || options>>4&0x03 > 2 {
+			d.fail(errMalformedPacket.New())
+			return
+		}
+		p.Subscriptions = append(p.Subscriptions, Subscription{
+			Filter:            filter,
+			QoS:               options & 0x03,
+			NoLocal:           options&0x04 != 0,
+			RetainAsPublished: options&0x08 != 0,
+			RetainHandling:    options >> 4 & 0x03,
+		})
+	}
+	if len(p.Subscriptions) == 0 {
+		d.fail(errMalformedPacket.New())
+	}
+}
+
+// Suback is the SUBACK packet.
+type Suback struct {
+	PacketID    uint16
+	Properties  Properties
+	ReasonCodes []ReasonCode
+}
+
+// Type implements Packet.
+func (*Suback) Type() PacketType { return SUBACK }
+
+func (p *Suback) encode(e *encoder) byte {
+	e.uint16(p.PacketID)
+	p.Properties.encode(e)
+	for _, code := range p.ReasonCodes {
+		// MQTT 3.1.1 has a single return code for failures, which equals the Unspecified Error reason code.
+		if e.version == mqtt.ProtocolLevel311 && code.IsError() {
+			code = UnspecifiedError
+		}
+		e.byte(byte(code))
+	}
+	return 0
+}
+
+func (p *Suback) decode(_ byte, d *decoder) {
+	p.PacketID = d.uint16()
+	p.Properties.decode(d)
+	for _, code := range d.rest() {
+		p.ReasonCodes = append(p.ReasonCodes, ReasonCode(code))
+	}
+}
+
+// Unsubscribe is the UNSUBSCRIBE packet.
+type Unsubscribe struct {
+	PacketID   uint16
+	Properties Properties
+	Filters    []string
+}
+
+// Type implements Packet.
+func (*Unsubscribe) Type() PacketType { return UNSUBSCRIBE }
+
+func (p *Unsubscribe) encode(e *encoder) byte {
+	e.uint16(p.PacketID)
+	p.Properties.encode(e)
+	for _, filter := range p.Filters {
+		e.string(filter)
+	}
+	return fixedFlags[UNSUBSCRIBE]
+}
+
+func (p *Unsubscribe) decode(_ byte, d *decoder) {
+	p.PacketID = d.uint16()
+	p.Properties.decode(d)
+	for d.err == nil && d.remaining() > 0 {
+		p.Filters = append(p.Filters, d.string())
+	}
+	if len(p.Filters) == 0 {
+		d.fail(errMalformedPacket.New())
+	}
+}
+
+// Unsuback is the UNSUBACK packet.
+type Unsuback struct {
+	PacketID    uint16
+	Properties  Properties
+	ReasonCodes []ReasonCode
+}
+
+// Type implements Packet.
+func (*Unsuback) Type() PacketType { return UNSUBACK }
+
+func (p *Unsuback) encode(e *encoder) byte {
+	e.uint16(p.PacketID)
+	if e.version == mqtt.ProtocolLevel311 {
+		return 0
+	}
+	p.Properties.encode(e)
+	for _, code := range p.ReasonCodes {
+		e.byte(byte(code))
+	}
+	return 0
+}
+
+func (p *Unsuback) decode(_ byte, d *decoder) {
+	p.PacketID = d.uint16()
+	p.Properties.decode(d)
+	for _, code := range d.rest() {
+		p.ReasonCodes = append(p.ReasonCodes, ReasonCode(code))
+	}
+}
+
+// Pingreq is the PINGREQ packet.
+type Pingreq struct{}
+
+// Type implements Packet.
+func (*Pingreq) Type() PacketType { return PINGREQ }
+
+func (*Pingreq) encode(*encoder) byte { return 0 }
+
+func (*Pingreq) decode(byte, *decoder) {}
+
+// Pingresp is the PINGRESP packet.
+type Pingresp struct{}
+
+// Type implements Packet.
+func (*Pingresp) Type() PacketType { return PINGRESP }
+
+func (*Pingresp) encode(*encoder) byte { return 0 }
+
+func (*Pingresp) decode(byte, *decoder) {}
+
+// Disconnect is the DISCONNECT packet.
+type Disconnect struct {
+	ReasonCode ReasonCode
+	Properties Properties
+}
+
+// Type implements Packet.
+func (*Disconnect) Type() PacketType { return DISCONNECT }
+
+func (p *Disconnect) encode(e *encoder) byte {
+	if e.version == mqtt.ProtocolLevel311 {
+		return 0
+	}
+	e.byte(byte(p.ReasonCode))
+	p.Properties.encode(e)
+	return 0
+}
+
+func (p *Disconnect) decode(_ byte, d *decoder) {
+	if d.remaining() > 0 {
+		p.ReasonCode = ReasonCode(d.byte())
+	}
+	if d.remaining() > 0 {
+		p.Properties.decode(d)
+	}
+}
+
+// Auth is the AUTH packet.
+type Auth struct {
+	ReasonCode ReasonCode
+	Properties Properties
+}
+
+// Type implements Packet.
+func (*Auth) Type() PacketType { return AUTH }
+
+func (p *Auth) encode(e *encoder) byte {
+	e.byte(byte(p.ReasonCode))
+	p.Properties.encode(e)
+	return 0
+}
+
+func (p *Auth) decode(_ byte, d *decoder) {
+	if d.remaining() > 0 {
+		p.ReasonCode = ReasonCode(d.byte())
+	}
+	if d.remaining() > 0 {
+		p.Properties.decode(d)
+	}
+}
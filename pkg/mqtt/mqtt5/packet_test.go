@@ -0,0 +1,268 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt5_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt/mqtt5"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestPacketEncoding(t *testing.T) {
+	t.Parallel()
+
+	for _, pkt := range []mqtt5.Packet{
+		&mqtt5.Connect{
+			ProtocolLevel: mqtt.ProtocolLevel5,
+			CleanStart:    true,
+			KeepAlive:     60,
+			Properties: mqtt5.Properties{
+				SessionExpiryInterval: mqtt5.Uint32(3600),
+				ReceiveMaximum:        mqtt5.Uint16(10),
+				MaximumPacketSize:     mqtt5.Uint32(4096),
+			},
+			ClientID: "test-client",
+			Will: &mqtt5.Will{
+				QoS: 1,
+				Properties: mqtt5.Properties{
+					WillDelayInterval: mqtt5.Uint32(5),
+				},
+				Topic:   "will/topic",
+				Payload: []byte("bye"),
+			},
+			Username: "test-user",
+			Password: []byte("test-password"),
+		},
+		&mqtt5.Connack{
+			SessionPresent: true,
+			ReasonCode:     mqtt5.Success,
+			Properties: mqtt5.Properties{
+				MaximumQoS:                  mqtt5.Byte(1),
+				RetainAvailable:             mqtt5.Byte(0),
+				AssignedClientIdentifier:    "assigned",
+				SharedSubscriptionAvailable: mqtt5.Byte(1),
+			},
+		},
+		&mqtt5.Publish{
+			QoS:       1,
+			TopicName: "foo/bar",
+			PacketID:  42,
+			Properties: mqtt5.Properties{
+				MessageExpiryInterval: mqtt5.Uint32(60),
+				ContentType:           "application/json",
+				ResponseTopic:         "foo/response",
+				CorrelationData:       []byte{0x1, 0x2},
+				UserProperties: []mqtt5.UserProperty{
+					{Key: "device_id", Value: "foo-device"},
+					{Key: "correlation_id", Value: "foo"},
+					{Key: "correlation_id", Value: "bar"},
+				},
+			},
+			Payload: []byte(`{"foo":"bar"}`),
+		},
+		&mqtt5.Publish{
+			TopicName: "foo",
+		},
+		&mqtt5.Puback{
+			PacketID:   42,
+			ReasonCode: mqtt5.NotAuthorized,
+			Properties: mqtt5.Properties{
+				ReasonString: "not authorized",
+			},
+		},
+		&mqtt5.Subscribe{
+			PacketID: 1,
+			Subscriptions: []mqtt5.Subscription{
+				{Filter: "foo/#", QoS: 1},
+				{Filter: "$share/group/foo/+", NoLocal: false, RetainAsPublished: true, RetainHandling: 2},
+			},
+		},
+		&mqtt5.Suback{
+			PacketID:    1,
+			ReasonCodes: []mqtt5.ReasonCode{mqtt5.GrantedQoS1, mqtt5.NotAuthorized},
+		},
+		&mqtt5.Unsubscribe{
+			PacketID: 2,
+			Filters:  []string{"foo/#", "bar"},
+		},
+		&mqtt5.Unsuback{
+			PacketID:    2,
+			ReasonCodes: []mqtt5.ReasonCode{mqtt5.Success, mqtt5.NoSubscriptionExisted},
+		},
+		&mqtt5.Pingreq{},
+		&mqtt5.Pingresp{},
+		&mqtt5.Disconnect{
+			ReasonCode: mqtt5.ServerShuttingDown,
+			Properties: mqtt5.Properties{
+				ServerReference: "other.example.com",
+			},
+		},
+	} {
+		pkt := pkt
+		t.Run(pkt.Type().String(), func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+
+			buf, err := mqtt5.Marshal(pkt, mqtt.ProtocolLevel5)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			decoded, err := mqtt5.Read(bytes.NewReader(buf), mqtt.ProtocolLevel5, 0)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(decoded, should.Resemble, pkt)
+		})
+	}
+}
+
+func TestPacketEncodingMQTT311(t *testing.T) {
+	t.Parallel()
+
+	for _, pkt := range []mqtt5.Packet{
+		&mqtt5.Connect{
+			ProtocolLevel: mqtt.ProtocolLevel311,
+			KeepAlive:     60,
+			ClientID:      "test-client",
+			Will: &mqtt5.Will{
+				QoS:     1,
+				Topic:   "will/topic",
+				Payload: []byte("bye"),
+			},
+			Username: "test-user",
+			Password: []byte("test-password"),
+		},
+		&mqtt5.Connack{
+			SessionPresent: true,
+			ReasonCode:     mqtt5.Success,
+		},
+		&mqtt5.Connack{
+			ReasonCode: mqtt5.NotAuthorized,
+		},
+		&mqtt5.Publish{
+			QoS:       1,
+			TopicName: "foo/bar",
+			PacketID:  42,
+			Payload:   []byte(`{"foo":"bar"}`),
+		},
+		&mqtt5.Puback{
+			PacketID: 42,
+		},
+		&mqtt5.Subscribe{
+			PacketID: 1,
+			Subscriptions: []mqtt5.Subscription{
+				{Filter: "foo/#", QoS: 1},
+				{Filter: "bar"},
+			},
+		},
+		&mqtt5.Suback{
+			PacketID:    1,
+			ReasonCodes: []mqtt5.ReasonCode{mqtt5.GrantedQoS1, mqtt5.UnspecifiedError},
+		},
+		&mqtt5.Unsubscribe{
+			PacketID: 2,
+			Filters:  []string{"foo/#", "bar"},
+		},
+		&mqtt5.Unsuback{
+			PacketID: 2,
+		},
+		&mqtt5.Pingreq{},
+		&mqtt5.Disconnect{},
+	} {
+		pkt := pkt
+		t.Run(pkt.Type().String(), func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+
+			buf, err := mqtt5.Marshal(pkt, mqtt.ProtocolLevel311)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			decoded, err := mqtt5.Read(bytes.NewReader(buf), mqtt.ProtocolLevel311, 0)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(decoded, should.Resemble, pkt)
+		})
+	}
+}
+
+func TestPacketDecodingErrors(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name          string
+		Bytes         []byte
+		MaxPacketSize uint32
+		ReasonCode    mqtt5.ReasonCode
+	}{
+		{
+			Name: "MQTT31Connect",
+			Bytes: []byte{
+				0x10, 0x0C,
+				0x00, 0x04, 'M', 'Q', 'T', 'T', 0x03, 0x02, 0x00, 0x3C,
+				0x00, 0x00,
+			},
+			ReasonCode: mqtt5.UnsupportedProtocolVersion,
+		},
+		{
+			Name:       "InvalidFlags",
+			Bytes:      []byte{0x82, 0x00},
+			ReasonCode: mqtt5.MalformedPacket,
+		},
+		{
+			Name:       "UnknownProperty",
+			Bytes:      []byte{0xE0, 0x03, 0x00, 0x01, 0x7F},
+			ReasonCode: mqtt5.MalformedPacket,
+		},
+		{
+			Name:       "DuplicateProperty",
+			Bytes:      []byte{0xE0, 0x0C, 0x00, 0x0A, 0x11, 0x00, 0x00, 0x00, 0x01, 0x11, 0x00, 0x00, 0x00, 0x02},
+			ReasonCode: mqtt5.MalformedPacket,
+		},
+		{
+			Name:       "Truncated",
+			Bytes:      []byte{0x30, 0x03, 0x00, 0x10, 'f'},
+			ReasonCode: mqtt5.MalformedPacket,
+		},
+		{
+			Name:          "TooLarge",
+			Bytes:         []byte{0x30, 0x80, 0x01},
+			MaxPacketSize: 64,
+			ReasonCode:    mqtt5.PacketTooLarge,
+		},
+		{
+			Name:       "QoS2",
+			Bytes:      []byte{0x50, 0x02, 0x00, 0x01},
+			ReasonCode: mqtt5.ProtocolError,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+
+			_, err := mqtt5.Read(bytes.NewReader(tc.Bytes), mqtt.ProtocolLevel5, tc.MaxPacketSize)
+			if !a.So(err, should.NotBeNil) {
+				t.FailNow()
+			}
+			a.So(mqtt5.ReasonCodeFromError(err), should.Equal, tc.ReasonCode)
+		})
+	}
+}
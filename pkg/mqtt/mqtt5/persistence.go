@@ -0,0 +1,342 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt5
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+)
+
+// ErrSessionTakenOver is returned by the SessionStore when the session is resumed by another connection.
+var ErrSessionTakenOver = errors.DefineAborted("session_taken_over", "session taken over")
+
+var errMessageEncoding = errors.DefineCorruption("message_encoding", "invalid encoding of stored message")
+
+const (
+	// maxTouchInterval is the maximum interval in which the stored state of a persistent session is touched.
+	maxTouchInterval = time.Minute
+	// maxInflight is the maximum number of QoS 1 messages of a persistent session that are awaiting a PUBACK.
+	maxInflight = 1 << 15
+
+	ownerLength = 16
+)
+
+// SessionID identifies a persistent session.
+type SessionID struct {
+	Username string
+	ClientID string
+}
+
+// StoredMessage is an unacknowledged QoS 1 message of a persistent session.
+type StoredMessage struct {
+	// Seq is the sequence number of the message, which is assigned by the store.
+	Seq uint64
+	// Data is the encoded message.
+	Data []byte
+}
+
+// SessionState is the stored state of a persistent session.
+type SessionState struct {
+	// Subscriptions are the requested QoS of the subscriptions by topic filter.
+	Subscriptions map[string]byte
+	// Messages are the unacknowledged QoS 1 messages in order of sequence number.
+	Messages []StoredMessage
+}
+
+// SessionUpdate is an update of the stored state of a persistent session.
+type SessionUpdate struct {
+	SetSubscriptions    map[string]byte
+	DeleteSubscriptions []string
+	AddMessages         [][]byte
+	DeleteMessages      []uint64
+}
+
+// SessionStore stores the state of persistent sessions.
+//
+// The state of a session is owned by the connection that resumed it last: operations of other owners fail with
+// ErrSessionTakenOver. The state expires if it is not updated within the expiry that is passed with each operation.
+type SessionStore interface {
+	// Resume takes over the session and returns the stored state, or nil if there is no stored state.
+	// If clean is true, the stored state is discarded.
+	Resume(ctx context.Context, id SessionID, owner string, clean bool, expiry time.Duration) (*SessionState, error)
+	// Update updates the stored state of the session, and returns the sequence numbers of the added messages.
+	// The store drops the oldest messages if the session exceeds the maximum number of messages of the store.
+	Update(ctx context.Context, id SessionID, owner string, expiry time.Duration, update *SessionUpdate) ([]uint64, error)
+	// Delete deletes the stored state of the session.
+	Delete(ctx context.Context, id SessionID, owner string) error
+}
+
+type resumedKey struct {
+	store SessionStore
+	id    SessionID
+}
+
+// resumed contains the persistent sessions of this process, so that a session is taken over immediately when it is
+// resumed by another connection of the same process. Sessions that are resumed by other processes are taken over
+// when the stored state is touched.
+var resumed = struct {
+	sync.Mutex
+	sessions map[resumedKey]*Session
+}{
+	sessions: make(map[resumedKey]*Session),
+}
+
+func registerResumed(s *Session) {
+	key := resumedKey{s.store, s.id}
+	resumed.Lock()
+	previous := resumed.sessions[key]
+	resumed.sessions[key] = s
+	resumed.Unlock()
+	if previous != nil && previous != s {
+		previous.takeOver()
+	}
+}
+
+func unregisterResumed(s *Session) {
+	key := resumedKey{s.store, s.id}
+	resumed.Lock()
+	if resumed.sessions[key] == s {
+		delete(resumed.sessions, key)
+	}
+	resumed.Unlock()
+}
+
+// DiscardSession discards the persistent session of the store, if any.
+// This is used when a client connects with a clean session through another session implementation.
+func DiscardSession(ctx context.Context, store SessionStore, id SessionID) error {
+	owner := random.String(ownerLength)
+	resumed.Lock()
+	previous := resumed.sessions[resumedKey{store, id}]
+	resumed.Unlock()
+	if previous != nil {
+		previous.takeOver()
+	}
+	if _, err := store.Resume(ctx, id, owner, true, maxTouchInterval); err != nil {
+		return err
+	}
+	return store.Delete(ctx, id, owner)
+}
+
+// touchInterval returns the interval in which the stored state of a session with the expiry is touched.
+func touchInterval(expiry time.Duration) time.Duration {
+	interval := expiry / 2
+	if interval > maxTouchInterval {
+		interval = maxTouchInterval
+	}
+	if interval < time.Second {
+		interval = time.Second
+	}
+	return interval
+}
+
+// encodeMessage encodes the outgoing message with its expiry time.
+func encodeMessage(out outgoing) ([]byte, error) {
+	pkt := *out.pkt
+	pkt.PacketID, pkt.Duplicate = 0, false
+	buf, err := Marshal(&pkt, mqtt.ProtocolLevel5)
+	if err != nil {
+		return nil, err
+	}
+	b := make([]byte, 8, 8+len(buf))
+	if !out.expires.IsZero() {
+		binary.BigEndian.PutUint64(b, uint64(out.expires.UnixNano()))
+	}
+	return append(b, buf...), nil
+}
+
+// decodeMessage decodes an outgoing message that is encoded with encodeMessage.
+func decodeMessage(b []byte) (outgoing, error) {
+	if len(b) < 8 {
+		return outgoing{}, errMessageEncoding.New()
+	}
+	pkt, err := Read(bytes.NewReader(b[8:]), mqtt.ProtocolLevel5, 0)
+	if err != nil {
+		return outgoing{}, errMessageEncoding.WithCause(err)
+	}
+	pub, ok := pkt.(*Publish)
+	if !ok {
+		return outgoing{}, errMessageEncoding.New()
+	}
+	out := outgoing{pkt: pub}
+	if expires := binary.BigEndian.Uint64(b[:8]); expires != 0 {
+		out.expires = time.Unix(0, int64(expires))
+	}
+	return out, nil
+}
+
+// sessionExpiry returns the session expiry interval.
+func (s *Session) sessionExpiry() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.expiry
+}
+
+// persistent returns whether the session is persisted after the connection closes.
+func (s *Session) persistent() bool { return s.sessionExpiry() > 0 }
+
+// storeExpiry returns the expiry of the stored state. While the client is connected, the stored state is kept as long
+// as the session is touched. After the client disconnects, the stored state expires with the session.
+func (s *Session) storeExpiry() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.connected {
+		return s.expiry + touchInterval(s.expiry)
+	}
+	return time.Until(s.expires)
+}
+
+// resume resumes the stored state of the session with the session expiry, and returns whether a session is present.
+// If the session expiry is zero, the stored state is deleted after it is resumed.
+func (s *Session) resume(clean bool, expiry time.Duration) (bool, error) {
+	s.id = SessionID{
+		Username: s.auth.Username,
+		ClientID: s.auth.ClientID,
+	}
+	s.owner = random.String(ownerLength)
+	s.expiry = expiry
+	storeExpiry := expiry + touchInterval(expiry)
+	state, err := s.store.Resume(s.ctx, s.id, s.owner, clean, storeExpiry)
+	if err != nil {
+		return false, err
+	}
+	// The session takes over the session of other connections of this process, also if it is not persistent.
+	registerResumed(s)
+	if expiry == 0 {
+		unregisterResumed(s)
+		if err := s.store.Delete(s.ctx, s.id, s.owner); err != nil {
+			return false, err
+		}
+	}
+	if state == nil {
+		return false, nil
+	}
+
+	logger := log.FromContext(s.ctx)
+	update := &SessionUpdate{}
+	for filter, qos := range state.Subscriptions {
+		if code := s.subscribe(Subscription{Filter: filter, QoS: qos}); code.IsError() {
+			logger.WithFields(log.Fields(
+				"topic_original", filter,
+				"reason_code", code,
+			)).Debug("Drop stored subscription")
+			update.DeleteSubscriptions = append(update.DeleteSubscriptions, filter)
+		}
+	}
+	for _, msg := range state.Messages {
+		out, err := decodeMessage(msg.Data)
+		if err != nil {
+			logger.WithError(err).Warn("Drop stored message")
+			update.DeleteMessages = append(update.DeleteMessages, msg.Seq)
+			continue
+		}
+		if expiry > 0 {
+			out.seq = msg.Seq
+		}
+		s.resumed = append(s.resumed, out)
+	}
+	if expiry > 0 && (len(update.DeleteSubscriptions) > 0 || len(update.DeleteMessages) > 0) {
+		if _, err := s.store.Update(s.ctx, s.id, s.owner, storeExpiry, update); err != nil {
+			return false, err
+		}
+	}
+	logger.WithFields(log.Fields(
+		"subscriptions", len(state.Subscriptions),
+		"messages", len(state.Messages),
+	)).Debug("Resume session")
+	return true, nil
+}
+
+// update updates the stored state of a persistent session.
+func (s *Session) update(update *SessionUpdate) ([]uint64, error) {
+	expiry := s.storeExpiry()
+	if expiry <= 0 {
+		return nil, ErrSessionTakenOver.New()
+	}
+	seqs, err := s.store.Update(s.ctx, s.id, s.owner, expiry, update)
+	if err != nil {
+		if errors.Resemble(err, ErrSessionTakenOver) {
+			s.takeOver()
+		}
+		return nil, err
+	}
+	return seqs, nil
+}
+
+// touch touches the stored state of a persistent session.
+// The session is taken over if the stored state is owned by another connection.
+func (s *Session) touch() error {
+	_, err := s.update(&SessionUpdate{})
+	return err
+}
+
+// takeOver ends the session, because it is resumed by another connection.
+func (s *Session) takeOver() {
+	s.takeOverOnce.Do(func() {
+		log.FromContext(s.ctx).Debug("Session taken over")
+		close(s.takenOver)
+	})
+}
+
+// WaitExpiry waits until the session is closed. If the session is persistent, it then waits until the session expires
+// or until the session is taken over by another connection.
+// Messages that are published to the session while it waits are stored, so that they are sent to the client when it
+// resumes the session.
+func (s *Session) WaitExpiry(ctx context.Context) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-s.closed:
+	}
+	if !s.persistent() {
+		return nil
+	}
+	defer unregisterResumed(s)
+	s.mu.Lock()
+	expiry := time.NewTimer(time.Until(s.expires))
+	s.mu.Unlock()
+	defer expiry.Stop()
+	ticker := time.NewTicker(touchInterval(s.sessionExpiry()))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-s.takenOver:
+			return nil
+		case <-ticker.C:
+			if err := s.touch(); err != nil {
+				if errors.Resemble(err, ErrSessionTakenOver) {
+					return nil
+				}
+				log.FromContext(s.ctx).WithError(err).Warn("Failed to touch session")
+			}
+		case <-expiry.C:
+			log.FromContext(s.ctx).Debug("Session expired")
+			s.takeOverOnce.Do(func() { close(s.takenOver) })
+			if err := s.store.Delete(s.ctx, s.id, s.owner); err != nil && !errors.Resemble(err, ErrSessionTakenOver) {
+				log.FromContext(s.ctx).WithError(err).Warn("Failed to delete expired session")
+			}
+			return nil
+		}
+	}
+}
@@ -0,0 +1,138 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt5_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt/mqtt5"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestPersistentSession(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	store := mqtt5.NewMemorySessionStore(16)
+	withStore := mqtt5.WithSessionStore(store, time.Hour)
+	persistentConnect := func() *mqtt5.Connect {
+		return &mqtt5.Connect{
+			KeepAlive: 60,
+			Properties: mqtt5.Properties{
+				SessionExpiryInterval: mqtt5.Uint32(60),
+			},
+			ClientID: "test-client",
+			Username: "test-user",
+			Password: []byte("secret"),
+		}
+	}
+	receivePublish := func(t *testing.T, client *testClient) *mqtt5.Publish {
+		t.Helper()
+		pub, ok := client.receive(t).(*mqtt5.Publish)
+		if !ok {
+			t.Fatal("Expected PUBLISH")
+		}
+		return pub
+	}
+
+	client, session, connack := connectSession(ctx, t, persistentConnect(), nil, withStore)
+	if !a.So(connack.ReasonCode, should.Equal, mqtt5.Success) {
+		t.FailNow()
+	}
+	a.So(connack.SessionPresent, should.BeFalse)
+	client.send(t, &mqtt5.Subscribe{
+		PacketID:      1,
+		Subscriptions: []mqtt5.Subscription{{Filter: "foo/#", QoS: 1}},
+	})
+	a.So(client.receive(t), should.Resemble, &mqtt5.Suback{
+		PacketID:    1,
+		ReasonCodes: []mqtt5.ReasonCode{mqtt5.GrantedQoS1},
+	})
+
+	// The first message is sent but not acknowledged, the second message is published while the client is
+	// disconnected, and the third message expires before the client resumes the session.
+	session.Publish(&mqtt5.Publish{QoS: 1, TopicName: "foo/1", Payload: []byte("1")})
+	a.So(receivePublish(t, client).TopicName, should.Equal, "foo/1")
+	client.Close()
+	time.Sleep(test.Delay)
+	session.Publish(&mqtt5.Publish{QoS: 1, TopicName: "foo/2", Payload: []byte("2")})
+	session.Publish(&mqtt5.Publish{
+		QoS:        1,
+		TopicName:  "foo/3",
+		Properties: mqtt5.Properties{MessageExpiryInterval: mqtt5.Uint32(1)},
+	})
+	time.Sleep(time.Second)
+
+	client, session, connack = connectSession(ctx, t, persistentConnect(), nil, withStore)
+	if !a.So(connack.ReasonCode, should.Equal, mqtt5.Success) {
+		t.FailNow()
+	}
+	a.So(connack.SessionPresent, should.BeTrue)
+	for _, topicName := range []string{"foo/1", "foo/2"} {
+		pub := receivePublish(t, client)
+		a.So(pub.TopicName, should.Equal, topicName)
+		a.So(pub.QoS, should.Equal, 1)
+		client.send(t, &mqtt5.Puback{PacketID: pub.PacketID})
+	}
+	// The subscription is resumed.
+	session.Publish(&mqtt5.Publish{QoS: 1, TopicName: "foo/4"})
+	pub := receivePublish(t, client)
+	a.So(pub.TopicName, should.Equal, "foo/4")
+	client.send(t, &mqtt5.Puback{PacketID: pub.PacketID})
+
+	t.Run("TakenOver", func(t *testing.T) {
+		a := assertions.New(t)
+
+		other, _, connack := connectSession(ctx, t, persistentConnect(), nil, withStore)
+		a.So(connack.SessionPresent, should.BeTrue)
+		disconnect, ok := client.receive(t).(*mqtt5.Disconnect)
+		if a.So(ok, should.BeTrue) {
+			a.So(disconnect.ReasonCode, should.Equal, mqtt5.SessionTakenOver)
+		}
+		// The acknowledged messages are not sent again.
+		other.send(t, &mqtt5.Pingreq{})
+		a.So(other.receive(t), should.Resemble, &mqtt5.Pingresp{})
+		client = other
+	})
+
+	t.Run("CleanSession", func(t *testing.T) {
+		a := assertions.New(t)
+
+		client.Close()
+		time.Sleep(test.Delay)
+		_, _, connack := connectSession(ctx, t, &mqtt5.Connect{
+			ProtocolLevel: mqtt.ProtocolLevel311,
+			CleanStart:    true,
+			KeepAlive:     60,
+			ClientID:      "test-client",
+			Username:      "test-user",
+			Password:      []byte("secret"),
+		}, nil, withStore)
+		a.So(connack.ReasonCode, should.Equal, mqtt5.Success)
+		a.So(connack.SessionPresent, should.BeFalse)
+
+		state, err := store.Resume(ctx, mqtt5.SessionID{
+			Username: "test-user",
+			ClientID: "test-client",
+		}, "test-owner", false, time.Minute)
+		a.So(err, should.BeNil)
+		a.So(state, should.BeNil)
+	})
+}
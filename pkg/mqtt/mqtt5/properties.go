@@ -0,0 +1,277 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt5
+
+import "go.thethings.network/lorawan-stack/v3/pkg/mqtt"
+
+const (
+	propPayloadFormatIndicator          byte = 0x01
+	propMessageExpiryInterval           byte = 0x02
+	propContentType                     byte = 0x03
+	propResponseTopic                   byte = 0x08
+	propCorrelationData                 byte = 0x09
+	propSubscriptionIdentifier          byte = 0x0B
+	propSessionExpiryInterval           byte = 0x11
+	propAssignedClientIdentifier        byte = 0x12
+	propServerKeepAlive                 byte = 0x13
+	propAuthenticationMethod            byte = 0x15
+	propAuthenticationData              byte = 0x16
+	propRequestProblemInformation       byte = 0x17
+	propWillDelayInterval               byte = 0x18
+	propRequestResponseInformation      byte = 0x19
+	propResponseInformation             byte = 0x1A
+	propServerReference                 byte = 0x1C
+	propReasonString                    byte = 0x1F
+	propReceiveMaximum                  byte = 0x21
+	propTopicAliasMaximum               byte = 0x22
+	propTopicAlias                      byte = 0x23
+	propMaximumQoS                      byte = 0x24
+	propRetainAvailable                 byte = 0x25
+	propUserProperty                    byte = 0x26
+	propMaximumPacketSize               byte = 0x27
+	propWildcardSubscriptionAvailable   byte = 0x28
+	propSubscriptionIdentifierAvailable byte = 0x29
+	propSharedSubscriptionAvailable     byte = 0x2A
+)
+
+// UserProperty is an MQTT 5 user property.
+type UserProperty struct {
+	Key   string
+	Value string
+}
+
+// Properties are the MQTT 5 properties of a control packet.
+// Optional numeric properties are nil when they are not present.
+// MQTT 3.1.1 control packets have no properties, so the properties are not encoded in MQTT 3.1.1.
+type Properties struct {
+	PayloadFormatIndicator          *byte
+	MessageExpiryInterval           *uint32
+	ContentType                     string
+	ResponseTopic                   string
+	CorrelationData                 []byte
+	SubscriptionIdentifiers         []uint32
+	SessionExpiryInterval           *uint32
+	AssignedClientIdentifier        string
+	ServerKeepAlive                 *uint16
+	AuthenticationMethod            string
+	AuthenticationData              []byte
+	RequestProblemInformation       *byte
+	WillDelayInterval               *uint32
+	RequestResponseInformation      *byte
+	ResponseInformation             string
+	ServerReference                 string
+	ReasonString                    string
+	ReceiveMaximum                  *uint16
+	TopicAliasMaximum               *uint16
+	TopicAlias                      *uint16
+	MaximumQoS                      *byte
+	RetainAvailable                 *byte
+	UserProperties                  []UserProperty
+	MaximumPacketSize               *uint32
+	WildcardSubscriptionAvailable   *byte
+	SubscriptionIdentifierAvailable *byte
+	SharedSubscriptionAvailable     *byte
+}
+
+// UserProperty returns the values of the user properties with the given key.
+func (p *Properties) UserProperty(key string) (values []string) {
+	for _, prop := range p.UserProperties {
+		if prop.Key == key {
+			values = append(values, prop.Value)
+		}
+	}
+	return values
+}
+
+// AddUserProperty adds a user property.
+func (p *Properties) AddUserProperty(key, value string) {
+	p.UserProperties = append(p.UserProperties, UserProperty{Key: key, Value: value})
+}
+
+// Byte returns a pointer to the given byte value.
+func Byte(v byte) *byte { return &v }
+
+// Uint16 returns a pointer to the given uint16 value.
+func Uint16(v uint16) *uint16 { return &v }
+
+// Uint32 returns a pointer to the given uint32 value.
+func Uint32(v uint32) *uint32 { return &v }
+
+func (p *Properties) encode(e *encoder) {
+	if e.version == mqtt.ProtocolLevel311 {
+		return
+	}
+	var b encoder
+	encodeByte := func(id byte, v *byte) {
+		if v != nil {
+			b.byte(id)
+			b.byte(*v)
+		}
+	}
+	encodeUint16 := func(id byte, v *uint16) {
+		if v != nil {
+			b.byte(id)
+			b.uint16(*v)
+		}
+	}
+	encodeUint32 := func(id byte, v *uint32) {
+		if v != nil {
+			b.byte(id)
+			b.uint32(*v)
+		}
+	}
+	encodeString := func(id byte, v string) {
+		if v != "" {
+			b.byte(id)
+			b.string(v)
+		}
+	}
+	encodeBinary := func(id byte, v []byte) {
+		if v != nil {
+			b.byte(id)
+			b.binary(v)
+		}
+	}
+	if p != nil {
+		encodeByte(propPayloadFormatIndicator, p.PayloadFormatIndicator)
+		encodeUint32(propMessageExpiryInterval, p.MessageExpiryInterval)
+		encodeString(propContentType, p.ContentType)
+		encodeString(propResponseTopic, p.ResponseTopic)
+		encodeBinary(propCorrelationData, p.CorrelationData)
+		for _, id := range p.SubscriptionIdentifiers {
+			b.byte(propSubscriptionIdentifier)
+			b.varint(id)
+		}
+		encodeUint32(propSessionExpiryInterval, p.SessionExpiryInterval)
+		encodeString(propAssignedClientIdentifier, p.AssignedClientIdentifier)
+		encodeUint16(propServerKeepAlive, p.ServerKeepAlive)
+		encodeString(propAuthenticationMethod, p.AuthenticationMethod)
+		encodeBinary(propAuthenticationData, p.AuthenticationData)
+		encodeByte(propRequestProblemInformation, p.RequestProblemInformation)
+		encodeUint32(propWillDelayInterval, p.WillDelayInterval)
+		encodeByte(propRequestResponseInformation, p.RequestResponseInformation)
+		encodeString(propResponseInformation, p.ResponseInformation)
+		encodeString(propServerReference, p.ServerReference)
+		encodeString(propReasonString, p.ReasonString)
+		encodeUint16(propReceiveMaximum, p.ReceiveMaximum)
+		encodeUint16(propTopicAliasMaximum, p.TopicAliasMaximum)
+		encodeUint16(propTopicAlias, p.TopicAlias)
+		encodeByte(propMaximumQoS, p.MaximumQoS)
+		encodeByte(propRetainAvailable, p.RetainAvailable)
+		for _, prop := range p.UserProperties {
+			b.byte(propUserProperty)
+			b.string(prop.Key)
+			b.string(prop.Value)
+		}
+		encodeUint32(propMaximumPacketSize, p.MaximumPacketSize)
+		encodeByte(propWildcardSubscriptionAvailable, p.WildcardSubscriptionAvailable)
+		encodeByte(propSubscriptionIdentifierAvailable, p.SubscriptionIdentifierAvailable)
+		encodeByte(propSharedSubscriptionAvailable, p.SharedSubscriptionAvailable)
+	}
+	e.varint(uint32(b.Len()))
+	e.Write(b.Bytes()) //nolint:errcheck
+}
+
+func (p *Properties) decode(d *decoder) {
+	if d.version == mqtt.ProtocolLevel311 {
+		return
+	}
+	n := d.varint()
+	props := d.sub(int(n))
+	decodeByte := func(v **byte) {
+		if *v != nil {
+			props.fail(errDuplicateProperty.New())
+		}
+		b := props.byte()
+		*v = &b
+	}
+	decodeUint16 := func(v **uint16) {
+		if *v != nil {
+			props.fail(errDuplicateProperty.New())
+		}
+		u := props.uint16()
+		*v = &u
+	}
+	decodeUint32 := func(v **uint32) {
+		if *v != nil {
+			props.fail(errDuplicateProperty.New())
+		}
+		u := props.uint32()
+		*v = &u
+	}
+	for props.err == nil && props.remaining() > 0 {
+		switch id := props.byte(); id {
+		case propPayloadFormatIndicator:
+			decodeByte(&p.PayloadFormatIndicator)
+		case propMessageExpiryInterval:
+			decodeUint32(&p.MessageExpiryInterval)
+		case propContentType:
+			p.ContentType = props.string()
+		case propResponseTopic:
+			p.ResponseTopic = props.string()
+		case propCorrelationData:
+			p.CorrelationData = props.binary()
+		case propSubscriptionIdentifier:
+			p.SubscriptionIdentifiers = append(p.SubscriptionIdentifiers, props.varint())
+		case propSessionExpiryInterval:
+			decodeUint32(&p.SessionExpiryInterval)
+		case propAssignedClientIdentifier:
+			p.AssignedClientIdentifier = props.string()
+		case propServerKeepAlive:
+			decodeUint16(&p.ServerKeepAlive)
+		case propAuthenticationMethod:
+			p.AuthenticationMethod = props.string()
+		case propAuthenticationData:
+			p.AuthenticationData = props.binary()
+		case propRequestProblemInformation:
+			decodeByte(&p.RequestProblemInformation)
+		case propWillDelayInterval:
+			decodeUint32(&p.WillDelayInterval)
+		case propRequestResponseInformation:
+			decodeByte(&p.RequestResponseInformation)
+		case propResponseInformation:
+			p.ResponseInformation = props.string()
+		case propServerReference:
+			p.ServerReference = props.string()
+		case propReasonString:
+			p.ReasonString = props.string()
+		case propReceiveMaximum:
+			decodeUint16(&p.ReceiveMaximum)
+		case propTopicAliasMaximum:
+			decodeUint16(&p.TopicAliasMaximum)
+		case propTopicAlias:
+			decodeUint16(&p.TopicAlias)
+		case propMaximumQoS:
+			decodeByte(&p.MaximumQoS)
+		case propRetainAvailable:
+			decodeByte(&p.RetainAvailable)
+		case propUserProperty:
+			p.UserProperties = append(p.UserProperties, UserProperty{Key: props.string(), Value: props.string()})
+		case propMaximumPacketSize:
+			decodeUint32(&p.MaximumPacketSize)
+		case propWildcardSubscriptionAvailable:
+			decodeByte(&p.WildcardSubscriptionAvailable)
+		case propSubscriptionIdentifierAvailable:
+			decodeByte(&p.SubscriptionIdentifierAvailable)
+		case propSharedSubscriptionAvailable:
+			decodeByte(&p.SharedSubscriptionAvailable)
+		default:
+			props.fail(errUnknownProperty.WithAttributes("property", id))
+		}
+	}
+	if props.err != nil {
+		d.fail(props.err)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt5
+
+import (
+	"fmt"
+
+	mqttpacket "github.com/TheThingsIndustries/mystique/pkg/packet"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// ReasonCode is an MQTT 5 reason code.
+type ReasonCode byte
+
+// MQTT 5 reason codes.
+const (
+	Success                             ReasonCode = 0x00
+	GrantedQoS1                         ReasonCode = 0x01
+	NoMatchingSubscribers               ReasonCode = 0x10
+	NoSubscriptionExisted               ReasonCode = 0x11
+	UnspecifiedError                    ReasonCode = 0x80
+	MalformedPacket                     ReasonCode = 0x81
+	ProtocolError                       ReasonCode = 0x82
+	ImplementationSpecificError         ReasonCode = 0x83
+	UnsupportedProtocolVersion          ReasonCode = 0x84
+	ClientIdentifierNotValid            ReasonCode = 0x85
+	BadUsernameOrPassword               ReasonCode = 0x86
+	NotAuthorized                       ReasonCode = 0x87
+	ServerUnavailable                   ReasonCode = 0x88
+	ServerBusy                          ReasonCode = 0x89
+	ServerShuttingDown                  ReasonCode = 0x8B
+	BadAuthenticationMethod             ReasonCode = 0x8C
+	KeepAliveTimeout                    ReasonCode = 0x8D
+	SessionTakenOver                    ReasonCode = 0x8E
+	TopicFilterInvalid                  ReasonCode = 0x8F
+	TopicNameInvalid                    ReasonCode = 0x90
+	PacketIdentifierInUse               ReasonCode = 0x91
+	PacketIdentifierNotFound            ReasonCode = 0x92
+	TopicAliasInvalid                   ReasonCode = 0x94
+	PacketTooLarge                      ReasonCode = 0x95
+	QuotaExceeded                       ReasonCode = 0x97
+	PayloadFormatInvalid                ReasonCode = 0x99
+	RetainNotSupported                  ReasonCode = 0x9A
+	QoSNotSupported                     ReasonCode = 0x9B
+	SharedSubscriptionsNotSupported     ReasonCode = 0x9E
+	SubscriptionIdentifiersNotSupported ReasonCode = 0xA1
+)
+
+// IsError returns whether the reason code indicates a failure.
+func (c ReasonCode) IsError() bool { return c >= 0x80 }
+
+// String implements fmt.Stringer.
+func (c ReasonCode) String() string { return fmt.Sprintf("0x%02X", byte(c)) }
+
+// connectReturnCodes maps the MQTT 3.1.1 CONNACK return codes to MQTT 5 reason codes.
+// The return codes are used by the authentication interface that is shared between the protocol versions.
+var connectReturnCodes = map[mqttpacket.ConnectReturnCode]ReasonCode{
+	mqttpacket.ConnectUnacceptableProtocolVersion: UnsupportedProtocolVersion,
+	mqttpacket.ConnectIdentifierRejected:          ClientIdentifierNotValid,
+	mqttpacket.ConnectServerUnavailable:           ServerUnavailable,
+	mqttpacket.ConnectMalformedUsernameOrPassword: BadUsernameOrPassword,
+	mqttpacket.ConnectNotAuthorized:               NotAuthorized,
+}
+
+// connectReturnCode returns the MQTT 3.1.1 CONNACK return code that corresponds to the reason code.
+func connectReturnCode(code ReasonCode) mqttpacket.ConnectReturnCode {
+	if code == Success {
+		return mqttpacket.ConnectAccepted
+	}
+	for returnCode, reasonCode := range connectReturnCodes {
+		if reasonCode == code {
+			return returnCode
+		}
+	}
+	return mqttpacket.ConnectServerUnavailable
+}
+
+// ReasonCodeFromError returns the reason code that corresponds to the error.
+func ReasonCodeFromError(err error) ReasonCode {
+	if err == nil {
+		return Success
+	}
+	if code, ok := err.(mqttpacket.ConnectReturnCode); ok {
+		if reasonCode, ok := connectReturnCodes[code]; ok {
+			return reasonCode
+		}
+		return UnspecifiedError
+	}
+	for _, e := range protocolErrorCodes {
+		if errors.Resemble(err, e.err) {
+			return e.code
+		}
+	}
+	switch {
+	case errors.Resemble(err, ErrSessionTakenOver):
+		return SessionTakenOver
+	case errors.Resemble(err, errPacketTooLarge):
+		return PacketTooLarge
+	case errors.Resemble(err, errUnsupportedProtocolVersion):
+		return UnsupportedProtocolVersion
+	case errors.Resemble(err, errMalformedPacket),
+		errors.Resemble(err, errInvalidFlags),
+		errors.Resemble(err, errInvalidString),
+		errors.Resemble(err, errUnknownProperty),
+		errors.Resemble(err, errDuplicateProperty):
+		return MalformedPacket
+	case errors.Resemble(err, errUnknownPacketType), errors.Resemble(err, errUnsupportedPacketType):
+		return ProtocolError
+	case errors.IsUnauthenticated(err):
+		return BadUsernameOrPassword
+	case errors.IsPermissionDenied(err):
+		return NotAuthorized
+	case errors.IsResourceExhausted(err):
+		return QuotaExceeded
+	case errors.IsInvalidArgument(err):
+		return PayloadFormatInvalid
+	case errors.IsNotFound(err):
+		return TopicNameInvalid
+	case errors.IsUnavailable(err):
+		return ServerUnavailable
+	default:
+		return ImplementationSpecificError
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt5
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+)
+
+// RunSession reads the control packets from the provided session and sends the responses and published messages
+// to the client.
+// If the client violates the protocol, the session sends a DISCONNECT packet with the reason code before closing.
+// The session closes when it is taken over by another connection. Persistent sessions are touched while connected.
+func RunSession(
+	ctx context.Context,
+	cancel func(error),
+	ts task.Starter,
+	session *Session,
+	wg *sync.WaitGroup,
+) {
+	wg.Add(2)
+	controlCh := make(chan Packet)
+	controlFunc := func(ctx context.Context) error {
+		defer wg.Done()
+		for {
+			pkt, err := session.ReadPacket()
+			if err != nil {
+				if _, ok := errors.From(err); ok && session.version == mqtt.ProtocolLevel5 {
+					log.FromContext(ctx).WithError(err).Warn("Protocol error")
+					disconnect := &Disconnect{ReasonCode: ReasonCodeFromError(err)}
+					disconnect.Properties.ReasonString = session.reasonString(err)
+					session.write(disconnect) //nolint:errcheck
+				}
+				cancel(err)
+				return err
+			}
+			if pkt == nil {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case controlCh <- pkt:
+			}
+		}
+	}
+	writeFunc := func(ctx context.Context) error {
+		defer wg.Done()
+		for _, out := range session.takeResumed() {
+			pub := out.prepare(time.Now())
+			if pub == nil {
+				log.FromContext(ctx).WithField("topic", out.pkt.TopicName).Debug("Drop expired message")
+				session.drop(out)
+				continue
+			}
+			if err := session.write(pub); err != nil {
+				cancel(err)
+				return err
+			}
+		}
+		for {
+			var pkt Packet
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-session.takenOver:
+				err := ErrSessionTakenOver.New()
+				if session.version == mqtt.ProtocolLevel5 {
+					session.write(&Disconnect{ReasonCode: SessionTakenOver}) //nolint:errcheck
+				}
+				cancel(err)
+				return err
+			case pkt = <-controlCh:
+			case out := <-session.publish:
+				pub := out.prepare(time.Now())
+				if pub == nil {
+					log.FromContext(ctx).WithField("topic", out.pkt.TopicName).Debug("Drop expired message")
+					session.drop(out)
+					continue
+				}
+				pkt = pub
+			}
+			if err := session.write(pkt); err != nil {
+				cancel(err)
+				return err
+			}
+		}
+	}
+	closeFunc := func(ctx context.Context) error {
+		log.FromContext(ctx).Info("Connected")
+		var touch <-chan time.Time
+		if expiry := session.sessionExpiry(); expiry > 0 {
+			ticker := time.NewTicker(touchInterval(expiry))
+			defer ticker.Stop()
+			touch = ticker.C
+		}
+	loop:
+		for {
+			select {
+			case <-ctx.Done():
+				break loop
+			case <-touch:
+				if err := session.touch(); err != nil && !errors.Resemble(err, ErrSessionTakenOver) {
+					log.FromContext(ctx).WithError(err).Warn("Failed to touch session")
+				}
+			}
+		}
+		log.FromContext(ctx).WithError(ctx.Err()).Info("Disconnected")
+
+		session.Close()
+		session.conn.Close()
+
+		wg.Wait()
+
+		return ctx.Err()
+	}
+
+	for name, f := range map[string]func(context.Context) error{
+		"mqtt_control_packets":  controlFunc,
+		"mqtt_write_packets":    writeFunc,
+		"mqtt_close_connection": closeFunc,
+	} {
+		ts.StartTask(&task.Config{
+			Context: ctx,
+			ID:      name,
+			Func:    f,
+			Restart: task.RestartNever,
+			Backoff: task.DefaultBackoffConfig,
+		})
+	}
+}
@@ -0,0 +1,786 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt5
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/TheThingsIndustries/mystique/pkg/auth"
+	mqttpacket "github.com/TheThingsIndustries/mystique/pkg/packet"
+	"github.com/TheThingsIndustries/mystique/pkg/topic"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt"
+)
+
+const (
+	// MaxPacketSize is the maximum size of the packets that the server accepts.
+	MaxPacketSize = 1 << 20
+	// PublishBufferSize is the size of the buffer of messages that are published to the client.
+	PublishBufferSize = 64
+
+	connectTimeout = 10 * time.Second
+	// maxQoS is the maximum QoS that is supported by the server.
+	maxQoS byte = 1
+)
+
+var (
+	errNotConnect              = errors.DefineInvalidArgument("not_connect", "first packet is not CONNECT")
+	errUnexpectedPacket        = errors.DefineInvalidArgument("unexpected_packet", "unexpected `{type}` packet")
+	errQoSNotSupported         = errors.DefineInvalidArgument("qos_not_supported", "QoS `{qos}` not supported")
+	errRetainNotSupported      = errors.DefineInvalidArgument("retain_not_supported", "retained messages not supported")
+	errTopicAliasInvalid       = errors.DefineInvalidArgument("topic_alias_invalid", "topic aliases not supported")
+	errSubscriptionIDs         = errors.DefineInvalidArgument("subscription_identifiers", "subscription identifiers not supported")
+	errAuthenticationMethod    = errors.DefineInvalidArgument("authentication_method", "authentication method `{method}` not supported")
+	errTopicNameInvalid        = errors.DefineInvalidArgument("topic_name_invalid", "invalid topic name `{topic}`")
+	errNotAuthorized           = errors.DefinePermissionDenied("not_authorized", "not authorized to publish to `{topic}`")
+	errClientIdentifierInvalid = errors.DefineInvalidArgument("client_identifier_invalid", "invalid client identifier")
+	errSessionExpiryInterval   = errors.DefineInvalidArgument("session_expiry_interval", "session expiry interval set on DISCONNECT of session without expiry")
+)
+
+// protocolErrorCodes are the reason codes of the protocol errors of the session.
+var protocolErrorCodes = []struct {
+	err  *errors.Definition
+	code ReasonCode
+}{
+	{errNotConnect, ProtocolError},
+	{errUnexpectedPacket, ProtocolError},
+	{errQoSNotSupported, QoSNotSupported},
+	{errRetainNotSupported, RetainNotSupported},
+	{errTopicAliasInvalid, TopicAliasInvalid},
+	{errSubscriptionIDs, SubscriptionIdentifiersNotSupported},
+	{errAuthenticationMethod, BadAuthenticationMethod},
+	{errTopicNameInvalid, TopicNameInvalid},
+	{errClientIdentifierInvalid, ClientIdentifierNotValid},
+	{errSessionExpiryInterval, ProtocolError},
+}
+
+// DeliverFunc handles a message that is published by the client.
+// For messages with QoS 1, the reason code of the returned error is sent to the client in the PUBACK packet.
+type DeliverFunc func(pkt *Publish) error
+
+type subscription struct {
+	filter []string
+	qos    byte
+	// shared is the key of the shared subscription, or empty if the subscription is not shared.
+	shared string
+}
+
+type outgoing struct {
+	pkt     *Publish
+	expires time.Time
+	// seq is the sequence number of the stored message of a persistent session, or zero if the message is not stored.
+	seq uint64
+}
+
+// Session is an MQTT 5 server session.
+//
+// The session supports QoS 0 and QoS 1, shared subscriptions, message expiry, user properties and persistent sessions.
+// Retained messages, topic aliases and subscription identifiers are not supported.
+// Access to topics is checked with the authentication interface in the context.
+//
+// The session also serves MQTT 3.1.1 clients. MQTT 5 features are not available to MQTT 3.1.1 clients.
+type Session struct {
+	ctx       context.Context
+	start     time.Time
+	conn      net.Conn
+	r         *bufio.Reader
+	transport string
+	deliver   DeliverFunc
+	shared    *SharedSubscriptions
+	publish   chan outgoing
+	store     SessionStore
+	maxExpiry time.Duration
+
+	// version is the protocol level of the session.
+	version byte
+	auth    *auth.Info
+	// will of the session, which is delivered when the connection closes without DISCONNECT.
+	will *Publish
+	// maxPacketSize is the maximum size of the packets that the client accepts.
+	maxPacketSize uint32
+	// problemInformation indicates whether the client accepts reason strings.
+	problemInformation bool
+	keepAlive          time.Duration
+
+	// id and owner identify the stored state of a resumed session.
+	id    SessionID
+	owner string
+	// resumed are the stored messages of a resumed session, which are sent before other messages.
+	resumed []outgoing
+	// takenOver is closed when the session ends because it is resumed by another connection, or because it expired.
+	takenOver    chan struct{}
+	takeOverOnce sync.Once
+	// closed is closed when the session is closed.
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	writeMu sync.Mutex
+
+	mu            sync.Mutex
+	subscriptions map[string]subscription
+	packetID      uint16
+	connected     bool
+	// expiry is the session expiry interval of a persistent session, or zero if the session is not persistent.
+	expiry time.Duration
+	// expires is the time at which a persistent session expires after the client disconnected.
+	expires time.Time
+	// inflight contains the sequence numbers of the stored messages that are sent to the client by packet ID.
+	inflight map[uint16]uint64
+}
+
+// SessionOption is an option for a Session.
+type SessionOption interface {
+	apply(*Session)
+}
+
+type sessionOptionFunc func(*Session)
+
+func (f sessionOptionFunc) apply(s *Session) { f(s) }
+
+// WithSharedSubscriptions enables shared subscriptions with the given members of shared subscriptions.
+func WithSharedSubscriptions(shared *SharedSubscriptions) SessionOption {
+	return sessionOptionFunc(func(s *Session) {
+		s.shared = shared
+	})
+}
+
+// WithSessionStore enables persistent sessions, of which the state is stored in the given store.
+// The session expiry interval of MQTT 5 sessions is limited to maxExpiry. MQTT 3.1.1 sessions that are not clean
+// expire maxExpiry after the client disconnects.
+func WithSessionStore(store SessionStore, maxExpiry time.Duration) SessionOption {
+	return sessionOptionFunc(func(s *Session) {
+		s.store, s.maxExpiry = store, maxExpiry
+	})
+}
+
+// NewSession returns a new session on the connection.
+func NewSession(
+	ctx context.Context, conn net.Conn, transport string, deliver DeliverFunc, opts ...SessionOption,
+) *Session {
+	s := &Session{
+		ctx:                ctx,
+		start:              time.Now(),
+		conn:               conn,
+		r:                  bufio.NewReader(conn),
+		transport:          transport,
+		deliver:            deliver,
+		publish:            make(chan outgoing, PublishBufferSize),
+		problemInformation: true,
+		takenOver:          make(chan struct{}),
+		closed:             make(chan struct{}),
+		subscriptions:      make(map[string]subscription),
+		inflight:           make(map[uint16]uint64),
+	}
+	for _, opt := range opts {
+		opt.apply(s)
+	}
+	return s
+}
+
+// Context returns the context of the session.
+func (s *Session) Context() context.Context { return s.ctx }
+
+// AuthInfo returns the authentication info of the session.
+func (s *Session) AuthInfo() auth.Info { return *s.auth }
+
+// ProtocolLevel returns the protocol level of the session.
+func (s *Session) ProtocolLevel() byte { return s.version }
+
+func (s *Session) write(pkt Packet) error {
+	buf, err := Marshal(pkt, s.version)
+	if err != nil {
+		return err
+	}
+	if s.maxPacketSize > 0 && uint32(len(buf)) > s.maxPacketSize {
+		log.FromContext(s.ctx).WithFields(log.Fields(
+			"type", pkt.Type().String(),
+			"size", len(buf),
+		)).Debug("Drop packet that exceeds maximum packet size of client")
+		return nil
+	}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_, err = s.conn.Write(buf)
+	return err
+}
+
+func (s *Session) read() (Packet, error) {
+	pkt, err := Read(s.r, s.version, MaxPacketSize)
+	if err != nil {
+		return nil, err
+	}
+	if s.keepAlive > 0 {
+		if err := s.conn.SetReadDeadline(time.Now().Add(s.keepAlive)); err != nil {
+			return nil, err
+		}
+	}
+	return pkt, nil
+}
+
+// reasonString returns the reason string of the error, if the client accepts reason strings.
+func (s *Session) reasonString(err error) string {
+	if !s.problemInformation || s.version != mqtt.ProtocolLevel5 || err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// ReadConnect reads and handles the CONNECT packet.
+// The client is authenticated with the authentication interface in the context.
+func (s *Session) ReadConnect() (err error) {
+	logger := log.FromContext(s.ctx)
+	if err := s.conn.SetReadDeadline(time.Now().Add(connectTimeout)); err != nil {
+		return err
+	}
+	pkt, err := Read(s.r, s.version, MaxPacketSize)
+	if err != nil {
+		if errors.Resemble(err, errUnsupportedProtocolVersion) || errors.Resemble(err, errPacketTooLarge) {
+			s.write(&Connack{ReasonCode: ReasonCodeFromError(err)}) //nolint:errcheck
+		}
+		return err
+	}
+	connect, ok := pkt.(*Connect)
+	if !ok {
+		return errNotConnect.New()
+	}
+	s.version = connect.ProtocolLevel
+
+	connack := &Connack{}
+	defer func() {
+		if err != nil && connack.ReasonCode == Success {
+			connack.ReasonCode = ReasonCodeFromError(err)
+		}
+		if err != nil {
+			connack.SessionPresent = false
+		}
+		if writeErr := s.write(connack); writeErr != nil {
+			logger.WithError(writeErr).Warn("Failed to send CONNACK")
+			if err == nil {
+				err = writeErr
+			}
+		}
+	}()
+
+	if connect.Properties.RequestProblemInformation != nil {
+		s.problemInformation = *connect.Properties.RequestProblemInformation != 0
+	}
+	if size := connect.Properties.MaximumPacketSize; size != nil {
+		s.maxPacketSize = *size
+	}
+	if method := connect.Properties.AuthenticationMethod; method != "" {
+		return errAuthenticationMethod.WithAttributes("method", method)
+	}
+	if will := connect.Will; will != nil {
+		if will.QoS > maxQoS {
+			return errQoSNotSupported.WithAttributes("qos", will.QoS)
+		}
+		if will.Retain && s.version == mqtt.ProtocolLevel5 {
+			return errRetainNotSupported.New()
+		}
+	}
+
+	clientID := connect.ClientID
+	if clientID == "" {
+		if !connect.CleanStart {
+			return errClientIdentifierInvalid.New()
+		}
+		clientID = fmt.Sprintf("%s-%d", s.conn.RemoteAddr().String(), time.Since(s.start))
+		connack.Properties.AssignedClientIdentifier = clientID
+	}
+	s.auth = &auth.Info{
+		RemoteAddr: s.conn.RemoteAddr().String(),
+		Transport:  s.transport,
+		ClientID:   clientID,
+		Username:   connect.Username,
+		Password:   connect.Password,
+	}
+	if conn, ok := s.conn.(interface{ ConnectionState() tls.ConnectionState }); ok {
+		s.auth.ServerName = conn.ConnectionState().ServerName
+	}
+	logger = logger.WithFields(log.Fields(
+		"username", connect.Username,
+		"client_id", clientID,
+		"protocol_level", s.version,
+	))
+	s.ctx = log.NewContext(s.ctx, logger)
+
+	if authInterface := auth.InterfaceFromContext(s.ctx); authInterface != nil {
+		ctx, err := authInterface.Connect(s.ctx, s.auth)
+		if err != nil {
+			logger.WithError(err).Debug("Rejected authentication")
+			// Authentication errors that do not have a CONNACK return code are not authorized.
+			if _, ok := err.(mqttpacket.ConnectReturnCode); !ok {
+				connack.ReasonCode = NotAuthorized
+			}
+			return err
+		}
+		s.ctx = ctx
+	}
+
+	if connect.KeepAlive > 0 {
+		s.keepAlive = time.Duration(connect.KeepAlive) * 1500 * time.Millisecond
+	} else {
+		s.keepAlive = time.Hour
+	}
+	if err := s.conn.SetReadDeadline(time.Now().Add(s.keepAlive)); err != nil {
+		return err
+	}
+
+	if will := connect.Will; will != nil && s.auth.CanWrite(will.Topic) {
+		s.will = &Publish{
+			QoS:        will.QoS,
+			TopicName:  will.Topic,
+			Properties: will.Properties,
+			Payload:    will.Payload,
+		}
+	}
+
+	// MQTT 5 clients request the session expiry interval. MQTT 3.1.1 sessions that are not clean are persistent.
+	var expiry time.Duration
+	if interval := connect.Properties.SessionExpiryInterval; interval != nil {
+		expiry = time.Duration(*interval) * time.Second
+	} else if s.version == mqtt.ProtocolLevel311 && !connect.CleanStart {
+		expiry = s.maxExpiry
+	}
+	if expiry > s.maxExpiry {
+		expiry = s.maxExpiry
+		connack.Properties.SessionExpiryInterval = Uint32(uint32(expiry / time.Second))
+	}
+	if s.store != nil {
+		present, err := s.resume(connect.CleanStart, expiry)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to resume session")
+			connack.ReasonCode = ServerUnavailable
+			return err
+		}
+		connack.SessionPresent = present
+	}
+
+	connack.Properties.MaximumQoS = Byte(maxQoS)
+	connack.Properties.RetainAvailable = Byte(0)
+	connack.Properties.MaximumPacketSize = Uint32(MaxPacketSize)
+	connack.Properties.SubscriptionIdentifierAvailable = Byte(0)
+	if s.shared == nil {
+		connack.Properties.SharedSubscriptionAvailable = Byte(0)
+	}
+	s.mu.Lock()
+	s.connected = true
+	s.mu.Unlock()
+	return nil
+}
+
+// ReadPacket reads and handles the next control packet, and returns the response to send to the client, if any.
+func (s *Session) ReadPacket() (Packet, error) {
+	pkt, err := s.read()
+	if err != nil {
+		return nil, err
+	}
+	log.FromContext(s.ctx).WithField("type", pkt.Type().String()).Debug("Read packet")
+	switch pkt := pkt.(type) {
+	case *Publish:
+		return s.handlePublish(pkt)
+	case *Puback:
+		return nil, s.handlePuback(pkt)
+	case *Subscribe:
+		return s.handleSubscribe(pkt)
+	case *Unsubscribe:
+		return s.handleUnsubscribe(pkt)
+	case *Pingreq:
+		return &Pingresp{}, nil
+	case *Disconnect:
+		// The will is delivered if the client disconnects with the Disconnect with Will Message reason code.
+		if pkt.ReasonCode != 0x04 {
+			s.will = nil
+		}
+		return nil, s.handleDisconnect(pkt)
+	default:
+		return nil, errUnexpectedPacket.WithAttributes("type", pkt.Type().String())
+	}
+}
+
+func (s *Session) handlePublish(pkt *Publish) (Packet, error) {
+	switch {
+	case pkt.QoS > maxQoS:
+		return nil, errQoSNotSupported.WithAttributes("qos", pkt.QoS)
+	case pkt.Retain && s.version == mqtt.ProtocolLevel5:
+		return nil, errRetainNotSupported.New()
+	case pkt.Properties.TopicAlias != nil:
+		return nil, errTopicAliasInvalid.New()
+	}
+	var err error
+	if topic.ValidateTopic(pkt.TopicName) != nil {
+		err = errTopicNameInvalid.WithAttributes("topic", pkt.TopicName)
+	} else if !s.auth.CanWrite(pkt.TopicName) {
+		err = errNotAuthorized.WithAttributes("topic", pkt.TopicName)
+	} else {
+		log.FromContext(s.ctx).WithFields(log.Fields(
+			"topic", pkt.TopicName,
+			"size", len(pkt.Payload),
+			"qos", pkt.QoS,
+		)).Debug("Deliver message")
+		err = s.deliver(pkt)
+	}
+	if pkt.QoS == 0 {
+		return nil, nil
+	}
+	res := &Puback{
+		PacketID:   pkt.PacketID,
+		ReasonCode: ReasonCodeFromError(err),
+	}
+	res.Properties.ReasonString = s.reasonString(err)
+	return res, nil
+}
+
+func (s *Session) handlePuback(pkt *Puback) error {
+	s.mu.Lock()
+	seq, ok := s.inflight[pkt.PacketID]
+	delete(s.inflight, pkt.PacketID)
+	s.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return s.persist(&SessionUpdate{DeleteMessages: []uint64{seq}})
+}
+
+func (s *Session) handleDisconnect(pkt *Disconnect) error {
+	interval := pkt.Properties.SessionExpiryInterval
+	if interval == nil {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	// The session expiry interval cannot be set on DISCONNECT if it was zero on CONNECT.
+	if s.expiry == 0 && *interval != 0 {
+		return errSessionExpiryInterval.New()
+	}
+	s.expiry = time.Duration(*interval) * time.Second
+	if s.expiry > s.maxExpiry {
+		s.expiry = s.maxExpiry
+	}
+	return nil
+}
+
+func (s *Session) handleSubscribe(pkt *Subscribe) (Packet, error) {
+	if len(pkt.Properties.SubscriptionIdentifiers) > 0 {
+		return nil, errSubscriptionIDs.New()
+	}
+	res := &Suback{
+		PacketID:    pkt.PacketID,
+		ReasonCodes: make([]ReasonCode, len(pkt.Subscriptions)),
+	}
+	update := &SessionUpdate{
+		SetSubscriptions: make(map[string]byte, len(pkt.Subscriptions)),
+	}
+	for i, sub := range pkt.Subscriptions {
+		res.ReasonCodes[i] = s.subscribe(sub)
+		if !res.ReasonCodes[i].IsError() {
+			update.SetSubscriptions[sub.Filter] = sub.QoS
+		}
+	}
+	if err := s.persist(update); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// subscribe subscribes to the topic filter, and returns the reason code.
+func (s *Session) subscribe(sub Subscription) ReasonCode {
+	group, filter, ok := parseSharedFilter(sub.Filter)
+	if !ok || topic.ValidateFilter(filter) != nil || (group != "" && sub.NoLocal) {
+		return TopicFilterInvalid
+	}
+	if group != "" && s.shared == nil {
+		return SharedSubscriptionsNotSupported
+	}
+	qos := sub.QoS
+	if qos > maxQoS {
+		qos = maxQoS
+	}
+	acceptedFilter, acceptedQoS, err := s.auth.Subscribe(filter, qos)
+	if err != nil {
+		return NotAuthorized
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if old, ok := s.subscriptions[sub.Filter]; ok && old.shared != "" {
+		s.shared.leave(old.shared, s)
+	}
+	accepted := subscription{
+		filter: topic.Split(acceptedFilter),
+		qos:    acceptedQoS,
+	}
+	if group != "" {
+		accepted.shared = sharedKey(s.auth.Username, group, acceptedFilter)
+		s.shared.join(accepted.shared, s)
+	}
+	s.subscriptions[sub.Filter] = accepted
+	log.FromContext(s.ctx).WithFields(log.Fields(
+		"topic", acceptedFilter,
+		"topic_original", sub.Filter,
+		"group", group,
+		"qos", acceptedQoS,
+	)).Debug("Subscribe")
+	return ReasonCode(acceptedQoS)
+}
+
+func (s *Session) handleUnsubscribe(pkt *Unsubscribe) (Packet, error) {
+	res := &Unsuback{
+		PacketID:    pkt.PacketID,
+		ReasonCodes: make([]ReasonCode, len(pkt.Filters)),
+	}
+	update := &SessionUpdate{}
+	s.mu.Lock()
+	for i, filter := range pkt.Filters {
+		sub, ok := s.subscriptions[filter]
+		if !ok {
+			res.ReasonCodes[i] = NoSubscriptionExisted
+			continue
+		}
+		if sub.shared != "" {
+			s.shared.leave(sub.shared, s)
+		}
+		delete(s.subscriptions, filter)
+		update.DeleteSubscriptions = append(update.DeleteSubscriptions, filter)
+		log.FromContext(s.ctx).WithField("topic_original", filter).Debug("Unsubscribe")
+	}
+	s.mu.Unlock()
+	if err := s.persist(update); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// persist updates the stored state of a persistent session. Only the error of a session that is taken over is
+// returned, so that the session ends. Other errors are logged.
+func (s *Session) persist(update *SessionUpdate) error {
+	if !s.persistent() {
+		return nil
+	}
+	if _, err := s.update(update); err != nil {
+		if errors.Resemble(err, ErrSessionTakenOver) {
+			return err
+		}
+		log.FromContext(s.ctx).WithError(err).Warn("Failed to update session")
+	}
+	return nil
+}
+
+// match returns the maximum QoS of the subscriptions that match the topic.
+// Shared subscriptions only match if the client is connected and the session is selected for the message.
+func (s *Session) match(pkt *Publish, topicParts []string) (qos byte, ok bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, sub := range s.subscriptions {
+		if !topic.MatchPath(topicParts, sub.filter) {
+			continue
+		}
+		if sub.shared != "" && (!s.connected || !s.shared.selected(sub.shared, s, pkt)) {
+			continue
+		}
+		if !ok || sub.qos > qos {
+			qos = sub.qos
+		}
+		ok = true
+	}
+	return qos, ok
+}
+
+// Publish publishes a message to the client if the session has a matching subscription.
+// Access to the topic is checked with the authentication interface.
+// If the message has a message expiry interval, the message is dropped if it cannot be sent to the client before
+// the interval elapses.
+// QoS 1 messages of persistent sessions are stored until the client acknowledges them. Messages that are published
+// while the client is disconnected are sent when the client resumes the session.
+func (s *Session) Publish(pkt *Publish) {
+	select {
+	case <-s.takenOver:
+		return
+	default:
+	}
+	topicParts := topic.Split(pkt.TopicName)
+	if !s.auth.CanRead(topicParts...) {
+		return
+	}
+	qos, ok := s.match(pkt, topicParts)
+	if !ok {
+		return
+	}
+	pub := &Publish{
+		QoS:        pkt.QoS,
+		TopicName:  pkt.TopicName,
+		Properties: pkt.Properties,
+		Payload:    pkt.Payload,
+	}
+	if qos < pub.QoS {
+		pub.QoS = qos
+	}
+	out := outgoing{pkt: pub}
+	if interval := pkt.Properties.MessageExpiryInterval; interval != nil {
+		out.expires = time.Now().Add(time.Duration(*interval) * time.Second)
+	}
+	logger := log.FromContext(s.ctx).WithFields(log.Fields(
+		"topic", pub.TopicName,
+		"size", len(pub.Payload),
+		"qos", pub.QoS,
+	))
+	if pub.QoS > 0 && s.persistent() {
+		data, err := encodeMessage(out)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to encode message")
+			return
+		}
+		seqs, err := s.update(&SessionUpdate{AddMessages: [][]byte{data}})
+		if err != nil {
+			logger.WithError(err).Warn("Failed to store message")
+			if errors.Resemble(err, ErrSessionTakenOver) {
+				return
+			}
+		} else {
+			out.seq = seqs[0]
+		}
+	}
+	s.mu.Lock()
+	if !s.connected {
+		s.mu.Unlock()
+		logger.Debug("Store message")
+		return
+	}
+	s.assignPacketID(&out)
+	s.mu.Unlock()
+	select {
+	case s.publish <- out:
+		logger.Debug("Publish message")
+	default:
+		s.untrack(out)
+		logger.Warn("Connection too slow, drop message")
+	}
+}
+
+// assignPacketID assigns a packet ID to a QoS 1 message. Stored messages are tracked until the client acknowledges
+// them, unless there are too many messages awaiting acknowledgment. The session mutex must be held.
+func (s *Session) assignPacketID(out *outgoing) {
+	if out.pkt.QoS == 0 {
+		return
+	}
+	for {
+		s.packetID++
+		if s.packetID == 0 {
+			continue
+		}
+		if _, ok := s.inflight[s.packetID]; !ok {
+			break
+		}
+	}
+	out.pkt.PacketID = s.packetID
+	if out.seq != 0 && len(s.inflight) < maxInflight {
+		s.inflight[s.packetID] = out.seq
+	}
+}
+
+// untrack stops tracking the acknowledgment of a message that is not sent to the client.
+// The stored message is sent again when the client resumes the session.
+func (s *Session) untrack(out outgoing) {
+	if out.seq == 0 {
+		return
+	}
+	s.mu.Lock()
+	if s.inflight[out.pkt.PacketID] == out.seq {
+		delete(s.inflight, out.pkt.PacketID)
+	}
+	s.mu.Unlock()
+}
+
+// takeResumed returns the stored messages of a resumed session with their packet IDs.
+func (s *Session) takeResumed() []outgoing {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	resumed := s.resumed
+	s.resumed = nil
+	for i := range resumed {
+		s.assignPacketID(&resumed[i])
+	}
+	return resumed
+}
+
+// drop drops a message that expired before it is sent to the client.
+func (s *Session) drop(out outgoing) {
+	if out.seq == 0 {
+		return
+	}
+	s.untrack(out)
+	if err := s.persist(&SessionUpdate{DeleteMessages: []uint64{out.seq}}); err != nil {
+		log.FromContext(s.ctx).WithError(err).Debug("Failed to delete expired message")
+	}
+}
+
+// prepare returns the message to send, or nil if the message expired.
+// The message expiry interval is set to the remaining interval.
+func (out outgoing) prepare(now time.Time) *Publish {
+	if out.expires.IsZero() {
+		return out.pkt
+	}
+	remaining := out.expires.Sub(now)
+	if remaining <= 0 {
+		return nil
+	}
+	pkt := *out.pkt
+	pkt.Properties.MessageExpiryInterval = Uint32(uint32((remaining + time.Second - 1) / time.Second))
+	return &pkt
+}
+
+// Close closes the session. The will is delivered if it is set.
+// Persistent sessions keep their subscriptions and store the QoS 1 messages that are published to them until the
+// session expires, see WaitExpiry.
+func (s *Session) Close() {
+	if s.will != nil {
+		s.deliver(s.will) //nolint:errcheck
+		s.will = nil
+	}
+	s.mu.Lock()
+	s.connected = false
+	s.inflight = make(map[uint16]uint64)
+	s.expires = time.Now().Add(s.expiry)
+	persistent := s.expiry > 0
+	for filter, sub := range s.subscriptions {
+		if sub.shared != "" {
+			s.shared.leave(sub.shared, s)
+		}
+		if !persistent {
+			delete(s.subscriptions, filter)
+		}
+	}
+	s.mu.Unlock()
+	defer s.closeOnce.Do(func() { close(s.closed) })
+	switch {
+	case s.owner == "":
+	case persistent:
+		if err := s.touch(); err != nil && !errors.Resemble(err, ErrSessionTakenOver) {
+			log.FromContext(s.ctx).WithError(err).Warn("Failed to touch session")
+		}
+	default:
+		// The session expiry interval is set to zero on DISCONNECT.
+		unregisterResumed(s)
+		if err := s.store.Delete(s.ctx, s.id, s.owner); err != nil && !errors.Resemble(err, ErrSessionTakenOver) {
+			log.FromContext(s.ctx).WithError(err).Warn("Failed to delete session")
+		}
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt5
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMessageExpiry(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	now := time.Now()
+	pkt := &Publish{
+		TopicName: "foo",
+		Properties: Properties{
+			MessageExpiryInterval: Uint32(60),
+		},
+	}
+
+	// Messages without expiry do not expire.
+	a.So(outgoing{pkt: pkt}.prepare(now), should.Equal, pkt)
+
+	// The message expiry interval is set to the remaining interval.
+	out := outgoing{pkt: pkt, expires: now.Add(time.Minute)}
+	a.So(out.prepare(now).Properties.MessageExpiryInterval, should.Resemble, Uint32(60))
+	a.So(out.prepare(now.Add(45*time.Second)).Properties.MessageExpiryInterval, should.Resemble, Uint32(15))
+	a.So(out.prepare(now.Add(59500*time.Millisecond)).Properties.MessageExpiryInterval, should.Resemble, Uint32(1))
+	a.So(pkt.Properties.MessageExpiryInterval, should.Resemble, Uint32(60))
+
+	// Expired messages are dropped.
+	a.So(out.prepare(now.Add(time.Minute)), should.BeNil)
+}
+
+func TestParseSharedFilter(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	for _, tc := range []struct {
+		Filter      string
+		Group       string
+		TopicFilter string
+		OK          bool
+	}{
+		{Filter: "foo/#", TopicFilter: "foo/#", OK: true},
+		{Filter: "$share/group/foo/+/bar", Group: "group", TopicFilter: "foo/+/bar", OK: true},
+		{Filter: "$share/group"},
+		{Filter: "$share//foo"},
+		{Filter: "$share/gr+oup/foo"},
+	} {
+		group, topicFilter, ok := parseSharedFilter(tc.Filter)
+		a.So(group, should.Equal, tc.Group)
+		a.So(topicFilter, should.Equal, tc.TopicFilter)
+		a.So(ok, should.Equal, tc.OK)
+	}
+}
+
+func TestSharedSubscriptionsSelectKey(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	shared := NewSharedSubscriptions(func(pkt *Publish) string {
+		if ids := pkt.Properties.UserProperty("device_id"); len(ids) > 0 {
+			return ids[0]
+		}
+		return pkt.TopicName
+	})
+	members := []*Session{{}, {}, {}}
+	for _, member := range members {
+		shared.join("key", member)
+	}
+
+	// Messages with the same selection key are published to the same member, regardless of the topic.
+	for _, deviceID := range []string{"foo", "bar", "baz", "qux"} {
+		var selected *Session
+		for _, topicName := range []string{"up", "join", "down/queued"} {
+			pkt := &Publish{TopicName: topicName}
+			pkt.Properties.AddUserProperty("device_id", deviceID)
+			var count int
+			for _, member := range members {
+				if shared.selected("key", member, pkt) {
+					count++
+					if selected == nil {
+						selected = member
+					}
+					a.So(member == selected, should.BeTrue)
+				}
+			}
+			a.So(count, should.Equal, 1)
+		}
+	}
+}
@@ -0,0 +1,373 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt5_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/TheThingsIndustries/mystique/pkg/auth"
+	mqttpacket "github.com/TheThingsIndustries/mystique/pkg/packet"
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt/mqtt5"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var (
+	timeout = 10 * test.Delay
+
+	errDownlinkFailed = errors.DefineFailedPrecondition("downlink_failed", "downlink failed")
+)
+
+// testAuth allows clients with password secret to read from and write to topics under foo.
+type testAuth struct{}
+
+func (a testAuth) Connect(ctx context.Context, info *auth.Info) (context.Context, error) {
+	if string(info.Password) != "secret" {
+		return nil, mqttpacket.ConnectNotAuthorized
+	}
+	info.Interface = a
+	return ctx, nil
+}
+
+func (testAuth) Subscribe(_ *auth.Info, requestedTopic string, requestedQoS byte) (string, byte, error) {
+	if !strings.HasPrefix(requestedTopic, "foo/") {
+		return "", 0, fmt.Errorf("not allowed")
+	}
+	return requestedTopic, requestedQoS, nil
+}
+
+func (testAuth) CanRead(_ *auth.Info, topic ...string) bool {
+	return len(topic) > 0 && topic[0] == "foo"
+}
+
+func (testAuth) CanWrite(_ *auth.Info, topic ...string) bool {
+	return len(topic) > 0 && topic[0] == "foo"
+}
+
+type testClient struct {
+	net.Conn
+	version byte
+}
+
+func (c *testClient) send(t *testing.T, pkt mqtt5.Packet) {
+	t.Helper()
+	if err := mqtt5.Write(c, pkt, c.version); err != nil {
+		t.Fatalf("Failed to write packet: %v", err)
+	}
+}
+
+func (c *testClient) receive(t *testing.T) mqtt5.Packet {
+	t.Helper()
+	c.SetReadDeadline(time.Now().Add(timeout)) //nolint:errcheck
+	pkt, err := mqtt5.Read(c, c.version, 0)
+	if err != nil {
+		t.Fatalf("Failed to read packet: %v", err)
+	}
+	return pkt
+}
+
+type delivery struct {
+	pkt *mqtt5.Publish
+	err error
+}
+
+func connect(
+	ctx context.Context, t *testing.T, shared *mqtt5.SharedSubscriptions, password string, deliveries chan delivery,
+) (*testClient, *mqtt5.Session, *mqtt5.Connack) {
+	t.Helper()
+	var opts []mqtt5.SessionOption
+	if shared != nil {
+		opts = append(opts, mqtt5.WithSharedSubscriptions(shared))
+	}
+	return connectSession(ctx, t, &mqtt5.Connect{
+		CleanStart: true,
+		KeepAlive:  60,
+		Username:   "test-user",
+		Password:   []byte(password),
+	}, deliveries, opts...)
+}
+
+func connectSession(
+	ctx context.Context, t *testing.T, pkt *mqtt5.Connect, deliveries chan delivery, opts ...mqtt5.SessionOption,
+) (*testClient, *mqtt5.Session, *mqtt5.Connack) {
+	t.Helper()
+	serverConn, clientConn := net.Pipe()
+	client := &testClient{Conn: clientConn, version: pkt.ProtocolLevel}
+	if client.version == 0 {
+		client.version = mqtt.ProtocolLevel5
+	}
+	session := mqtt5.NewSession(
+		auth.NewContextWithInterface(ctx, testAuth{}), serverConn, "tcp",
+		func(pkt *mqtt5.Publish) error {
+			d := <-deliveries
+			d.pkt = pkt
+			deliveries <- d
+			return d.err
+		},
+		opts...,
+	)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- session.ReadConnect()
+	}()
+	client.send(t, pkt)
+	connack, ok := client.receive(t).(*mqtt5.Connack)
+	if !ok {
+		t.Fatal("Expected CONNACK")
+	}
+	if err := <-errCh; err != nil {
+		return client, nil, connack
+	}
+	ctx, cancel := context.WithCancel(session.Context())
+	t.Cleanup(func() {
+		cancel()
+		client.Close()
+	})
+	mqtt5.RunSession(ctx, func(error) { cancel() }, task.StartTaskFunc(task.DefaultStartTask), session, &sync.WaitGroup{})
+	return client, session, connack
+}
+
+func TestSession(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	_, _, connack := connect(ctx, t, nil, "invalid", nil)
+	a.So(connack.ReasonCode, should.Equal, mqtt5.NotAuthorized)
+
+	deliveries := make(chan delivery, 1)
+	client, session, connack := connect(ctx, t, nil, "secret", deliveries)
+	if !a.So(connack.ReasonCode, should.Equal, mqtt5.Success) {
+		t.FailNow()
+	}
+	a.So(connack.Properties.AssignedClientIdentifier, should.NotBeEmpty)
+	a.So(connack.Properties.MaximumQoS, should.Resemble, mqtt5.Byte(1))
+	a.So(connack.Properties.RetainAvailable, should.Resemble, mqtt5.Byte(0))
+	a.So(connack.Properties.SharedSubscriptionAvailable, should.Resemble, mqtt5.Byte(0))
+
+	client.send(t, &mqtt5.Subscribe{
+		PacketID: 1,
+		Subscriptions: []mqtt5.Subscription{
+			{Filter: "foo/#", QoS: 2},
+			{Filter: "bar/#", QoS: 1},
+			{Filter: "$share/group/foo/#", QoS: 1},
+		},
+	})
+	a.So(client.receive(t), should.Resemble, &mqtt5.Suback{
+		PacketID: 1,
+		ReasonCodes: []mqtt5.ReasonCode{
+			mqtt5.GrantedQoS1,
+			mqtt5.NotAuthorized,
+			mqtt5.SharedSubscriptionsNotSupported,
+		},
+	})
+
+	t.Run("Publish", func(t *testing.T) {
+		a := assertions.New(t)
+
+		pkt := &mqtt5.Publish{
+			QoS:       0,
+			TopicName: "foo/up",
+			Properties: mqtt5.Properties{
+				UserProperties: []mqtt5.UserProperty{{Key: "device_id", Value: "foo-device"}},
+			},
+			Payload: []byte("up"),
+		}
+		session.Publish(&mqtt5.Publish{TopicName: "bar/up", Payload: []byte("no access")})
+		session.Publish(pkt)
+		a.So(client.receive(t), should.Resemble, pkt)
+	})
+
+	t.Run("Deliver", func(t *testing.T) {
+		for _, tc := range []struct {
+			Name       string
+			Topic      string
+			Err        error
+			ReasonCode mqtt5.ReasonCode
+			Delivered  bool
+		}{
+			{
+				Name:       "Success",
+				Topic:      "foo/down",
+				ReasonCode: mqtt5.Success,
+				Delivered:  true,
+			},
+			{
+				Name:       "Failed",
+				Topic:      "foo/down",
+				Err:        errDownlinkFailed.New(),
+				ReasonCode: mqtt5.ImplementationSpecificError,
+				Delivered:  true,
+			},
+			{
+				Name:       "NotAuthorized",
+				Topic:      "bar/down",
+				ReasonCode: mqtt5.NotAuthorized,
+			},
+			{
+				Name:       "InvalidTopic",
+				Topic:      "foo/+",
+				ReasonCode: mqtt5.TopicNameInvalid,
+			},
+		} {
+			tc := tc
+			t.Run(tc.Name, func(t *testing.T) {
+				a := assertions.New(t)
+
+				if tc.Delivered {
+					deliveries <- delivery{err: tc.Err}
+				}
+				client.send(t, &mqtt5.Publish{
+					QoS:       1,
+					TopicName: tc.Topic,
+					PacketID:  42,
+					Payload:   []byte("down"),
+				})
+				puback, ok := client.receive(t).(*mqtt5.Puback)
+				if !a.So(ok, should.BeTrue) {
+					t.FailNow()
+				}
+				a.So(puback.PacketID, should.Equal, 42)
+				a.So(puback.ReasonCode, should.Equal, tc.ReasonCode)
+				if tc.ReasonCode.IsError() {
+					a.So(puback.Properties.ReasonString, should.NotBeEmpty)
+				}
+				if tc.Delivered {
+					d := <-deliveries
+					a.So(d.pkt.TopicName, should.Equal, tc.Topic)
+				}
+			})
+		}
+	})
+
+	t.Run("ProtocolError", func(t *testing.T) {
+		a := assertions.New(t)
+
+		client.send(t, &mqtt5.Publish{
+			QoS:       0,
+			Retain:    true,
+			TopicName: "foo/down",
+		})
+		disconnect, ok := client.receive(t).(*mqtt5.Disconnect)
+		if a.So(ok, should.BeTrue) {
+			a.So(disconnect.ReasonCode, should.Equal, mqtt5.RetainNotSupported)
+		}
+	})
+}
+
+func TestSharedSubscriptions(t *testing.T) {
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	shared := mqtt5.NewSharedSubscriptions(nil)
+	clients := make([]*testClient, 3)
+	sessions := make([]*mqtt5.Session, 3)
+	for i := range clients {
+		var connack *mqtt5.Connack
+		clients[i], sessions[i], connack = connect(ctx, t, shared, "secret", nil)
+		if !a.So(connack.ReasonCode, should.Equal, mqtt5.Success) {
+			t.FailNow()
+		}
+		a.So(connack.Properties.SharedSubscriptionAvailable, should.BeNil)
+		filter := "$share/group/foo/+"
+		if i == 2 {
+			// The third client uses a regular subscription.
+			filter = "foo/+"
+		}
+		clients[i].send(t, &mqtt5.Subscribe{
+			PacketID:      1,
+			Subscriptions: []mqtt5.Subscription{{Filter: filter}},
+		})
+		a.So(clients[i].receive(t), should.Resemble, &mqtt5.Suback{
+			PacketID:    1,
+			ReasonCodes: []mqtt5.ReasonCode{mqtt5.Success},
+		})
+	}
+	a.So(shared.Members("test-user", "group", "foo/+"), should.Equal, 2)
+
+	const numTopics = 32
+	received := make([]chan string, len(clients))
+	for i, client := range clients {
+		i, client := i, client
+		received[i] = make(chan string, numTopics)
+		client.SetReadDeadline(time.Time{}) //nolint:errcheck
+		go func() {
+			for {
+				pkt, err := mqtt5.Read(client, mqtt.ProtocolLevel5, 0)
+				if err != nil {
+					close(received[i])
+					return
+				}
+				if pub, ok := pkt.(*mqtt5.Publish); ok {
+					received[i] <- pub.TopicName
+				} else {
+					received[i] <- pkt.Type().String()
+				}
+			}
+		}()
+	}
+	for i := 0; i < numTopics; i++ {
+		topicName := fmt.Sprintf("foo/%d", i)
+		for _, session := range sessions {
+			session.Publish(&mqtt5.Publish{TopicName: topicName})
+		}
+	}
+
+	counts := make([]int, len(clients))
+	topics := make(map[string]int)
+	for i := range clients {
+	receive:
+		for counts[i] < numTopics {
+			select {
+			case topicName, ok := <-received[i]:
+				if !ok {
+					break receive
+				}
+				counts[i]++
+				topics[topicName]++
+			case <-time.After(timeout / 2):
+				break receive
+			}
+		}
+	}
+	// Each message is published once to the shared subscription, and once to the regular subscription.
+	a.So(counts[0]+counts[1], should.Equal, numTopics)
+	a.So(counts[0], should.BeGreaterThan, 0)
+	a.So(counts[1], should.BeGreaterThan, 0)
+	a.So(counts[2], should.Equal, numTopics)
+	for i := 0; i < numTopics; i++ {
+		a.So(topics[fmt.Sprintf("foo/%d", i)], should.Equal, 2)
+	}
+
+	// The remaining member receives all messages when the other member unsubscribes.
+	clients[0].send(t, &mqtt5.Unsubscribe{PacketID: 2, Filters: []string{"$share/group/foo/+"}})
+	select {
+	case typ := <-received[0]:
+		a.So(typ, should.Equal, "UNSUBACK")
+	case <-time.After(timeout):
+		t.Fatal("Receive UNSUBACK timeout")
+	}
+	a.So(shared.Members("test-user", "group", "foo/+"), should.Equal, 1)
+}
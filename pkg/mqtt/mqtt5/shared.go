@@ -0,0 +1,116 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt5
+
+import (
+	"hash/fnv"
+	"strings"
+	"sync"
+)
+
+const sharePrefix = "$share/"
+
+// parseSharedFilter parses a shared subscription filter of the form $share/{group}/{filter}.
+// If the filter is not a shared subscription filter, the group is empty.
+func parseSharedFilter(filter string) (group, topicFilter string, ok bool) {
+	if !strings.HasPrefix(filter, sharePrefix) {
+		return "", filter, true
+	}
+	parts := strings.SplitN(strings.TrimPrefix(filter, sharePrefix), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" || strings.ContainsAny(parts[0], "+#") {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// SharedSubscriptions contains the members of shared subscriptions.
+//
+// Each message that matches a shared subscription is published to a single member of the group. The member is
+// selected by the selection key of the message, so that all messages with the same key are published to the same
+// member as long as the members of the group do not change.
+//
+// The members are the sessions of the process: sessions that are served by other processes are not members of the
+// same group.
+type SharedSubscriptions struct {
+	selectKey func(*Publish) string
+
+	mu     sync.RWMutex
+	groups map[string][]*Session
+}
+
+// NewSharedSubscriptions returns a new SharedSubscriptions.
+// The selectKey function returns the key by which the member of the group is selected for a message.
+// If selectKey is nil, the member is selected by the topic name of the message.
+func NewSharedSubscriptions(selectKey func(*Publish) string) *SharedSubscriptions {
+	if selectKey == nil {
+		selectKey = func(pkt *Publish) string { return pkt.TopicName }
+	}
+	return &SharedSubscriptions{
+		selectKey: selectKey,
+		groups:    make(map[string][]*Session),
+	}
+}
+
+func (s *SharedSubscriptions) join(key string, session *Session) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, member := range s.groups[key] {
+		if member == session {
+			return
+		}
+	}
+	s.groups[key] = append(s.groups[key], session)
+}
+
+func (s *SharedSubscriptions) leave(key string, session *Session) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	members := s.groups[key]
+	for i, member := range members {
+		if member != session {
+			continue
+		}
+		members = append(members[:i:i], members[i+1:]...)
+		if len(members) == 0 {
+			delete(s.groups, key)
+		} else {
+			s.groups[key] = members
+		}
+		return
+	}
+}
+
+func (s *SharedSubscriptions) selected(key string, session *Session, pkt *Publish) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	members := s.groups[key]
+	if len(members) == 0 {
+		return false
+	}
+	h := fnv.New32a()
+	h.Write([]byte(s.selectKey(pkt))) //nolint:errcheck
+	return members[h.Sum32()%uint32(len(members))] == session
+}
+
+// Members returns the number of members of the shared subscription.
+func (s *SharedSubscriptions) Members(username, group, filter string) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.groups[sharedKey(username, group, filter)])
+}
+
+func sharedKey(username, group, filter string) string {
+	return username + "\x00" + group + "\x00" + filter
+}
@@ -0,0 +1,106 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"bufio"
+	"net"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// Protocol levels of the CONNECT packet.
+const (
+	ProtocolLevel31  byte = 3
+	ProtocolLevel311 byte = 4
+	ProtocolLevel5   byte = 5
+)
+
+// maxProtocolNameLength is the length of the longest protocol name, MQIsdp.
+const maxProtocolNameLength = 6
+
+var errInvalidConnect = errors.DefineInvalidArgument("invalid_connect", "invalid CONNECT packet")
+
+// peekedConn is a net.Conn that reads through a buffered reader, so that peeked bytes are read again.
+type peekedConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+// Read implements io.Reader.
+func (c *peekedConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+// ConnectHeader contains the fields of the variable header of the CONNECT packet that are used to select the session
+// implementation.
+type ConnectHeader struct {
+	ProtocolLevel byte
+	// CleanSession is the Clean Session flag of MQTT 3.1 and MQTT 3.1.1, and the Clean Start flag of MQTT 5.
+	CleanSession bool
+}
+
+// PeekConnect returns the variable header of the CONNECT packet that the client sends first.
+// The CONNECT packet is not consumed: the returned connection reads the peeked bytes again, so that the connection
+// can be handed over to the session implementation of the protocol level.
+func PeekConnect(conn net.Conn, timeout time.Duration) (net.Conn, *ConnectHeader, error) {
+	if err := conn.SetReadDeadline(time.Now().Add(timeout)); err != nil {
+		return nil, nil, err
+	}
+	r := bufio.NewReader(conn)
+	header, err := r.Peek(1)
+	if err != nil {
+		return nil, nil, err
+	}
+	if header[0] != 0x10 {
+		return nil, nil, errInvalidConnect.New()
+	}
+	// Skip the variable byte integer of the remaining length.
+	offset := 1
+	for {
+		b, err := r.Peek(offset + 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		offset++
+		if b[offset-1]&0x80 == 0 {
+			break
+		}
+		if offset == 5 {
+			return nil, nil, errInvalidConnect.New()
+		}
+	}
+	b, err := r.Peek(offset + 2)
+	if err != nil {
+		return nil, nil, err
+	}
+	nameLength := int(b[offset])<<8 | int(b[offset+1])
+	if nameLength > maxProtocolNameLength {
+		return nil, nil, errInvalidConnect.New()
+	}
+	levelOffset := offset + 2 + nameLength
+	b, err = r.Peek(levelOffset + 2)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		return nil, nil, err
+	}
+	return &peekedConn{Conn: conn, r: r}, &ConnectHeader{
+		ProtocolLevel: b[levelOffset],
+		CleanSession:  b[levelOffset+1]&0x02 != 0,
+	}, nil
+}
@@ -0,0 +1,115 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt_test
+
+import (
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/mqtt"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestPeekConnect(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name    string
+		Connect []byte
+		Header  *mqtt.ConnectHeader
+		Invalid bool
+	}{
+		{
+			Name: "MQTT31",
+			Connect: []byte{
+				0x10, 0x0E,
+				0x00, 0x06, 'M', 'Q', 'I', 's', 'd', 'p', 0x03, 0x02, 0x00, 0x3C,
+				0x00, 0x00,
+			},
+			Header: &mqtt.ConnectHeader{
+				ProtocolLevel: mqtt.ProtocolLevel31,
+				CleanSession:  true,
+			},
+		},
+		{
+			Name: "MQTT311",
+			Connect: []byte{
+				0x10, 0x0C,
+				0x00, 0x04, 'M', 'Q', 'T', 'T', 0x04, 0x00, 0x00, 0x3C,
+				0x00, 0x00,
+			},
+			Header: &mqtt.ConnectHeader{
+				ProtocolLevel: mqtt.ProtocolLevel311,
+			},
+		},
+		{
+			Name: "MQTT5",
+			Connect: []byte{
+				0x10, 0x0D,
+				0x00, 0x04, 'M', 'Q', 'T', 'T', 0x05, 0x02, 0x00, 0x3C,
+				0x00,
+				0x00, 0x00,
+			},
+			Header: &mqtt.ConnectHeader{
+				ProtocolLevel: mqtt.ProtocolLevel5,
+				CleanSession:  true,
+			},
+		},
+		{
+			Name:    "NotConnect",
+			Connect: []byte{0xC0, 0x00},
+			Invalid: true,
+		},
+		{
+			Name:    "InvalidProtocolName",
+			Connect: []byte{0x10, 0x0C, 0x00, 0xFF, 'M', 'Q', 'T', 'T', 0x04, 0x02, 0x00, 0x3C, 0x00, 0x00},
+			Invalid: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+
+			serverConn, clientConn := net.Pipe()
+			defer serverConn.Close()
+			go func() {
+				clientConn.Write(tc.Connect) //nolint:errcheck
+				clientConn.Close()
+			}()
+
+			conn, header, err := mqtt.PeekConnect(serverConn, 10*test.Delay)
+			if tc.Invalid {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(header, should.Resemble, tc.Header)
+
+			// The CONNECT packet is read again from the returned connection.
+			conn.SetReadDeadline(time.Now().Add(10 * test.Delay)) //nolint:errcheck
+			buf, err := io.ReadAll(conn)
+			a.So(err, should.BeNil)
+			a.So(buf, should.Resemble, tc.Connect)
+		})
+	}
+}
@@ -0,0 +1,500 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package campaign implements channel plan migration campaigns for the Network Server.
+//
+// A campaign alters the desired channels of a set of end devices. The Network Server then
+// eventually sends the LinkADRReq, NewChannelReq and DlChannelReq MAC commands required to
+// converge the current channels of each end device to the desired ones. The Manager rate-limits
+// the rollout, tracks per-device completion and rolls back end devices that stop uplinking after
+// the change. The status of the campaigns is stored in a Registry.
+package campaign
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errCampaignExists   = errors.DefineAlreadyExists("campaign_exists", "campaign `{campaign_id}` already exists")
+	errCampaignNotFound = errors.DefineNotFound("campaign_not_found", "campaign `{campaign_id}` not found")
+	errDeviceNotFound   = errors.DefineNotFound("device_not_found", "device not found")
+	errNoTarget         = errors.DefineInvalidArgument("no_target", "no channels or channel mask specified")
+	errNoMACState       = errors.DefineFailedPrecondition("no_mac_state", "device has no MAC state")
+	errMACStateReset    = errors.DefineAborted("mac_state_reset", "MAC state of device was reset")
+)
+
+var (
+	evtApplyDevice = events.Define(
+		"ns.campaign.device.apply", "apply campaign channels to device",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.MACParameters{}),
+	)
+	evtCompleteDevice = events.Define(
+		"ns.campaign.device.complete", "campaign channels in use by device",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.MACParameters{}),
+	)
+	evtRollbackDevice = events.Define(
+		"ns.campaign.device.rollback", "roll back campaign channels of device",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.MACParameters{}),
+	)
+	evtFailDevice = events.Define(
+		"ns.campaign.device.fail", "fail to migrate device",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithErrorDataType(),
+	)
+)
+
+// targetChannels returns the desired channels of a device after applying the campaign to desired.
+func targetChannels(c *ttnpb.ChannelMigrationCampaign, desired []*ttnpb.MACParameters_Channel) []*ttnpb.MACParameters_Channel {
+	src := desired
+	if len(c.Channels) > 0 {
+		src = c.Channels
+	}
+	chs := cloneChannels(src)
+	for i, enable := range c.ChannelMask {
+		if i >= len(chs) {
+			break
+		}
+		chs[i].EnableUplink = enable
+	}
+	return chs
+}
+
+// Registry stores the status of campaigns.
+type Registry interface {
+	// Get returns the status of the campaign identified by ids.
+	Get(ctx context.Context, ids *ttnpb.ChannelMigrationCampaignIdentifiers) (*ttnpb.ChannelMigrationCampaignStatus, error)
+	// List returns the status of all campaigns of the application identified by appIDs.
+	List(ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers) ([]*ttnpb.ChannelMigrationCampaignStatus, error)
+	// Range calls f for the status of all campaigns, until f returns false.
+	Range(ctx context.Context, f func(context.Context, *ttnpb.ChannelMigrationCampaignStatus) bool) error
+	// Set creates, updates or deletes the status of the campaign identified by ids atomically.
+	// f is called with nil if the campaign does not exist. If f returns nil, the campaign is deleted.
+	Set(
+		ctx context.Context,
+		ids *ttnpb.ChannelMigrationCampaignIdentifiers,
+		f func(*ttnpb.ChannelMigrationCampaignStatus) (*ttnpb.ChannelMigrationCampaignStatus, error),
+	) (*ttnpb.ChannelMigrationCampaignStatus, error)
+	// LockProcessing attempts to acquire the lock for processing campaigns for at most ttl.
+	// It returns false if the lock is held by another caller. The lock is released by calling release.
+	LockProcessing(ctx context.Context, ttl time.Duration) (release func(context.Context) error, ok bool, err error)
+}
+
+// done returns whether all devices in the campaign reached a final state,
+// or whether the campaign was canceled and no devices are awaiting completion.
+func done(st *ttnpb.ChannelMigrationCampaignStatus) bool {
+	for _, dev := range st.Devices {
+		switch dev.State {
+		case ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_APPLIED:
+			return false
+		case ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_PENDING:
+			if !st.Canceled {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// DeviceRegistry is the subset of the Network Server device registry used by the Manager.
+type DeviceRegistry interface {
+	GetByID(ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error)
+	SetByID(ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error)
+}
+
+// Manager orchestrates campaigns.
+// The status of the campaigns is stored in the campaign registry, so that campaigns survive restarts and are
+// shared by all Network Server instances. Campaigns are processed by at most one instance at a time.
+type Manager struct {
+	devices   DeviceRegistry
+	campaigns Registry
+	lockTTL   time.Duration
+}
+
+// NewManager returns a new Manager, which stores campaigns in campaigns and alters devices in devices.
+// The processing lock is held for at most lockTTL per rollout step.
+func NewManager(devices DeviceRegistry, campaigns Registry, lockTTL time.Duration) *Manager {
+	return &Manager{
+		devices:   devices,
+		campaigns: campaigns,
+		lockTTL:   lockTTL,
+	}
+}
+
+func campaignUID(ids *ttnpb.ChannelMigrationCampaignIdentifiers) string {
+	return unique.ID(context.Background(), ids.ApplicationIds) + "." + ids.CampaignId
+}
+
+// Create creates the campaign c. The campaign is applied to devices in subsequent calls to Step.
+func (m *Manager) Create(ctx context.Context, c *ttnpb.ChannelMigrationCampaign, now time.Time) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+	if err := c.ValidateFields(); err != nil {
+		return nil, err
+	}
+	if len(c.Channels) == 0 && len(c.ChannelMask) == 0 {
+		return nil, errNoTarget.New()
+	}
+	st, err := m.campaigns.Set(ctx, c.Ids, func(stored *ttnpb.ChannelMigrationCampaignStatus) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+		if stored != nil {
+			return nil, errCampaignExists.WithAttributes("campaign_id", c.Ids.CampaignId)
+		}
+		devs := make([]*ttnpb.ChannelMigrationCampaignStatus_Device, 0, len(c.DeviceIds))
+		for _, devID := range c.DeviceIds {
+			devs = append(devs, &ttnpb.ChannelMigrationCampaignStatus_Device{
+				DeviceId:  devID,
+				State:     ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_PENDING,
+				UpdatedAt: timestamppb.New(now),
+			})
+		}
+		return &ttnpb.ChannelMigrationCampaignStatus{
+			Campaign:  c,
+			CreatedAt: timestamppb.New(now),
+			Devices:   devs,
+		}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).WithFields(log.Fields(
+		"campaign_uid", campaignUID(c.Ids),
+		"device_count", len(st.Devices),
+	)).Info("Campaign created")
+	return st, nil
+}
+
+// Get returns the status of the campaign identified by ids.
+func (m *Manager) Get(ctx context.Context, ids *ttnpb.ChannelMigrationCampaignIdentifiers) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+	return m.campaigns.Get(ctx, ids)
+}
+
+// List returns the status of all campaigns of the application identified by appIDs, ordered by creation time.
+func (m *Manager) List(ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers) ([]*ttnpb.ChannelMigrationCampaignStatus, error) {
+	sts, err := m.campaigns.List(ctx, appIDs)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(sts, func(i, j int) bool {
+		ti, tj := sts[i].CreatedAt.AsTime(), sts[j].CreatedAt.AsTime()
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return sts[i].Campaign.Ids.CampaignId < sts[j].Campaign.Ids.CampaignId
+	})
+	return sts, nil
+}
+
+// Cancel cancels the campaign identified by ids. If rollback is true, all devices to which the
+// campaign was applied are rolled back immediately.
+func (m *Manager) Cancel(ctx context.Context, ids *ttnpb.ChannelMigrationCampaignIdentifiers, rollback bool, now time.Time) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+	st, err := m.campaigns.Set(ctx, ids, func(stored *ttnpb.ChannelMigrationCampaignStatus) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+		if stored == nil {
+			return nil, errCampaignNotFound.WithAttributes("campaign_id", ids.CampaignId)
+		}
+		stored.Canceled = true
+		return stored, nil
+	})
+	if err != nil || !rollback {
+		return st, err
+	}
+	var updates []deviceUpdate
+	for _, dev := range st.Devices {
+		switch dev.State {
+		case ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_APPLIED,
+			ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_COMPLETED:
+			updated := cloneDevice(dev)
+			m.rollback(ctx, ids.ApplicationIds, updated, now)
+			updates = append(updates, deviceUpdate{before: dev, after: updated})
+		}
+	}
+	return m.update(ctx, ids, updates)
+}
+
+// Delete deletes the campaign identified by ids. Devices are not altered.
+func (m *Manager) Delete(ctx context.Context, ids *ttnpb.ChannelMigrationCampaignIdentifiers) error {
+	_, err := m.campaigns.Set(ctx, ids, func(stored *ttnpb.ChannelMigrationCampaignStatus) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+		if stored == nil {
+			return nil, errCampaignNotFound.WithAttributes("campaign_id", ids.CampaignId)
+		}
+		return nil, nil
+	})
+	return err
+}
+
+// deviceUpdate is a change of the status of a device in a campaign.
+type deviceUpdate struct {
+	before, after *ttnpb.ChannelMigrationCampaignStatus_Device
+}
+
+// update applies the device updates to the stored campaign status.
+// Updates of devices of which the status changed concurrently are discarded.
+func (m *Manager) update(ctx context.Context, ids *ttnpb.ChannelMigrationCampaignIdentifiers, updates []deviceUpdate) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+	return m.campaigns.Set(ctx, ids, func(stored *ttnpb.ChannelMigrationCampaignStatus) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+		if stored == nil {
+			return nil, errCampaignNotFound.WithAttributes("campaign_id", ids.CampaignId)
+		}
+		for _, upd := range updates {
+			for i, dev := range stored.Devices {
+				if dev.DeviceId != upd.before.DeviceId {
+					continue
+				}
+				if proto.Equal(dev, upd.before) {
+					stored.Devices[i] = upd.after
+				} else {
+					log.FromContext(ctx).WithField("device_id", dev.DeviceId).Debug("Discard concurrently changed campaign device status")
+				}
+				break
+			}
+		}
+		return stored, nil
+	})
+}
+
+// Step performs a single rollout step for all campaigns that are not done.
+// Devices to which the campaign was applied are checked for completion and rollback first,
+// after which the campaign is applied to at most RolloutRate pending devices per campaign.
+// Step does nothing if another caller is processing the campaigns.
+func (m *Manager) Step(ctx context.Context, now time.Time) error {
+	release, ok, err := m.campaigns.LockProcessing(ctx, m.lockTTL)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		log.FromContext(ctx).Debug("Campaigns are processed by another instance, skip rollout step")
+		return nil
+	}
+	defer func() {
+		if err := release(ctx); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to release campaign processing lock")
+		}
+	}()
+
+	var sts []*ttnpb.ChannelMigrationCampaignStatus
+	if err := m.campaigns.Range(ctx, func(_ context.Context, st *ttnpb.ChannelMigrationCampaignStatus) bool {
+		if !done(st) {
+			sts = append(sts, st)
+		}
+		return true
+	}); err != nil {
+		return err
+	}
+	for _, st := range sts {
+		ids := st.Campaign.Ids
+		ctx := log.NewContextWithField(ctx, "campaign_uid", campaignUID(ids))
+		var updates []deviceUpdate
+		for _, dev := range st.Devices {
+			if dev.State != ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_APPLIED {
+				continue
+			}
+			updated := cloneDevice(dev)
+			m.check(ctx, st.Campaign, updated, now)
+			if !proto.Equal(dev, updated) {
+				updates = append(updates, deviceUpdate{before: dev, after: updated})
+			}
+		}
+		if !st.Canceled {
+			applied := uint32(0)
+			for _, dev := range st.Devices {
+				if applied >= st.Campaign.RolloutRate {
+					break
+				}
+				if dev.State != ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_PENDING {
+					continue
+				}
+				updated := cloneDevice(dev)
+				m.apply(ctx, st.Campaign, updated, now)
+				updates = append(updates, deviceUpdate{before: dev, after: updated})
+				applied++
+			}
+		}
+		if len(updates) == 0 {
+			continue
+		}
+		if _, err := m.update(ctx, ids, updates); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to update campaign status")
+		}
+	}
+	return nil
+}
+
+func deviceIdentifiers(appIDs *ttnpb.ApplicationIdentifiers, dev *ttnpb.ChannelMigrationCampaignStatus_Device) *ttnpb.EndDeviceIdentifiers {
+	return &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: appIDs,
+		DeviceId:       dev.DeviceId,
+	}
+}
+
+func (m *Manager) fail(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, dev *ttnpb.ChannelMigrationCampaignStatus_Device, err error, now time.Time) {
+	log.FromContext(ctx).WithError(err).Warn("Failed to migrate device")
+	dev.State = ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_FAILED
+	dev.UpdatedAt = timestamppb.New(now)
+	if ttnErr, ok := errors.From(err); ok {
+		dev.Error = ttnpb.ErrorDetailsToProto(ttnErr)
+	}
+	events.Publish(evtFailDevice.NewWithIdentifiersAndData(ctx, ids, err))
+}
+
+func (m *Manager) apply(ctx context.Context, c *ttnpb.ChannelMigrationCampaign, dev *ttnpb.ChannelMigrationCampaignStatus_Device, now time.Time) {
+	ids := deviceIdentifiers(c.Ids.ApplicationIds, dev)
+	ctx = log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, ids))
+	var prev, target []*ttnpb.MACParameters_Channel
+	_, _, err := m.devices.SetByID(ctx, ids.ApplicationIds, ids.DeviceId,
+		[]string{
+			"mac_state.desired_parameters.channels",
+		},
+		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored == nil {
+				return nil, nil, errDeviceNotFound.New()
+			}
+			if stored.GetMacState().GetDesiredParameters() == nil {
+				return nil, nil, errNoMACState.New()
+			}
+			prev = cloneChannels(stored.MacState.DesiredParameters.Channels)
+			target = targetChannels(c, prev)
+			stored.MacState.DesiredParameters.Channels = cloneChannels(target)
+			return stored, []string{
+				"mac_state.desired_parameters.channels",
+			}, nil
+		},
+	)
+	if err != nil {
+		m.fail(ctx, ids, dev, err, now)
+		return
+	}
+	dev.State = ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_APPLIED
+	dev.AppliedAt = timestamppb.New(now)
+	dev.UpdatedAt = timestamppb.New(now)
+	dev.PreviousChannels = prev
+	dev.TargetChannels = target
+	events.Publish(evtApplyDevice.NewWithIdentifiersAndData(ctx, ids, &ttnpb.MACParameters{
+		Channels: target,
+	}))
+}
+
+func (m *Manager) check(ctx context.Context, c *ttnpb.ChannelMigrationCampaign, dev *ttnpb.ChannelMigrationCampaignStatus_Device, now time.Time) {
+	ids := deviceIdentifiers(c.Ids.ApplicationIds, dev)
+	ctx = log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, ids))
+	stored, _, err := m.devices.GetByID(ctx, ids.ApplicationIds, ids.DeviceId, []string{
+		"mac_state.current_parameters.channels",
+		"mac_state.desired_parameters.channels",
+		"mac_state.recent_uplinks",
+	})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			m.fail(ctx, ids, dev, err, now)
+		} else {
+			log.FromContext(ctx).WithError(err).Warn("Failed to get device")
+		}
+		return
+	}
+	macState := stored.GetMacState()
+	switch {
+	case macState == nil:
+		m.fail(ctx, ids, dev, errNoMACState.New(), now)
+		return
+	case !channelsEqual(macState.GetDesiredParameters().GetChannels(), dev.TargetChannels):
+		// The desired parameters are recomputed when the MAC state is reset, for example on rejoin.
+		m.fail(ctx, ids, dev, errMACStateReset.New(), now)
+		return
+	case channelsEqual(macState.GetCurrentParameters().GetChannels(), dev.TargetChannels):
+		dev.State = ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_COMPLETED
+		dev.UpdatedAt = timestamppb.New(now)
+		events.Publish(evtCompleteDevice.NewWithIdentifiersAndData(ctx, ids, &ttnpb.MACParameters{
+			Channels: dev.TargetChannels,
+		}))
+		return
+	}
+	rollbackTimeout := ttnpb.StdDurationOrZero(c.RollbackTimeout)
+	if rollbackTimeout == 0 {
+		return
+	}
+	lastActivityAt := dev.AppliedAt.AsTime()
+	if ups := macState.RecentUplinks; len(ups) > 0 {
+		if recvAt := ttnpb.StdTime(ups[len(ups)-1].ReceivedAt); recvAt != nil && recvAt.After(lastActivityAt) {
+			lastActivityAt = *recvAt
+		}
+	}
+	if now.Sub(lastActivityAt) < rollbackTimeout {
+		return
+	}
+	m.rollback(ctx, c.Ids.ApplicationIds, dev, now)
+}
+
+func (m *Manager) rollback(ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers, dev *ttnpb.ChannelMigrationCampaignStatus_Device, now time.Time) {
+	ids := deviceIdentifiers(appIDs, dev)
+	_, _, err := m.devices.SetByID(ctx, ids.ApplicationIds, ids.DeviceId,
+		[]string{
+			"mac_state.desired_parameters.channels",
+		},
+		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
+			if stored == nil {
+				return nil, nil, errDeviceNotFound.New()
+			}
+			if stored.GetMacState().GetDesiredParameters() == nil {
+				return nil, nil, errNoMACState.New()
+			}
+			stored.MacState.DesiredParameters.Channels = cloneChannels(dev.PreviousChannels)
+			return stored, []string{
+				"mac_state.desired_parameters.channels",
+			}, nil
+		},
+	)
+	if err != nil {
+		m.fail(ctx, ids, dev, err, now)
+		return
+	}
+	log.FromContext(ctx).Info("Rolled back device")
+	dev.State = ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_ROLLED_BACK
+	dev.UpdatedAt = timestamppb.New(now)
+	events.Publish(evtRollbackDevice.NewWithIdentifiersAndData(ctx, ids, &ttnpb.MACParameters{
+		Channels: dev.PreviousChannels,
+	}))
+}
+
+func cloneDevice(dev *ttnpb.ChannelMigrationCampaignStatus_Device) *ttnpb.ChannelMigrationCampaignStatus_Device {
+	return proto.Clone(dev).(*ttnpb.ChannelMigrationCampaignStatus_Device)
+}
+
+func cloneChannels(chs []*ttnpb.MACParameters_Channel) []*ttnpb.MACParameters_Channel {
+	if chs == nil {
+		return nil
+	}
+	cloned := make([]*ttnpb.MACParameters_Channel, 0, len(chs))
+	for _, ch := range chs {
+		cloned = append(cloned, proto.Clone(ch).(*ttnpb.MACParameters_Channel))
+	}
+	return cloned
+}
+
+func channelsEqual(a, b []*ttnpb.MACParameters_Channel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,428 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package campaign_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/campaign"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockRegistry struct {
+	mu      sync.Mutex
+	devices map[string]*ttnpb.EndDevice
+}
+
+func (r *mockRegistry) GetByID(ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string) (*ttnpb.EndDevice, context.Context, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	dev, ok := r.devices[devID]
+	if !ok {
+		return nil, ctx, errors.DefineNotFound("not_found", "not found").New()
+	}
+	return proto.Clone(dev).(*ttnpb.EndDevice), ctx, nil
+}
+
+func (r *mockRegistry) SetByID(ctx context.Context, appID *ttnpb.ApplicationIdentifiers, devID string, paths []string, f func(context.Context, *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, context.Context, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var stored *ttnpb.EndDevice
+	if dev, ok := r.devices[devID]; ok {
+		stored = proto.Clone(dev).(*ttnpb.EndDevice)
+	}
+	dev, _, err := f(ctx, stored)
+	if err != nil {
+		return nil, ctx, err
+	}
+	if dev == nil {
+		delete(r.devices, devID)
+		return nil, ctx, nil
+	}
+	r.devices[devID] = dev
+	return dev, ctx, nil
+}
+
+func (r *mockRegistry) update(devID string, f func(*ttnpb.EndDevice)) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	f(r.devices[devID])
+}
+
+type mockCampaignRegistry struct {
+	mu        sync.Mutex
+	campaigns map[string]*ttnpb.ChannelMigrationCampaignStatus
+	locked    bool
+}
+
+func newMockCampaignRegistry() *mockCampaignRegistry {
+	return &mockCampaignRegistry{
+		campaigns: make(map[string]*ttnpb.ChannelMigrationCampaignStatus),
+	}
+}
+
+func campaignKey(ids *ttnpb.ChannelMigrationCampaignIdentifiers) string {
+	return ids.ApplicationIds.ApplicationId + "." + ids.CampaignId
+}
+
+func (r *mockCampaignRegistry) Get(ctx context.Context, ids *ttnpb.ChannelMigrationCampaignIdentifiers) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	st, ok := r.campaigns[campaignKey(ids)]
+	if !ok {
+		return nil, errors.DefineNotFound("campaign_not_found", "campaign not found").New()
+	}
+	return ttnpb.Clone(st), nil
+}
+
+func (r *mockCampaignRegistry) List(ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers) ([]*ttnpb.ChannelMigrationCampaignStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var sts []*ttnpb.ChannelMigrationCampaignStatus
+	for _, st := range r.campaigns {
+		if st.Campaign.Ids.ApplicationIds.ApplicationId == appIDs.ApplicationId {
+			sts = append(sts, ttnpb.Clone(st))
+		}
+	}
+	return sts, nil
+}
+
+func (r *mockCampaignRegistry) Range(ctx context.Context, f func(context.Context, *ttnpb.ChannelMigrationCampaignStatus) bool) error {
+	r.mu.Lock()
+	sts := make([]*ttnpb.ChannelMigrationCampaignStatus, 0, len(r.campaigns))
+	for _, st := range r.campaigns {
+		sts = append(sts, ttnpb.Clone(st))
+	}
+	r.mu.Unlock()
+	for _, st := range sts {
+		if !f(ctx, st) {
+			break
+		}
+	}
+	return nil
+}
+
+func (r *mockCampaignRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.ChannelMigrationCampaignIdentifiers,
+	f func(*ttnpb.ChannelMigrationCampaignStatus) (*ttnpb.ChannelMigrationCampaignStatus, error),
+) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	k := campaignKey(ids)
+	var stored *ttnpb.ChannelMigrationCampaignStatus
+	if st, ok := r.campaigns[k]; ok {
+		stored = ttnpb.Clone(st)
+	}
+	st, err := f(stored)
+	if err != nil {
+		return nil, err
+	}
+	if st == nil {
+		delete(r.campaigns, k)
+		return nil, nil
+	}
+	r.campaigns[k] = ttnpb.Clone(st)
+	return st, nil
+}
+
+func (r *mockCampaignRegistry) LockProcessing(ctx context.Context, ttl time.Duration) (func(context.Context) error, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.locked {
+		return nil, false, nil
+	}
+	r.locked = true
+	return func(context.Context) error {
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.locked = false
+		return nil
+	}, true, nil
+}
+
+func makeChannels(enabled ...bool) []*ttnpb.MACParameters_Channel {
+	chs := make([]*ttnpb.MACParameters_Channel, 0, len(enabled))
+	for i, enable := range enabled {
+		chs = append(chs, &ttnpb.MACParameters_Channel{
+			UplinkFrequency:   915200000 + uint64(i)*200000,
+			DownlinkFrequency: 923300000,
+			MaxDataRateIndex:  ttnpb.DataRateIndex_DATA_RATE_5,
+			EnableUplink:      enable,
+		})
+	}
+	return chs
+}
+
+func makeDevice(devID string, chs []*ttnpb.MACParameters_Channel) *ttnpb.EndDevice {
+	return &ttnpb.EndDevice{
+		Ids: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+			DeviceId:       devID,
+		},
+		MacState: &ttnpb.MACState{
+			CurrentParameters: &ttnpb.MACParameters{Channels: chs},
+			DesiredParameters: &ttnpb.MACParameters{Channels: chs},
+		},
+	}
+}
+
+var (
+	appIDs      = &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	campaignIDs = &ttnpb.ChannelMigrationCampaignIdentifiers{
+		ApplicationIds: appIDs,
+		CampaignId:     "test-campaign",
+	}
+)
+
+const (
+	statePending    = ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_PENDING
+	stateApplied    = ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_APPLIED
+	stateCompleted  = ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_COMPLETED
+	stateRolledBack = ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_ROLLED_BACK
+	stateFailed     = ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_FAILED
+)
+
+func TestManagerCreateValidation(t *testing.T) {
+	for _, tc := range []struct {
+		Name     string
+		Campaign *ttnpb.ChannelMigrationCampaign
+		Valid    bool
+	}{
+		{
+			Name:     "no ID",
+			Campaign: &ttnpb.ChannelMigrationCampaign{DeviceIds: []string{"test-dev"}, ChannelMask: []bool{true}, RolloutRate: 1},
+		},
+		{
+			Name:     "no devices",
+			Campaign: &ttnpb.ChannelMigrationCampaign{Ids: campaignIDs, ChannelMask: []bool{true}, RolloutRate: 1},
+		},
+		{
+			Name:     "no target",
+			Campaign: &ttnpb.ChannelMigrationCampaign{Ids: campaignIDs, DeviceIds: []string{"test-dev"}, RolloutRate: 1},
+		},
+		{
+			Name:     "no rollout rate",
+			Campaign: &ttnpb.ChannelMigrationCampaign{Ids: campaignIDs, DeviceIds: []string{"test-dev"}, ChannelMask: []bool{true}},
+		},
+		{
+			Name:     "duplicate devices",
+			Campaign: &ttnpb.ChannelMigrationCampaign{Ids: campaignIDs, DeviceIds: []string{"test-dev", "test-dev"}, ChannelMask: []bool{true}, RolloutRate: 1},
+		},
+		{
+			Name:     "valid",
+			Campaign: &ttnpb.ChannelMigrationCampaign{Ids: campaignIDs, DeviceIds: []string{"test-dev"}, ChannelMask: []bool{true}, RolloutRate: 1},
+			Valid:    true,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a, ctx := test.New(t)
+			m := NewManager(&mockRegistry{}, newMockCampaignRegistry(), time.Minute)
+			_, err := m.Create(ctx, tc.Campaign, time.Unix(1000, 0))
+			if tc.Valid {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+		})
+	}
+}
+
+func deviceStates(st *ttnpb.ChannelMigrationCampaignStatus) []ttnpb.ChannelMigrationDeviceState {
+	states := make([]ttnpb.ChannelMigrationDeviceState, 0, len(st.Devices))
+	for _, dev := range st.Devices {
+		states = append(states, dev.State)
+	}
+	return states
+}
+
+func TestManager(t *testing.T) {
+	a, ctx := test.New(t)
+
+	initial := makeChannels(true, true, false, false)
+	target := makeChannels(true, true, true, true)
+	reg := &mockRegistry{
+		devices: map[string]*ttnpb.EndDevice{
+			"dev-1": makeDevice("dev-1", initial),
+			"dev-2": makeDevice("dev-2", initial),
+			"dev-3": makeDevice("dev-3", initial),
+		},
+	}
+	m := NewManager(reg, newMockCampaignRegistry(), time.Minute)
+
+	start := time.Unix(1000, 0)
+	c := &ttnpb.ChannelMigrationCampaign{
+		Ids:             campaignIDs,
+		DeviceIds:       []string{"dev-1", "dev-2", "dev-3", "dev-4"},
+		ChannelMask:     []bool{true, true, true, true},
+		RolloutRate:     2,
+		RollbackTimeout: durationpb.New(time.Hour),
+	}
+	st, err := m.Create(ctx, c, start)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(deviceStates(st), should.Resemble, []ttnpb.ChannelMigrationDeviceState{
+		statePending, statePending, statePending, statePending,
+	})
+	_, err = m.Create(ctx, c, start)
+	a.So(errors.IsAlreadyExists(err), should.BeTrue)
+
+	states := func() []ttnpb.ChannelMigrationDeviceState {
+		st, err := m.Get(ctx, campaignIDs)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return deviceStates(st)
+	}
+
+	// The first step applies the target to at most RolloutRate devices.
+	a.So(m.Step(ctx, start), should.BeNil)
+	a.So(states(), should.Resemble, []ttnpb.ChannelMigrationDeviceState{
+		stateApplied, stateApplied, statePending, statePending,
+	})
+	dev1, _, _ := reg.GetByID(ctx, nil, "dev-1", nil)
+	a.So(dev1.MacState.DesiredParameters.Channels, should.Resemble, target)
+	a.So(dev1.MacState.CurrentParameters.Channels, should.Resemble, initial)
+
+	// dev-1 acknowledges the change, dev-2 keeps uplinking without acknowledging it.
+	reg.update("dev-1", func(dev *ttnpb.EndDevice) {
+		dev.MacState.CurrentParameters.Channels = target
+	})
+	reg.update("dev-2", func(dev *ttnpb.EndDevice) {
+		dev.MacState.RecentUplinks = []*ttnpb.MACState_UplinkMessage{
+			{ReceivedAt: timestamppb.New(start.Add(30 * time.Minute))},
+		}
+	})
+	a.So(m.Step(ctx, start.Add(time.Hour)), should.BeNil)
+	a.So(states(), should.Resemble, []ttnpb.ChannelMigrationDeviceState{
+		stateCompleted, stateApplied, stateApplied, stateFailed,
+	})
+
+	// dev-2 and dev-3 stop uplinking after the change and are rolled back.
+	a.So(m.Step(ctx, start.Add(2*time.Hour)), should.BeNil)
+	a.So(states(), should.Resemble, []ttnpb.ChannelMigrationDeviceState{
+		stateCompleted, stateRolledBack, stateRolledBack, stateFailed,
+	})
+	dev2, _, _ := reg.GetByID(ctx, nil, "dev-2", nil)
+	a.So(dev2.MacState.DesiredParameters.Channels, should.Resemble, initial)
+
+	st, err = m.Get(ctx, campaignIDs)
+	if a.So(err, should.BeNil) {
+		a.So(st.Devices[0].AppliedAt, should.Resemble, timestamppb.New(start))
+		a.So(st.Devices[3].Error, should.NotBeNil)
+	}
+}
+
+func TestManagerCancel(t *testing.T) {
+	a, ctx := test.New(t)
+
+	initial := makeChannels(true, false)
+	reg := &mockRegistry{
+		devices: map[string]*ttnpb.EndDevice{
+			"dev-1": makeDevice("dev-1", initial),
+			"dev-2": makeDevice("dev-2", initial),
+		},
+	}
+	m := NewManager(reg, newMockCampaignRegistry(), time.Minute)
+
+	now := time.Unix(1000, 0)
+	c := &ttnpb.ChannelMigrationCampaign{
+		Ids:         campaignIDs,
+		DeviceIds:   []string{"dev-1", "dev-2"},
+		Channels:    makeChannels(false, true),
+		RolloutRate: 1,
+	}
+	_, err := m.Create(ctx, c, now)
+	a.So(err, should.BeNil)
+	a.So(m.Step(ctx, now), should.BeNil)
+
+	_, err = m.Cancel(ctx, &ttnpb.ChannelMigrationCampaignIdentifiers{
+		ApplicationIds: appIDs,
+		CampaignId:     "unknown",
+	}, true, now)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	_, err = m.Cancel(ctx, campaignIDs, true, now)
+	a.So(err, should.BeNil)
+	a.So(m.Step(ctx, now), should.BeNil)
+
+	st, err := m.Get(ctx, campaignIDs)
+	a.So(err, should.BeNil)
+	a.So(st.Canceled, should.BeTrue)
+	a.So(deviceStates(st), should.Resemble, []ttnpb.ChannelMigrationDeviceState{
+		stateRolledBack, statePending,
+	})
+	for _, devID := range []string{"dev-1", "dev-2"} {
+		dev, _, _ := reg.GetByID(ctx, nil, devID, nil)
+		a.So(dev.MacState.DesiredParameters.Channels, should.Resemble, initial)
+	}
+
+	sts, err := m.List(ctx, appIDs)
+	a.So(err, should.BeNil)
+	a.So(sts, should.HaveLength, 1)
+	sts, err = m.List(ctx, &ttnpb.ApplicationIdentifiers{ApplicationId: "other-app"})
+	a.So(err, should.BeNil)
+	a.So(sts, should.BeEmpty)
+	a.So(m.Delete(ctx, campaignIDs), should.BeNil)
+	sts, err = m.List(ctx, appIDs)
+	a.So(err, should.BeNil)
+	a.So(sts, should.BeEmpty)
+}
+
+func TestManagerStepLocked(t *testing.T) {
+	a, ctx := test.New(t)
+
+	reg := &mockRegistry{
+		devices: map[string]*ttnpb.EndDevice{
+			"dev-1": makeDevice("dev-1", makeChannels(true, false)),
+		},
+	}
+	campaigns := newMockCampaignRegistry()
+	m := NewManager(reg, campaigns, time.Minute)
+
+	now := time.Unix(1000, 0)
+	_, err := m.Create(ctx, &ttnpb.ChannelMigrationCampaign{
+		Ids:         campaignIDs,
+		DeviceIds:   []string{"dev-1"},
+		ChannelMask: []bool{true, true},
+		RolloutRate: 1,
+	}, now)
+	a.So(err, should.BeNil)
+
+	// Another instance holds the processing lock, so the step is skipped.
+	release, ok, err := campaigns.LockProcessing(ctx, time.Minute)
+	if !a.So(err, should.BeNil) || !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(m.Step(ctx, now), should.BeNil)
+	st, err := m.Get(ctx, campaignIDs)
+	a.So(err, should.BeNil)
+	a.So(deviceStates(st), should.Resemble, []ttnpb.ChannelMigrationDeviceState{statePending})
+
+	a.So(release(ctx), should.BeNil)
+	a.So(m.Step(ctx, now), should.BeNil)
+	st, err = m.Get(ctx, campaignIDs)
+	a.So(err, should.BeNil)
+	a.So(deviceStates(st), should.Resemble, []ttnpb.ChannelMigrationDeviceState{stateApplied})
+}
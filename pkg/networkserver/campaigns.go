@@ -0,0 +1,120 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var errCampaignsDisabled = errors.DefineFailedPrecondition("campaigns_disabled", "channel migration campaigns are disabled")
+
+type nsChannelMigrationCampaignsServer struct {
+	ttnpb.UnimplementedNsChannelMigrationCampaignsServer
+
+	ns *NetworkServer
+}
+
+// Create implements ttnpb.NsChannelMigrationCampaignsServer.
+// The campaign is rolled out to the devices gradually by the campaign processing task.
+func (srv *nsChannelMigrationCampaignsServer) Create(ctx context.Context, req *ttnpb.ChannelMigrationCampaign) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+	if err := rights.RequireApplication(ctx, req.Ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if srv.ns.campaigns == nil {
+		return nil, errCampaignsDisabled.New()
+	}
+	return srv.ns.campaigns.Create(ctx, req, time.Now())
+}
+
+// Get implements ttnpb.NsChannelMigrationCampaignsServer.
+func (srv *nsChannelMigrationCampaignsServer) Get(ctx context.Context, req *ttnpb.ChannelMigrationCampaignIdentifiers) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	if srv.ns.campaigns == nil {
+		return nil, errCampaignsDisabled.New()
+	}
+	return srv.ns.campaigns.Get(ctx, req)
+}
+
+// List implements ttnpb.NsChannelMigrationCampaignsServer.
+func (srv *nsChannelMigrationCampaignsServer) List(ctx context.Context, req *ttnpb.ApplicationIdentifiers) (*ttnpb.ChannelMigrationCampaignStatuses, error) {
+	if err := rights.RequireApplication(ctx, req, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, err
+	}
+	if srv.ns.campaigns == nil {
+		return nil, errCampaignsDisabled.New()
+	}
+	sts, err := srv.ns.campaigns.List(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.ChannelMigrationCampaignStatuses{
+		Statuses: sts,
+	}, nil
+}
+
+// Cancel implements ttnpb.NsChannelMigrationCampaignsServer.
+// If rollback is requested, devices that were already migrated are rolled back.
+func (srv *nsChannelMigrationCampaignsServer) Cancel(ctx context.Context, req *ttnpb.CancelChannelMigrationCampaignRequest) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+	if err := rights.RequireApplication(ctx, req.Ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if srv.ns.campaigns == nil {
+		return nil, errCampaignsDisabled.New()
+	}
+	return srv.ns.campaigns.Cancel(ctx, req.Ids, req.Rollback, time.Now())
+}
+
+// Delete implements ttnpb.NsChannelMigrationCampaignsServer.
+// Devices are not altered when a campaign is deleted.
+func (srv *nsChannelMigrationCampaignsServer) Delete(ctx context.Context, req *ttnpb.ChannelMigrationCampaignIdentifiers) (*emptypb.Empty, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if srv.ns.campaigns == nil {
+		return nil, errCampaignsDisabled.New()
+	}
+	if err := srv.ns.campaigns.Delete(ctx, req); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+func (ns *NetworkServer) createProcessCampaignsTask(interval time.Duration) func(context.Context) error {
+	return func(ctx context.Context) error {
+		// Campaign steps are driven by wall clock time, so that the rollout is independent of the
+		// time source used for uplink and downlink processing.
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case now := <-ticker.C:
+				if err := ns.campaigns.Step(ctx, now); err != nil {
+					log.FromContext(ctx).WithError(err).Warn("Failed to process campaigns")
+				}
+			}
+		}
+	}
+}
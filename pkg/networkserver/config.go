@@ -17,6 +17,7 @@ package networkserver
 import (
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/campaign"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -131,6 +132,21 @@ type InteropConfig struct {
 	ID                   *types.EUI64 `name:"id" description:"NSID of this Network Server (EUI)"`
 }
 
+// CampaignConfig defines channel plan migration campaign configuration.
+type CampaignConfig struct {
+	Registry campaign.Registry `name:"-"`
+	Interval time.Duration     `name:"interval" description:"Interval between campaign rollout steps. Zero disables campaign processing"`
+	LockTTL  time.Duration     `name:"lock-ttl" description:"Time for which an instance holds the campaign processing lock per rollout step. Must be greater than the interval"`
+}
+
+// DownlinkLoadConfig defines the configuration of downlink path selection based on the downlink load reported by Gateway Servers.
+type DownlinkLoadConfig struct {
+	Registry         GatewayLoadRegistry `name:"-"`
+	TTL              time.Duration       `name:"ttl" description:"Time for which a reported gateway downlink load is considered. Zero disables downlink load based path selection"`
+	MinHeadroom      float32             `name:"min-headroom" description:"Minimum fraction of the duty-cycle limit that should remain available for a gateway to be preferred"`
+	MaxTxFailureRate float32             `name:"max-tx-failure-rate" description:"Maximum fraction of failed transmissions for a gateway to be preferred"`
+}
+
 // Config represents the NetworkServer configuration.
 type Config struct {
 	ApplicationUplinkQueue   ApplicationUplinkQueueConfig `name:"application-uplink-queue"`
@@ -148,6 +164,8 @@ type Config struct {
 	Interop                  InteropConfig                `name:"interop" description:"Interop client configuration"`
 	DeviceKEKLabel           string                       `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
 	DownlinkQueueCapacity    int                          `name:"downlink-queue-capacity" description:"Maximum downlink queue size per-session"`
+	Campaigns                CampaignConfig               `name:"campaigns" description:"Channel plan migration campaign configuration"`
+	DownlinkLoad             DownlinkLoadConfig           `name:"downlink-load" description:"Downlink path selection based on gateway downlink load"`
 }
 
 // DefaultConfig is the default Network Server configuration.
@@ -178,4 +196,13 @@ var DefaultConfig = Config{
 		StatusCountPeriodicity: func(v uint32) *uint32 { return &v }(mac.DefaultStatusCountPeriodicity),
 	},
 	DownlinkQueueCapacity: 10000,
+	Campaigns: CampaignConfig{
+		Interval: time.Minute,
+		LockTTL:  5 * time.Minute,
+	},
+	DownlinkLoad: DownlinkLoadConfig{
+		TTL:              2 * time.Minute,
+		MinHeadroom:      0.1,
+		MaxTxFailureRate: 0.5,
+	},
 }
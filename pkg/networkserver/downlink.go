@@ -642,6 +642,9 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 type downlinkPath struct {
 	*ttnpb.GatewayIdentifiers
 	*ttnpb.DownlinkPath
+
+	// preference is the preference of the path based on its origin and constraint. Lower values are preferred.
+	preference int
 }
 
 func buildMetadataComparator(
@@ -689,6 +692,7 @@ func downlinkPathsFromMetadata(
 			},
 		}
 		if md.PacketBroker != nil {
+			path.preference = 2
 			tail = append(tail, path)
 		} else {
 			path.GatewayIdentifiers = md.GatewayIds
@@ -696,6 +700,7 @@ func downlinkPathsFromMetadata(
 			case ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_NONE:
 				head = append(head, path)
 			case ttnpb.DownlinkPathConstraint_DOWNLINK_PATH_CONSTRAINT_PREFER_OTHER:
+				path.preference = 1
 				body = append(body, path)
 			}
 		}
@@ -1412,6 +1417,7 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 	}
 
 	now := time.Now()
+	paths = ns.gatewayLoads.Rank(ctx, paths)
 	queuedEvents := []events.Event{}
 	rxParameters, rxParametersEvents, err := computeRxParameters(
 		ctx,
@@ -1708,7 +1714,7 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 				QueuedEvents:               queuedEvents,
 			}
 		}
-		groupedPaths[0] = paths
+		groupedPaths[0] = ns.gatewayLoads.Rank(ctx, paths)
 	}
 
 	req := &ttnpb.TxRequest{
@@ -1915,6 +1921,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 							"pending_mac_state.rx_windows_available",
 						}, nil
 					}
+					paths = ns.gatewayLoads.Rank(ctx, paths)
 
 					var (
 						rx1 = ttnpb.StdTime(up.ReceivedAt).Add(phy.JoinAcceptDelay1)
@@ -0,0 +1,148 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// GatewayLoadRegistry stores the downlink load of gateways reported by Gateway Servers.
+type GatewayLoadRegistry interface {
+	// Set stores the downlink load of the gateway. The load expires after ttl.
+	Set(ctx context.Context, load *ttnpb.GatewayDownlinkLoad, ttl time.Duration) error
+	// GetMany returns the downlink loads of the gateways identified by ids, in the same order.
+	// The load is nil for gateways without a stored load.
+	GetMany(ctx context.Context, ids []*ttnpb.GatewayIdentifiers) ([]*ttnpb.GatewayDownlinkLoad, error)
+}
+
+// headroom returns the lowest remaining fraction of the duty-cycle limit of all sub-bands.
+func headroom(pb *ttnpb.GatewayDownlinkLoad) float32 {
+	res := float32(1)
+	for _, sb := range pb.SubBands {
+		if sb.DownlinkUtilizationLimit <= 0 {
+			continue
+		}
+		headroom := 1 - sb.DownlinkUtilization/sb.DownlinkUtilizationLimit
+		if headroom < 0 {
+			headroom = 0
+		}
+		if headroom < res {
+			res = headroom
+		}
+	}
+	return res
+}
+
+// gatewayLoadTier is the rank of a gateway based on its downlink load. Lower tiers are preferred.
+type gatewayLoadTier int
+
+const (
+	gatewayLoadTierAvailable gatewayLoadTier = iota
+	gatewayLoadTierDegraded
+	gatewayLoadTierExhausted
+)
+
+// gatewayLoads keeps track of the downlink load reported by Gateway Servers.
+type gatewayLoads struct {
+	registry         GatewayLoadRegistry
+	ttl              time.Duration
+	minHeadroom      float32
+	maxTxFailureRate float32
+}
+
+func newGatewayLoads(conf DownlinkLoadConfig) *gatewayLoads {
+	return &gatewayLoads{
+		registry:         conf.Registry,
+		ttl:              conf.TTL,
+		minHeadroom:      conf.MinHeadroom,
+		maxTxFailureRate: conf.MaxTxFailureRate,
+	}
+}
+
+func (l *gatewayLoads) enabled() bool {
+	return l.registry != nil && l.ttl > 0
+}
+
+// Report stores the reported load. The load expires after the configured TTL.
+func (l *gatewayLoads) Report(ctx context.Context, pb *ttnpb.GatewayDownlinkLoad) error {
+	if !l.enabled() {
+		return nil
+	}
+	return l.registry.Set(ctx, pb, l.ttl)
+}
+
+// tier returns the tier of a gateway with the given load.
+// Gateways without a recent load report are considered available.
+func (l *gatewayLoads) tier(pb *ttnpb.GatewayDownlinkLoad) gatewayLoadTier {
+	if pb == nil {
+		return gatewayLoadTierAvailable
+	}
+	switch headroom := headroom(pb); {
+	case headroom <= 0:
+		return gatewayLoadTierExhausted
+	case headroom < l.minHeadroom, pb.TxFailureRate > l.maxTxFailureRate:
+		return gatewayLoadTierDegraded
+	default:
+		return gatewayLoadTierAvailable
+	}
+}
+
+// Rank sorts the paths by the downlink load of their gateways.
+// The relative order of the paths with the same preference and tier is preserved, such that
+// the gateways with the best RSSI margin remain first within each tier.
+// If the loads cannot be retrieved, the paths are returned as-is.
+func (l *gatewayLoads) Rank(ctx context.Context, paths []downlinkPath) []downlinkPath {
+	if !l.enabled() || len(paths) < 2 {
+		return paths
+	}
+	ids := make([]*ttnpb.GatewayIdentifiers, 0, len(paths))
+	for _, path := range paths {
+		if path.GatewayIdentifiers != nil {
+			ids = append(ids, path.GatewayIdentifiers)
+		}
+	}
+	if len(ids) == 0 {
+		return paths
+	}
+	loads, err := l.registry.GetMany(ctx, ids)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get gateway downlink loads")
+		return paths
+	}
+	tiers := make(map[string]gatewayLoadTier, len(ids))
+	for i, ids := range ids {
+		tiers[unique.ID(ctx, ids)] = l.tier(loads[i])
+	}
+	pathTier := func(path downlinkPath) gatewayLoadTier {
+		if path.GatewayIdentifiers == nil {
+			return gatewayLoadTierAvailable
+		}
+		return tiers[unique.ID(ctx, path.GatewayIdentifiers)]
+	}
+	paths = append(paths[:0:0], paths...)
+	sort.SliceStable(paths, func(i, j int) bool {
+		if paths[i].preference != paths[j].preference {
+			return paths[i].preference < paths[j].preference
+		}
+		return pathTier(paths[i]) < pathTier(paths[j])
+	})
+	return paths
+}
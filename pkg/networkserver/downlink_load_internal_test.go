@@ -0,0 +1,176 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockGatewayLoadRegistry struct {
+	mu    sync.Mutex
+	loads map[string]*ttnpb.GatewayDownlinkLoad
+}
+
+func (r *mockGatewayLoadRegistry) Set(ctx context.Context, load *ttnpb.GatewayDownlinkLoad, _ time.Duration) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.loads[unique.ID(ctx, load.GatewayIds)] = load
+	return nil
+}
+
+func (r *mockGatewayLoadRegistry) GetMany(ctx context.Context, ids []*ttnpb.GatewayIdentifiers) ([]*ttnpb.GatewayDownlinkLoad, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	loads := make([]*ttnpb.GatewayDownlinkLoad, len(ids))
+	for i, ids := range ids {
+		loads[i] = r.loads[unique.ID(ctx, ids)]
+	}
+	return loads, nil
+}
+
+func TestGatewayLoads(t *testing.T) {
+	a, ctx := test.New(t)
+
+	loads := newGatewayLoads(DownlinkLoadConfig{
+		Registry: &mockGatewayLoadRegistry{
+			loads: make(map[string]*ttnpb.GatewayDownlinkLoad),
+		},
+		TTL:              time.Minute,
+		MinHeadroom:      0.1,
+		MaxTxFailureRate: 0.5,
+	})
+
+	gtwIDs := func(id string) *ttnpb.GatewayIdentifiers {
+		return &ttnpb.GatewayIdentifiers{GatewayId: id}
+	}
+	subBand := func(utilization float32) *ttnpb.GatewayConnectionStats_SubBand {
+		return &ttnpb.GatewayConnectionStats_SubBand{
+			MinFrequency:             863000000,
+			MaxFrequency:             870000000,
+			DownlinkUtilizationLimit: 0.01,
+			DownlinkUtilization:      utilization,
+		}
+	}
+	for _, load := range []*ttnpb.GatewayDownlinkLoad{
+		{GatewayIds: gtwIDs("gtw-busy"), SubBands: []*ttnpb.GatewayConnectionStats_SubBand{subBand(0.0095)}},
+		{GatewayIds: gtwIDs("gtw-exhausted"), SubBands: []*ttnpb.GatewayConnectionStats_SubBand{subBand(0.011)}},
+		{GatewayIds: gtwIDs("gtw-failing"), SubBands: []*ttnpb.GatewayConnectionStats_SubBand{subBand(0)}, TxFailureRate: 0.75},
+		{GatewayIds: gtwIDs("gtw-idle"), SubBands: []*ttnpb.GatewayConnectionStats_SubBand{subBand(0.002)}},
+	} {
+		a.So(loads.Report(ctx, load), should.BeNil)
+	}
+
+	path := func(id string, preference int) downlinkPath {
+		return downlinkPath{
+			GatewayIdentifiers: gtwIDs(id),
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte(id)},
+			},
+			preference: preference,
+		}
+	}
+	paths := []downlinkPath{
+		path("gtw-exhausted", 0),
+		path("gtw-busy", 0),
+		path("gtw-unknown", 0),
+		path("gtw-idle", 0),
+		path("gtw-failing", 1),
+		path("gtw-other", 1),
+		{
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte("packet-broker")},
+			},
+			preference: 2,
+		},
+	}
+	var tokens []string
+	for _, path := range loads.Rank(ctx, paths) {
+		tokens = append(tokens, string(path.GetUplinkToken()))
+	}
+	a.So(tokens, should.Resemble, []string{
+		"gtw-unknown",
+		"gtw-idle",
+		"gtw-busy",
+		"gtw-exhausted",
+		"gtw-other",
+		"gtw-failing",
+		"packet-broker",
+	})
+	// The input paths are not altered.
+	a.So(string(paths[0].GetUplinkToken()), should.Equal, "gtw-exhausted")
+
+}
+
+func TestGatewayLoadTier(t *testing.T) {
+	loads := newGatewayLoads(DownlinkLoadConfig{
+		TTL:              time.Minute,
+		MinHeadroom:      0.1,
+		MaxTxFailureRate: 0.5,
+	})
+	subBands := func(utilization ...float32) []*ttnpb.GatewayConnectionStats_SubBand {
+		var sbs []*ttnpb.GatewayConnectionStats_SubBand
+		for _, u := range utilization {
+			sbs = append(sbs, &ttnpb.GatewayConnectionStats_SubBand{
+				DownlinkUtilizationLimit: 0.01,
+				DownlinkUtilization:      u,
+			})
+		}
+		return sbs
+	}
+	for _, tc := range []struct {
+		Name string
+		Load *ttnpb.GatewayDownlinkLoad
+		Tier gatewayLoadTier
+	}{
+		{
+			Name: "NoLoad",
+			Tier: gatewayLoadTierAvailable,
+		},
+		{
+			Name: "Idle",
+			Load: &ttnpb.GatewayDownlinkLoad{SubBands: subBands(0.002, 0.001)},
+			Tier: gatewayLoadTierAvailable,
+		},
+		{
+			Name: "Busy",
+			Load: &ttnpb.GatewayDownlinkLoad{SubBands: subBands(0.002, 0.0095)},
+			Tier: gatewayLoadTierDegraded,
+		},
+		{
+			Name: "Failing",
+			Load: &ttnpb.GatewayDownlinkLoad{SubBands: subBands(0.002), TxFailureRate: 0.75},
+			Tier: gatewayLoadTierDegraded,
+		},
+		{
+			Name: "Exhausted",
+			Load: &ttnpb.GatewayDownlinkLoad{SubBands: subBands(0.002, 0.011)},
+			Tier: gatewayLoadTierExhausted,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			a.So(loads.tier(tc.Load), should.Equal, tc.Tier)
+		})
+	}
+}
@@ -1548,3 +1548,16 @@ func (ns *NetworkServer) ReportTxAcknowledgment(
 
 	return ttnpb.Empty, nil
 }
+
+// ReportDownlinkLoad is called by the Gateway Server to report the downlink load of a connected gateway.
+func (ns *NetworkServer) ReportDownlinkLoad(
+	ctx context.Context, load *ttnpb.GatewayDownlinkLoad,
+) (*emptypb.Empty, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	if err := ns.gatewayLoads.Report(ctx, load); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
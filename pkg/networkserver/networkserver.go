@@ -28,6 +28,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/campaign"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
@@ -155,6 +156,10 @@ type NetworkServer struct {
 	scheduledDownlinkMatcher ScheduledDownlinkMatcher
 
 	uplinkSubmissionPool workerpool.WorkerPool[[]*ttnpb.ApplicationUp]
+
+	campaigns *campaign.Manager
+
+	gatewayLoads *gatewayLoads
 }
 
 // Option configures the NetworkServer.
@@ -174,6 +179,7 @@ const (
 	downlinkProcessTaskName           = "process_downlink"
 	applicationUplinkDispatchTaskName = "dispatch_application_uplink"
 	downlinkDispatchTaskName          = "dispatch_downlink"
+	campaignProcessTaskName           = "process_campaigns"
 
 	maxInt = int(^uint(0) >> 1)
 )
@@ -205,6 +211,12 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		return nil, errInvalidConfiguration.WithCause(errors.New("Downlink queue capacity must be greater than or equal to 0"))
 	case conf.DownlinkQueueCapacity > maxInt/2:
 		return nil, errInvalidConfiguration.WithCause(errors.New(fmt.Sprintf("Downlink queue capacity must be below %d", maxInt/2)))
+	case conf.Campaigns.Interval < 0:
+		return nil, errInvalidConfiguration.WithCause(errors.New("Campaigns.Interval must not be negative"))
+	case conf.Campaigns.Interval > 0 && conf.Campaigns.LockTTL <= conf.Campaigns.Interval:
+		return nil, errInvalidConfiguration.WithCause(errors.New("Campaigns.LockTTL must be greater than Campaigns.Interval"))
+	case conf.DownlinkLoad.TTL < 0:
+		return nil, errInvalidConfiguration.WithCause(errors.New("DownlinkLoad.TTL must not be negative"))
 	}
 
 	devAddrPrefixes := conf.DevAddrPrefixes
@@ -262,6 +274,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		deviceKEKLabel:           conf.DeviceKEKLabel,
 		downlinkQueueCapacity:    conf.DownlinkQueueCapacity,
 		scheduledDownlinkMatcher: conf.ScheduledDownlinkMatcher,
+		gatewayLoads:             newGatewayLoads(conf.DownlinkLoad),
 	}
 	ns.uplinkSubmissionPool = workerpool.NewWorkerPool(workerpool.Config[[]*ttnpb.ApplicationUp]{
 		Component:  c,
@@ -271,6 +284,9 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		QueueSize:  int(conf.ApplicationUplinkQueue.FastBufferSize),
 		MaxWorkers: int(conf.ApplicationUplinkQueue.FastNumConsumers),
 	})
+	if conf.Campaigns.Registry != nil {
+		ns.campaigns = campaign.NewManager(ns.devices, conf.Campaigns.Registry, conf.Campaigns.LockTTL)
+	}
 	ctx = ns.Context()
 
 	if len(opts) == 0 {
@@ -291,6 +307,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 			"/ttn.lorawan.v3.GsNs",
 			"/ttn.lorawan.v3.AsNs",
 			"/ttn.lorawan.v3.NsEndDeviceRegistry",
+			"/ttn.lorawan.v3.NsChannelMigrationCampaigns",
 			"/ttn.lorawan.v3.Ns",
 		} {
 			c.GRPC.RegisterUnaryHook(filter, hook.name, hook.middleware)
@@ -346,6 +363,15 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 			Backoff: processTaskBackoff,
 		})
 	}
+	if ns.campaigns != nil && conf.Campaigns.Interval > 0 {
+		ns.RegisterTask(&task.Config{
+			Context: ctx,
+			ID:      campaignProcessTaskName,
+			Func:    ns.createProcessCampaignsTask(conf.Campaigns.Interval),
+			Restart: task.RestartAlways,
+			Backoff: processTaskBackoff,
+		})
+	}
 	c.RegisterGRPC(ns)
 	return ns, nil
 }
@@ -361,12 +387,14 @@ func (ns *NetworkServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterAsNsServer(s, ns)
 	ttnpb.RegisterNsEndDeviceRegistryServer(s, ns)
 	ttnpb.RegisterNsServer(s, ns)
+	ttnpb.RegisterNsChannelMigrationCampaignsServer(s, &nsChannelMigrationCampaignsServer{ns: ns})
 }
 
 // RegisterHandlers registers gRPC handlers.
 func (ns *NetworkServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
 	ttnpb.RegisterNsEndDeviceRegistryHandler(ns.Context(), s, conn)
 	ttnpb.RegisterNsHandler(ns.Context(), s, conn)
+	ttnpb.RegisterNsChannelMigrationCampaignsHandler(ns.Context(), s, conn)
 }
 
 // Roles returns the roles that the Network Server fulfills.
@@ -0,0 +1,319 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/proto"
+)
+
+var errCampaignNotFound = errors.DefineNotFound("campaign_not_found", "campaign `{campaign_id}` not found")
+
+// releaseLockScript deletes the lock at KEYS[1] if it is held by ARGV[1].
+var releaseLockScript = redis.NewScript(`if redis.call('get', KEYS[1]) == ARGV[1] then
+	return redis.call('del', KEYS[1])
+else
+	return 0
+end`)
+
+// CampaignRegistry is an implementation of campaign.Registry.
+// The campaigns are stored per application, and the campaign IDs of an application are stored in a set.
+// The status of the devices of a campaign is stored in a hash by device ID, separately from the campaign, so that
+// only the changed device statuses are written when a campaign is updated.
+type CampaignRegistry struct {
+	Redis   *ttnredis.Client
+	LockTTL time.Duration
+}
+
+// Init initializes the CampaignRegistry.
+func (r *CampaignRegistry) Init(ctx context.Context) error {
+	if err := ttnredis.InitMutex(ctx, r.Redis); err != nil {
+		return err
+	}
+	if err := releaseLockScript.Load(ctx, r.Redis).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+func (r *CampaignRegistry) campaignKey(appUID, campaignID string) string {
+	return r.Redis.Key("campaign", appUID, campaignID)
+}
+
+func (r *CampaignRegistry) devicesKey(appUID, campaignID string) string {
+	return r.Redis.Key("devices", appUID, campaignID)
+}
+
+func (r *CampaignRegistry) applicationKey(appUID string) string {
+	return r.Redis.Key("application", appUID)
+}
+
+func (r *CampaignRegistry) processingLockKey() string {
+	return r.Redis.Key("processing", "lock")
+}
+
+// Get returns the status of the campaign identified by ids.
+func (r *CampaignRegistry) Get(
+	ctx context.Context, ids *ttnpb.ChannelMigrationCampaignIdentifiers,
+) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+	defer trace.StartRegion(ctx, "get channel migration campaign").End()
+
+	if err := ids.ValidateFields(); err != nil {
+		return nil, err
+	}
+	pb, err := r.get(ctx, r.Redis, unique.ID(ctx, ids.ApplicationIds), ids.CampaignId)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errCampaignNotFound.WithAttributes("campaign_id", ids.CampaignId)
+		}
+		return nil, err
+	}
+	return pb, nil
+}
+
+// get returns the status of the campaign identified by appUID and campaignID, including the status of its devices.
+func (r *CampaignRegistry) get(
+	ctx context.Context, cmd redis.Cmdable, appUID, campaignID string,
+) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+	pb := &ttnpb.ChannelMigrationCampaignStatus{}
+	if err := ttnredis.GetProto(ctx, cmd, r.campaignKey(appUID, campaignID)).ScanProto(pb); err != nil {
+		return nil, err
+	}
+	devs, err := r.getDevices(ctx, cmd, appUID, campaignID)
+	if err != nil {
+		return nil, err
+	}
+	setDevices(pb, devs)
+	return pb, nil
+}
+
+// getDevices returns the status of the devices of the campaign identified by appUID and campaignID by device ID.
+func (r *CampaignRegistry) getDevices(
+	ctx context.Context, cmd redis.Cmdable, appUID, campaignID string,
+) (map[string]*ttnpb.ChannelMigrationCampaignStatus_Device, error) {
+	vs, err := cmd.HGetAll(ctx, r.devicesKey(appUID, campaignID)).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	devs := make(map[string]*ttnpb.ChannelMigrationCampaignStatus_Device, len(vs))
+	for devID, v := range vs {
+		dev := &ttnpb.ChannelMigrationCampaignStatus_Device{}
+		if err := ttnredis.UnmarshalProto(v, dev); err != nil {
+			return nil, err
+		}
+		devs[devID] = dev
+	}
+	return devs, nil
+}
+
+// setDevices sets the status of the devices of pb, in the order of the devices of the campaign.
+func setDevices(
+	pb *ttnpb.ChannelMigrationCampaignStatus, devs map[string]*ttnpb.ChannelMigrationCampaignStatus_Device,
+) {
+	pb.Devices = make([]*ttnpb.ChannelMigrationCampaignStatus_Device, 0, len(devs))
+	for _, devID := range pb.GetCampaign().GetDeviceIds() {
+		if dev, ok := devs[devID]; ok {
+			pb.Devices = append(pb.Devices, dev)
+		}
+	}
+}
+
+// List returns the status of all campaigns of the application identified by appIDs.
+func (r *CampaignRegistry) List(
+	ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers,
+) ([]*ttnpb.ChannelMigrationCampaignStatus, error) {
+	defer trace.StartRegion(ctx, "list channel migration campaigns").End()
+
+	appUID := unique.ID(ctx, appIDs)
+	campaignIDs, err := r.Redis.SMembers(ctx, r.applicationKey(appUID)).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	pbs := make([]*ttnpb.ChannelMigrationCampaignStatus, 0, len(campaignIDs))
+	for _, campaignID := range campaignIDs {
+		pb, err := r.get(ctx, r.Redis, appUID, campaignID)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				// The campaign is deleted.
+				continue
+			}
+			return nil, err
+		}
+		pbs = append(pbs, pb)
+	}
+	return pbs, nil
+}
+
+// Range calls f for the status of all campaigns, until f returns false.
+func (r *CampaignRegistry) Range(
+	ctx context.Context, f func(context.Context, *ttnpb.ChannelMigrationCampaignStatus) bool,
+) error {
+	defer trace.StartRegion(ctx, "range channel migration campaigns").End()
+
+	campaignEntityRegex, err := ttnredis.EntityRegex(r.campaignKey("*", "*"))
+	if err != nil {
+		return err
+	}
+	return ttnredis.RangeRedisKeys(ctx, r.Redis, r.campaignKey("*", "*"), ttnredis.DefaultRangeCount, func(key string) (bool, error) {
+		if !campaignEntityRegex.MatchString(key) {
+			return true, nil
+		}
+		pb := &ttnpb.ChannelMigrationCampaignStatus{}
+		if err := ttnredis.GetProto(ctx, r.Redis, key).ScanProto(pb); err != nil {
+			if errors.IsNotFound(err) {
+				// The campaign is deleted.
+				return true, nil
+			}
+			return false, err
+		}
+		ids := pb.GetCampaign().GetIds()
+		devs, err := r.getDevices(ctx, r.Redis, unique.ID(ctx, ids.GetApplicationIds()), ids.GetCampaignId())
+		if err != nil {
+			return false, err
+		}
+		setDevices(pb, devs)
+		return f(ctx, pb), nil
+	})
+}
+
+// Set creates, updates or deletes the status of the campaign identified by ids.
+// Only the campaign fields and device statuses that changed are written.
+func (r *CampaignRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.ChannelMigrationCampaignIdentifiers,
+	f func(*ttnpb.ChannelMigrationCampaignStatus) (*ttnpb.ChannelMigrationCampaignStatus, error),
+) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+	if err := ids.ValidateFields(); err != nil {
+		return nil, err
+	}
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "set channel migration campaign").End()
+
+	appUID := unique.ID(ctx, ids.ApplicationIds)
+	ck := r.campaignKey(appUID, ids.CampaignId)
+	dk := r.devicesKey(appUID, ids.CampaignId)
+	var pb *ttnpb.ChannelMigrationCampaignStatus
+	err = ttnredis.LockedWatch(ctx, r.Redis, ck, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		stored, err := r.get(ctx, tx, appUID, ids.CampaignId)
+		if errors.IsNotFound(err) {
+			stored = nil
+		} else if err != nil {
+			return err
+		}
+		var storedDevs map[string]*ttnpb.ChannelMigrationCampaignStatus_Device
+		var storedCampaign *ttnpb.ChannelMigrationCampaignStatus
+		if stored != nil {
+			// f may modify stored, so keep a copy to determine the changes.
+			storedDevs = make(map[string]*ttnpb.ChannelMigrationCampaignStatus_Device, len(stored.Devices))
+			for _, dev := range stored.Devices {
+				storedDevs[dev.DeviceId] = ttnpb.Clone(dev)
+			}
+			storedCampaign = withoutDevices(stored)
+		}
+		pb, err = f(stored)
+		if err != nil {
+			return err
+		}
+		if pb == nil {
+			_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+				p.Del(ctx, ck, dk)
+				p.SRem(ctx, r.applicationKey(appUID), ids.CampaignId)
+				return nil
+			})
+			return err
+		}
+		if err := pb.ValidateFields(); err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			if campaign := withoutDevices(pb); !proto.Equal(campaign, storedCampaign) {
+				if _, err := ttnredis.SetProto(ctx, p, ck, campaign, 0); err != nil {
+					return err
+				}
+			}
+			devIDs := make(map[string]struct{}, len(pb.Devices))
+			for _, dev := range pb.Devices {
+				devIDs[dev.DeviceId] = struct{}{}
+				if proto.Equal(dev, storedDevs[dev.DeviceId]) {
+					continue
+				}
+				s, err := ttnredis.MarshalProto(dev)
+				if err != nil {
+					return err
+				}
+				p.HSet(ctx, dk, dev.DeviceId, s)
+			}
+			for devID := range storedDevs {
+				if _, ok := devIDs[devID]; !ok {
+					p.HDel(ctx, dk, devID)
+				}
+			}
+			if stored == nil {
+				p.SAdd(ctx, r.applicationKey(appUID), ids.CampaignId)
+			}
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
+
+// withoutDevices returns a copy of pb without the status of the devices.
+func withoutDevices(pb *ttnpb.ChannelMigrationCampaignStatus) *ttnpb.ChannelMigrationCampaignStatus {
+	return &ttnpb.ChannelMigrationCampaignStatus{
+		Campaign:  ttnpb.Clone(pb.Campaign),
+		CreatedAt: ttnpb.Clone(pb.CreatedAt),
+		Canceled:  pb.Canceled,
+	}
+}
+
+// LockProcessing attempts to acquire the lock for processing campaigns for at most ttl.
+func (r *CampaignRegistry) LockProcessing(
+	ctx context.Context, ttl time.Duration,
+) (func(context.Context) error, bool, error) {
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, false, err
+	}
+	k := r.processingLockKey()
+	ok, err := r.Redis.SetNX(ctx, k, lockerID, ttl).Result()
+	if err != nil {
+		return nil, false, ttnredis.ConvertError(err)
+	}
+	if !ok {
+		return nil, false, nil
+	}
+	return func(ctx context.Context) error {
+		if err := releaseLockScript.Run(ctx, r.Redis, []string{k}, lockerID).Err(); err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		return nil
+	}, true, nil
+}
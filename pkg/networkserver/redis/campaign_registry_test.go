@@ -0,0 +1,137 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCampaignRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test", "campaigns")
+	defer flush()
+	defer cl.Close()
+
+	r := &redis.CampaignRegistry{
+		Redis:   cl,
+		LockTTL: test.Delay << 10,
+	}
+	if err := r.Init(ctx); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "app1"}
+	ids := &ttnpb.ChannelMigrationCampaignIdentifiers{
+		ApplicationIds: appIDs,
+		CampaignId:     "campaign1",
+	}
+	st := &ttnpb.ChannelMigrationCampaignStatus{
+		Campaign: &ttnpb.ChannelMigrationCampaign{
+			Ids:         ids,
+			DeviceIds:   []string{"dev1"},
+			ChannelMask: []bool{true},
+			RolloutRate: 1,
+		},
+		CreatedAt: timestamppb.New(time.Unix(1000, 0)),
+		Devices: []*ttnpb.ChannelMigrationCampaignStatus_Device{
+			{
+				DeviceId:  "dev1",
+				State:     ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_PENDING,
+				UpdatedAt: timestamppb.New(time.Unix(1000, 0)),
+			},
+		},
+	}
+
+	_, err := r.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	set, err := r.Set(ctx, ids, func(stored *ttnpb.ChannelMigrationCampaignStatus) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+		a.So(stored, should.BeNil)
+		return st, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(set, should.Resemble, st)
+
+	got, err := r.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(got, should.Resemble, st)
+
+	sts, err := r.List(ctx, appIDs)
+	a.So(err, should.BeNil)
+	a.So(sts, should.Resemble, []*ttnpb.ChannelMigrationCampaignStatus{st})
+
+	sts, err = r.List(ctx, &ttnpb.ApplicationIdentifiers{ApplicationId: "app2"})
+	a.So(err, should.BeNil)
+	a.So(sts, should.BeEmpty)
+
+	var ranged []*ttnpb.ChannelMigrationCampaignStatus
+	a.So(r.Range(ctx, func(_ context.Context, st *ttnpb.ChannelMigrationCampaignStatus) bool {
+		ranged = append(ranged, st)
+		return true
+	}), should.BeNil)
+	a.So(ranged, should.Resemble, []*ttnpb.ChannelMigrationCampaignStatus{st})
+
+	updated := ttnpb.Clone(st)
+	updated.Devices[0].State = ttnpb.ChannelMigrationDeviceState_CHANNEL_MIGRATION_DEVICE_STATE_FAILED
+	updated.Devices[0].UpdatedAt = timestamppb.New(time.Unix(2000, 0))
+	set, err = r.Set(ctx, ids, func(stored *ttnpb.ChannelMigrationCampaignStatus) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+		a.So(stored, should.Resemble, st)
+		stored.Devices[0] = updated.Devices[0]
+		return stored, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(set, should.Resemble, updated)
+	got, err = r.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(got, should.Resemble, updated)
+	st = updated
+
+	release, ok, err := r.LockProcessing(ctx, time.Minute)
+	if !a.So(err, should.BeNil) || !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	_, ok, err = r.LockProcessing(ctx, time.Minute)
+	a.So(err, should.BeNil)
+	a.So(ok, should.BeFalse)
+	a.So(release(ctx), should.BeNil)
+	release, ok, err = r.LockProcessing(ctx, time.Minute)
+	a.So(err, should.BeNil)
+	if a.So(ok, should.BeTrue) {
+		a.So(release(ctx), should.BeNil)
+	}
+
+	set, err = r.Set(ctx, ids, func(stored *ttnpb.ChannelMigrationCampaignStatus) (*ttnpb.ChannelMigrationCampaignStatus, error) {
+		a.So(stored, should.Resemble, st)
+		return nil, nil
+	})
+	a.So(err, should.BeNil)
+	a.So(set, should.BeNil)
+
+	_, err = r.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	sts, err = r.List(ctx, appIDs)
+	a.So(err, should.BeNil)
+	a.So(sts, should.BeEmpty)
+}
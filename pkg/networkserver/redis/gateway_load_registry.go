@@ -0,0 +1,80 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// GatewayLoadRegistry is an implementation of networkserver.GatewayLoadRegistry.
+// The loads are expired by Redis, so that loads of gateways that are no longer reported are removed.
+type GatewayLoadRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *GatewayLoadRegistry) uidKey(uid string) string {
+	return r.Redis.Key("uid", uid)
+}
+
+// Set implements networkserver.GatewayLoadRegistry.
+func (r *GatewayLoadRegistry) Set(ctx context.Context, load *ttnpb.GatewayDownlinkLoad, ttl time.Duration) error {
+	if err := load.GetGatewayIds().ValidateFields(); err != nil {
+		return err
+	}
+	cmd, err := ttnredis.SetProto(ctx, r.Redis, r.uidKey(unique.ID(ctx, load.GatewayIds)), load, ttl)
+	if err != nil {
+		return err
+	}
+	if err := cmd.Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// GetMany implements networkserver.GatewayLoadRegistry.
+func (r *GatewayLoadRegistry) GetMany(
+	ctx context.Context, ids []*ttnpb.GatewayIdentifiers,
+) ([]*ttnpb.GatewayDownlinkLoad, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	keys := make([]string, 0, len(ids))
+	for _, ids := range ids {
+		keys = append(keys, r.uidKey(unique.ID(ctx, ids)))
+	}
+	vs, err := r.Redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	loads := make([]*ttnpb.GatewayDownlinkLoad, len(ids))
+	for i, v := range vs {
+		s, ok := v.(string)
+		if !ok {
+			// The load is not stored or expired.
+			continue
+		}
+		load := &ttnpb.GatewayDownlinkLoad{}
+		if err := ttnredis.UnmarshalProto(s, load); err != nil {
+			return nil, err
+		}
+		loads[i] = load
+	}
+	return loads, nil
+}
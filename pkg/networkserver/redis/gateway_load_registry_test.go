@@ -0,0 +1,65 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestGatewayLoadRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test", "gateway-loads")
+	defer flush()
+	defer cl.Close()
+
+	r := &redis.GatewayLoadRegistry{Redis: cl}
+
+	gtw1 := &ttnpb.GatewayIdentifiers{GatewayId: "gtw1"}
+	gtw2 := &ttnpb.GatewayIdentifiers{GatewayId: "gtw2"}
+	load := &ttnpb.GatewayDownlinkLoad{
+		GatewayIds: gtw1,
+		SubBands: []*ttnpb.GatewayConnectionStats_SubBand{
+			{
+				MinFrequency:             863000000,
+				MaxFrequency:             870000000,
+				DownlinkUtilizationLimit: 0.01,
+				DownlinkUtilization:      0.005,
+			},
+		},
+		TxFailureRate: 0.25,
+	}
+
+	loads, err := r.GetMany(ctx, []*ttnpb.GatewayIdentifiers{gtw1, gtw2})
+	a.So(err, should.BeNil)
+	a.So(loads, should.Resemble, []*ttnpb.GatewayDownlinkLoad{nil, nil})
+
+	a.So(r.Set(ctx, load, test.Delay<<4), should.BeNil)
+	loads, err = r.GetMany(ctx, []*ttnpb.GatewayIdentifiers{gtw2, gtw1})
+	a.So(err, should.BeNil)
+	a.So(loads, should.Resemble, []*ttnpb.GatewayDownlinkLoad{nil, load})
+
+	// The load expires after the TTL.
+	time.Sleep(test.Delay << 5)
+	loads, err = r.GetMany(ctx, []*ttnpb.GatewayIdentifiers{gtw1})
+	a.So(err, should.BeNil)
+	a.So(loads, should.Resemble, []*ttnpb.GatewayDownlinkLoad{nil})
+}
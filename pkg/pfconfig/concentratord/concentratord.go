@@ -0,0 +1,203 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package concentratord implements the TOML configuration for the ChirpStack Concentratord.
+package concentratord
+
+import (
+	"bytes"
+	"math"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// bandIDToRegion maps the band IDs to the ChirpStack region names.
+var bandIDToRegion = map[string]string{
+	band.AS_923:     "AS923",
+	band.AS_923_2:   "AS923_2",
+	band.AS_923_3:   "AS923_3",
+	band.AS_923_4:   "AS923_4",
+	band.AU_915_928: "AU915",
+	band.CN_470_510: "CN470",
+	band.CN_779_787: "CN779",
+	band.EU_433:     "EU433",
+	band.EU_863_870: "EU868",
+	band.IN_865_867: "IN865",
+	band.ISM_2400:   "ISM2400",
+	band.KR_920_923: "KR920",
+	band.RU_864_870: "RU864",
+	band.US_902_928: "US915",
+}
+
+var errUnsupportedBand = errors.DefineInvalidArgument(
+	"unsupported_band", "band `{band_id}` is not supported by ChirpStack Concentratord",
+)
+
+// LoRaStandardChannelConfig contains the configuration of the LoRa single spreading factor channel.
+type LoRaStandardChannelConfig struct {
+	Frequency       uint64
+	Bandwidth       uint32
+	SpreadingFactor uint32
+}
+
+// FSKChannelConfig contains the configuration of the FSK channel.
+type FSKChannelConfig struct {
+	Frequency uint64
+	Bandwidth uint32
+	DataRate  uint32
+}
+
+// LBTChannelConfig contains the listen-before-talk configuration for a channel.
+type LBTChannelConfig struct {
+	Frequency            uint64
+	ScanTimeMicroseconds uint32
+}
+
+// LBTConfig contains the configuration for listen-before-talk.
+type LBTConfig struct {
+	RSSITarget float32
+	RSSIOffset float32
+	Channels   []LBTChannelConfig
+}
+
+// ConcentratorConfig contains the configuration of the concentrator.
+type ConcentratorConfig struct {
+	MultiSFChannels     []uint64
+	LoRaStandardChannel *LoRaStandardChannelConfig
+	FSKChannel          *FSKChannelConfig
+	LBT                 *LBTConfig
+}
+
+// LocationConfig contains the static location of the gateway.
+type LocationConfig struct {
+	Latitude  float64
+	Longitude float64
+	Altitude  int32
+}
+
+// GatewayConfig contains the configuration of the gateway.
+type GatewayConfig struct {
+	ID            *types.EUI64
+	AntennaGain   int8
+	LoRaWANPublic bool
+	Region        string
+	// Model is the ChirpStack Concentratord model of the gateway. It is not known by the stack, so it is set by the
+	// caller. ChirpStack Concentratord does not start without a model.
+	Model        string
+	Concentrator ConcentratorConfig
+	Location     *LocationConfig
+}
+
+// Config represents the configuration of the ChirpStack Concentratord.
+type Config struct {
+	Gateway GatewayConfig
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (conf Config) MarshalText() ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := concentratordTmpl.Execute(buf, conf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Build builds the ChirpStack Concentratord configuration for the given gateway, using the given frequency plan store.
+// The model is the ChirpStack Concentratord model of the gateway.
+func Build(gtw *ttnpb.Gateway, fps *frequencyplans.Store, model string) (*Config, error) {
+	fp, err := fps.GetByID(gtw.FrequencyPlanId)
+	if err != nil {
+		return nil, err
+	}
+	region, ok := bandIDToRegion[fp.BandID]
+	if !ok {
+		return nil, errUnsupportedBand.WithAttributes("band_id", fp.BandID)
+	}
+	phy, err := band.GetLatest(fp.BandID)
+	if err != nil {
+		return nil, err
+	}
+
+	conf := &Config{
+		Gateway: GatewayConfig{
+			LoRaWANPublic: true,
+			Region:        region,
+			Model:         model,
+		},
+	}
+	if eui := gtw.GetIds().GetEui(); eui != nil {
+		conf.Gateway.ID = types.MustEUI64(eui)
+	}
+	if antennas := gtw.GetAntennas(); len(antennas) > 0 {
+		antenna := antennas[0]
+		conf.Gateway.AntennaGain = int8(math.Round(float64(antenna.Gain)))
+		if location := antenna.Location; location != nil {
+			conf.Gateway.Location = &LocationConfig{
+				Latitude:  location.Latitude,
+				Longitude: location.Longitude,
+				Altitude:  location.Altitude,
+			}
+		}
+	}
+
+	concentrator := &conf.Gateway.Concentrator
+	for _, channel := range fp.UplinkChannels {
+		concentrator.MultiSFChannels = append(concentrator.MultiSFChannels, channel.Frequency)
+	}
+	if channel := fp.LoRaStandardChannel; channel != nil {
+		if dr, ok := phy.DataRates[ttnpb.DataRateIndex(channel.DataRate)]; ok {
+			if lora := dr.Rate.GetLora(); lora != nil {
+				concentrator.LoRaStandardChannel = &LoRaStandardChannelConfig{
+					Frequency:       channel.Frequency,
+					Bandwidth:       lora.Bandwidth,
+					SpreadingFactor: lora.SpreadingFactor,
+				}
+			}
+		}
+	}
+	if channel := fp.FSKChannel; channel != nil {
+		if dr, ok := phy.DataRates[ttnpb.DataRateIndex(channel.DataRate)]; ok {
+			if fsk := dr.Rate.GetFsk(); fsk != nil {
+				concentrator.FSKChannel = &FSKChannelConfig{
+					Frequency: channel.Frequency,
+					Bandwidth: 125000,
+					DataRate:  fsk.BitRate,
+				}
+			}
+		}
+	}
+	if lbt := fp.LBT; lbt != nil {
+		lbtConfig := &LBTConfig{
+			RSSITarget: lbt.RSSITarget,
+			RSSIOffset: lbt.RSSIOffset,
+		}
+		// The SX1301 supports listen-before-talk on up to 8 channels.
+		for i, channel := range fp.DownlinkChannels {
+			if i > 7 {
+				break
+			}
+			lbtConfig.Channels = append(lbtConfig.Channels, LBTChannelConfig{
+				Frequency:            channel.Frequency,
+				ScanTimeMicroseconds: uint32(lbt.ScanTime / time.Microsecond),
+			})
+		}
+		concentrator.LBT = lbtConfig
+	}
+	return conf, nil
+}
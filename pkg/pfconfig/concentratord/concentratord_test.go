@@ -0,0 +1,324 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concentratord_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	. "go.thethings.network/lorawan-stack/v3/pkg/pfconfig/concentratord"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var euMultiSFChannels = []uint64{
+	868100000, 868300000, 868500000, 867100000, 867300000, 867500000, 867700000, 867900000,
+}
+
+func TestBuild(t *testing.T) {
+	plans := make(map[string][]byte, len(test.StaticFrequencyPlans)+1)
+	for k, v := range test.StaticFrequencyPlans {
+		plans[k] = v
+	}
+	plans["frequency-plans.yml"] = append(plans["frequency-plans.yml"], []byte(`
+- id: AS_923_LBT
+  band-id: AS_923
+  name: Asia 923 MHz with LBT
+  base-frequency: 915
+  file: AS_923_LBT.yml`)...)
+	plans["AS_923_LBT.yml"] = []byte(`band-id: AS_923
+uplink-channels:
+- frequency: 923200000
+  min-data-rate: 0
+  max-data-rate: 5
+- frequency: 923400000
+  min-data-rate: 0
+  max-data-rate: 5
+downlink-channels:
+- frequency: 923200000
+  min-data-rate: 0
+  max-data-rate: 5
+- frequency: 923400000
+  min-data-rate: 0
+  max-data-rate: 5
+listen-before-talk:
+  rssi-target: -80
+  rssi-offset: -4
+  scan-time: 5ms
+`)
+	fps := frequencyplans.NewStore(fetch.NewMemFetcher(plans))
+	eui := types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+
+	for _, tc := range []struct {
+		Name           string
+		Gateway        *ttnpb.Gateway
+		Model          string
+		Config         *Config
+		ErrorAssertion func(t *testing.T, err error) bool
+	}{
+		{
+			Name:    "Empty gateway",
+			Gateway: &ttnpb.Gateway{},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.BeError)
+			},
+		},
+		{
+			Name: "EU868/No antennas",
+			Gateway: &ttnpb.Gateway{
+				FrequencyPlanId: test.EUFrequencyPlanID,
+			},
+			Config: &Config{
+				Gateway: GatewayConfig{
+					LoRaWANPublic: true,
+					Region:        "EU868",
+					Concentrator: ConcentratorConfig{
+						MultiSFChannels: euMultiSFChannels,
+						LoRaStandardChannel: &LoRaStandardChannelConfig{
+							Frequency:       868300000,
+							Bandwidth:       250000,
+							SpreadingFactor: 7,
+						},
+						FSKChannel: &FSKChannelConfig{
+							Frequency: 868800000,
+							Bandwidth: 125000,
+							DataRate:  50000,
+						},
+					},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.BeNil)
+			},
+		},
+		{
+			Name: "EU868/EUI/1 antenna",
+			Gateway: &ttnpb.Gateway{
+				Ids: &ttnpb.GatewayIdentifiers{
+					Eui: eui.Bytes(),
+				},
+				FrequencyPlanId: test.EUFrequencyPlanID,
+				Antennas: []*ttnpb.GatewayAntenna{
+					{
+						Gain: 3.6,
+						Location: &ttnpb.Location{
+							Latitude:  0.42,
+							Longitude: 42.42,
+							Altitude:  42,
+						},
+					},
+				},
+			},
+			Model: "rak_2287_eu868",
+			Config: &Config{
+				Gateway: GatewayConfig{
+					ID:            &eui,
+					AntennaGain:   4,
+					LoRaWANPublic: true,
+					Region:        "EU868",
+					Model:         "rak_2287_eu868",
+					Concentrator: ConcentratorConfig{
+						MultiSFChannels: euMultiSFChannels,
+						LoRaStandardChannel: &LoRaStandardChannelConfig{
+							Frequency:       868300000,
+							Bandwidth:       250000,
+							SpreadingFactor: 7,
+						},
+						FSKChannel: &FSKChannelConfig{
+							Frequency: 868800000,
+							Bandwidth: 125000,
+							DataRate:  50000,
+						},
+					},
+					Location: &LocationConfig{
+						Latitude:  0.42,
+						Longitude: 42.42,
+						Altitude:  42,
+					},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.BeNil)
+			},
+		},
+		{
+			Name: "AS923/LBT",
+			Gateway: &ttnpb.Gateway{
+				FrequencyPlanId: "AS_923_LBT",
+			},
+			Config: &Config{
+				Gateway: GatewayConfig{
+					LoRaWANPublic: true,
+					Region:        "AS923",
+					Concentrator: ConcentratorConfig{
+						MultiSFChannels: []uint64{923200000, 923400000},
+						LBT: &LBTConfig{
+							RSSITarget: -80,
+							RSSIOffset: -4,
+							Channels: []LBTChannelConfig{
+								{Frequency: 923200000, ScanTimeMicroseconds: 5000},
+								{Frequency: 923400000, ScanTimeMicroseconds: 5000},
+							},
+						},
+					},
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.BeNil)
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			gtw := ttnpb.Clone(tc.Gateway)
+			conf, err := Build(gtw, fps, tc.Model)
+			a.So(gtw, should.Resemble, tc.Gateway)
+			if a.So(tc.ErrorAssertion(t, err), should.BeTrue) {
+				a.So(conf, should.Resemble, tc.Config)
+			}
+		})
+	}
+}
+
+func TestBuildUnsupportedBand(t *testing.T) {
+	a := assertions.New(t)
+	plans := map[string][]byte{
+		"frequency-plans.yml": []byte(`- id: CUSTOM
+  band-id: MA_869_870_DRAFT
+  name: Morocco 869 MHz
+  base-frequency: 868
+  file: CUSTOM.yml`),
+		"CUSTOM.yml": []byte(`band-id: MA_869_870_DRAFT
+uplink-channels:
+- frequency: 869100000
+  min-data-rate: 0
+  max-data-rate: 5
+`),
+	}
+	_, err := Build(&ttnpb.Gateway{
+		FrequencyPlanId: "CUSTOM",
+	}, frequencyplans.NewStore(fetch.NewMemFetcher(plans)), "")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestConfigMarshalText(t *testing.T) {
+	a := assertions.New(t)
+	eui := types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	conf := Config{
+		Gateway: GatewayConfig{
+			ID:            &eui,
+			AntennaGain:   2,
+			LoRaWANPublic: true,
+			Region:        "AS923",
+			Model:         "multitech_mtcap_lora_868",
+			Concentrator: ConcentratorConfig{
+				MultiSFChannels: []uint64{923200000, 923400000},
+				LoRaStandardChannel: &LoRaStandardChannelConfig{
+					Frequency:       922100000,
+					Bandwidth:       250000,
+					SpreadingFactor: 7,
+				},
+				FSKChannel: &FSKChannelConfig{
+					Frequency: 921800000,
+					Bandwidth: 125000,
+					DataRate:  50000,
+				},
+				LBT: &LBTConfig{
+					RSSITarget: -80,
+					RSSIOffset: -4,
+					Channels: []LBTChannelConfig{
+						{Frequency: 923200000, ScanTimeMicroseconds: 5000},
+					},
+				},
+			},
+			Location: &LocationConfig{
+				Latitude:  0.42,
+				Longitude: 42.42,
+				Altitude:  42,
+			},
+		},
+	}
+	b, err := conf.MarshalText()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(string(b), should.EndWith, `# LoRa gateway configuration.
+[gateway]
+
+# Gateway ID.
+gateway_id="0102030405060708"
+
+# Antenna gain (dB).
+antenna_gain=2
+
+# Public LoRaWAN network.
+lorawan_public=true
+
+# Region.
+region="AS923"
+
+# Gateway vendor / model.
+#
+# This configures various vendor and model specific settings like the min / max
+# frequency, TX gain table, ... See the ChirpStack Concentratord documentation
+# for the supported models.
+model="multitech_mtcap_lora_868"
+
+# Gateway vendor / model flags.
+model_flags=[]
+
+  # LoRa concentrator configuration.
+  [gateway.concentrator]
+
+  # Multi spreading-factor channels (LoRa).
+  multi_sf_channels=[
+    923200000,
+    923400000,
+  ]
+
+  # LoRa std channel (single spreading-factor).
+  [gateway.concentrator.lora_std]
+  frequency=922100000
+  bandwidth=250000
+  spreading_factor=7
+
+  # FSK channel.
+  [gateway.concentrator.fsk]
+  frequency=921800000
+  bandwidth=125000
+  datarate=50000
+
+  # Listen-before-talk.
+  [gateway.concentrator.lbt]
+  enabled=true
+  rssi_target=-80.0
+  sx127x_rssi_offset=-4.0
+
+    [[gateway.concentrator.lbt.channels]]
+    frequency=923200000
+    scan_time_us=5000
+
+  # Static gateway location.
+  [gateway.location]
+  latitude=0.420000
+  longitude=42.420000
+  altitude=42
+`)
+}
@@ -0,0 +1,114 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package concentratord
+
+import (
+	"text/template"
+)
+
+// concentratordTmpl is based on the SX1301 configuration of ChirpStack Concentratord 4.
+var concentratordTmpl = template.Must(template.New("concentratord.toml").Parse(`# ChirpStack Concentratord configuration.
+[concentratord]
+
+# Log level.
+#
+# Valid options are:
+#   * TRACE
+#   * DEBUG
+#   * INFO
+#   * WARN
+#   * ERROR
+#   * OFF
+log_level="INFO"
+
+# Log to syslog.
+log_to_syslog=false
+
+# Statistics interval.
+stats_interval="30s"
+
+  # Configuration for the (ZeroMQ based) API.
+  [concentratord.api]
+
+  # Event PUB socket bind.
+  event_bind="ipc:///tmp/concentratord_event"
+
+  # Command REP socket bind.
+  command_bind="ipc:///tmp/concentratord_command"
+
+
+# LoRa gateway configuration.
+[gateway]
+
+# Gateway ID.
+{{ if .Gateway.ID }}gateway_id="{{ printf "%s" .Gateway.ID.MarshalText }}"{{ else }}#gateway_id="0000000000000000"{{ end }}
+
+# Antenna gain (dB).
+antenna_gain={{ .Gateway.AntennaGain }}
+
+# Public LoRaWAN network.
+lorawan_public={{ .Gateway.LoRaWANPublic }}
+
+# Region.
+region="{{ .Gateway.Region }}"
+
+# Gateway vendor / model.
+#
+# This configures various vendor and model specific settings like the min / max
+# frequency, TX gain table, ... See the ChirpStack Concentratord documentation
+# for the supported models.
+{{ if .Gateway.Model }}model="{{ .Gateway.Model }}"{{ else }}#model=""{{ end }}
+
+# Gateway vendor / model flags.
+model_flags=[]
+
+  # LoRa concentrator configuration.
+  [gateway.concentrator]
+
+  # Multi spreading-factor channels (LoRa).
+  multi_sf_channels=[
+{{- range .Gateway.Concentrator.MultiSFChannels }}
+    {{ . }},
+{{- end }}
+  ]
+{{ with .Gateway.Concentrator.LoRaStandardChannel }}
+  # LoRa std channel (single spreading-factor).
+  [gateway.concentrator.lora_std]
+  frequency={{ .Frequency }}
+  bandwidth={{ .Bandwidth }}
+  spreading_factor={{ .SpreadingFactor }}
+{{ end }}{{ with .Gateway.Concentrator.FSKChannel }}
+  # FSK channel.
+  [gateway.concentrator.fsk]
+  frequency={{ .Frequency }}
+  bandwidth={{ .Bandwidth }}
+  datarate={{ .DataRate }}
+{{ end }}{{ with .Gateway.Concentrator.LBT }}
+  # Listen-before-talk.
+  [gateway.concentrator.lbt]
+  enabled=true
+  rssi_target={{ printf "%.1f" .RSSITarget }}
+  sx127x_rssi_offset={{ printf "%.1f" .RSSIOffset }}
+{{ range .Channels }}
+    [[gateway.concentrator.lbt.channels]]
+    frequency={{ .Frequency }}
+    scan_time_us={{ .ScanTimeMicroseconds }}
+{{ end }}{{ end }}{{ with .Gateway.Location }}
+  # Static gateway location.
+  [gateway.location]
+  latitude={{ printf "%f" .Latitude }}
+  longitude={{ printf "%f" .Longitude }}
+  altitude={{ .Altitude }}
+{{ end }}`))
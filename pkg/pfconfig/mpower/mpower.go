@@ -0,0 +1,102 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mpower implements the JSON configuration for the packet forwarder of MultiTech mPower gateways.
+package mpower
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/shared"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// Default values of the packet forwarder of mPower.
+const (
+	DefaultKeepaliveInterval = 10
+	DefaultStatInterval      = 30
+	DefaultPushTimeout       = 100
+)
+
+// Config represents the full configuration for the packet forwarder of MultiTech mPower gateways.
+type Config struct {
+	SX1301Conf  shared.SX1301Config `json:"SX1301_conf"`
+	GatewayConf GatewayConf         `json:"gateway_conf"`
+}
+
+// GatewayConf contains the configuration for the gateway's server connection and location.
+type GatewayConf struct {
+	GatewayID          string   `json:"gateway_ID,omitempty"`
+	ServerAddress      string   `json:"server_address"`
+	ServerPortUp       uint32   `json:"serv_port_up"`
+	ServerPortDown     uint32   `json:"serv_port_down"`
+	KeepaliveInterval  uint32   `json:"keepalive_interval"`
+	StatInterval       uint32   `json:"stat_interval"`
+	PushTimeout        uint32   `json:"push_timeout_ms"`
+	ForwardCRCValid    bool     `json:"forward_crc_valid"`
+	ForwardCRCError    bool     `json:"forward_crc_error"`
+	ForwardCRCDisabled bool     `json:"forward_crc_disabled"`
+	ReferenceLatitude  *float64 `json:"ref_latitude,omitempty"`
+	ReferenceLongitude *float64 `json:"ref_longitude,omitempty"`
+	ReferenceAltitude  *int32   `json:"ref_altitude,omitempty"`
+	FakeGPS            bool     `json:"fake_gps,omitempty"`
+}
+
+// Build builds a packet forwarder configuration for the given gateway, using the given frequency plan store.
+func Build(gateway *ttnpb.Gateway, store *frequencyplans.Store) (*Config, error) {
+	host, port, err := shared.ParseGatewayServerAddress(gateway.GatewayServerAddress)
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{
+		GatewayConf: GatewayConf{
+			ServerAddress:     host,
+			ServerPortUp:      uint32(port),
+			ServerPortDown:    uint32(port),
+			KeepaliveInterval: DefaultKeepaliveInterval,
+			StatInterval:      DefaultStatInterval,
+			PushTimeout:       DefaultPushTimeout,
+			ForwardCRCValid:   true,
+		},
+	}
+	if gateway.GetIds().GetEui() != nil {
+		c.GatewayConf.GatewayID = types.MustEUI64(gateway.GetIds().GetEui()).String()
+	}
+
+	frequencyPlan, err := store.GetByID(gateway.FrequencyPlanId)
+	if err != nil {
+		return nil, err
+	}
+	if len(frequencyPlan.Radios) != 0 {
+		sx1301Config, err := shared.BuildSX1301Config(frequencyPlan)
+		if err != nil {
+			return nil, err
+		}
+		c.SX1301Conf = *sx1301Config
+	}
+
+	if antennas := gateway.GetAntennas(); len(antennas) > 0 {
+		antenna := antennas[0]
+		c.SX1301Conf.AntennaGain = antenna.Gain
+		if location := antenna.Location; location != nil {
+			// Without a GPS, the packet forwarder uses the reference location as the gateway location.
+			c.GatewayConf.ReferenceLatitude = &location.Latitude
+			c.GatewayConf.ReferenceLongitude = &location.Longitude
+			c.GatewayConf.ReferenceAltitude = &location.Altitude
+			c.GatewayConf.FakeGPS = !gateway.UpdateLocationFromStatus
+		}
+	}
+
+	return c, nil
+}
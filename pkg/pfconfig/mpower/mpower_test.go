@@ -0,0 +1,130 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mpower_test
+
+import (
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	. "go.thethings.network/lorawan-stack/v3/pkg/pfconfig/mpower"
+	"go.thethings.network/lorawan-stack/v3/pkg/pfconfig/shared"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestBuild(t *testing.T) {
+	const host = "test.example.com"
+	eui := types.EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	fps := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+
+	sx1301Config := func(fpID string) shared.SX1301Config {
+		return *test.Must(shared.BuildSX1301Config(test.Must(fps.GetByID(fpID))))
+	}
+	latitude, longitude, altitude := 0.42, 42.42, int32(42)
+
+	for _, tc := range []struct {
+		Name           string
+		Gateway        *ttnpb.Gateway
+		Config         *Config
+		ErrorAssertion func(t *testing.T, err error) bool
+	}{
+		{
+			Name:    "Empty gateway",
+			Gateway: &ttnpb.Gateway{},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.BeError)
+			},
+		},
+		{
+			Name: "EU868/No EUI/No antennas",
+			Gateway: &ttnpb.Gateway{
+				FrequencyPlanId:      test.EUFrequencyPlanID,
+				GatewayServerAddress: host,
+			},
+			Config: &Config{
+				SX1301Conf: sx1301Config(test.EUFrequencyPlanID),
+				GatewayConf: GatewayConf{
+					ServerAddress:     host,
+					ServerPortUp:      shared.DefaultGatewayServerUDPPort,
+					ServerPortDown:    shared.DefaultGatewayServerUDPPort,
+					KeepaliveInterval: DefaultKeepaliveInterval,
+					StatInterval:      DefaultStatInterval,
+					PushTimeout:       DefaultPushTimeout,
+					ForwardCRCValid:   true,
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.BeNil)
+			},
+		},
+		{
+			Name: "EU868/EUI/1 antenna",
+			Gateway: &ttnpb.Gateway{
+				Ids: &ttnpb.GatewayIdentifiers{
+					Eui: eui.Bytes(),
+				},
+				FrequencyPlanId:      test.EUFrequencyPlanID,
+				GatewayServerAddress: host + ":42",
+				Antennas: []*ttnpb.GatewayAntenna{
+					{
+						Gain: 4,
+						Location: &ttnpb.Location{
+							Latitude:  latitude,
+							Longitude: longitude,
+							Altitude:  altitude,
+						},
+					},
+				},
+			},
+			Config: &Config{
+				SX1301Conf: func() shared.SX1301Config {
+					conf := sx1301Config(test.EUFrequencyPlanID)
+					conf.AntennaGain = 4
+					return conf
+				}(),
+				GatewayConf: GatewayConf{
+					GatewayID:          eui.String(),
+					ServerAddress:      host,
+					ServerPortUp:       42,
+					ServerPortDown:     42,
+					KeepaliveInterval:  DefaultKeepaliveInterval,
+					StatInterval:       DefaultStatInterval,
+					PushTimeout:        DefaultPushTimeout,
+					ForwardCRCValid:    true,
+					ReferenceLatitude:  &latitude,
+					ReferenceLongitude: &longitude,
+					ReferenceAltitude:  &altitude,
+					FakeGPS:            true,
+				},
+			},
+			ErrorAssertion: func(t *testing.T, err error) bool {
+				return assertions.New(t).So(err, should.BeNil)
+			},
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			gtw := ttnpb.Clone(tc.Gateway)
+			conf, err := Build(gtw, fps)
+			a.So(gtw, should.Resemble, tc.Gateway)
+			if a.So(tc.ErrorAssertion(t, err), should.BeTrue) {
+				a.So(conf, should.Resemble, tc.Config)
+			}
+		})
+	}
+}
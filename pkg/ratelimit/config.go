@@ -16,8 +16,9 @@ package ratelimit
 
 import (
 	"context"
+	"time"
 
-	"github.com/throttled/throttled"
+	"github.com/throttled/throttled/v2"
 	"github.com/throttled/throttled/v2/store/memstore"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
@@ -79,22 +80,40 @@ var errInvalidRate = errors.DefineInvalidArgument("invalid_rate", "invalid rate
 
 // NewProfile returns a new ratelimit.Interface from profile configuration.
 func NewProfile(ctx context.Context, conf config.RateLimitingProfile, size uint) (Interface, error) {
-	if size == 0 {
-		size = defaultMaxSize
-	}
 	if conf.MaxPerMin == 0 {
 		return nil, errInvalidRate.WithAttributes("rate", conf.MaxPerMin, "name", conf.Name)
 	}
+	return newRateLimiter(ctx, throttled.PerMin(int(conf.MaxPerMin)), conf.MaxPerMin, conf.MaxBurst, size)
+}
+
+var errInvalidWindow = errors.DefineInvalidArgument("invalid_window", "invalid window `{window}`")
+
+// NewWindow returns a new ratelimit.Interface that allows max accesses per window for each resource.
+// The accesses may happen in a single burst.
+func NewWindow(ctx context.Context, max uint, window time.Duration, size uint) (Interface, error) {
+	if max == 0 {
+		return nil, errInvalidRate.WithAttributes("rate", max, "name", "window")
+	}
+	if window <= 0 {
+		return nil, errInvalidWindow.WithAttributes("window", window)
+	}
+	return newRateLimiter(ctx, throttled.PerDuration(int(max), window), max, max, size)
+}
+
+func newRateLimiter(ctx context.Context, rate throttled.Rate, maxRate, maxBurst, size uint) (Interface, error) {
+	if size == 0 {
+		size = defaultMaxSize
+	}
 	store, err := memstore.New(int(size))
 	if err != nil {
 		return nil, err
 	}
-	if conf.MaxBurst == 0 {
-		conf.MaxBurst = conf.MaxPerMin
+	if maxBurst == 0 {
+		maxBurst = maxRate
 	}
 	quota := throttled.RateQuota{
-		MaxRate:  throttled.PerMin(int(conf.MaxPerMin)),
-		MaxBurst: int(conf.MaxBurst - 1),
+		MaxRate:  rate,
+		MaxBurst: int(maxBurst - 1),
 	}
 	limiter, err := throttled.NewGCRARateLimiter(store, quota)
 	if err != nil {
@@ -16,6 +16,7 @@ package ratelimit_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/smartystreets/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
@@ -172,3 +173,27 @@ func TestRateLimit(t *testing.T) {
 		a.So(result.Limit, should.Equal, 200)
 	})
 }
+
+func TestRateLimitWindow(t *testing.T) {
+	a := assertions.New(t)
+
+	_, err := ratelimit.NewWindow(test.Context(), 0, time.Hour, 0)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	_, err = ratelimit.NewWindow(test.Context(), 10, 0, 0)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	limiter, err := ratelimit.NewWindow(test.Context(), 10, time.Hour, 0)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	resource := &mockResource{key: "key", classes: []string{"window"}}
+	for i := uint(0); i < 10; i++ {
+		limit, result := limiter.RateLimit(resource)
+		a.So(limit, should.BeFalse)
+		a.So(result.Remaining, should.Equal, 10-i-1)
+	}
+	limit, result := limiter.RateLimit(resource)
+	a.So(limit, should.BeTrue)
+	// One access is allowed again after a tenth of the window.
+	a.So(result.RetryAfter, should.BeBetweenOrEqual, 5*time.Minute, 6*time.Minute)
+}
@@ -92,6 +92,15 @@ func GatewayUpResource(ctx context.Context, ids *ttnpb.GatewayIdentifiers) Resou
 	}
 }
 
+// GatewayRemoteShellResource represents remote shell traffic from a gateway.
+// It is separate from the uplink traffic of the gateway, so that remote shell sessions do not limit the uplink traffic.
+func GatewayRemoteShellResource(ctx context.Context, ids *ttnpb.GatewayIdentifiers) Resource {
+	return &resource{
+		key:     fmt.Sprintf("gs:remote-shell:gtw:%s", unique.ID(ctx, ids)),
+		classes: []string{"gs:remote-shell"},
+	}
+}
+
 // GatewayAcceptMQTTConnectionResource represents a new MQTT gateway connection from a remote address.
 func GatewayAcceptMQTTConnectionResource(remoteAddr string) Resource {
 	remoteIP := remoteAddr
@@ -148,6 +157,22 @@ func ApplicationWebhooksDownResource(ctx context.Context, ids *ttnpb.EndDeviceId
 	}
 }
 
+// ApplicationUpResource represents uplink traffic of an end device to the Application Server.
+func ApplicationUpResource(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) Resource {
+	return &resource{
+		key:     fmt.Sprintf("as:up:dev:%s", unique.ID(ctx, ids)),
+		classes: []string{"as:up"},
+	}
+}
+
+// DeviceRepositoryTestPayloadFormattersResource represents testing payload formatters in the Device Repository.
+func DeviceRepositoryTestPayloadFormattersResource(ctx context.Context) Resource {
+	return &resource{
+		key:     fmt.Sprintf("dr:formatters:test:token:%s", grpcAuthTokenID(ctx)),
+		classes: []string{"dr:formatters:test"},
+	}
+}
+
 // NewCustomResource returns a new resource. It is used internally by other components.
 func NewCustomResource(key string, classes ...string) Resource {
 	return &resource{key, classes}
@@ -19,7 +19,7 @@
 -- ARGV[1] - group ID
 -- ARGV[2] - consumer ID
 -- ARGV[3] - pivot - current time, expressed as nanoseconds elapsed since Unix epoch
--- ARGV[4] - approximate maximum length of ready task stream
+-- ARGV[4] - approximate maximum length of ready task stream, or 0 to not trim the stream
 --
 -- KEYS[1] - ready task key
 -- KEYS[2] - input task key
@@ -85,7 +85,11 @@ if #zs > 0 then
 
         local member = zs[i]
         members[#members + 1] = member
-        redis.call('xadd', KEYS[1], 'maxlen', '~', ARGV[4], '*', 'payload', member, 'start_at', zs[i + 1])
+        if tonumber(ARGV[4]) > 0 then
+            redis.call('xadd', KEYS[1], 'maxlen', '~', ARGV[4], '*', 'payload', member, 'start_at', zs[i + 1])
+        else
+            redis.call('xadd', KEYS[1], '*', 'payload', member, 'start_at', zs[i + 1])
+        end
     end
     redis.call('zrem', KEYS[3], unpack(members))
 end
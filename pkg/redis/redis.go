@@ -460,7 +460,7 @@ func initTaskGroup(ctx context.Context, r redis.Cmdable, group, k string) error
 }
 
 // addTask adds a task identified by payload with timestamp startAt to the stream at InputTaskKey(k).
-// maxLen is the approximate length of the stream, to which it may be trimmed.
+// maxLen is the approximate length of the stream, to which it may be trimmed. If maxLen is 0, the stream is not trimmed.
 func addTask(ctx context.Context, r redis.Cmdable, k string, maxLen int64, payload string, startAt time.Time, replace bool) error {
 	m := make(map[string]any, 2)
 	m[payloadKey] = payload
@@ -490,7 +490,7 @@ func parseTime(s string) (time.Time, error) {
 // The tasks are moved from InputTaskKey(k) to WaitingTaskKey(k). Once the task should be dispatched, it is moved form WaitingTaskKey(k) to ReadyTaskKey(k).
 // group is the consumer group name.
 // consumer is the consumer ID.
-// maxLen represents the maximum size of the streams used for dispatching.
+// maxLen represents the maximum size of the streams used for dispatching. If maxLen is 0, the streams are not trimmed.
 // minIdleTime is used for automatic task reclaiming. Only tasks older than minIdleTime will be redispatched from the input stream to the waiting stream.
 func dispatchTask(
 	ctx context.Context,
@@ -637,6 +637,7 @@ func popTask(
 }
 
 // TaskQueue is a task queue.
+// The task streams are trimmed to approximately MaxLen entries, unless MaxLen is 0.
 type TaskQueue struct {
 	Redis            WatchCmdable
 	MaxLen           int64
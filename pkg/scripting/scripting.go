@@ -21,10 +21,14 @@ import "time"
 type Options struct {
 	StackDepthLimit int
 	Timeout         time.Duration
+	// MemoryLimit is the maximum memory in bytes that a script can use.
+	// This limit is only enforced by engines that support it.
+	MemoryLimit int
 }
 
 // DefaultOptions are the default Options.
 var DefaultOptions = Options{
 	StackDepthLimit: 32,
 	Timeout:         100 * time.Millisecond,
+	MemoryLimit:     16 << 20,
 }
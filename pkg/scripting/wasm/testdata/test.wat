@@ -0,0 +1,48 @@
+;; Source of test.wasm.
+(module
+  (memory (export "memory") 1)
+  (global $heap (mut i32) (i32.const 4096))
+  (data (i32.const 1024) "{\"result\":\"ok\"}")
+
+  (func (export "alloc") (param $size i32) (result i32)
+    global.get $heap
+    global.get $heap
+    local.get $size
+    i32.add
+    global.set $heap)
+
+  ;; Returns the input.
+  (func (export "echo") (param $ptr i32) (param $len i32) (result i64)
+    local.get $ptr
+    i64.extend_i32_u
+    i64.const 32
+    i64.shl
+    local.get $len
+    i64.extend_i32_u
+    i64.or)
+
+  ;; Returns {"result":"ok"}.
+  (func (export "constant") (param i32) (param i32) (result i64)
+    i64.const 4398046511119) ;; 1024 << 32 | 15
+
+  ;; Returns no output.
+  (func (export "null") (param i32) (param i32) (result i64)
+    i64.const 0)
+
+  ;; Never returns.
+  (func (export "spin") (param i32) (param i32) (result i64)
+    loop
+      br 0
+    end
+    unreachable)
+
+  ;; Grows the memory with 16 pages, and traps if that fails.
+  (func (export "grow") (param i32) (param i32) (result i64)
+    i32.const 16
+    memory.grow
+    i32.const -1
+    i32.eq
+    if
+      unreachable
+    end
+    i64.const 0))
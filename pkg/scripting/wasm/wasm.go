@@ -0,0 +1,274 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package wasm implements a WebAssembly scripting engine.
+//
+// Scripts are WebAssembly binary modules. Modules may import WASI (wasi_snapshot_preview1), but have no access to
+// the file system, the network, the environment or the clock. Modules must export their linear memory as `memory`,
+// and an allocation function `alloc(size: i32) -> i32` which returns a pointer to size bytes of memory.
+//
+// An entrypoint is an exported function `(ptr: i32, len: i32) -> i64`. The parameters are passed to the entrypoint
+// as UTF-8 JSON in memory allocated with `alloc`. The entrypoint returns the pointer to its UTF-8 JSON output in the
+// upper 32 bits and the length of the output in the lower 32 bits of the result.
+//
+// Each run uses a new instance of the module, so modules do not need to free memory and cannot keep state between runs.
+package wasm
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"runtime/trace"
+	"time"
+
+	"github.com/bluele/gcache"
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/imports/wasi_snapshot_preview1"
+	"github.com/tetratelabs/wazero/sys"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	memoryExport = "memory"
+	allocExport  = "alloc"
+
+	pageSize = 1 << 16
+
+	// cacheSize is the number of compiled modules that are cached.
+	cacheSize = 256
+)
+
+type engine struct {
+	options scripting.Options
+	runtime wazero.Runtime
+
+	singleflight singleflight.Group
+	modules      gcache.Cache
+}
+
+// New returns a new WebAssembly scripting engine.
+// Modules are compiled ahead of time to machine code on the platforms which support it, and are interpreted otherwise.
+// Compiled modules are cached by the hash of the module.
+func New(options scripting.Options) scripting.AheadOfTimeEngine {
+	ctx := context.Background()
+	config := wazero.NewRuntimeConfig().WithCloseOnContextDone(true)
+	if options.MemoryLimit > 0 {
+		pages := options.MemoryLimit / pageSize
+		if pages < 1 {
+			pages = 1
+		}
+		config = config.WithMemoryLimitPages(uint32(pages))
+	}
+	runtime := wazero.NewRuntimeWithConfig(ctx, config)
+	wasi_snapshot_preview1.MustInstantiate(ctx, runtime)
+	return &engine{
+		options: options,
+		runtime: runtime,
+		modules: gcache.New(cacheSize).LRU().
+			EvictedFunc(func(_, value any) {
+				// Instances that are running are not affected.
+				value.(wazero.CompiledModule).Close(ctx) //nolint:errcheck
+			}).
+			Build(),
+	}
+}
+
+var (
+	errCompile            = errors.DefineInvalidArgument("compile", "compile module: {message}")
+	errNoMemory           = errors.DefineInvalidArgument("no_memory", "module does not export `memory`")
+	errNoAlloc            = errors.DefineInvalidArgument("no_alloc", "module does not export `alloc`")
+	errScriptTimeout      = errors.DefineDeadlineExceeded("script_timeout", "script timeout")
+	errScriptInterrupt    = errors.DefineAborted("script_interrupt", "script interrupt")
+	errNoScriptOutput     = errors.DefineAborted("no_script_output", "no script output")
+	errRuntime            = errors.DefineAborted("runtime", "{message}")
+	errEntrypointNotFound = errors.DefineNotFound("entrypoint_not_found", "entrypoint `{entrypoint}` not found")
+	errInput              = errors.DefineInvalidArgument("input", "invalid input")
+	errOutputRange        = errors.DefineAborted("output_range", "output out of memory range")
+)
+
+func convertError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if exitErr, ok := err.(*sys.ExitError); ok {
+		switch exitErr.ExitCode() {
+		case sys.ExitCodeDeadlineExceeded:
+			return errScriptTimeout.WithCause(err)
+		case sys.ExitCodeContextCanceled:
+			return errScriptInterrupt.WithCause(err)
+		}
+	}
+	return errRuntime.WithAttributes("message", err.Error()).WithCause(err)
+}
+
+func moduleKey(script string) string {
+	hash := sha256.Sum256([]byte(script))
+	return string(hash[:])
+}
+
+// compile compiles the module, or returns the cached compiled module.
+func (e *engine) compile(ctx context.Context, key, script string) (wazero.CompiledModule, error) {
+	if cached, err := e.modules.Get(key); err == nil {
+		return cached.(wazero.CompiledModule), nil
+	}
+	res, err, _ := e.singleflight.Do(key, func() (_ any, err error) {
+		defer trace.StartRegion(ctx, "compile wasm").End()
+
+		start := time.Now()
+		defer func() {
+			compilationsLatency.Observe(time.Since(start).Seconds())
+			if err != nil {
+				compilations.WithLabelValues("error").Inc()
+			} else {
+				compilations.WithLabelValues("ok").Inc()
+			}
+		}()
+
+		compiled, err := e.runtime.CompileModule(ctx, []byte(script))
+		if err != nil {
+			return nil, errCompile.WithAttributes("message", err.Error()).WithCause(err)
+		}
+		if _, ok := compiled.ExportedMemories()[memoryExport]; !ok {
+			compiled.Close(ctx) //nolint:errcheck
+			return nil, errNoMemory.New()
+		}
+		if _, ok := compiled.ExportedFunctions()[allocExport]; !ok {
+			compiled.Close(ctx) //nolint:errcheck
+			return nil, errNoAlloc.New()
+		}
+		if err := e.modules.Set(key, compiled); err != nil {
+			return nil, err
+		}
+		return compiled, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(wazero.CompiledModule), nil
+}
+
+// Run compiles the WebAssembly module and runs the entrypoint fn.
+func (e *engine) Run(ctx context.Context, script, fn string, params ...any) (func(target any) error, error) {
+	compiled, err := e.compile(ctx, moduleKey(script), script)
+	if err != nil {
+		return nil, err
+	}
+	return e.run(ctx, compiled, fn, params...)
+}
+
+// Compile compiles the WebAssembly module and returns a function that runs entrypoints of the module.
+// The compiled module is cached, and compiled again when it is evicted from the cache.
+func (e *engine) Compile(
+	ctx context.Context, script string,
+) (run func(context.Context, string, ...any) (func(any) error, error), err error) {
+	key := moduleKey(script)
+	if _, err := e.compile(ctx, key, script); err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, fn string, params ...any) (func(any) error, error) {
+		compiled, err := e.compile(ctx, key, script)
+		if err != nil {
+			return nil, err
+		}
+		return e.run(ctx, compiled, fn, params...)
+	}, nil
+}
+
+func (e *engine) run(
+	ctx context.Context, compiled wazero.CompiledModule, fn string, params ...any,
+) (as func(target any) error, err error) {
+	defer trace.StartRegion(ctx, "run wasm").End()
+
+	start := time.Now()
+	defer func() {
+		runLatency.Observe(time.Since(start).Seconds())
+		if err != nil {
+			runs.WithLabelValues("error").Inc()
+		} else {
+			runs.WithLabelValues("ok").Inc()
+		}
+	}()
+
+	if _, ok := compiled.ExportedFunctions()[fn]; !ok {
+		return nil, errEntrypointNotFound.WithAttributes("entrypoint", fn)
+	}
+	var input []byte
+	if len(params) == 1 {
+		input, err = json.Marshal(params[0])
+	} else {
+		input, err = json.Marshal(params)
+	}
+	if err != nil {
+		return nil, errInput.WithCause(err)
+	}
+
+	if e.options.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, e.options.Timeout)
+		defer cancel()
+	}
+	// The module is instantiated without a name, so that it can be instantiated concurrently.
+	mod, err := e.runtime.InstantiateModule(ctx, compiled, wazero.NewModuleConfig().
+		WithName("").
+		WithStartFunctions("_initialize"),
+	)
+	if err != nil {
+		return nil, convertError(err)
+	}
+	defer mod.Close(ctx) //nolint:errcheck
+
+	output, err := call(ctx, mod, fn, input)
+	if err != nil {
+		return nil, err
+	}
+	return func(target any) error {
+		if len(output) == 0 || string(output) == "null" {
+			return errNoScriptOutput.New()
+		}
+		return json.Unmarshal(output, target)
+	}, nil
+}
+
+// call writes the input to the memory of the module, calls the entrypoint and returns a copy of the output.
+func call(ctx context.Context, mod api.Module, fn string, input []byte) ([]byte, error) {
+	res, err := mod.ExportedFunction(allocExport).Call(ctx, uint64(len(input)))
+	if err != nil {
+		return nil, convertError(err)
+	}
+	ptr := api.DecodeU32(res[0])
+	memory := mod.Memory()
+	if !memory.Write(ptr, input) {
+		return nil, errInput.New()
+	}
+	res, err = mod.ExportedFunction(fn).Call(ctx, uint64(ptr), uint64(len(input)))
+	if err != nil {
+		return nil, convertError(err)
+	}
+	if len(res) != 1 {
+		return nil, errNoScriptOutput.New()
+	}
+	outputPtr, outputLen := uint32(res[0]>>32), uint32(res[0])
+	if outputLen == 0 {
+		return nil, nil
+	}
+	output, ok := memory.Read(outputPtr, outputLen)
+	if !ok {
+		return nil, errOutputRange.New()
+	}
+	// The memory is released when the module is closed, so the output is copied.
+	return append([]byte(nil), output...), nil
+}
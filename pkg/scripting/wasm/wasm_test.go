@@ -0,0 +1,138 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package wasm_test
+
+import (
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting"
+	"go.thethings.network/lorawan-stack/v3/pkg/scripting/wasm"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func readModule(t *testing.T) string {
+	t.Helper()
+	b, err := os.ReadFile("testdata/test.wasm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestRun(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	script := readModule(t)
+
+	e := wasm.New(scripting.DefaultOptions)
+	as, err := e.Run(ctx, script, "constant")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var output struct {
+		Result string `json:"result"`
+	}
+	a.So(as(&output), should.BeNil)
+	a.So(output.Result, should.Equal, "ok")
+
+	// The parameter is passed as JSON.
+	type echo struct {
+		Bytes []byte `json:"bytes"`
+		FPort uint8  `json:"fPort"`
+	}
+	as, err = e.Run(ctx, script, "echo", echo{Bytes: []byte{0x01, 0x02}, FPort: 42})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var echoed echo
+	a.So(as(&echoed), should.BeNil)
+	a.So(echoed, should.Resemble, echo{Bytes: []byte{0x01, 0x02}, FPort: 42})
+
+	as, err = e.Run(ctx, script, "null")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(errors.IsAborted(as(&output)), should.BeTrue)
+}
+
+func TestCompile(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	e := wasm.New(scripting.DefaultOptions)
+	run, err := e.Compile(ctx, readModule(t))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	// Each run uses a new instance, so runs can be concurrent.
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			as, err := run(ctx, "echo", map[string]int{"i": i})
+			if !a.So(err, should.BeNil) {
+				return
+			}
+			var output map[string]int
+			a.So(as(&output), should.BeNil)
+			a.So(output["i"], should.Equal, i)
+		}(i)
+	}
+	wg.Wait()
+
+	_, err = run(ctx, "missing")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	_, err = e.Compile(ctx, "not a module")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestRunTimeout(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+
+	e := wasm.New(scripting.Options{
+		Timeout: 50 * time.Millisecond,
+	})
+	start := time.Now()
+	_, err := e.Run(ctx, readModule(t), "spin")
+	a.So(errors.IsDeadlineExceeded(err), should.BeTrue)
+	a.So(time.Since(start), should.BeLessThan, time.Second)
+}
+
+func TestRunMemoryLimit(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := test.Context()
+	script := readModule(t)
+
+	// The module has one page of memory, and grows it with 16 pages.
+	_, err := wasm.New(scripting.Options{MemoryLimit: 32 << 16}).Run(ctx, script, "grow")
+	a.So(err, should.BeNil)
+
+	_, err = wasm.New(scripting.Options{MemoryLimit: 8 << 16}).Run(ctx, script, "grow")
+	a.So(errors.IsAborted(err), should.BeTrue)
+}
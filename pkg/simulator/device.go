@@ -0,0 +1,1234 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"bytes"
+	"hash/fnv"
+	"math"
+	"math/rand"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errNoChannel       = errors.DefineFailedPrecondition("no_channel", "no enabled channel supports data rate `{data_rate}`")
+	errJoinAcceptMIC   = errors.DefineCorruption("join_accept_mic", "join-accept MIC mismatch")
+	errDownlinkMIC     = errors.DefineCorruption("downlink_mic", "downlink MIC mismatch")
+	errDownlinkFCnt    = errors.DefineInvalidArgument("downlink_f_cnt", "downlink FCnt `{f_cnt}` is not greater than `{last_f_cnt}`")
+	errFCntUpExhausted = errors.DefineResourceExhausted("f_cnt_up_exhausted", "uplink frame counter exhausted")
+)
+
+// maxFOptsLength is the maximum length of MAC commands in the FOpts field.
+const maxFOptsLength = 15
+
+// rxWindowTolerance is the tolerance of the scheduled downlink timestamp with regards to the receive windows.
+const rxWindowTolerance = 5 * time.Millisecond
+
+// deviceChannel is an uplink channel of a device.
+type deviceChannel struct {
+	uplinkFrequency   uint64
+	downlinkFrequency uint64
+	minDataRate       ttnpb.DataRateIndex
+	maxDataRate       ttnpb.DataRateIndex
+	enabled           bool
+}
+
+// transmission is a frame sent by a device.
+type transmission struct {
+	// key identifies the transmission for deterministic channel conditions.
+	key           string
+	payload       []byte
+	frequency     uint64
+	dataRateIndex ttnpb.DataRateIndex
+	dataRate      *ttnpb.DataRate
+	eirp          float64
+	location      Location
+}
+
+// lastUplink is the state of the last uplink of a device, used to match downlinks to receive windows.
+type lastUplink struct {
+	timestamp    uint32
+	join         bool
+	devNonce     types.DevNonce
+	fCnt         uint32
+	confirmed    bool
+	rx1Delay     time.Duration
+	rx1Frequency uint64
+	rx2Frequency uint64
+	// transmissions is the number of transmissions of the frame so far.
+	transmissions uint32
+	// received indicates that a downlink has been received in one of the receive windows.
+	received bool
+	// acked indicates that the confirmed uplink has been acknowledged.
+	acked bool
+	// delivered indicates that one of the transmissions of the frame has been received by a gateway.
+	delivered bool
+}
+
+// device is a virtual end device.
+type device struct {
+	deviceConfig
+	phy    *band.Band
+	rng    *rand.Rand
+	trace  func(Event)
+	loc    Location
+	uplink func() []byte
+
+	mu sync.Mutex
+
+	devNonce uint16
+	joined   bool
+	// version is the LoRaWAN version of the session. This is LoRaWAN 1.0.x if a LoRaWAN 1.1 device joins
+	// a network that does not support LoRaWAN 1.1.
+	version ttnpb.MACVersion
+
+	devAddr                                       types.DevAddr
+	appSKey, fNwkSIntKey, sNwkSIntKey, nwkSEncKey types.AES128Key
+
+	fCntUp uint32
+	// lastNFCntDown and lastAFCntDown are the last downlink frame counters. In LoRaWAN 1.0.x, only lastNFCntDown is used.
+	lastNFCntDown, lastAFCntDown         uint32
+	receivedNFCntDown, receivedAFCntDown bool
+	// ackFCntDown is the FCnt of the confirmed downlink to acknowledge in the next uplink.
+	ackFCntDown *uint32
+
+	channels     []deviceChannel
+	dataRate     ttnpb.DataRateIndex
+	txPowerIndex uint32
+	nbTrans      uint32
+	rx1DROffset  ttnpb.DataRateOffset
+	rx2DataRate  ttnpb.DataRateIndex
+	rx2Frequency uint64
+	rx1Delay     time.Duration
+	adrAckLimit  uint32
+	adrAckDelay  uint32
+	adrAckCnt    uint32
+	pingSlotFreq uint64
+	maxEIRP      float32
+
+	answers       []*ttnpb.MACCommand
+	stickyAnswers []*ttnpb.MACCommand
+	// pendingRequests are MAC requests that are sent in each uplink until they are confirmed.
+	pendingRequests map[ttnpb.MACCommandIdentifier]*ttnpb.MACCommand
+
+	timeSynced  bool
+	classBReady bool
+
+	last *lastUplink
+	// nextFrameAt is the simulation time at which the next new frame is transmitted.
+	nextFrameAt time.Duration
+	// lastDownlinkSNR is the SNR of the last received downlink, used in DevStatusAns.
+	lastDownlinkSNR float64
+
+	stats DeviceStats
+}
+
+// DeviceStats contains the statistics of a virtual end device.
+type DeviceStats struct {
+	JoinRequests        uint64 `json:"join_requests"`
+	JoinAccepts         uint64 `json:"join_accepts"`
+	Uplinks             uint64 `json:"uplinks"`
+	UplinksReceived     uint64 `json:"uplinks_received"`
+	ConfirmedUplinks    uint64 `json:"confirmed_uplinks"`
+	ConfirmedAcked      uint64 `json:"confirmed_acked"`
+	Downlinks           uint64 `json:"downlinks"`
+	DownlinksLost       uint64 `json:"downlinks_lost"`
+	DownlinksInvalid    uint64 `json:"downlinks_invalid"`
+	MACCommandsReceived uint64 `json:"mac_commands_received"`
+}
+
+func deviceSeed(seed int64, id string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(id))
+	return seed ^ int64(h.Sum64())
+}
+
+func newDevice(conf deviceConfig, phy *band.Band, seed int64, trace func(Event)) *device {
+	d := &device{
+		deviceConfig: conf,
+		phy:          phy,
+		rng:          rand.New(rand.NewSource(deviceSeed(seed, conf.ID()))),
+		trace:        trace,
+		devNonce:     conf.devNonce,
+	}
+	d.loc = conf.location
+	if conf.radius > 0 {
+		// Uniformly distribute the devices over the disk.
+		r := conf.radius * math.Sqrt(d.rng.Float64())
+		theta := 2 * math.Pi * d.rng.Float64()
+		d.loc = conf.location.offset(r*math.Cos(theta), r*math.Sin(theta))
+	}
+	payload := conf.payload
+	if payload == nil {
+		payload = make([]byte, conf.payloadSize)
+	}
+	d.uplink = func() []byte {
+		if conf.payload == nil {
+			d.rng.Read(payload)
+		}
+		return append([]byte(nil), payload...)
+	}
+	d.resetSession()
+	if !conf.otaa {
+		d.joined = true
+		d.version = conf.macVersion
+		d.devAddr = conf.devAddr
+		d.appSKey = conf.appSKey
+		d.fNwkSIntKey, d.sNwkSIntKey, d.nwkSEncKey = conf.fNwkSIntKey, conf.sNwkSIntKey, conf.nwkSEncKey
+		d.fCntUp = conf.fCntUp
+		if macspec.UseRekeyInd(d.version) {
+			d.pendingRequests[ttnpb.MACCommandIdentifier_CID_RESET] = (&ttnpb.MACCommand_ResetInd{
+				MinorVersion: ttnpb.Minor_MINOR_1,
+			}).MACCommand()
+		}
+		d.sessionStarted()
+	}
+	return d
+}
+
+// resetSession resets the MAC state to the band defaults.
+func (d *device) resetSession() {
+	d.channels = make([]deviceChannel, 0, len(d.phy.UplinkChannels))
+	for i, ch := range d.phy.UplinkChannels {
+		d.channels = append(d.channels, deviceChannel{
+			uplinkFrequency:   ch.Frequency,
+			downlinkFrequency: d.phy.DownlinkChannels[i%len(d.phy.DownlinkChannels)].Frequency,
+			minDataRate:       ch.MinDataRate,
+			maxDataRate:       ch.MaxDataRate,
+			enabled:           true,
+		})
+	}
+	d.dataRate = d.deviceConfig.dataRate
+	d.txPowerIndex = 0
+	d.nbTrans = 1
+	d.rx1DROffset = 0
+	d.rx2DataRate = d.phy.DefaultRx2Parameters.DataRateIndex
+	d.rx2Frequency = d.phy.DefaultRx2Parameters.Frequency
+	d.rx1Delay = d.phy.ReceiveDelay1
+	d.adrAckLimit = 1 << uint32(d.phy.ADRAckLimit)
+	d.adrAckDelay = 1 << uint32(d.phy.ADRAckDelay)
+	d.adrAckCnt = 0
+	d.maxEIRP = d.deviceConfig.maxEIRP
+	d.fCntUp = 0
+	d.lastNFCntDown, d.lastAFCntDown = 0, 0
+	d.receivedNFCntDown, d.receivedAFCntDown = false, false
+	d.ackFCntDown = nil
+	d.answers, d.stickyAnswers = nil, nil
+	d.pendingRequests = make(map[ttnpb.MACCommandIdentifier]*ttnpb.MACCommand)
+	d.timeSynced, d.classBReady = false, false
+	if len(d.phy.PingSlotFrequencies) > 0 {
+		d.pingSlotFreq = d.phy.PingSlotFrequencies[0]
+	}
+}
+
+// sessionStarted queues the MAC requests that are sent at the start of a session.
+func (d *device) sessionStarted() {
+	switch d.class {
+	case ttnpb.Class_CLASS_B:
+		d.pendingRequests[ttnpb.MACCommandIdentifier_CID_DEVICE_TIME] = ttnpb.MACCommandIdentifier_CID_DEVICE_TIME.MACCommand()
+	case ttnpb.Class_CLASS_C:
+		if macspec.UseDeviceModeInd(d.version) {
+			d.pendingRequests[ttnpb.MACCommandIdentifier_CID_DEVICE_MODE] = (&ttnpb.MACCommand_DeviceModeInd{
+				Class: ttnpb.Class_CLASS_C,
+			}).MACCommand()
+		}
+	}
+}
+
+// Joined returns whether the device has an active session.
+func (d *device) Joined() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.joined
+}
+
+// DevAddr returns the device address of the active session.
+func (d *device) DevAddr() (types.DevAddr, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.devAddr, d.joined
+}
+
+// Stats returns the statistics of the device.
+func (d *device) Stats() DeviceStats {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.stats
+}
+
+func (d *device) eirp() float64 {
+	eirp := float64(d.maxEIRP)
+	if int(d.txPowerIndex) < len(d.phy.TxOffset) {
+		eirp += float64(d.phy.TxOffset[d.txPowerIndex])
+	}
+	return eirp
+}
+
+// selectChannel returns a random enabled channel that supports the data rate.
+func (d *device) selectChannel(dr ttnpb.DataRateIndex) (int, error) {
+	candidates := make([]int, 0, len(d.channels))
+	for i, ch := range d.channels {
+		if ch.enabled && ch.uplinkFrequency != 0 && ch.minDataRate <= dr && dr <= ch.maxDataRate {
+			candidates = append(candidates, i)
+		}
+	}
+	if len(candidates) == 0 {
+		return 0, errNoChannel.WithAttributes("data_rate", dr)
+	}
+	return candidates[d.rng.Intn(len(candidates))], nil
+}
+
+// Transmit returns the next frame the device transmits at the given simulation time, and the delay until the
+// next transmission. No frame is returned if the device waits for the next frame.
+func (d *device) Transmit(at time.Duration) (*transmission, time.Duration, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if !d.joined {
+		tx, err := d.joinRequest(at)
+		return tx, d.nextInterval(), err
+	}
+	// Repeat the last frame until the number of transmissions is reached, or until it is acknowledged.
+	if last := d.last; last != nil && !last.join && last.transmissions < d.nbTrans &&
+		!last.received && (!last.confirmed || !last.acked) {
+		tx, err := d.dataUplink(at, true)
+		return tx, d.untilNextFrame(at), err
+	}
+	if at < d.nextFrameAt {
+		return nil, d.nextFrameAt - at, nil
+	}
+	d.nextFrameAt = at + d.nextInterval()
+	tx, err := d.dataUplink(at, false)
+	return tx, d.untilNextFrame(at), err
+}
+
+// untilNextFrame returns the delay until the next transmission, which is either a repetition or a new frame.
+func (d *device) untilNextFrame(at time.Duration) time.Duration {
+	next := d.nextFrameAt - at
+	if d.last != nil && d.last.transmissions < d.nbTrans {
+		if delay := d.retransmitDelay(); delay < next {
+			return delay
+		}
+	}
+	return next
+}
+
+func (d *device) nextInterval() time.Duration {
+	jitter := (2*d.rng.Float64() - 1) * d.jitter
+	return time.Duration(float64(d.interval) * (1 + jitter))
+}
+
+// retransmitDelay returns the delay of a retransmission, which is after the RX2 window.
+func (d *device) retransmitDelay() time.Duration {
+	return d.rx1Delay + time.Second + time.Second + time.Duration(d.rng.Int63n(int64(2*time.Second)))
+}
+
+func (d *device) joinRequest(at time.Duration) (*transmission, error) {
+	dr := d.deviceConfig.dataRate
+	chIdx, err := d.selectChannel(dr)
+	if err != nil {
+		return nil, err
+	}
+	devNonce := types.DevNonce{byte(d.devNonce >> 8), byte(d.devNonce)}
+	d.devNonce++
+	msg := &ttnpb.Message{
+		MHdr: &ttnpb.MHDR{
+			MType: ttnpb.MType_JOIN_REQUEST,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_JoinRequestPayload{
+			JoinRequestPayload: &ttnpb.JoinRequestPayload{
+				JoinEui:  d.joinEUI.Bytes(),
+				DevEui:   d.devEUI.Bytes(),
+				DevNonce: devNonce.Bytes(),
+			},
+		},
+	}
+	buf, err := lorawan.MarshalMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+	key := d.appKey
+	if macspec.UseNwkKey(d.macVersion) {
+		key = d.nwkKey
+	}
+	mic, err := crypto.ComputeJoinRequestMIC(key, buf)
+	if err != nil {
+		return nil, err
+	}
+	ch := d.channels[chIdx]
+	d.last = &lastUplink{
+		timestamp:     concentratorTimestamp(at),
+		join:          true,
+		devNonce:      devNonce,
+		rx1Delay:      d.phy.JoinAcceptDelay1,
+		rx1Frequency:  ch.downlinkFrequency,
+		rx2Frequency:  d.phy.DefaultRx2Parameters.Frequency,
+		transmissions: 1,
+	}
+	d.stats.JoinRequests++
+	d.trace(Event{
+		Time:      at,
+		Type:      EventJoinRequest,
+		Device:    d.ID(),
+		DevNonce:  uint32(d.devNonce - 1),
+		Frequency: ch.uplinkFrequency,
+		DataRate:  uint32(dr),
+	})
+	return &transmission{
+		key:           d.ID() + "/join/" + devNonce.String(),
+		payload:       append(buf, mic[:]...),
+		frequency:     ch.uplinkFrequency,
+		dataRateIndex: dr,
+		dataRate:      d.phy.DataRates[dr].Rate,
+		eirp:          d.eirp(),
+		location:      d.loc,
+	}, nil
+}
+
+// macAnswers returns the MAC commands to send in the next uplink.
+func (d *device) macAnswers() []*ttnpb.MACCommand {
+	cmds := make([]*ttnpb.MACCommand, 0, len(d.answers)+len(d.stickyAnswers)+len(d.pendingRequests))
+	cmds = append(cmds, d.answers...)
+	cmds = append(cmds, d.stickyAnswers...)
+	// Send the pending requests in a deterministic order.
+	for _, cid := range []ttnpb.MACCommandIdentifier{
+		ttnpb.MACCommandIdentifier_CID_RESET,
+		ttnpb.MACCommandIdentifier_CID_REKEY,
+		ttnpb.MACCommandIdentifier_CID_DEVICE_MODE,
+		ttnpb.MACCommandIdentifier_CID_DEVICE_TIME,
+		ttnpb.MACCommandIdentifier_CID_PING_SLOT_INFO,
+	} {
+		if cmd, ok := d.pendingRequests[cid]; ok {
+			cmds = append(cmds, cmd)
+		}
+	}
+	return cmds
+}
+
+func (d *device) appendMACCommands(b []byte, cmds []*ttnpb.MACCommand) ([]byte, error) {
+	var err error
+	for _, cmd := range cmds {
+		b, err = lorawan.DefaultMACCommands.AppendUplink(*d.phy, b, cmd)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// backOffADR implements the ADR backoff of the device when it does not receive downlinks.
+func (d *device) backOffADR() {
+	if !d.adr {
+		return
+	}
+	d.adrAckCnt++
+	if d.adrAckCnt < d.adrAckLimit+d.adrAckDelay {
+		return
+	}
+	d.adrAckCnt = d.adrAckLimit
+	switch {
+	case d.txPowerIndex > 0:
+		d.txPowerIndex = 0
+	case d.dataRate > d.minDataRate():
+		d.dataRate--
+	default:
+		for i, ch := range d.phy.UplinkChannels {
+			if i < len(d.channels) {
+				d.channels[i].enabled = true
+				d.channels[i].uplinkFrequency = ch.Frequency
+			}
+		}
+	}
+}
+
+func (d *device) minDataRate() ttnpb.DataRateIndex {
+	min := ttnpb.DataRateIndex_DATA_RATE_15
+	for _, ch := range d.channels {
+		if ch.enabled && ch.minDataRate < min {
+			min = ch.minDataRate
+		}
+	}
+	return min
+}
+
+//nolint:gocyclo
+func (d *device) dataUplink(at time.Duration, retransmission bool) (*transmission, error) {
+	if !retransmission {
+		if d.last != nil && !d.last.join {
+			if d.fCntUp == math.MaxUint32 {
+				return nil, errFCntUpExhausted.New()
+			}
+			d.fCntUp++
+		}
+		d.backOffADR()
+	}
+	dr := d.dataRate
+	chIdx, err := d.selectChannel(dr)
+	if err != nil {
+		return nil, err
+	}
+	ch := d.channels[chIdx]
+
+	fOpts, err := d.appendMACCommands(nil, d.macAnswers())
+	if err != nil {
+		return nil, err
+	}
+	fPort, frmPayload := d.fPort, d.uplink()
+	switch maxSize := int(d.phy.DataRates[dr].MaxMACPayloadSize(false)); {
+	case len(fOpts) > maxFOptsLength:
+		// Send the MAC commands in the FRMPayload.
+		fPort, frmPayload, fOpts = 0, fOpts, nil
+		if len(frmPayload)+8 > maxSize {
+			frmPayload = frmPayload[:maxSize-8]
+		}
+	case len(fOpts)+len(frmPayload)+8 > maxSize:
+		if len(fOpts) > 0 {
+			fPort, frmPayload, fOpts = 0, fOpts, nil
+		} else if maxSize > 8 {
+			frmPayload = frmPayload[:maxSize-8]
+		}
+	}
+
+	key := d.appSKey
+	if fPort == 0 {
+		key = d.nwkSEncKey
+	}
+	if frmPayload, err = crypto.EncryptUplink(key, d.devAddr, d.fCntUp, frmPayload); err != nil {
+		return nil, err
+	}
+	if len(fOpts) > 0 && macspec.EncryptFOpts(d.version) {
+		encOpts := macspec.EncryptionOptions(d.version, macspec.UplinkFrame, fPort, true)
+		if fOpts, err = crypto.EncryptUplink(d.nwkSEncKey, d.devAddr, d.fCntUp, fOpts, encOpts...); err != nil {
+			return nil, err
+		}
+	}
+
+	mType := ttnpb.MType_UNCONFIRMED_UP
+	if d.confirmed {
+		mType = ttnpb.MType_CONFIRMED_UP
+	}
+	var confFCnt uint32
+	ack := d.ackFCntDown != nil
+	if ack {
+		confFCnt = *d.ackFCntDown
+	}
+	msg := &ttnpb.Message{
+		MHdr: &ttnpb.MHDR{
+			MType: mType,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_MacPayload{
+			MacPayload: &ttnpb.MACPayload{
+				FHdr: &ttnpb.FHDR{
+					DevAddr: d.devAddr.Bytes(),
+					FCtrl: &ttnpb.FCtrl{
+						Adr:       d.adr,
+						AdrAckReq: d.adr && d.adrAckCnt >= d.adrAckLimit,
+						Ack:       ack,
+						ClassB:    d.classBReady,
+					},
+					FCnt:  d.fCntUp,
+					FOpts: fOpts,
+				},
+				FPort:      fPort,
+				FrmPayload: frmPayload,
+			},
+		},
+	}
+	buf, err := lorawan.MarshalMessage(msg)
+	if err != nil {
+		return nil, err
+	}
+	var mic [4]byte
+	if macspec.UseLegacyMIC(d.version) {
+		mic, err = crypto.ComputeLegacyUplinkMIC(d.fNwkSIntKey, d.devAddr, d.fCntUp, buf)
+	} else {
+		mic, err = crypto.ComputeUplinkMIC(
+			d.sNwkSIntKey, d.fNwkSIntKey, confFCnt, uint8(dr), uint8(chIdx), d.devAddr, d.fCntUp, buf,
+		)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	transmissions, delivered := uint32(1), false
+	if retransmission {
+		transmissions, delivered = d.last.transmissions+1, d.last.delivered
+	}
+	d.last = &lastUplink{
+		timestamp:     concentratorTimestamp(at),
+		fCnt:          d.fCntUp,
+		confirmed:     d.confirmed,
+		rx1Delay:      d.rx1Delay,
+		rx2Frequency:  d.rx2Frequency,
+		transmissions: transmissions,
+		delivered:     delivered,
+	}
+	if rx1ChIdx, err := d.phy.Rx1Channel(uint8(chIdx)); err == nil && int(rx1ChIdx) < len(d.channels) {
+		d.last.rx1Frequency = d.channels[rx1ChIdx].downlinkFrequency
+	}
+	// The answers are sent once; the sticky answers are sent until a downlink is received.
+	d.answers = nil
+	d.ackFCntDown = nil
+	if !retransmission {
+		d.stats.Uplinks++
+		if d.confirmed {
+			d.stats.ConfirmedUplinks++
+		}
+	}
+	d.trace(Event{
+		Time:           at,
+		Type:           EventUplink,
+		Device:         d.ID(),
+		DevAddr:        d.devAddr.String(),
+		FCnt:           d.fCntUp,
+		FPort:          fPort,
+		Confirmed:      d.confirmed,
+		Frequency:      ch.uplinkFrequency,
+		DataRate:       uint32(dr),
+		EIRP:           d.eirp(),
+		Retransmission: retransmission,
+	})
+	return &transmission{
+		key:           d.ID() + "/up/" + d.devAddr.String() + "/" + strconv.FormatUint(uint64(d.fCntUp), 10) + "/" + strconv.FormatUint(uint64(transmissions), 10),
+		payload:       append(buf, mic[:]...),
+		frequency:     ch.uplinkFrequency,
+		dataRateIndex: dr,
+		dataRate:      d.phy.DataRates[dr].Rate,
+		eirp:          d.eirp(),
+		location:      d.loc,
+	}, nil
+}
+
+// UplinkReceived registers that the last transmission has been received by at least one gateway.
+// Data frames are counted once, regardless of the number of transmissions.
+func (d *device) UplinkReceived() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.last == nil || d.last.join || d.last.delivered {
+		return
+	}
+	d.last.delivered = true
+	d.stats.UplinksReceived++
+}
+
+// downlink is a frame transmitted by a gateway.
+type downlink struct {
+	gatewayID string
+	payload   []byte
+	frequency uint64
+	dataRate  *ttnpb.DataRate
+	txPower   float64
+	// timestamp is the concentrator timestamp at which the downlink is transmitted.
+	timestamp uint32
+	// time is the absolute time at which the downlink is transmitted, used for class B.
+	time *time.Time
+	// immediate indicates that the downlink is transmitted immediately, used for class C.
+	immediate bool
+}
+
+// rxWindow identifies the receive window in which a downlink is received.
+type rxWindow int
+
+const (
+	rxWindowNone rxWindow = iota
+	rxWindow1
+	rxWindow2
+	rxWindowClassB
+	rxWindowClassC
+)
+
+func (w rxWindow) String() string {
+	switch w {
+	case rxWindow1:
+		return "rx1"
+	case rxWindow2:
+		return "rx2"
+	case rxWindowClassB:
+		return "ping_slot"
+	case rxWindowClassC:
+		return "class_c"
+	default:
+		return "none"
+	}
+}
+
+func withinTolerance(actual uint32, expected time.Duration) bool {
+	diff := time.Duration(actual)*time.Microsecond - expected
+	return diff >= -rxWindowTolerance && diff <= rxWindowTolerance
+}
+
+// window returns the receive window of the device in which the downlink is received.
+func (d *device) window(down *downlink) rxWindow {
+	if last := d.last; last != nil && !down.immediate && down.time == nil {
+		delta := down.timestamp - last.timestamp
+		switch {
+		case down.frequency == last.rx1Frequency && withinTolerance(delta, last.rx1Delay):
+			return rxWindow1
+		case down.frequency == last.rx2Frequency && withinTolerance(delta, last.rx1Delay+time.Second):
+			return rxWindow2
+		}
+	}
+	if !d.joined {
+		return rxWindowNone
+	}
+	switch {
+	case d.class == ttnpb.Class_CLASS_B && d.classBReady && down.time != nil && d.isPingSlotFrequency(down.frequency):
+		return rxWindowClassB
+	case d.class == ttnpb.Class_CLASS_C && down.frequency == d.rx2Frequency:
+		return rxWindowClassC
+	}
+	return rxWindowNone
+}
+
+func (d *device) isPingSlotFrequency(freq uint64) bool {
+	if freq == d.pingSlotFreq {
+		return true
+	}
+	for _, f := range d.phy.PingSlotFrequencies {
+		if f == freq {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchJoinAccept returns whether the device is waiting for a join-accept in the receive window of the downlink.
+func (d *device) MatchJoinAccept(down *downlink) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.last != nil && d.last.join && !d.last.received && d.window(down) != rxWindowNone
+}
+
+// HandleJoinAccept handles the join-accept. It returns an error if the join-accept is not for this device.
+// The receive function is called when the join-accept is for this device, to determine whether it is received.
+func (d *device) HandleJoinAccept(now time.Duration, down *downlink, receive func() (linkResult, bool)) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.joined || d.last == nil || !d.last.join {
+		return errJoinAcceptMIC.New()
+	}
+	key := d.appKey
+	if macspec.UseNwkKey(d.macVersion) {
+		key = d.nwkKey
+	}
+	if len(down.payload) < 17 {
+		return errJoinAcceptMIC.New()
+	}
+	decrypted, err := crypto.DecryptJoinAccept(key, down.payload[1:])
+	if err != nil {
+		return err
+	}
+	joinAcceptBytes := decrypted[:len(decrypted)-4]
+	mic := decrypted[len(decrypted)-4:]
+	pld := &ttnpb.JoinAcceptPayload{}
+	if err := lorawan.UnmarshalJoinAcceptPayload(joinAcceptBytes, pld); err != nil {
+		return err
+	}
+	optNeg := macspec.UseNwkKey(d.macVersion) && pld.GetDlSettings().GetOptNeg()
+	var expectedMIC [4]byte
+	if optNeg {
+		expectedMIC, err = crypto.ComputeJoinAcceptMIC(
+			crypto.DeriveJSIntKey(d.nwkKey, d.devEUI), 0xff, d.joinEUI, d.last.devNonce,
+			append([]byte{down.payload[0]}, joinAcceptBytes...),
+		)
+	} else {
+		expectedMIC, err = crypto.ComputeLegacyJoinAcceptMIC(key, append([]byte{down.payload[0]}, joinAcceptBytes...))
+	}
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(mic, expectedMIC[:]) {
+		return errJoinAcceptMIC.New()
+	}
+	res, ok := receive()
+	if !ok {
+		d.stats.DownlinksLost++
+		return nil
+	}
+
+	devNonce := d.last.devNonce
+	joinNonce := types.MustJoinNonce(pld.JoinNonce).OrZero()
+	window := d.window(down)
+	d.resetSession()
+	d.joined = true
+	d.last.received = true
+	d.devAddr = types.MustDevAddr(pld.DevAddr).OrZero()
+	if optNeg {
+		d.version = d.macVersion
+		d.appSKey = crypto.DeriveAppSKey(d.appKey, joinNonce, d.joinEUI, devNonce)
+		d.fNwkSIntKey = crypto.DeriveFNwkSIntKey(d.nwkKey, joinNonce, d.joinEUI, devNonce)
+		d.sNwkSIntKey = crypto.DeriveSNwkSIntKey(d.nwkKey, joinNonce, d.joinEUI, devNonce)
+		d.nwkSEncKey = crypto.DeriveNwkSEncKey(d.nwkKey, joinNonce, d.joinEUI, devNonce)
+		d.pendingRequests[ttnpb.MACCommandIdentifier_CID_REKEY] = (&ttnpb.MACCommand_RekeyInd{
+			MinorVersion: ttnpb.Minor_MINOR_1,
+		}).MACCommand()
+	} else {
+		d.version = d.macVersion
+		if macspec.UseNwkKey(d.macVersion) {
+			// LoRaWAN 1.1 devices fall back to LoRaWAN 1.0.3 if the network does not support LoRaWAN 1.1.
+			d.version = ttnpb.MACVersion_MAC_V1_0_3
+		}
+		netID := types.MustNetID(pld.NetId).OrZero()
+		d.appSKey = crypto.DeriveLegacyAppSKey(key, joinNonce, netID, devNonce)
+		nwkSKey := crypto.DeriveLegacyNwkSKey(key, joinNonce, netID, devNonce)
+		d.fNwkSIntKey, d.sNwkSIntKey, d.nwkSEncKey = nwkSKey, nwkSKey, nwkSKey
+	}
+	d.rx1DROffset = pld.GetDlSettings().GetRx1DrOffset()
+	d.rx2DataRate = pld.GetDlSettings().GetRx2Dr()
+	if pld.RxDelay != 0 {
+		d.rx1Delay = pld.RxDelay.Duration()
+	}
+	if cfList := pld.CfList; cfList != nil {
+		d.applyCFList(cfList)
+	}
+	d.sessionStarted()
+	d.lastDownlinkSNR = res.SNR
+	d.stats.JoinAccepts++
+	d.trace(Event{
+		Time:    now,
+		Type:    EventJoinAccept,
+		Device:  d.ID(),
+		Gateway: down.gatewayID,
+		DevAddr: d.devAddr.String(),
+		Window:  window.String(),
+	})
+	return nil
+}
+
+func (d *device) applyCFList(cfList *ttnpb.CFList) {
+	switch cfList.Type {
+	case ttnpb.CFListType_FREQUENCIES:
+		for i, freq := range cfList.Freq {
+			if freq == 0 {
+				continue
+			}
+			f := uint64(freq) * d.phy.FreqMultiplier
+			d.setChannel(len(d.phy.UplinkChannels)+i, deviceChannel{
+				uplinkFrequency:   f,
+				downlinkFrequency: f,
+				minDataRate:       0,
+				maxDataRate:       d.phy.MaxADRDataRateIndex,
+				enabled:           true,
+			})
+		}
+	case ttnpb.CFListType_CHANNEL_MASKS:
+		for i := range d.channels {
+			if i < len(cfList.ChMasks) {
+				d.channels[i].enabled = cfList.ChMasks[i]
+			}
+		}
+	}
+}
+
+func (d *device) setChannel(i int, ch deviceChannel) {
+	for len(d.channels) <= i {
+		d.channels = append(d.channels, deviceChannel{})
+	}
+	d.channels[i] = ch
+}
+
+// HandleDataDownlink handles the data downlink. It returns false if the downlink is not for this device.
+// The receive function is called when the downlink is for this device, to determine whether it is received.
+//
+//nolint:gocyclo
+func (d *device) HandleDataDownlink(
+	now time.Duration, down *downlink, msg *ttnpb.Message, receive func() (linkResult, bool),
+) (bool, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	pld := msg.GetMacPayload()
+	if !d.joined || !bytes.Equal(pld.GetFHdr().GetDevAddr(), d.devAddr.Bytes()) {
+		return false, nil
+	}
+	window := d.window(down)
+	if window == rxWindowNone {
+		return false, nil
+	}
+	if (window == rxWindow1 || window == rxWindow2) && d.last.received {
+		// A downlink has already been received in the RX1 window.
+		return false, nil
+	}
+	res, ok := receive()
+	if !ok {
+		d.stats.DownlinksLost++
+		return true, nil
+	}
+
+	// Reconstruct the 32-bit frame counter.
+	lastFCnt, received := d.lastNFCntDown, d.receivedNFCntDown
+	afCnt := !macspec.UseSharedFCntDown(d.version) && pld.FPort != 0
+	if afCnt {
+		lastFCnt, received = d.lastAFCntDown, d.receivedAFCntDown
+	}
+	fCnt := lastFCnt&0xffff0000 | pld.FHdr.FCnt&0xffff
+	if fCnt < lastFCnt || (received && fCnt == lastFCnt) {
+		fCnt += 0x10000
+	}
+	if received && fCnt <= lastFCnt {
+		d.stats.DownlinksInvalid++
+		return true, errDownlinkFCnt.WithAttributes("f_cnt", fCnt, "last_f_cnt", lastFCnt)
+	}
+
+	raw := down.payload[:len(down.payload)-4]
+	var (
+		expectedMIC [4]byte
+		err         error
+	)
+	if macspec.UseLegacyMIC(d.version) {
+		expectedMIC, err = crypto.ComputeLegacyDownlinkMIC(d.sNwkSIntKey, d.devAddr, fCnt, raw)
+	} else {
+		var confFCnt uint32
+		if pld.FHdr.FCtrl.GetAck() && d.last != nil && d.last.confirmed {
+			confFCnt = d.last.fCnt
+		}
+		expectedMIC, err = crypto.ComputeDownlinkMIC(d.sNwkSIntKey, d.devAddr, confFCnt, fCnt, raw)
+	}
+	if err != nil {
+		return true, err
+	}
+	if !bytes.Equal(msg.Mic, expectedMIC[:]) {
+		d.stats.DownlinksInvalid++
+		return true, errDownlinkMIC.New()
+	}
+
+	fOpts := pld.FHdr.FOpts
+	if len(fOpts) > 0 && macspec.EncryptFOpts(d.version) {
+		encOpts := macspec.EncryptionOptions(d.version, macspec.DownlinkFrame, pld.FPort, true)
+		if fOpts, err = crypto.DecryptDownlink(d.nwkSEncKey, d.devAddr, fCnt, fOpts, encOpts...); err != nil {
+			return true, err
+		}
+	}
+	key := d.appSKey
+	if pld.FPort == 0 {
+		key = d.nwkSEncKey
+	}
+	frmPayload, err := crypto.DecryptDownlink(key, d.devAddr, fCnt, pld.FrmPayload)
+	if err != nil {
+		return true, err
+	}
+
+	if afCnt {
+		d.lastAFCntDown, d.receivedAFCntDown = fCnt, true
+	} else {
+		d.lastNFCntDown, d.receivedNFCntDown = fCnt, true
+	}
+	if window == rxWindow1 || window == rxWindow2 {
+		d.last.received = true
+	}
+	if pld.FHdr.FCtrl.GetAck() && d.last != nil && d.last.confirmed && !d.last.acked {
+		d.last.acked = true
+		d.stats.ConfirmedAcked++
+	}
+	if msg.MHdr.MType == ttnpb.MType_CONFIRMED_DOWN {
+		d.ackFCntDown = &fCnt
+	}
+	d.adrAckCnt = 0
+	d.stickyAnswers = nil
+	d.lastDownlinkSNR = res.SNR
+	d.stats.Downlinks++
+
+	cmdBuf := fOpts
+	if pld.FPort == 0 && len(frmPayload) > 0 {
+		cmdBuf = frmPayload
+	}
+	var cmds []*ttnpb.MACCommand
+	for r := bytes.NewReader(cmdBuf); r.Len() > 0; {
+		cmd := &ttnpb.MACCommand{}
+		if err := lorawan.DefaultMACCommands.ReadDownlink(*d.phy, r, cmd); err != nil {
+			break
+		}
+		cmds = append(cmds, cmd)
+	}
+	d.stats.MACCommandsReceived += uint64(len(cmds))
+	d.handleMACCommands(cmds)
+
+	cids := make([]string, 0, len(cmds))
+	for _, cmd := range cmds {
+		cids = append(cids, cmd.Cid.String())
+	}
+	ev := Event{
+		Time:        now,
+		Type:        EventDownlink,
+		Device:      d.ID(),
+		Gateway:     down.gatewayID,
+		DevAddr:     d.devAddr.String(),
+		FCnt:        fCnt,
+		FPort:       pld.FPort,
+		Confirmed:   msg.MHdr.MType == ttnpb.MType_CONFIRMED_DOWN,
+		Ack:         pld.FHdr.FCtrl.GetAck(),
+		Frequency:   down.frequency,
+		Window:      window.String(),
+		MACCommands: cids,
+	}
+	if pld.FPort != 0 {
+		ev.Payload = frmPayload
+	}
+	d.trace(ev)
+	return true, nil
+}
+
+func (d *device) answer(cmd *ttnpb.MACCommand) {
+	d.answers = append(d.answers, cmd)
+}
+
+func (d *device) stickyAnswer(cmd *ttnpb.MACCommand) {
+	d.stickyAnswers = append(d.stickyAnswers, cmd)
+}
+
+// handleMACCommands applies the MAC commands and queues the answers.
+//
+//nolint:gocyclo
+func (d *device) handleMACCommands(cmds []*ttnpb.MACCommand) {
+	for i := 0; i < len(cmds); i++ {
+		cmd := cmds[i]
+		switch cmd.Cid {
+		case ttnpb.MACCommandIdentifier_CID_LINK_ADR:
+			// Consecutive LinkADRReq commands are handled as a block.
+			j := i + 1
+			for j < len(cmds) && cmds[j].Cid == ttnpb.MACCommandIdentifier_CID_LINK_ADR {
+				j++
+			}
+			d.handleLinkADRReqs(cmds[i:j])
+			i = j - 1
+
+		case ttnpb.MACCommandIdentifier_CID_DUTY_CYCLE:
+			d.answer(ttnpb.MACCommandIdentifier_CID_DUTY_CYCLE.MACCommand())
+
+		case ttnpb.MACCommandIdentifier_CID_RX_PARAM_SETUP:
+			req := cmd.GetRxParamSetupReq()
+			_, drOK := d.phy.DataRates[req.Rx2DataRateIndex]
+			freqOK := req.Rx2Frequency != 0
+			if drOK && freqOK {
+				d.rx1DROffset = req.Rx1DataRateOffset
+				d.rx2DataRate = req.Rx2DataRateIndex
+				d.rx2Frequency = req.Rx2Frequency
+			}
+			d.stickyAnswer((&ttnpb.MACCommand_RxParamSetupAns{
+				Rx2DataRateIndexAck:  drOK,
+				Rx1DataRateOffsetAck: drOK,
+				Rx2FrequencyAck:      freqOK,
+			}).MACCommand())
+
+		case ttnpb.MACCommandIdentifier_CID_DEV_STATUS:
+			margin := int32(math.Round(d.lastDownlinkSNR))
+			if margin < -32 {
+				margin = -32
+			} else if margin > 31 {
+				margin = 31
+			}
+			d.answer((&ttnpb.MACCommand_DevStatusAns{
+				Battery: d.battery,
+				Margin:  margin,
+			}).MACCommand())
+
+		case ttnpb.MACCommandIdentifier_CID_NEW_CHANNEL:
+			req := cmd.GetNewChannelReq()
+			drOK := req.MinDataRateIndex <= req.MaxDataRateIndex
+			if drOK {
+				_, drOK = d.phy.DataRates[req.MaxDataRateIndex]
+			}
+			if drOK {
+				if req.Frequency == 0 {
+					if int(req.ChannelIndex) < len(d.channels) {
+						d.channels[req.ChannelIndex] = deviceChannel{}
+					}
+				} else {
+					d.setChannel(int(req.ChannelIndex), deviceChannel{
+						uplinkFrequency:   req.Frequency,
+						downlinkFrequency: req.Frequency,
+						minDataRate:       req.MinDataRateIndex,
+						maxDataRate:       req.MaxDataRateIndex,
+						enabled:           true,
+					})
+				}
+			}
+			d.answer((&ttnpb.MACCommand_NewChannelAns{
+				FrequencyAck: true,
+				DataRateAck:  drOK,
+			}).MACCommand())
+
+		case ttnpb.MACCommandIdentifier_CID_RX_TIMING_SETUP:
+			d.rx1Delay = cmd.GetRxTimingSetupReq().Delay.Duration()
+			d.stickyAnswer(ttnpb.MACCommandIdentifier_CID_RX_TIMING_SETUP.MACCommand())
+
+		case ttnpb.MACCommandIdentifier_CID_TX_PARAM_SETUP:
+			req := cmd.GetTxParamSetupReq()
+			if !d.phy.TxParamSetupReqSupport {
+				continue
+			}
+			d.maxEIRP = lorawan.DeviceEIRPToFloat32(req.MaxEirpIndex)
+			if d.maxEIRP > d.deviceConfig.maxEIRP {
+				d.maxEIRP = d.deviceConfig.maxEIRP
+			}
+			d.answer(ttnpb.MACCommandIdentifier_CID_TX_PARAM_SETUP.MACCommand())
+
+		case ttnpb.MACCommandIdentifier_CID_DL_CHANNEL:
+			req := cmd.GetDlChannelReq()
+			chOK := int(req.ChannelIndex) < len(d.channels) && d.channels[req.ChannelIndex].uplinkFrequency != 0
+			freqOK := req.Frequency != 0
+			if chOK && freqOK {
+				d.channels[req.ChannelIndex].downlinkFrequency = req.Frequency
+			}
+			d.stickyAnswer((&ttnpb.MACCommand_DLChannelAns{
+				ChannelIndexAck: chOK,
+				FrequencyAck:    freqOK,
+			}).MACCommand())
+
+		case ttnpb.MACCommandIdentifier_CID_ADR_PARAM_SETUP:
+			req := cmd.GetAdrParamSetupReq()
+			d.adrAckLimit = 1 << uint32(req.AdrAckLimitExponent)
+			d.adrAckDelay = 1 << uint32(req.AdrAckDelayExponent)
+			d.answer(ttnpb.MACCommandIdentifier_CID_ADR_PARAM_SETUP.MACCommand())
+
+		case ttnpb.MACCommandIdentifier_CID_REJOIN_PARAM_SETUP:
+			d.answer((&ttnpb.MACCommand_RejoinParamSetupAns{
+				MaxTimeExponentAck: true,
+			}).MACCommand())
+
+		case ttnpb.MACCommandIdentifier_CID_REKEY:
+			delete(d.pendingRequests, ttnpb.MACCommandIdentifier_CID_REKEY)
+
+		case ttnpb.MACCommandIdentifier_CID_RESET:
+			delete(d.pendingRequests, ttnpb.MACCommandIdentifier_CID_RESET)
+
+		case ttnpb.MACCommandIdentifier_CID_DEVICE_MODE:
+			delete(d.pendingRequests, ttnpb.MACCommandIdentifier_CID_DEVICE_MODE)
+
+		case ttnpb.MACCommandIdentifier_CID_DEVICE_TIME:
+			delete(d.pendingRequests, ttnpb.MACCommandIdentifier_CID_DEVICE_TIME)
+			d.timeSynced = true
+			if d.class == ttnpb.Class_CLASS_B && !d.classBReady {
+				d.pendingRequests[ttnpb.MACCommandIdentifier_CID_PING_SLOT_INFO] = (&ttnpb.MACCommand_PingSlotInfoReq{
+					Period: d.pingSlotPeriod,
+				}).MACCommand()
+			}
+
+		case ttnpb.MACCommandIdentifier_CID_PING_SLOT_INFO:
+			if _, ok := d.pendingRequests[ttnpb.MACCommandIdentifier_CID_PING_SLOT_INFO]; ok {
+				delete(d.pendingRequests, ttnpb.MACCommandIdentifier_CID_PING_SLOT_INFO)
+				d.classBReady = d.timeSynced
+			}
+
+		case ttnpb.MACCommandIdentifier_CID_PING_SLOT_CHANNEL:
+			req := cmd.GetPingSlotChannelReq()
+			_, drOK := d.phy.DataRates[req.DataRateIndex]
+			if drOK && req.Frequency != 0 {
+				d.pingSlotFreq = req.Frequency
+			}
+			d.answer((&ttnpb.MACCommand_PingSlotChannelAns{
+				FrequencyAck:     true,
+				DataRateIndexAck: drOK,
+			}).MACCommand())
+
+		case ttnpb.MACCommandIdentifier_CID_BEACON_FREQ:
+			d.answer((&ttnpb.MACCommand_BeaconFreqAns{
+				FrequencyAck: true,
+			}).MACCommand())
+		}
+	}
+}
+
+// handleLinkADRReqs handles a block of LinkADRReq commands.
+func (d *device) handleLinkADRReqs(reqs []*ttnpb.MACCommand) {
+	enabled := make([]bool, len(d.channels))
+	for i, ch := range d.channels {
+		enabled[i] = ch.enabled
+	}
+	chMaskOK := true
+	var last *ttnpb.MACCommand_LinkADRReq
+	for _, cmd := range reqs {
+		req := cmd.GetLinkAdrReq()
+		last = req
+		var mask [16]bool
+		copy(mask[:], req.ChannelMask)
+		chs, err := d.phy.ParseChMask(mask, uint8(req.ChannelMaskControl))
+		if err != nil {
+			chMaskOK = false
+			continue
+		}
+		for idx, on := range chs {
+			switch {
+			case int(idx) < len(enabled):
+				enabled[idx] = on && d.channels[idx].uplinkFrequency != 0
+			case on:
+				chMaskOK = false
+			}
+		}
+	}
+	anyEnabled := false
+	for _, on := range enabled {
+		anyEnabled = anyEnabled || on
+	}
+	chMaskOK = chMaskOK && anyEnabled
+
+	noChange := macspec.HasNoChangeADRIndices(d.version)
+	dr := last.DataRateIndex
+	if noChange && dr == ttnpb.DataRateIndex_DATA_RATE_15 {
+		dr = d.dataRate
+	}
+	_, drOK := d.phy.DataRates[dr]
+	if drOK && chMaskOK {
+		drOK = false
+		for i, ch := range d.channels {
+			if enabled[i] && ch.minDataRate <= dr && dr <= ch.maxDataRate {
+				drOK = true
+				break
+			}
+		}
+	}
+	txPower := last.TxPowerIndex
+	if noChange && txPower == 15 {
+		txPower = d.txPowerIndex
+	}
+	txPowerOK := txPower <= uint32(d.phy.MaxTxPowerIndex())
+
+	if chMaskOK && drOK && txPowerOK {
+		for i := range d.channels {
+			d.channels[i].enabled = enabled[i]
+		}
+		d.dataRate = dr
+		d.txPowerIndex = txPower
+		d.nbTrans = last.NbTrans
+		if d.nbTrans == 0 {
+			d.nbTrans = 1
+		}
+	}
+	ans := &ttnpb.MACCommand_LinkADRAns{
+		ChannelMaskAck:   chMaskOK,
+		DataRateIndexAck: drOK,
+		TxPowerIndexAck:  txPowerOK,
+	}
+	n := len(reqs)
+	if macspec.SingularLinkADRAns(d.version) {
+		n = 1
+	}
+	for i := 0; i < n; i++ {
+		d.answer(ans.MACCommand())
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var (
+	testAppKey  = types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10}
+	testDevAddr = types.DevAddr{0x26, 0x01, 0x02, 0x03}
+	testNetID   = types.NetID{0x00, 0x00, 0x13}
+)
+
+func newTestDevice(t *testing.T) *device {
+	t.Helper()
+	confs, err := DeviceGroup{
+		Name:        "test",
+		Count:       1,
+		JoinEUI:     "70B3D57ED0000000",
+		DevEUI:      "0004A30B001C0530",
+		AppKey:      testAppKey.String(),
+		Interval:    time.Minute,
+		FPort:       1,
+		PayloadSize: 4,
+	}.devices(band.EU_863_870)
+	if err != nil {
+		t.Fatalf("Failed to expand device group: %v", err)
+	}
+	phy := test.Must(band.Get(band.EU_863_870, confs[0].phyVersion))
+	return newDevice(confs[0], &phy, 42, func(Event) {})
+}
+
+func receiveAll() (linkResult, bool) {
+	return linkResult{Received: true, RSSI: -80, SNR: 7}, true
+}
+
+func joinAccept(t *testing.T, joinNonce types.JoinNonce) []byte {
+	t.Helper()
+	pld := test.Must(lorawan.MarshalJoinAcceptPayload(&ttnpb.JoinAcceptPayload{
+		JoinNonce:  joinNonce.Bytes(),
+		NetId:      testNetID.Bytes(),
+		DevAddr:    testDevAddr.Bytes(),
+		DlSettings: &ttnpb.DLSettings{Rx2Dr: ttnpb.DataRateIndex_DATA_RATE_3},
+		RxDelay:    ttnpb.RxDelay_RX_DELAY_1,
+	}))
+	mhdr := []byte{0x20}
+	mic := test.Must(crypto.ComputeLegacyJoinAcceptMIC(testAppKey, append(mhdr, pld...)))
+	enc := test.Must(crypto.EncryptJoinAccept(testAppKey, append(pld, mic[:]...)))
+	return append(mhdr, enc...)
+}
+
+func TestDeviceJoin(t *testing.T) {
+	a := assertions.New(t)
+	dev := newTestDevice(t)
+
+	tx, _, err := dev.Transmit(0)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	msg := &ttnpb.Message{}
+	if !a.So(lorawan.UnmarshalMessage(tx.payload, msg), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(msg.MHdr.MType, should.Equal, ttnpb.MType_JOIN_REQUEST)
+	mic := test.Must(crypto.ComputeJoinRequestMIC(testAppKey, tx.payload[:len(tx.payload)-4]))
+	a.So(mic[:], should.Resemble, msg.Mic)
+
+	down := &downlink{
+		payload:   joinAccept(t, types.JoinNonce{0x00, 0x00, 0x01}),
+		frequency: dev.last.rx1Frequency,
+		dataRate:  tx.dataRate,
+		timestamp: concentratorTimestamp(dev.phy.JoinAcceptDelay1),
+	}
+	a.So(dev.MatchJoinAccept(down), should.BeTrue)
+
+	// A join-accept encrypted with another key is not for this device.
+	other := *down
+	other.payload = append([]byte(nil), down.payload...)
+	other.payload[len(other.payload)-1] ^= 0xff
+	a.So(dev.HandleJoinAccept(time.Second, &other, receiveAll), should.HaveSameErrorDefinitionAs, errJoinAcceptMIC)
+	a.So(dev.Joined(), should.BeFalse)
+
+	a.So(dev.HandleJoinAccept(5*time.Second, down, receiveAll), should.BeNil)
+	a.So(dev.Joined(), should.BeTrue)
+	addr, ok := dev.DevAddr()
+	a.So(ok, should.BeTrue)
+	a.So(addr, should.Equal, testDevAddr)
+
+	devNonce := types.DevNonce{0x00, 0x00}
+	nwkSKey := crypto.DeriveLegacyNwkSKey(testAppKey, types.JoinNonce{0x00, 0x00, 0x01}, testNetID, devNonce)
+	a.So(dev.fNwkSIntKey, should.Equal, nwkSKey)
+	a.So(dev.appSKey, should.Equal, crypto.DeriveLegacyAppSKey(testAppKey, types.JoinNonce{0x00, 0x00, 0x01}, testNetID, devNonce))
+	a.So(dev.rx2DataRate, should.Equal, ttnpb.DataRateIndex_DATA_RATE_3)
+	a.So(dev.Stats().JoinAccepts, should.Equal, 1)
+
+	// The join-accept is not handled twice.
+	a.So(dev.MatchJoinAccept(down), should.BeFalse)
+}
+
+func TestDeviceLinkADRReq(t *testing.T) {
+	a := assertions.New(t)
+	dev := newTestDevice(t)
+
+	tx, _, err := dev.Transmit(0)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	if !a.So(dev.HandleJoinAccept(5*time.Second, &downlink{
+		payload:   joinAccept(t, types.JoinNonce{0x00, 0x00, 0x01}),
+		frequency: dev.last.rx1Frequency,
+		dataRate:  tx.dataRate,
+		timestamp: concentratorTimestamp(dev.phy.JoinAcceptDelay1),
+	}, receiveAll), should.BeNil) {
+		t.FailNow()
+	}
+
+	at := 10 * time.Second
+	tx, _, err = dev.Transmit(at)
+	if !a.So(err, should.BeNil) || !a.So(tx, should.NotBeNil) {
+		t.FailNow()
+	}
+
+	fOpts := test.Must(lorawan.DefaultMACCommands.AppendDownlink(*dev.phy, nil, (&ttnpb.MACCommand_LinkADRReq{
+		DataRateIndex:      ttnpb.DataRateIndex_DATA_RATE_5,
+		TxPowerIndex:       2,
+		ChannelMask:        []bool{true, true, true},
+		NbTrans:            1,
+		ChannelMaskControl: 0,
+	}).MACCommand()))
+	raw := test.Must(lorawan.MarshalMessage(&ttnpb.Message{
+		MHdr: &ttnpb.MHDR{MType: ttnpb.MType_UNCONFIRMED_DOWN, Major: ttnpb.Major_LORAWAN_R1},
+		Payload: &ttnpb.Message_MacPayload{MacPayload: &ttnpb.MACPayload{
+			FHdr: &ttnpb.FHDR{
+				DevAddr: testDevAddr.Bytes(),
+				FCtrl:   &ttnpb.FCtrl{},
+				FOpts:   fOpts,
+			},
+		}},
+		Mic: make([]byte, 4),
+	}))
+	raw = raw[:len(raw)-4]
+	nwkSKey := crypto.DeriveLegacyNwkSKey(testAppKey, types.JoinNonce{0x00, 0x00, 0x01}, testNetID, types.DevNonce{})
+	mic := test.Must(crypto.ComputeLegacyDownlinkMIC(nwkSKey, testDevAddr, 0, raw))
+	down := &downlink{
+		payload:   append(raw, mic[:]...),
+		frequency: dev.last.rx1Frequency,
+		dataRate:  tx.dataRate,
+		timestamp: concentratorTimestamp(at + time.Second),
+	}
+	msg := &ttnpb.Message{}
+	if !a.So(lorawan.UnmarshalMessage(down.payload, msg), should.BeNil) {
+		t.FailNow()
+	}
+	handled, err := dev.HandleDataDownlink(at+time.Second, down, msg, receiveAll)
+	a.So(err, should.BeNil)
+	a.So(handled, should.BeTrue)
+	a.So(dev.dataRate, should.Equal, ttnpb.DataRateIndex_DATA_RATE_5)
+	a.So(dev.txPowerIndex, should.Equal, 2)
+
+	// The next uplink carries the LinkADRAns.
+	tx, _, err = dev.Transmit(at + 2*time.Minute)
+	if !a.So(err, should.BeNil) || !a.So(tx, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(tx.dataRateIndex, should.Equal, ttnpb.DataRateIndex_DATA_RATE_5)
+	up := &ttnpb.Message{}
+	if !a.So(lorawan.UnmarshalMessage(tx.payload, up), should.BeNil) {
+		t.FailNow()
+	}
+	cmd := &ttnpb.MACCommand{}
+	if !a.So(lorawan.DefaultMACCommands.ReadUplink(
+		*dev.phy, bytes.NewReader(up.GetMacPayload().FHdr.FOpts), cmd,
+	), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(cmd.GetLinkAdrAns(), should.Resemble, &ttnpb.MACCommand_LinkADRAns{
+		ChannelMaskAck:   true,
+		DataRateIndexAck: true,
+		TxPowerIndexAck:  true,
+	})
+}
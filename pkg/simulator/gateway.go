@@ -0,0 +1,189 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var errConnect = errors.DefineUnavailable("connect", "connect gateway `{gateway}`")
+
+// defaultStatusInterval is the default interval at which gateways send status messages.
+const defaultStatusInterval = 30 * time.Second
+
+// concentratorTimestamp returns the 32-bit concentrator timestamp in microseconds at the simulation time.
+// All virtual concentrators start counting at the start of the simulation.
+func concentratorTimestamp(at time.Duration) uint32 {
+	return uint32(at / time.Microsecond)
+}
+
+// rxPacket is an uplink received by a gateway.
+type rxPacket struct {
+	payload       []byte
+	frequency     uint64
+	dataRate      *ttnpb.DataRate
+	dataRateIndex ttnpb.DataRateIndex
+	rssi, snr     float64
+	// at is the simulation time at which the packet is received.
+	at time.Duration
+	// time is the wall clock time at which the packet is received.
+	time time.Time
+}
+
+// uplinkMessage returns the packet as uplink message, which is used for conversion to the gateway protocols.
+func (rx *rxPacket) uplinkMessage() *ttnpb.UplinkMessage {
+	timestamp := concentratorTimestamp(rx.at)
+	return &ttnpb.UplinkMessage{
+		RawPayload: rx.payload,
+		Settings: &ttnpb.TxSettings{
+			DataRate:  rx.dataRate,
+			Frequency: rx.frequency,
+			Timestamp: timestamp,
+		},
+		RxMetadata: []*ttnpb.RxMetadata{{
+			Time:      timestamppb.New(rx.time),
+			Timestamp: timestamp,
+			Rssi:      float32(rx.rssi),
+			Snr:       float32(rx.snr),
+		}},
+		CrcStatus: wrapperspb.Bool(true),
+	}
+}
+
+// gatewayStatus is the status of a gateway.
+type gatewayStatus struct {
+	time     time.Time
+	location Location
+	stats    GatewayStats
+}
+
+// gatewayConn is a connection of a virtual gateway to the Gateway Server.
+type gatewayConn interface {
+	SendUplink(ctx context.Context, rx *rxPacket) error
+	SendStatus(ctx context.Context, status *gatewayStatus) error
+	Close() error
+}
+
+// dialFunc connects the gateway to the Gateway Server. Received downlinks are passed to handler.
+type dialFunc func(ctx context.Context, gtw *gateway, handler func(*downlink)) (gatewayConn, error)
+
+func dialGateway(ctx context.Context, gtw *gateway, handler func(*downlink)) (gatewayConn, error) {
+	switch gtw.Protocol {
+	case ProtocolBasicStation:
+		return dialBasicStation(ctx, gtw, handler)
+	default:
+		return dialUDP(ctx, gtw, handler)
+	}
+}
+
+// GatewayStats contains the statistics of a virtual gateway.
+type GatewayStats struct {
+	UplinksReceived  uint64 `json:"uplinks_received"`
+	UplinksForwarded uint64 `json:"uplinks_forwarded"`
+	UplinkErrors     uint64 `json:"uplink_errors"`
+	Downlinks        uint64 `json:"downlinks"`
+	DownlinkErrors   uint64 `json:"downlink_errors"`
+	StatusMessages   uint64 `json:"status_messages"`
+}
+
+// gateway is a virtual gateway.
+type gateway struct {
+	GatewayConfig
+	eui    types.EUI64
+	bandID string
+	// epoch is the wall clock time of the start of the simulation.
+	epoch time.Time
+
+	conn gatewayConn
+
+	mu    sync.Mutex
+	stats GatewayStats
+}
+
+func newGateway(conf GatewayConfig, bandID string, epoch time.Time) (*gateway, error) {
+	eui, err := parseEUI(conf.EUI, "eui")
+	if err != nil {
+		return nil, err
+	}
+	if conf.StatusInterval <= 0 {
+		conf.StatusInterval = defaultStatusInterval
+	}
+	return &gateway{
+		GatewayConfig: conf,
+		eui:           eui,
+		bandID:        bandID,
+		epoch:         epoch,
+	}, nil
+}
+
+// Stats returns the statistics of the gateway.
+func (g *gateway) Stats() GatewayStats {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.stats
+}
+
+func (g *gateway) update(f func(*GatewayStats)) {
+	g.mu.Lock()
+	f(&g.stats)
+	g.mu.Unlock()
+}
+
+// Receive registers the received packet and forwards it to the Gateway Server.
+func (g *gateway) Receive(ctx context.Context, rx *rxPacket) error {
+	g.update(func(s *GatewayStats) { s.UplinksReceived++ })
+	if g.conn == nil {
+		return nil
+	}
+	if err := g.conn.SendUplink(ctx, rx); err != nil {
+		g.update(func(s *GatewayStats) { s.UplinkErrors++ })
+		return err
+	}
+	g.update(func(s *GatewayStats) { s.UplinksForwarded++ })
+	return nil
+}
+
+// SendStatus sends the gateway status to the Gateway Server.
+func (g *gateway) SendStatus(ctx context.Context, now time.Time) error {
+	stats := g.Stats()
+	if g.conn == nil {
+		return nil
+	}
+	if err := g.conn.SendStatus(ctx, &gatewayStatus{
+		time:     now,
+		location: g.Location,
+		stats:    stats,
+	}); err != nil {
+		return err
+	}
+	g.update(func(s *GatewayStats) { s.StatusMessages++ })
+	return nil
+}
+
+// Close closes the connection to the Gateway Server.
+func (g *gateway) Close() error {
+	if g.conn == nil {
+		return nil
+	}
+	return g.conn.Close()
+}
@@ -0,0 +1,250 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/id6"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/lbslns"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var errDiscover = errors.DefineUnavailable("discover", "discover LNS of gateway `{gateway}`: {message}")
+
+type basicStationConn struct {
+	gtw     *gateway
+	phy     *band.Band
+	conn    *websocket.Conn
+	handler func(*downlink)
+	// sessionID is the session identifier in the upper bits of xtime.
+	sessionID int32
+
+	writeMu sync.Mutex
+	wg      sync.WaitGroup
+}
+
+// dialBasicStation connects the gateway using the LoRa Basics Station LNS protocol.
+// The address of the gateway is the URI of the LNS, which is used for discovery.
+func dialBasicStation(ctx context.Context, gtw *gateway, handler func(*downlink)) (gatewayConn, error) {
+	phy, err := band.GetLatest(gtw.bandID)
+	if err != nil {
+		return nil, err
+	}
+	uri, err := discoverLNS(ctx, gtw)
+	if err != nil {
+		return nil, err
+	}
+	header := http.Header{}
+	if gtw.APIKey != "" {
+		header.Set("Authorization", "Bearer "+gtw.APIKey)
+	}
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, uri, header)
+	if err != nil {
+		return nil, errConnect.WithAttributes("gateway", gtw.ID).WithCause(err)
+	}
+	c := &basicStationConn{
+		gtw:       gtw,
+		phy:       &phy,
+		conn:      conn,
+		handler:   handler,
+		sessionID: int32(gtw.eui.MarshalNumber() & 0x7fff),
+	}
+	if err := c.writeJSON(lbslns.Version{
+		Station:  "simulator",
+		Firmware: "simulator",
+		Package:  "simulator",
+		Model:    "simulator",
+		Protocol: 2,
+	}); err != nil {
+		conn.Close()
+		return nil, errConnect.WithAttributes("gateway", gtw.ID).WithCause(err)
+	}
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		c.read(ctx)
+	}()
+	return c, nil
+}
+
+// discoverLNS queries the LNS URI of the gateway.
+func discoverLNS(ctx context.Context, gtw *gateway) (string, error) {
+	conn, _, err := websocket.DefaultDialer.DialContext(ctx, strings.TrimSuffix(gtw.Address, "/")+"/router-info", nil)
+	if err != nil {
+		return "", errConnect.WithAttributes("gateway", gtw.ID).WithCause(err)
+	}
+	defer conn.Close()
+	if err := conn.WriteJSON(lbslns.DiscoverQuery{
+		EUI: id6.EUI{EUI64: gtw.eui},
+	}); err != nil {
+		return "", errConnect.WithAttributes("gateway", gtw.ID).WithCause(err)
+	}
+	var res lbslns.DiscoverResponse
+	if err := conn.ReadJSON(&res); err != nil {
+		return "", errConnect.WithAttributes("gateway", gtw.ID).WithCause(err)
+	}
+	if res.Error != "" {
+		return "", errDiscover.WithAttributes("gateway", gtw.ID, "message", res.Error)
+	}
+	return res.URI, nil
+}
+
+func (c *basicStationConn) writeJSON(v interface{}) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.conn.WriteJSON(v)
+}
+
+// xTime returns the xtime of the simulation time.
+func (c *basicStationConn) xTime(at time.Duration) int64 {
+	return ws.ConcentratorTimeToXTime(c.sessionID, scheduling.ConcentratorTime(at))
+}
+
+func (c *basicStationConn) read(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				logger.WithError(err).Debug("Failed to read from web socket")
+			}
+			return
+		}
+		typ, err := lbslns.Type(data)
+		if err != nil {
+			logger.WithError(err).Debug("Failed to parse message type")
+			continue
+		}
+		if typ != lbslns.TypeDownstreamDownlinkMessage {
+			continue
+		}
+		var dnmsg lbslns.DownlinkMessage
+		if err := json.Unmarshal(data, &dnmsg); err != nil {
+			logger.WithError(err).Debug("Failed to unmarshal downlink message")
+			c.gtw.update(func(s *GatewayStats) { s.DownlinkErrors++ })
+			continue
+		}
+		down, xTime, err := c.downlink(&dnmsg)
+		if err != nil {
+			logger.WithError(err).Debug("Failed to convert downlink message")
+			c.gtw.update(func(s *GatewayStats) { s.DownlinkErrors++ })
+			continue
+		}
+		if err := c.writeJSON(lbslns.TxConfirmation{
+			Diid:  dnmsg.Diid,
+			RCtx:  dnmsg.RCtx,
+			XTime: xTime,
+		}); err != nil {
+			logger.WithError(err).Warn("Failed to send transmission confirmation")
+		}
+		c.gtw.update(func(s *GatewayStats) { s.Downlinks++ })
+		c.handler(down)
+	}
+}
+
+func (c *basicStationConn) downlink(dnmsg *lbslns.DownlinkMessage) (*downlink, int64, error) {
+	payload, err := hex.DecodeString(dnmsg.Pdu)
+	if err != nil {
+		return nil, 0, err
+	}
+	down := &downlink{
+		gatewayID: c.gtw.ID,
+		payload:   payload,
+		txPower:   float64(c.phy.DefaultMaxEIRP),
+	}
+	switch {
+	case dnmsg.TimestampDownlinkMessage != nil:
+		dr, ok := c.phy.DataRates[ttnpb.DataRateIndex(dnmsg.Rx1DR)]
+		if !ok {
+			return nil, 0, errDataRateIndex.WithAttributes("index", dnmsg.Rx1DR, "band_id", c.phy.ID)
+		}
+		xTime := dnmsg.XTime + int64(dnmsg.RxDelay)*int64(time.Second/time.Microsecond)
+		down.dataRate = dr.Rate
+		down.frequency = uint64(dnmsg.Rx1Freq)
+		down.timestamp = ws.TimestampFromXTime(xTime)
+		return down, xTime, nil
+	case dnmsg.AbsoluteTimeDownlinkMessage != nil:
+		dr, ok := c.phy.DataRates[ttnpb.DataRateIndex(dnmsg.DR)]
+		if !ok {
+			return nil, 0, errDataRateIndex.WithAttributes("index", dnmsg.DR, "band_id", c.phy.ID)
+		}
+		t := ws.TimeFromGPSTime(dnmsg.GPSTime)
+		down.dataRate = dr.Rate
+		down.frequency = uint64(dnmsg.Freq)
+		down.time = &t
+		return down, c.xTime(time.Since(c.gtw.epoch)), nil
+	default:
+		return nil, 0, errField.WithAttributes("field", "dnmsg")
+	}
+}
+
+// SendUplink implements gatewayConn.
+func (c *basicStationConn) SendUplink(_ context.Context, rx *rxPacket) error {
+	up := rx.uplinkMessage()
+	var msg ttnpb.Message
+	if err := lorawan.UnmarshalMessage(rx.payload, &msg); err != nil {
+		return err
+	}
+	switch msg.MHdr.MType {
+	case ttnpb.MType_JOIN_REQUEST:
+		var jreq lbslns.JoinRequest
+		if err := jreq.FromUplinkMessage(up, c.gtw.bandID); err != nil {
+			return err
+		}
+		jreq.UpInfo.XTime = c.xTime(rx.at)
+		return c.writeJSON(jreq)
+	default:
+		var updf lbslns.UplinkDataFrame
+		if err := updf.FromUplinkMessage(up, c.gtw.bandID); err != nil {
+			return err
+		}
+		updf.UpInfo.XTime = c.xTime(rx.at)
+		return c.writeJSON(updf)
+	}
+}
+
+// SendStatus implements gatewayConn.
+// The LNS protocol has no status messages; the connection statistics are maintained by the Gateway Server.
+func (*basicStationConn) SendStatus(context.Context, *gatewayStatus) error {
+	return nil
+}
+
+// Close implements gatewayConn.
+func (c *basicStationConn) Close() error {
+	c.writeMu.Lock()
+	err := c.conn.WriteMessage(
+		websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+	)
+	c.writeMu.Unlock()
+	if cerr := c.conn.Close(); err == nil {
+		err = cerr
+	}
+	c.wg.Wait()
+	return err
+}
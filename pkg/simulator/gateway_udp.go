@@ -0,0 +1,204 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"math/rand"
+	"net"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb/udp"
+)
+
+// udpKeepAliveInterval is the interval at which PULL_DATA packets are sent to keep the downlink path open.
+const udpKeepAliveInterval = 5 * time.Second
+
+type udpConn struct {
+	gtw     *gateway
+	conn    *net.UDPConn
+	handler func(*downlink)
+
+	mu  sync.Mutex
+	rng *rand.Rand
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// dialUDP connects the gateway using the Semtech UDP packet forwarder protocol.
+func dialUDP(ctx context.Context, gtw *gateway, handler func(*downlink)) (gatewayConn, error) {
+	addr, err := net.ResolveUDPAddr("udp", gtw.Address)
+	if err != nil {
+		return nil, errConnect.WithAttributes("gateway", gtw.ID).WithCause(err)
+	}
+	conn, err := net.DialUDP("udp", nil, addr)
+	if err != nil {
+		return nil, errConnect.WithAttributes("gateway", gtw.ID).WithCause(err)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	c := &udpConn{
+		gtw:     gtw,
+		conn:    conn,
+		handler: handler,
+		rng:     rand.New(rand.NewSource(int64(gtw.eui.MarshalNumber()))),
+		cancel:  cancel,
+	}
+	if err := c.write(udp.PullData, nil, nil); err != nil {
+		cancel()
+		conn.Close()
+		return nil, errConnect.WithAttributes("gateway", gtw.ID).WithCause(err)
+	}
+	c.wg.Add(2)
+	go func() {
+		defer c.wg.Done()
+		c.keepAlive(ctx)
+	}()
+	go func() {
+		defer c.wg.Done()
+		c.read(ctx)
+	}()
+	return c, nil
+}
+
+// write writes the packet. If token is nil, a random token is used.
+func (c *udpConn) write(typ udp.PacketType, token *[2]byte, data *udp.Data) error {
+	pkt := udp.Packet{
+		ProtocolVersion: udp.Version2,
+		PacketType:      typ,
+		GatewayEUI:      &c.gtw.eui,
+		Data:            data,
+	}
+	if token != nil {
+		pkt.Token = *token
+	} else {
+		c.mu.Lock()
+		c.rng.Read(pkt.Token[:])
+		c.mu.Unlock()
+	}
+	buf, err := pkt.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	_, err = c.conn.Write(buf)
+	return err
+}
+
+func (c *udpConn) keepAlive(ctx context.Context) {
+	ticker := time.NewTicker(udpKeepAliveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := c.write(udp.PullData, nil, nil); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to send PULL_DATA")
+			}
+		}
+	}
+}
+
+func (c *udpConn) read(ctx context.Context) {
+	logger := log.FromContext(ctx)
+	buf := make([]byte, 65507)
+	for {
+		n, err := c.conn.Read(buf)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.WithError(err).Warn("Failed to read from UDP connection")
+			}
+			return
+		}
+		var pkt udp.Packet
+		if err := pkt.UnmarshalBinary(buf[:n]); err != nil {
+			logger.WithError(err).Debug("Failed to unmarshal UDP packet")
+			continue
+		}
+		if pkt.PacketType != udp.PullResp || pkt.Data == nil || pkt.Data.TxPacket == nil {
+			continue
+		}
+		tx := *pkt.Data.TxPacket
+		// The absolute time is taken from the GPS time below.
+		tx.Time = nil
+		msg, err := udp.ToDownlinkMessage(&tx)
+		if err != nil {
+			logger.WithError(err).Debug("Failed to convert downlink")
+			c.gtw.update(func(s *GatewayStats) { s.DownlinkErrors++ })
+			continue
+		}
+		scheduled := msg.GetScheduled()
+		down := &downlink{
+			gatewayID: c.gtw.ID,
+			payload:   msg.RawPayload,
+			frequency: scheduled.Frequency,
+			dataRate:  scheduled.DataRate,
+			txPower:   float64(scheduled.GetDownlink().GetTxPower()),
+			timestamp: scheduled.Timestamp,
+			immediate: pkt.Data.TxPacket.Imme,
+		}
+		if tmms := pkt.Data.TxPacket.Tmms; tmms != nil {
+			t := gpstime.Parse(time.Duration(*tmms) * time.Millisecond)
+			down.time = &t
+		}
+		ack := &udp.Data{TxPacketAck: &udp.TxPacketAck{Error: udp.TxErrNone}}
+		if err := c.write(udp.TxAck, &pkt.Token, ack); err != nil {
+			logger.WithError(err).Warn("Failed to send TX_ACK")
+		}
+		c.gtw.update(func(s *GatewayStats) { s.Downlinks++ })
+		c.handler(down)
+	}
+}
+
+// SendUplink implements gatewayConn.
+func (c *udpConn) SendUplink(_ context.Context, rx *rxPacket) error {
+	rxs, _, _ := udp.FromGatewayUp(&ttnpb.GatewayUp{
+		UplinkMessages: []*ttnpb.UplinkMessage{rx.uplinkMessage()},
+	})
+	t := udp.CompactTime(rx.time)
+	rxs[0].Time = &t
+	return c.write(udp.PushData, nil, &udp.Data{RxPacket: rxs})
+}
+
+// SendStatus implements gatewayConn.
+func (c *udpConn) SendStatus(_ context.Context, status *gatewayStatus) error {
+	lat, lon, alt := status.location.Latitude, status.location.Longitude, int32(status.location.Altitude)
+	return c.write(udp.PushData, nil, &udp.Data{
+		Stat: &udp.Stat{
+			Time: udp.ExpandedTime(status.time),
+			Lati: &lat,
+			Long: &lon,
+			Alti: &alt,
+			RxNb: uint32(status.stats.UplinksReceived),
+			RxOk: uint32(status.stats.UplinksReceived),
+			RxFW: uint32(status.stats.UplinksForwarded),
+			ACKR: 100,
+			DWNb: uint32(status.stats.Downlinks),
+			TxNb: uint32(status.stats.Downlinks),
+		},
+	})
+}
+
+// Close implements gatewayConn.
+func (c *udpConn) Close() error {
+	c.cancel()
+	err := c.conn.Close()
+	c.wg.Wait()
+	return err
+}
@@ -0,0 +1,242 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Propagation models.
+const (
+	PropagationFreeSpace   = "free-space"
+	PropagationLogDistance = "log-distance"
+)
+
+// PropagationConfig configures the radio propagation model.
+type PropagationConfig struct {
+	// Model is the path loss model: free-space (default) or log-distance.
+	Model string `yaml:"model"`
+	// PathLossExponent is the path loss exponent of the log-distance model. The default is 2.7.
+	PathLossExponent float64 `yaml:"path-loss-exponent"`
+	// ReferenceDistance is the reference distance in meters of the log-distance model. The default is 1 meter.
+	ReferenceDistance float64 `yaml:"reference-distance"`
+	// ShadowingStdDev is the standard deviation in dB of the log-normal shadowing.
+	ShadowingStdDev float64 `yaml:"shadowing-std-dev"`
+	// NoiseFigure is the noise figure in dB of the gateway receivers. The default is 6 dB.
+	NoiseFigure *float64 `yaml:"noise-figure"`
+	// PacketLoss is the fraction of transmissions that is lost regardless of the link budget.
+	PacketLoss float64 `yaml:"packet-loss"`
+}
+
+// pathLossFunc returns the path loss in dB over the given distance in meters at the given frequency in Hz.
+type pathLossFunc func(distance, frequency float64) float64
+
+// maxSNR is the maximum SNR in dB that concentrators report.
+const maxSNR = 13.5
+
+// minDistance is the minimum distance in meters between a device and a gateway, to avoid negative path loss.
+const minDistance = 1.0
+
+func freeSpacePathLoss(distance, frequency float64) float64 {
+	if distance < minDistance {
+		distance = minDistance
+	}
+	return 20*math.Log10(distance) + 20*math.Log10(frequency) - 147.55
+}
+
+func (c PropagationConfig) model() (pathLossFunc, error) {
+	if c.PacketLoss < 0 || c.PacketLoss > 1 {
+		return nil, errField.WithAttributes("field", "packet-loss")
+	}
+	if c.ShadowingStdDev < 0 {
+		return nil, errField.WithAttributes("field", "shadowing-std-dev")
+	}
+	switch c.Model {
+	case "", PropagationFreeSpace:
+		return freeSpacePathLoss, nil
+	case PropagationLogDistance:
+		exp, d0 := c.PathLossExponent, c.ReferenceDistance
+		if exp == 0 {
+			exp = 2.7
+		}
+		if d0 == 0 {
+			d0 = minDistance
+		}
+		if exp < 0 || d0 < 0 {
+			return nil, errField.WithAttributes("field", "log-distance")
+		}
+		return func(distance, frequency float64) float64 {
+			if distance < d0 {
+				distance = d0
+			}
+			return freeSpacePathLoss(d0, frequency) + 10*exp*math.Log10(distance/d0)
+		}, nil
+	default:
+		return nil, errPropagation.WithAttributes("model", c.Model)
+	}
+}
+
+func (c PropagationConfig) noiseFigure() float64 {
+	if c.NoiseFigure == nil {
+		return 6
+	}
+	return *c.NoiseFigure
+}
+
+// demodulationFloor returns the minimum SNR in dB required to demodulate the data rate.
+func demodulationFloor(dr *ttnpb.DataRate) float64 {
+	switch mod := dr.GetModulation().(type) {
+	case *ttnpb.DataRate_Lora:
+		// Semtech SX1301 demodulator SNR limits.
+		return -5 - 2.5*float64(mod.Lora.SpreadingFactor-6)
+	case *ttnpb.DataRate_Fsk:
+		return 10
+	case *ttnpb.DataRate_Lrfhss:
+		return -20
+	default:
+		return 0
+	}
+}
+
+// bandwidth returns the bandwidth in Hz of the data rate.
+func bandwidth(dr *ttnpb.DataRate) float64 {
+	switch mod := dr.GetModulation().(type) {
+	case *ttnpb.DataRate_Lora:
+		return float64(mod.Lora.Bandwidth)
+	case *ttnpb.DataRate_Fsk:
+		// The receiver bandwidth of FSK is roughly twice the bit rate.
+		return 2 * float64(mod.Fsk.BitRate)
+	case *ttnpb.DataRate_Lrfhss:
+		return float64(mod.Lrfhss.OperatingChannelWidth)
+	default:
+		return 125000
+	}
+}
+
+// earthRadius is the mean radius of the Earth in meters.
+const earthRadius = 6371e3
+
+// distance returns the distance in meters between the locations, including the difference in altitude.
+func distance(a, b Location) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	ground := 2 * earthRadius * math.Asin(math.Sqrt(math.Min(1, h)))
+	return math.Hypot(ground, b.Altitude-a.Altitude)
+}
+
+// offset returns the location that is north and east meters away from the location.
+func (l Location) offset(north, east float64) Location {
+	lat := l.Latitude + north/earthRadius*180/math.Pi
+	lon := l.Longitude + east/(earthRadius*math.Cos(l.Latitude*math.Pi/180))*180/math.Pi
+	return Location{Latitude: lat, Longitude: lon, Altitude: l.Altitude}
+}
+
+// deterministicFloat returns a uniformly distributed value in [0, 1) that is derived from the seed and keys.
+// This makes the outcome of a transmission independent of the order in which goroutines are scheduled.
+func deterministicFloat(seed int64, keys ...interface{}) float64 {
+	h := fnv.New64a()
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(seed))
+	h.Write(b[:])
+	for _, k := range keys {
+		switch k := k.(type) {
+		case string:
+			h.Write([]byte(k))
+		case uint32:
+			binary.BigEndian.PutUint32(b[:4], k)
+			h.Write(b[:4])
+		case uint64:
+			binary.BigEndian.PutUint64(b[:], k)
+			h.Write(b[:])
+		case int:
+			binary.BigEndian.PutUint64(b[:], uint64(k))
+			h.Write(b[:])
+		}
+		h.Write([]byte{0})
+	}
+	return float64(h.Sum64()>>11) / (1 << 53)
+}
+
+// deterministicNormal returns a standard normal distributed value that is derived from the seed and keys.
+func deterministicNormal(seed int64, keys ...interface{}) float64 {
+	u1 := deterministicFloat(seed, append(keys, "u1")...)
+	u2 := deterministicFloat(seed, append(keys, "u2")...)
+	if u1 < math.SmallestNonzeroFloat64 {
+		u1 = math.SmallestNonzeroFloat64
+	}
+	return math.Sqrt(-2*math.Log(u1)) * math.Cos(2*math.Pi*u2)
+}
+
+// linkResult is the outcome of a transmission between a device and a gateway.
+type linkResult struct {
+	Received bool
+	RSSI     float64
+	SNR      float64
+}
+
+// channel computes link budgets between devices and gateways.
+type channel struct {
+	seed        int64
+	pathLoss    pathLossFunc
+	shadowing   float64
+	noiseFigure float64
+	packetLoss  float64
+}
+
+func newChannel(seed int64, conf PropagationConfig) (*channel, error) {
+	pathLoss, err := conf.model()
+	if err != nil {
+		return nil, err
+	}
+	return &channel{
+		seed:        seed,
+		pathLoss:    pathLoss,
+		shadowing:   conf.ShadowingStdDev,
+		noiseFigure: conf.noiseFigure(),
+		packetLoss:  conf.PacketLoss,
+	}, nil
+}
+
+// Link returns the outcome of the transmission identified by key, sent with the given EIRP in dBm from a device
+// at from to a gateway with the given antenna gain at to.
+func (c *channel) Link(
+	key string, from, to Location, eirp, antennaGain float64, frequency uint64, dr *ttnpb.DataRate,
+) linkResult {
+	loss := c.pathLoss(distance(from, to), float64(frequency))
+	if c.shadowing > 0 {
+		loss += c.shadowing * deterministicNormal(c.seed, key, "shadowing")
+	}
+	rssi := eirp + antennaGain - loss
+	noise := -174 + 10*math.Log10(bandwidth(dr)) + c.noiseFigure
+	snr := rssi - noise
+	received := snr >= demodulationFloor(dr)
+	if received && c.packetLoss > 0 {
+		received = deterministicFloat(c.seed, key, "loss") >= c.packetLoss
+	}
+	if snr > maxSNR {
+		snr = maxSNR
+	}
+	return linkResult{
+		Received: received,
+		RSSI:     math.Round(rssi),
+		SNR:      math.Round(snr*4) / 4,
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestPathLoss(t *testing.T) {
+	a := assertions.New(t)
+
+	// 868 MHz over 1 km in free space is about 91.2 dB.
+	a.So(freeSpacePathLoss(1000, 868e6), should.AlmostEqual, 91.22, 0.01)
+	// The path loss does not become negative close to the antenna.
+	a.So(freeSpacePathLoss(0, 868e6), should.Equal, freeSpacePathLoss(minDistance, 868e6))
+
+	logDistance := test.Must(PropagationConfig{
+		Model:             PropagationLogDistance,
+		PathLossExponent:  3,
+		ReferenceDistance: 100,
+	}.model())
+	a.So(logDistance(100, 868e6), should.AlmostEqual, freeSpacePathLoss(100, 868e6), 0.0001)
+	a.So(logDistance(1000, 868e6)-logDistance(100, 868e6), should.AlmostEqual, 30, 0.0001)
+
+	_, err := PropagationConfig{Model: "two-ray"}.model()
+	a.So(err, should.HaveSameErrorDefinitionAs, errPropagation)
+	_, err = PropagationConfig{PacketLoss: 1.5}.model()
+	a.So(err, should.HaveSameErrorDefinitionAs, errField)
+}
+
+func TestDistance(t *testing.T) {
+	a := assertions.New(t)
+
+	amsterdam := Location{Latitude: 52.3676, Longitude: 4.9041}
+	a.So(distance(amsterdam, amsterdam), should.Equal, 0)
+	a.So(distance(amsterdam, amsterdam.offset(3000, 4000)), should.AlmostEqual, 5000, 1)
+}
+
+func TestChannelLink(t *testing.T) {
+	a := assertions.New(t)
+	phy := test.Must(band.GetLatest(band.EU_863_870))
+	sf7, sf12 := phy.DataRates[5].Rate, phy.DataRates[0].Rate
+
+	gtw := Location{Latitude: 52.3676, Longitude: 4.9041}
+	near, far := gtw.offset(500, 0), gtw.offset(20000, 0)
+
+	ch := test.Must(newChannel(1, PropagationConfig{Model: PropagationLogDistance}))
+	a.So(ch.Link("a", near, gtw, 14, 0, 868100000, sf7).Received, should.BeTrue)
+	a.So(ch.Link("a", far, gtw, 14, 0, 868100000, sf7).Received, should.BeFalse)
+	// Higher spreading factors reach further.
+	a.So(ch.Link("a", far, gtw, 14, 0, 868100000, sf12).Received, should.BeTrue)
+
+	// Shadowing and packet loss are deterministic for the same seed and key, and differ between keys.
+	conf := PropagationConfig{ShadowingStdDev: 8, PacketLoss: 0.3}
+	ch1, ch2 := test.Must(newChannel(42, conf)), test.Must(newChannel(42, conf))
+	var received, differs int
+	for i := 0; i < 1000; i++ {
+		key := fmt.Sprintf("dev/%d", i)
+		res1 := ch1.Link(key, near, gtw, 14, 0, 868100000, sf7)
+		res2 := ch2.Link(key, near, gtw, 14, 0, 868100000, sf7)
+		a.So(res1, should.Resemble, res2)
+		if res1.Received {
+			received++
+		}
+		if res1.RSSI != ch1.Link(key+"/", near, gtw, 14, 0, 868100000, sf7).RSSI {
+			differs++
+		}
+	}
+	a.So(math.Abs(float64(received)/1000-0.7), should.BeLessThan, 0.05)
+	a.So(differs, should.BeGreaterThan, 900)
+}
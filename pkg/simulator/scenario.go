@@ -0,0 +1,491 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	yaml "gopkg.in/yaml.v2"
+)
+
+var (
+	errParseScenario   = errors.DefineInvalidArgument("parse_scenario", "parse scenario")
+	errNoDuration      = errors.DefineInvalidArgument("no_duration", "no scenario duration")
+	errNoGateways      = errors.DefineInvalidArgument("no_gateways", "no gateways in scenario")
+	errNoDevices       = errors.DefineInvalidArgument("no_devices", "no devices in scenario")
+	errGatewayConfig   = errors.DefineInvalidArgument("gateway_config", "invalid configuration of gateway `{gateway}`")
+	errDeviceConfig    = errors.DefineInvalidArgument("device_config", "invalid configuration of device group `{group}`")
+	errDuplicateID     = errors.DefineInvalidArgument("duplicate_id", "duplicate ID `{id}`")
+	errProtocol        = errors.DefineInvalidArgument("protocol", "unknown gateway protocol `{protocol}`")
+	errActivation      = errors.DefineInvalidArgument("activation", "unknown activation mode `{activation}`")
+	errClass           = errors.DefineInvalidArgument("class", "unknown device class `{class}`")
+	errField           = errors.DefineInvalidArgument("field", "invalid field `{field}`")
+	errMissingField    = errors.DefineInvalidArgument("missing_field", "missing field `{field}`")
+	errPropagation     = errors.DefineInvalidArgument("propagation", "unknown propagation model `{model}`")
+	errDataRateIndex   = errors.DefineInvalidArgument("data_rate_index", "data rate `{index}` is not defined in band `{band_id}`")
+	errTooManyInstance = errors.DefineInvalidArgument("too_many_instances", "device group `{group}` exceeds the EUI or address range")
+)
+
+// Gateway protocols.
+const (
+	ProtocolUDP          = "udp"
+	ProtocolBasicStation = "basicstation"
+)
+
+// Activation modes.
+const (
+	ActivationOTAA = "otaa"
+	ActivationABP  = "abp"
+)
+
+// Location is a position on the globe.
+type Location struct {
+	Latitude  float64 `yaml:"latitude"`
+	Longitude float64 `yaml:"longitude"`
+	Altitude  float64 `yaml:"altitude"`
+}
+
+// GatewayConfig configures a virtual gateway.
+type GatewayConfig struct {
+	// ID is the gateway ID in the Identity Server.
+	ID string `yaml:"id"`
+	// EUI is the gateway EUI as hexadecimal string.
+	EUI string `yaml:"eui"`
+	// Protocol is the protocol the gateway uses to connect to the Gateway Server.
+	// This is either udp (default) or basicstation.
+	Protocol string `yaml:"protocol"`
+	// Address is the UDP address (host:port) or the Basic Station LNS URI (wss://host:port) of the Gateway Server.
+	Address string `yaml:"address"`
+	// APIKey is the gateway API key, used for Basic Station.
+	APIKey string `yaml:"api-key"`
+	// Location is the antenna location.
+	Location Location `yaml:"location"`
+	// AntennaGain is the antenna gain in dBi.
+	AntennaGain float64 `yaml:"antenna-gain"`
+	// StatusInterval is the interval at which the gateway sends status messages.
+	StatusInterval time.Duration `yaml:"status-interval"`
+}
+
+// DeviceGroup configures a group of virtual end devices with the same characteristics.
+// The EUIs, addresses and locations of the individual devices are derived from the group configuration.
+type DeviceGroup struct {
+	// Name is the name of the group. Devices are named <name>-<index>.
+	Name string `yaml:"name"`
+	// Count is the number of devices in the group.
+	Count int `yaml:"count"`
+
+	LoRaWANVersion    string `yaml:"lorawan-version"`
+	LoRaWANPHYVersion string `yaml:"lorawan-phy-version"`
+	// Class is the device class: A (default), B or C.
+	Class string `yaml:"class"`
+	// Activation is the activation mode: otaa (default) or abp.
+	Activation string `yaml:"activation"`
+
+	// JoinEUI is the JoinEUI of all devices in the group.
+	JoinEUI string `yaml:"join-eui"`
+	// DevEUI is the DevEUI of the first device. Subsequent devices get subsequent DevEUIs.
+	DevEUI string `yaml:"dev-eui"`
+	AppKey string `yaml:"app-key"`
+	NwkKey string `yaml:"nwk-key"`
+	// DevNonce is the first DevNonce used in join-requests.
+	DevNonce uint16 `yaml:"dev-nonce"`
+
+	// DevAddr is the DevAddr of the first ABP device. Subsequent devices get subsequent addresses.
+	DevAddr     string `yaml:"dev-addr"`
+	AppSKey     string `yaml:"app-s-key"`
+	NwkSKey     string `yaml:"nwk-s-key"`
+	FNwkSIntKey string `yaml:"f-nwk-s-int-key"`
+	SNwkSIntKey string `yaml:"s-nwk-s-int-key"`
+	NwkSEncKey  string `yaml:"nwk-s-enc-key"`
+	// FCnt is the initial uplink frame counter of ABP devices.
+	FCnt uint32 `yaml:"f-cnt"`
+
+	// Interval is the average time between uplinks.
+	Interval time.Duration `yaml:"interval"`
+	// Jitter is the fraction of the interval by which uplinks are randomly spread.
+	Jitter float64 `yaml:"jitter"`
+	// FPort is the application port of uplinks.
+	FPort uint32 `yaml:"f-port"`
+	// Payload is the hexadecimal application payload. If empty, a random payload of PayloadSize bytes is used.
+	Payload     string `yaml:"payload"`
+	PayloadSize int    `yaml:"payload-size"`
+	Confirmed   bool   `yaml:"confirmed"`
+	// ADR enables adaptive data rate. This is enabled by default.
+	ADR *bool `yaml:"adr"`
+	// DataRate is the initial data rate index.
+	DataRate *uint32 `yaml:"data-rate"`
+	// MaxEIRP is the maximum EIRP of the device in dBm. It defaults to the band's default maximum EIRP.
+	MaxEIRP *float64 `yaml:"max-eirp"`
+	// Battery is reported in DevStatusAns. 0 is external power, 255 means not able to measure.
+	Battery uint32 `yaml:"battery"`
+	// PingSlotPeriod is the exponent e of the class B ping slot period 2^e seconds.
+	PingSlotPeriod uint32 `yaml:"ping-slot-period"`
+
+	// Location is the center of the area where the devices are placed.
+	Location Location `yaml:"location"`
+	// Radius is the radius in meters around the location in which the devices are randomly placed.
+	Radius float64 `yaml:"radius"`
+}
+
+// Scenario describes a simulation.
+type Scenario struct {
+	// Seed seeds all random decisions in the simulation.
+	Seed int64 `yaml:"seed"`
+	// Duration is the duration of the simulation.
+	Duration time.Duration `yaml:"duration"`
+	// BandID is the band of all gateways and devices.
+	BandID string `yaml:"band-id"`
+	// Propagation is the radio propagation model.
+	Propagation PropagationConfig `yaml:"propagation"`
+
+	Gateways []GatewayConfig `yaml:"gateways"`
+	Devices  []DeviceGroup   `yaml:"devices"`
+}
+
+// ParseScenario parses and validates the scenario in YAML format.
+func ParseScenario(b []byte) (*Scenario, error) {
+	s := &Scenario{}
+	if err := yaml.UnmarshalStrict(b, s); err != nil {
+		return nil, errParseScenario.WithCause(err)
+	}
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Validate returns an error if the scenario is invalid.
+func (s *Scenario) Validate() error {
+	if s.Duration <= 0 {
+		return errNoDuration.New()
+	}
+	if s.BandID == "" {
+		s.BandID = band.EU_863_870
+	}
+	if _, err := band.GetLatest(s.BandID); err != nil {
+		return err
+	}
+	if _, err := s.Propagation.model(); err != nil {
+		return err
+	}
+	if len(s.Gateways) == 0 {
+		return errNoGateways.New()
+	}
+	if len(s.Devices) == 0 {
+		return errNoDevices.New()
+	}
+	ids := make(map[string]struct{})
+	for _, gtw := range s.Gateways {
+		if err := gtw.validate(); err != nil {
+			return errGatewayConfig.WithAttributes("gateway", gtw.ID).WithCause(err)
+		}
+		if _, ok := ids[gtw.ID]; ok {
+			return errDuplicateID.WithAttributes("id", gtw.ID)
+		}
+		ids[gtw.ID] = struct{}{}
+	}
+	for _, grp := range s.Devices {
+		if _, err := grp.devices(s.BandID); err != nil {
+			return errDeviceConfig.WithAttributes("group", grp.Name).WithCause(err)
+		}
+		if _, ok := ids[grp.Name]; ok {
+			return errDuplicateID.WithAttributes("id", grp.Name)
+		}
+		ids[grp.Name] = struct{}{}
+	}
+	return nil
+}
+
+func (c GatewayConfig) validate() error {
+	if c.ID == "" {
+		return errMissingField.WithAttributes("field", "id")
+	}
+	if err := (&ttnpb.GatewayIdentifiers{GatewayId: c.ID}).ValidateFields("gateway_id"); err != nil {
+		return errField.WithAttributes("field", "id").WithCause(err)
+	}
+	if _, err := parseEUI(c.EUI, "eui"); err != nil {
+		return err
+	}
+	switch c.Protocol {
+	case "", ProtocolUDP, ProtocolBasicStation:
+	default:
+		return errProtocol.WithAttributes("protocol", c.Protocol)
+	}
+	if c.Address == "" {
+		return errMissingField.WithAttributes("field", "address")
+	}
+	return nil
+}
+
+// deviceConfig is the configuration of a single virtual end device.
+type deviceConfig struct {
+	name  string
+	index int
+
+	macVersion ttnpb.MACVersion
+	phyVersion ttnpb.PHYVersion
+	class      ttnpb.Class
+	otaa       bool
+
+	joinEUI, devEUI types.EUI64
+	appKey, nwkKey  types.AES128Key
+	devNonce        uint16
+
+	devAddr                                       types.DevAddr
+	appSKey, fNwkSIntKey, sNwkSIntKey, nwkSEncKey types.AES128Key
+	fCntUp                                        uint32
+
+	interval       time.Duration
+	jitter         float64
+	fPort          uint32
+	payload        []byte
+	payloadSize    int
+	confirmed      bool
+	adr            bool
+	dataRate       ttnpb.DataRateIndex
+	maxEIRP        float32
+	battery        uint32
+	pingSlotPeriod ttnpb.PingSlotPeriod
+
+	location Location
+	radius   float64
+}
+
+func parseEUI(s, field string) (types.EUI64, error) {
+	var eui types.EUI64
+	if s == "" {
+		return eui, errMissingField.WithAttributes("field", field)
+	}
+	if err := eui.UnmarshalText([]byte(s)); err != nil {
+		return eui, errField.WithAttributes("field", field).WithCause(err)
+	}
+	return eui, nil
+}
+
+func parseKey(s, field string) (types.AES128Key, error) {
+	var key types.AES128Key
+	if s == "" {
+		return key, errMissingField.WithAttributes("field", field)
+	}
+	if err := key.UnmarshalText([]byte(s)); err != nil {
+		return key, errField.WithAttributes("field", field).WithCause(err)
+	}
+	return key, nil
+}
+
+func parseDevAddr(s, field string) (types.DevAddr, error) {
+	var addr types.DevAddr
+	if s == "" {
+		return addr, errMissingField.WithAttributes("field", field)
+	}
+	if err := addr.UnmarshalText([]byte(s)); err != nil {
+		return addr, errField.WithAttributes("field", field).WithCause(err)
+	}
+	return addr, nil
+}
+
+func parseClass(s string) (ttnpb.Class, error) {
+	switch strings.ToUpper(s) {
+	case "", "A":
+		return ttnpb.Class_CLASS_A, nil
+	case "B":
+		return ttnpb.Class_CLASS_B, nil
+	case "C":
+		return ttnpb.Class_CLASS_C, nil
+	default:
+		return 0, errClass.WithAttributes("class", s)
+	}
+}
+
+// devices returns the configuration of the individual devices of the group.
+//
+//nolint:gocyclo
+func (g DeviceGroup) devices(bandID string) ([]deviceConfig, error) {
+	if g.Name == "" {
+		return nil, errMissingField.WithAttributes("field", "name")
+	}
+	if g.Count < 1 {
+		return nil, errField.WithAttributes("field", "count")
+	}
+	base := deviceConfig{
+		name:           g.Name,
+		macVersion:     ttnpb.MACVersion_MAC_V1_0_3,
+		phyVersion:     ttnpb.PHYVersion_RP001_V1_0_3_REV_A,
+		interval:       g.Interval,
+		jitter:         g.Jitter,
+		fPort:          g.FPort,
+		payloadSize:    g.PayloadSize,
+		confirmed:      g.Confirmed,
+		adr:            g.ADR == nil || *g.ADR,
+		battery:        g.Battery,
+		pingSlotPeriod: ttnpb.PingSlotPeriod(g.PingSlotPeriod),
+		location:       g.Location,
+		radius:         g.Radius,
+		devNonce:       g.DevNonce,
+		fCntUp:         g.FCnt,
+	}
+	if g.LoRaWANVersion != "" {
+		if err := base.macVersion.UnmarshalText([]byte(g.LoRaWANVersion)); err != nil {
+			return nil, errField.WithAttributes("field", "lorawan-version").WithCause(err)
+		}
+	}
+	if g.LoRaWANPHYVersion != "" {
+		if err := base.phyVersion.UnmarshalText([]byte(g.LoRaWANPHYVersion)); err != nil {
+			return nil, errField.WithAttributes("field", "lorawan-phy-version").WithCause(err)
+		}
+	}
+	phy, err := band.Get(bandID, base.phyVersion)
+	if err != nil {
+		return nil, err
+	}
+	if base.class, err = parseClass(g.Class); err != nil {
+		return nil, err
+	}
+	if base.interval <= 0 {
+		return nil, errField.WithAttributes("field", "interval")
+	}
+	if base.jitter < 0 || base.jitter > 1 {
+		return nil, errField.WithAttributes("field", "jitter")
+	}
+	if base.fPort == 0 || base.fPort > 223 {
+		return nil, errField.WithAttributes("field", "f-port")
+	}
+	if g.Payload != "" {
+		if base.payload, err = hex.DecodeString(g.Payload); err != nil {
+			return nil, errField.WithAttributes("field", "payload").WithCause(err)
+		}
+	}
+	if base.battery > 255 {
+		return nil, errField.WithAttributes("field", "battery")
+	}
+	if base.pingSlotPeriod > ttnpb.PingSlotPeriod_PING_EVERY_128S {
+		return nil, errField.WithAttributes("field", "ping-slot-period")
+	}
+	base.dataRate = phy.UplinkChannels[0].MaxDataRate
+	if g.DataRate != nil {
+		base.dataRate = ttnpb.DataRateIndex(*g.DataRate)
+	}
+	if _, ok := phy.DataRates[base.dataRate]; !ok {
+		return nil, errDataRateIndex.WithAttributes("index", base.dataRate, "band_id", bandID)
+	}
+	base.maxEIRP = phy.DefaultMaxEIRP
+	if g.MaxEIRP != nil {
+		base.maxEIRP = float32(*g.MaxEIRP)
+	}
+
+	var (
+		devEUI  types.EUI64
+		devAddr types.DevAddr
+	)
+	switch strings.ToLower(g.Activation) {
+	case "", ActivationOTAA:
+		base.otaa = true
+		if base.joinEUI, err = parseEUI(g.JoinEUI, "join-eui"); err != nil {
+			return nil, err
+		}
+		if devEUI, err = parseEUI(g.DevEUI, "dev-eui"); err != nil {
+			return nil, err
+		}
+		if base.appKey, err = parseKey(g.AppKey, "app-key"); err != nil {
+			return nil, err
+		}
+		base.nwkKey = base.appKey
+		if g.NwkKey != "" {
+			if base.nwkKey, err = parseKey(g.NwkKey, "nwk-key"); err != nil {
+				return nil, err
+			}
+		}
+	case ActivationABP:
+		if g.DevEUI != "" {
+			if devEUI, err = parseEUI(g.DevEUI, "dev-eui"); err != nil {
+				return nil, err
+			}
+		}
+		if devAddr, err = parseDevAddr(g.DevAddr, "dev-addr"); err != nil {
+			return nil, err
+		}
+		if base.appSKey, err = parseKey(g.AppSKey, "app-s-key"); err != nil {
+			return nil, err
+		}
+		if g.NwkSKey != "" {
+			if base.fNwkSIntKey, err = parseKey(g.NwkSKey, "nwk-s-key"); err != nil {
+				return nil, err
+			}
+			base.sNwkSIntKey, base.nwkSEncKey = base.fNwkSIntKey, base.fNwkSIntKey
+		} else {
+			if base.fNwkSIntKey, err = parseKey(g.FNwkSIntKey, "f-nwk-s-int-key"); err != nil {
+				return nil, err
+			}
+			if base.sNwkSIntKey, err = parseKey(g.SNwkSIntKey, "s-nwk-s-int-key"); err != nil {
+				return nil, err
+			}
+			if base.nwkSEncKey, err = parseKey(g.NwkSEncKey, "nwk-s-enc-key"); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, errActivation.WithAttributes("activation", g.Activation)
+	}
+
+	devs := make([]deviceConfig, 0, g.Count)
+	for i := 0; i < g.Count; i++ {
+		dev := base
+		dev.index = i
+		if !devEUI.IsZero() {
+			if dev.devEUI, err = addToEUI(devEUI, uint64(i)); err != nil {
+				return nil, errTooManyInstance.WithAttributes("group", g.Name)
+			}
+		}
+		if !base.otaa {
+			if dev.devAddr, err = addToDevAddr(devAddr, uint32(i)); err != nil {
+				return nil, errTooManyInstance.WithAttributes("group", g.Name)
+			}
+		}
+		devs = append(devs, dev)
+	}
+	return devs, nil
+}
+
+// ID returns the identifier of the device.
+func (c deviceConfig) ID() string {
+	return fmt.Sprintf("%s-%d", c.name, c.index)
+}
+
+func addToEUI(eui types.EUI64, n uint64) (types.EUI64, error) {
+	v := eui.MarshalNumber()
+	if v+n < v {
+		return types.EUI64{}, errTooManyInstance.New()
+	}
+	var res types.EUI64
+	res.UnmarshalNumber(v + n)
+	return res, nil
+}
+
+func addToDevAddr(addr types.DevAddr, n uint32) (types.DevAddr, error) {
+	v := addr.MarshalNumber()
+	if v+n < v {
+		return types.DevAddr{}, errTooManyInstance.New()
+	}
+	var res types.DevAddr
+	res.UnmarshalNumber(v + n)
+	return res, nil
+}
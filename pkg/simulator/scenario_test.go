@@ -0,0 +1,118 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/simulator"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+const testScenario = `
+seed: 42
+duration: 1h
+band-id: EU_863_870
+propagation:
+  model: log-distance
+  shadowing-std-dev: 4
+gateways:
+- id: gtw-1
+  eui: 0102030405060708
+  address: localhost:1700
+  location:
+    latitude: 52.3676
+    longitude: 4.9041
+devices:
+- name: meters
+  count: 10
+  lorawan-version: 1.1.0
+  lorawan-phy-version: 1.1.0-b
+  join-eui: 70B3D57ED0000000
+  dev-eui: 0004A30B001C0500
+  app-key: 01020304050607080910111213141516
+  nwk-key: 01020304050607080910111213141516
+  interval: 5m
+  f-port: 1
+  payload-size: 8
+  radius: 2000
+  location:
+    latitude: 52.3676
+    longitude: 4.9041
+`
+
+func TestParseScenario(t *testing.T) {
+	a := assertions.New(t)
+
+	s, err := simulator.ParseScenario([]byte(testScenario))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(s.Seed, should.Equal, 42)
+	a.So(s.Duration, should.Equal, time.Hour)
+	a.So(s.Gateways, should.HaveLength, 1)
+	a.So(s.Devices, should.HaveLength, 1)
+	a.So(s.Devices[0].Interval, should.Equal, 5*time.Minute)
+
+	for _, tc := range []struct {
+		Name     string
+		Scenario string
+	}{
+		{
+			Name:     "UnknownField",
+			Scenario: testScenario + "foo: bar\n",
+		},
+		{
+			Name:     "NoDuration",
+			Scenario: "gateways: []\n",
+		},
+		{
+			Name:     "UnknownBand",
+			Scenario: "duration: 1h\nband-id: FOO\n",
+		},
+		{
+			Name: "DuplicateID",
+			Scenario: testScenario + `
+- name: gtw-1
+  count: 1
+  activation: abp
+  dev-addr: 26010203
+  nwk-s-key: 01020304050607080910111213141516
+  app-s-key: 01020304050607080910111213141516
+  interval: 5m
+  f-port: 1
+`,
+		},
+		{
+			Name: "InvalidKey",
+			Scenario: testScenario + `
+- name: abp
+  count: 1
+  activation: abp
+  dev-addr: 26010203
+  nwk-s-key: 0102
+  interval: 5m
+  f-port: 1
+`,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			_, err := simulator.ParseScenario([]byte(tc.Scenario))
+			assertions.New(t).So(err, should.NotBeNil)
+		})
+	}
+}
@@ -0,0 +1,480 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simulator implements a deterministic LoRaWAN network simulator.
+//
+// The simulator connects virtual gateways to a Gateway Server using the Semtech UDP packet forwarder protocol or
+// the LoRa Basics Station LNS protocol. Virtual end devices perform joins, send uplinks with real LoRaWAN
+// cryptography, answer MAC commands and react to ADR. Which gateways receive a transmission, and with which signal
+// quality, is determined by a propagation model. All random decisions are derived from the scenario seed, so that
+// the same scenario results in the same traffic.
+package simulator
+
+import (
+	"bytes"
+	"container/heap"
+	"context"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Event types.
+const (
+	EventJoinRequest   = "join_request"
+	EventJoinAccept    = "join_accept"
+	EventUplink        = "uplink"
+	EventReception     = "reception"
+	EventDownlink      = "downlink"
+	EventDownlinkLost  = "downlink_lost"
+	EventGatewayStatus = "gateway_status"
+	EventError         = "error"
+)
+
+// Event is an event in the simulation.
+type Event struct {
+	// Time is the simulation time since the start of the simulation.
+	Time           time.Duration `json:"time"`
+	Type           string        `json:"type"`
+	Device         string        `json:"device,omitempty"`
+	Gateway        string        `json:"gateway,omitempty"`
+	DevAddr        string        `json:"dev_addr,omitempty"`
+	DevNonce       uint32        `json:"dev_nonce,omitempty"`
+	FCnt           uint32        `json:"f_cnt,omitempty"`
+	FPort          uint32        `json:"f_port,omitempty"`
+	Confirmed      bool          `json:"confirmed,omitempty"`
+	Ack            bool          `json:"ack,omitempty"`
+	Retransmission bool          `json:"retransmission,omitempty"`
+	Frequency      uint64        `json:"frequency,omitempty"`
+	DataRate       uint32        `json:"data_rate,omitempty"`
+	EIRP           float64       `json:"eirp,omitempty"`
+	RSSI           float64       `json:"rssi,omitempty"`
+	SNR            float64       `json:"snr,omitempty"`
+	Window         string        `json:"window,omitempty"`
+	MACCommands    []string      `json:"mac_commands,omitempty"`
+	Payload        []byte        `json:"payload,omitempty"`
+	Error          string        `json:"error,omitempty"`
+}
+
+// Report summarizes a simulation.
+type Report struct {
+	Duration time.Duration           `json:"duration"`
+	Devices  map[string]DeviceStats  `json:"devices"`
+	Gateways map[string]GatewayStats `json:"gateways"`
+	Total    DeviceStats             `json:"total"`
+}
+
+// Option configures the Simulator.
+type Option func(*Simulator)
+
+// WithDryRun runs the simulation without connecting the gateways. The simulation runs on a virtual clock and
+// completes immediately.
+func WithDryRun(dryRun bool) Option {
+	return func(s *Simulator) {
+		s.dryRun = dryRun
+	}
+}
+
+// WithTrace configures the function that is called for every event in the simulation.
+// The function is called sequentially.
+func WithTrace(f func(Event)) Option {
+	return func(s *Simulator) {
+		s.traceFunc = f
+	}
+}
+
+// withDialer configures the function that connects gateways.
+func withDialer(f dialFunc) Option {
+	return func(s *Simulator) {
+		s.dial = f
+	}
+}
+
+// Simulator simulates a LoRaWAN network.
+type Simulator struct {
+	scenario  *Scenario
+	phy       *band.Band
+	channel   *channel
+	dryRun    bool
+	dial      dialFunc
+	traceFunc func(Event)
+	traceMu   sync.Mutex
+
+	clock    clock
+	gateways []*gateway
+	devices  []*device
+}
+
+// New returns a new Simulator for the scenario.
+func New(scenario *Scenario, opts ...Option) (*Simulator, error) {
+	if err := scenario.Validate(); err != nil {
+		return nil, err
+	}
+	phy, err := band.GetLatest(scenario.BandID)
+	if err != nil {
+		return nil, err
+	}
+	ch, err := newChannel(scenario.Seed, scenario.Propagation)
+	if err != nil {
+		return nil, err
+	}
+	s := &Simulator{
+		scenario: scenario,
+		phy:      &phy,
+		channel:  ch,
+		dial:     dialGateway,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	for _, grp := range scenario.Devices {
+		confs, err := grp.devices(scenario.BandID)
+		if err != nil {
+			return nil, err
+		}
+		for _, conf := range confs {
+			devPHY, err := band.Get(scenario.BandID, conf.phyVersion)
+			if err != nil {
+				return nil, err
+			}
+			s.devices = append(s.devices, newDevice(conf, &devPHY, scenario.Seed, s.trace))
+		}
+	}
+	return s, nil
+}
+
+func (s *Simulator) trace(ev Event) {
+	if s.traceFunc == nil {
+		return
+	}
+	s.traceMu.Lock()
+	defer s.traceMu.Unlock()
+	s.traceFunc(ev)
+}
+
+// clock is the time source of the simulation.
+type clock interface {
+	// Now returns the simulation time.
+	Now() time.Duration
+	// WaitUntil blocks until the simulation time t.
+	WaitUntil(ctx context.Context, t time.Duration) error
+	// Time returns the wall clock time of the simulation time t.
+	Time(t time.Duration) time.Time
+}
+
+type realClock struct {
+	epoch time.Time
+}
+
+func (c realClock) Now() time.Duration { return time.Since(c.epoch) }
+
+func (c realClock) Time(t time.Duration) time.Time { return c.epoch.Add(t) }
+
+func (c realClock) WaitUntil(ctx context.Context, t time.Duration) error {
+	d := time.Until(c.epoch.Add(t))
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+type virtualClock struct {
+	epoch time.Time
+
+	mu  sync.Mutex
+	now time.Duration
+}
+
+func (c *virtualClock) Now() time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *virtualClock) Time(t time.Duration) time.Time { return c.epoch.Add(t) }
+
+func (c *virtualClock) WaitUntil(ctx context.Context, t time.Duration) error {
+	c.mu.Lock()
+	if t > c.now {
+		c.now = t
+	}
+	c.mu.Unlock()
+	return ctx.Err()
+}
+
+type actionKind int
+
+const (
+	actionTransmit actionKind = iota
+	actionStatus
+)
+
+// action is a scheduled action in the simulation.
+type action struct {
+	at   time.Duration
+	seq  uint64
+	kind actionKind
+	dev  *device
+	gtw  *gateway
+}
+
+// actionQueue is a priority queue of actions ordered by time. Actions with the same time are ordered by their
+// sequence number, to keep the simulation deterministic.
+type actionQueue []*action
+
+func (q actionQueue) Len() int { return len(q) }
+
+func (q actionQueue) Less(i, j int) bool {
+	if q[i].at != q[j].at {
+		return q[i].at < q[j].at
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q actionQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *actionQueue) Push(x interface{}) { *q = append(*q, x.(*action)) }
+
+func (q *actionQueue) Pop() interface{} {
+	old := *q
+	n := len(old)
+	a := old[n-1]
+	*q = old[:n-1]
+	return a
+}
+
+// Run runs the simulation until the scenario duration elapses or until the context is done.
+func (s *Simulator) Run(ctx context.Context) (*Report, error) {
+	epoch := time.Now()
+	if s.dryRun {
+		s.clock = &virtualClock{epoch: epoch}
+	} else {
+		s.clock = realClock{epoch: epoch}
+	}
+
+	s.gateways = make([]*gateway, 0, len(s.scenario.Gateways))
+	defer func() {
+		for _, gtw := range s.gateways {
+			if err := gtw.Close(); err != nil {
+				log.FromContext(ctx).WithError(err).WithField("gateway_id", gtw.ID).Debug("Failed to close gateway")
+			}
+		}
+	}()
+	for _, conf := range s.scenario.Gateways {
+		gtw, err := newGateway(conf, s.scenario.BandID, epoch)
+		if err != nil {
+			return nil, err
+		}
+		if !s.dryRun {
+			gtw.conn, err = s.dial(ctx, gtw, func(down *downlink) {
+				s.handleDownlink(ctx, gtw, down)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		s.gateways = append(s.gateways, gtw)
+	}
+
+	var (
+		queue actionQueue
+		seq   uint64
+	)
+	schedule := func(a *action) {
+		a.seq = seq
+		seq++
+		heap.Push(&queue, a)
+	}
+	for _, dev := range s.devices {
+		// Spread the first transmissions of the devices over their interval.
+		dev.mu.Lock()
+		offset := time.Duration(dev.rng.Int63n(int64(dev.interval)))
+		dev.mu.Unlock()
+		schedule(&action{at: offset, kind: actionTransmit, dev: dev})
+	}
+	for _, gtw := range s.gateways {
+		if gtw.Protocol == ProtocolBasicStation {
+			continue
+		}
+		schedule(&action{at: 0, kind: actionStatus, gtw: gtw})
+	}
+
+	for queue.Len() > 0 {
+		a := heap.Pop(&queue).(*action)
+		if a.at > s.scenario.Duration {
+			break
+		}
+		if err := s.clock.WaitUntil(ctx, a.at); err != nil {
+			return nil, err
+		}
+		switch a.kind {
+		case actionTransmit:
+			next := s.transmit(ctx, a.at, a.dev)
+			schedule(&action{at: a.at + next, kind: actionTransmit, dev: a.dev})
+		case actionStatus:
+			if err := a.gtw.SendStatus(ctx, s.clock.Time(a.at)); err != nil {
+				s.trace(Event{Time: a.at, Type: EventError, Gateway: a.gtw.ID, Error: err.Error()})
+			} else {
+				s.trace(Event{Time: a.at, Type: EventGatewayStatus, Gateway: a.gtw.ID})
+			}
+			schedule(&action{at: a.at + a.gtw.StatusInterval, kind: actionStatus, gtw: a.gtw})
+		}
+	}
+	if err := s.clock.WaitUntil(ctx, s.scenario.Duration); err != nil {
+		return nil, err
+	}
+	return s.report(), nil
+}
+
+// transmit transmits the next frame of the device and returns the delay until the next transmission.
+func (s *Simulator) transmit(ctx context.Context, at time.Duration, dev *device) time.Duration {
+	tx, next, err := dev.Transmit(at)
+	if err != nil {
+		s.trace(Event{Time: at, Type: EventError, Device: dev.ID(), Error: err.Error()})
+		return next
+	}
+	if tx == nil {
+		return next
+	}
+	received := false
+	for _, gtw := range s.gateways {
+		res := s.channel.Link(
+			tx.key+"/"+gtw.ID, tx.location, gtw.Location, tx.eirp, gtw.AntennaGain, tx.frequency, tx.dataRate,
+		)
+		if !res.Received {
+			continue
+		}
+		received = true
+		s.trace(Event{
+			Time:      at,
+			Type:      EventReception,
+			Device:    dev.ID(),
+			Gateway:   gtw.ID,
+			Frequency: tx.frequency,
+			DataRate:  uint32(tx.dataRateIndex),
+			RSSI:      res.RSSI,
+			SNR:       res.SNR,
+		})
+		if err := gtw.Receive(ctx, &rxPacket{
+			payload:       tx.payload,
+			frequency:     tx.frequency,
+			dataRate:      tx.dataRate,
+			dataRateIndex: tx.dataRateIndex,
+			rssi:          res.RSSI,
+			snr:           res.SNR,
+			at:            at,
+			time:          s.clock.Time(at),
+		}); err != nil {
+			s.trace(Event{Time: at, Type: EventError, Device: dev.ID(), Gateway: gtw.ID, Error: err.Error()})
+		}
+	}
+	if received {
+		dev.UplinkReceived()
+	}
+	return next
+}
+
+// handleDownlink delivers the downlink transmitted by the gateway to the device it is intended for.
+func (s *Simulator) handleDownlink(ctx context.Context, gtw *gateway, down *downlink) {
+	now := s.clock.Now()
+	logger := log.FromContext(ctx).WithField("gateway_id", gtw.ID)
+	msg := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(down.payload, msg); err != nil {
+		logger.WithError(err).Debug("Failed to unmarshal downlink")
+		return
+	}
+	receive := func(dev *device) func() (linkResult, bool) {
+		return func() (linkResult, bool) {
+			res := s.channel.Link(
+				"down/"+gtw.ID+"/"+dev.ID()+"/"+hex.EncodeToString(down.payload),
+				gtw.Location, dev.loc, down.txPower, 0, down.frequency, down.dataRate,
+			)
+			if !res.Received {
+				s.trace(Event{
+					Time:    now,
+					Type:    EventDownlinkLost,
+					Device:  dev.ID(),
+					Gateway: gtw.ID,
+					RSSI:    res.RSSI,
+					SNR:     res.SNR,
+				})
+			}
+			return res, res.Received
+		}
+	}
+	switch msg.MHdr.MType {
+	case ttnpb.MType_JOIN_ACCEPT:
+		for _, dev := range s.devices {
+			if !dev.MatchJoinAccept(down) {
+				continue
+			}
+			// The device can only be identified by decrypting the join-accept.
+			if err := dev.HandleJoinAccept(now, down, receive(dev)); err == nil {
+				return
+			}
+		}
+	case ttnpb.MType_UNCONFIRMED_DOWN, ttnpb.MType_CONFIRMED_DOWN:
+		devAddr := msg.GetMacPayload().GetFHdr().GetDevAddr()
+		for _, dev := range s.devices {
+			if addr, ok := dev.DevAddr(); !ok || !bytes.Equal(addr[:], devAddr) {
+				continue
+			}
+			handled, err := dev.HandleDataDownlink(now, down, msg, receive(dev))
+			if err != nil {
+				s.trace(Event{Time: now, Type: EventError, Device: dev.ID(), Gateway: gtw.ID, Error: err.Error()})
+			}
+			if handled {
+				return
+			}
+		}
+	}
+}
+
+func (s *Simulator) report() *Report {
+	r := &Report{
+		Duration: s.scenario.Duration,
+		Devices:  make(map[string]DeviceStats, len(s.devices)),
+		Gateways: make(map[string]GatewayStats, len(s.gateways)),
+	}
+	for _, dev := range s.devices {
+		st := dev.Stats()
+		r.Devices[dev.ID()] = st
+		r.Total.JoinRequests += st.JoinRequests
+		r.Total.JoinAccepts += st.JoinAccepts
+		r.Total.Uplinks += st.Uplinks
+		r.Total.UplinksReceived += st.UplinksReceived
+		r.Total.ConfirmedUplinks += st.ConfirmedUplinks
+		r.Total.ConfirmedAcked += st.ConfirmedAcked
+		r.Total.Downlinks += st.Downlinks
+		r.Total.DownlinksLost += st.DownlinksLost
+		r.Total.DownlinksInvalid += st.DownlinksInvalid
+		r.Total.MACCommandsReceived += st.MACCommandsReceived
+	}
+	for _, gtw := range s.gateways {
+		r.Gateways[gtw.ID] = gtw.Stats()
+	}
+	return r
+}
@@ -0,0 +1,200 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulator
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smartystreets/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func newTestScenario(duration time.Duration, interval time.Duration) *Scenario {
+	loc := Location{Latitude: 52.3676, Longitude: 4.9041}
+	return &Scenario{
+		Seed:     42,
+		Duration: duration,
+		Propagation: PropagationConfig{
+			Model:           PropagationLogDistance,
+			ShadowingStdDev: 6,
+			PacketLoss:      0.1,
+		},
+		Gateways: []GatewayConfig{
+			{ID: "gtw-1", EUI: "0102030405060708", Address: "localhost:1700", Location: loc},
+			{ID: "gtw-2", EUI: "0102030405060709", Address: "localhost:1700", Location: loc.offset(2000, 2000)},
+		},
+		Devices: []DeviceGroup{
+			{
+				Name:        "otaa",
+				Count:       5,
+				JoinEUI:     "70B3D57ED0000000",
+				DevEUI:      "0004A30B001C0500",
+				AppKey:      testAppKey.String(),
+				Interval:    interval,
+				Jitter:      0.1,
+				FPort:       1,
+				PayloadSize: 8,
+				Location:    loc,
+				Radius:      3000,
+			},
+			{
+				Name:        "abp",
+				Count:       5,
+				Activation:  ActivationABP,
+				DevAddr:     "26010000",
+				NwkSKey:     testAppKey.String(),
+				AppSKey:     testAppKey.String(),
+				Interval:    interval,
+				FPort:       2,
+				PayloadSize: 4,
+				Confirmed:   true,
+				Location:    loc,
+				Radius:      3000,
+			},
+		},
+	}
+}
+
+func TestDryRunDeterminism(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	run := func() ([]Event, *Report) {
+		var events []Event
+		sim := test.Must(New(newTestScenario(time.Hour, 5*time.Minute), WithDryRun(true), WithTrace(func(ev Event) {
+			events = append(events, ev)
+		})))
+		report, err := sim.Run(ctx)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		return events, report
+	}
+	events1, report1 := run()
+	events2, report2 := run()
+	a.So(events1, should.NotBeEmpty)
+	a.So(events1, should.Resemble, events2)
+	a.So(report1, should.Resemble, report2)
+
+	// Without a network, the devices keep joining and the ABP devices repeat their confirmed uplinks.
+	a.So(report1.Devices, should.HaveLength, 10)
+	a.So(report1.Total.JoinRequests, should.BeGreaterThan, 0)
+	a.So(report1.Total.JoinAccepts, should.Equal, 0)
+	a.So(report1.Total.ConfirmedUplinks, should.BeGreaterThan, 0)
+	a.So(report1.Total.UplinksReceived, should.BeLessThanOrEqualTo, report1.Total.Uplinks)
+	for _, ev := range events1 {
+		a.So(ev.Time, should.BeLessThanOrEqualTo, time.Hour)
+		a.So(ev.Type, should.NotEqual, EventError)
+	}
+}
+
+type mockConn struct {
+	handler func(*downlink)
+	gtw     *gateway
+
+	mu      sync.Mutex
+	uplinks []*rxPacket
+}
+
+// SendUplink implements gatewayConn.
+// It responds to join-requests with a join-accept in the RX1 window.
+func (c *mockConn) SendUplink(_ context.Context, rx *rxPacket) error {
+	c.mu.Lock()
+	c.uplinks = append(c.uplinks, rx)
+	c.mu.Unlock()
+	msg := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(rx.payload, msg); err != nil {
+		return err
+	}
+	if msg.MHdr.MType != ttnpb.MType_JOIN_REQUEST || c.gtw.ID != "gtw-1" {
+		return nil
+	}
+	c.handler(&downlink{
+		gatewayID: c.gtw.ID,
+		payload:   joinAcceptFor(msg.GetJoinRequestPayload()),
+		frequency: rx.frequency,
+		dataRate:  rx.dataRate,
+		txPower:   16,
+		timestamp: concentratorTimestamp(rx.at + 5*time.Second),
+	})
+	return nil
+}
+
+// SendStatus implements gatewayConn.
+func (*mockConn) SendStatus(context.Context, *gatewayStatus) error { return nil }
+
+// Close implements gatewayConn.
+func (*mockConn) Close() error { return nil }
+
+var joinAcceptFor func(*ttnpb.JoinRequestPayload) []byte
+
+func TestSimulatorJoin(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	joinAcceptFor = func(*ttnpb.JoinRequestPayload) []byte {
+		return joinAccept(t, types.JoinNonce{0x00, 0x00, 0x01})
+	}
+	scenario := newTestScenario(1500*time.Millisecond, time.Second)
+	scenario.Propagation = PropagationConfig{}
+	scenario.Devices = scenario.Devices[:1]
+	scenario.Devices[0].Count = 1
+	scenario.Devices[0].Radius = 0
+
+	var (
+		connsMu sync.Mutex
+		conns   []*mockConn
+		events  []Event
+	)
+	sim := test.Must(New(scenario,
+		WithTrace(func(ev Event) { events = append(events, ev) }),
+		withDialer(func(_ context.Context, gtw *gateway, handler func(*downlink)) (gatewayConn, error) {
+			conn := &mockConn{handler: handler, gtw: gtw}
+			connsMu.Lock()
+			conns = append(conns, conn)
+			connsMu.Unlock()
+			return conn, nil
+		}),
+	))
+	report, err := sim.Run(ctx)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(conns, should.HaveLength, 2)
+	for _, conn := range conns {
+		a.So(conn.uplinks, should.NotBeEmpty)
+	}
+	a.So(report.Total.JoinRequests, should.Equal, 1)
+	a.So(report.Total.JoinAccepts, should.Equal, 1)
+	a.So(report.Gateways["gtw-1"].UplinksForwarded, should.BeGreaterThanOrEqualTo, 1)
+
+	var joined bool
+	for _, ev := range events {
+		if ev.Type == EventJoinAccept {
+			joined = true
+			a.So(ev.Gateway, should.Equal, "gtw-1")
+			a.So(ev.DevAddr, should.Equal, testDevAddr.String())
+			a.So(ev.Window, should.Equal, "rx1")
+		}
+	}
+	a.So(joined, should.BeTrue)
+}
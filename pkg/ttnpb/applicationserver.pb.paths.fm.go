@@ -9,11 +9,30 @@ var ApplicationLinkFieldPathsNested = []string{
 	"default_formatters.up_formatter",
 	"default_formatters.up_formatter_parameter",
 	"skip_payload_crypto",
+	"uplink_policy",
+	"uplink_policy.action",
+	"uplink_policy.max_decoded_payload_size",
+	"uplink_policy.max_uplinks",
+	"uplink_policy.window",
 }
 
 var ApplicationLinkFieldPathsTopLevel = []string{
 	"default_formatters",
 	"skip_payload_crypto",
+	"uplink_policy",
+}
+var ApplicationUplinkPolicyFieldPathsNested = []string{
+	"action",
+	"max_decoded_payload_size",
+	"max_uplinks",
+	"window",
+}
+
+var ApplicationUplinkPolicyFieldPathsTopLevel = []string{
+	"action",
+	"max_decoded_payload_size",
+	"max_uplinks",
+	"window",
 }
 var GetApplicationLinkRequestFieldPathsNested = []string{
 	"application_ids",
@@ -36,6 +55,11 @@ var SetApplicationLinkRequestFieldPathsNested = []string{
 	"link.default_formatters.up_formatter",
 	"link.default_formatters.up_formatter_parameter",
 	"link.skip_payload_crypto",
+	"link.uplink_policy",
+	"link.uplink_policy.action",
+	"link.uplink_policy.max_decoded_payload_size",
+	"link.uplink_policy.max_uplinks",
+	"link.uplink_policy.window",
 }
 
 var SetApplicationLinkRequestFieldPathsTopLevel = []string{
@@ -186,6 +210,7 @@ var DecodeUplinkRequestFieldPathsNested = []string{
 	"uplink.network_ids.tenant_id",
 	"uplink.normalized_payload",
 	"uplink.normalized_payload_warnings",
+	"uplink.policy_violations",
 	"uplink.received_at",
 	"uplink.rx_metadata",
 	"uplink.session_key_id",
@@ -255,6 +280,7 @@ var DecodeUplinkResponseFieldPathsNested = []string{
 	"uplink.network_ids.tenant_id",
 	"uplink.normalized_payload",
 	"uplink.normalized_payload_warnings",
+	"uplink.policy_violations",
 	"uplink.received_at",
 	"uplink.rx_metadata",
 	"uplink.session_key_id",
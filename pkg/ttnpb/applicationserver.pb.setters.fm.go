@@ -41,6 +41,80 @@ func (dst *ApplicationLink) SetFields(src *ApplicationLink, paths ...string) err
 			} else {
 				dst.SkipPayloadCrypto = nil
 			}
+		case "uplink_policy":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationUplinkPolicy
+				if (src == nil || src.UplinkPolicy == nil) && dst.UplinkPolicy == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.UplinkPolicy
+				}
+				if dst.UplinkPolicy != nil {
+					newDst = dst.UplinkPolicy
+				} else {
+					newDst = &ApplicationUplinkPolicy{}
+					dst.UplinkPolicy = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UplinkPolicy = src.UplinkPolicy
+				} else {
+					dst.UplinkPolicy = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationUplinkPolicy) SetFields(src *ApplicationUplinkPolicy, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "max_uplinks":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_uplinks' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxUplinks = src.MaxUplinks
+			} else {
+				var zero uint32
+				dst.MaxUplinks = zero
+			}
+		case "window":
+			if len(subs) > 0 {
+				return fmt.Errorf("'window' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Window = src.Window
+			} else {
+				dst.Window = nil
+			}
+		case "max_decoded_payload_size":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_decoded_payload_size' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxDecodedPayloadSize = src.MaxDecodedPayloadSize
+			} else {
+				var zero uint32
+				dst.MaxDecodedPayloadSize = zero
+			}
+		case "action":
+			if len(subs) > 0 {
+				return fmt.Errorf("'action' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Action = src.Action
+			} else {
+				dst.Action = 0
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -71,6 +71,18 @@ func (m *ApplicationLink) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "uplink_policy":
+
+			if v, ok := interface{}(m.GetUplinkPolicy()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationLinkValidationError{
+						field:  "uplink_policy",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return ApplicationLinkValidationError{
 				field:  name,
@@ -135,6 +147,123 @@ var _ interface {
 	ErrorName() string
 } = ApplicationLinkValidationError{}
 
+// ValidateFields checks the field values on ApplicationUplinkPolicy with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationUplinkPolicy) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationUplinkPolicyFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "max_uplinks":
+			// no validation rules for MaxUplinks
+		case "window":
+
+			if d := m.GetWindow(); d != nil {
+				dur, err := d.AsDuration(), d.CheckValid()
+				if err != nil {
+					return ApplicationUplinkPolicyValidationError{
+						field:  "window",
+						reason: "value is not a valid duration",
+						cause:  err,
+					}
+				}
+
+				gte := time.Duration(1*time.Second + 0*time.Nanosecond)
+
+				if dur < gte {
+					return ApplicationUplinkPolicyValidationError{
+						field:  "window",
+						reason: "value must be greater than or equal to 1s",
+					}
+				}
+
+			}
+
+		case "max_decoded_payload_size":
+			// no validation rules for MaxDecodedPayloadSize
+		case "action":
+
+			if _, ok := ApplicationUplinkPolicy_Action_name[int32(m.GetAction())]; !ok {
+				return ApplicationUplinkPolicyValidationError{
+					field:  "action",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		default:
+			return ApplicationUplinkPolicyValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationUplinkPolicyValidationError is the validation error returned by
+// ApplicationUplinkPolicy.ValidateFields if the designated constraints aren't met.
+type ApplicationUplinkPolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationUplinkPolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationUplinkPolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationUplinkPolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationUplinkPolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationUplinkPolicyValidationError) ErrorName() string {
+	return "ApplicationUplinkPolicyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationUplinkPolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationUplinkPolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationUplinkPolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationUplinkPolicyValidationError{}
+
 // ValidateFields checks the field values on GetApplicationLinkRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
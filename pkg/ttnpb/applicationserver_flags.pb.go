@@ -8,6 +8,7 @@ package ttnpb
 
 import (
 	flagsplugin "github.com/TheThingsIndustries/protoc-gen-go-flags/flagsplugin"
+	golang "github.com/TheThingsIndustries/protoc-gen-go-flags/golang"
 	pflag "github.com/spf13/pflag"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
@@ -17,6 +18,8 @@ func AddSelectFlagsForApplicationLink(flags *pflag.FlagSet, prefix string, hidde
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("default-formatters", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("default-formatters", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForMessagePayloadFormatters(flags, flagsplugin.Prefix("default-formatters", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("skip-payload-crypto", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("skip-payload-crypto", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("uplink-policy", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("uplink-policy", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationUplinkPolicy(flags, flagsplugin.Prefix("uplink-policy", prefix), hidden)
 }
 
 // SelectFromFlags outputs the fieldmask paths forApplicationLink message from select flags.
@@ -36,6 +39,16 @@ func PathsFromSelectFlagsForApplicationLink(flags *pflag.FlagSet, prefix string)
 	} else if selected && val {
 		paths = append(paths, flagsplugin.Prefix("skip_payload_crypto", prefix))
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("uplink_policy", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("uplink_policy", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationUplinkPolicy(flags, flagsplugin.Prefix("uplink_policy", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
 	return paths, nil
 }
 
@@ -43,6 +56,7 @@ func PathsFromSelectFlagsForApplicationLink(flags *pflag.FlagSet, prefix string)
 func AddSetFlagsForApplicationLink(flags *pflag.FlagSet, prefix string, hidden bool) {
 	AddSetFlagsForMessagePayloadFormatters(flags, flagsplugin.Prefix("default-formatters", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("skip-payload-crypto", prefix), "", flagsplugin.WithHidden(hidden)))
+	AddSetFlagsForApplicationUplinkPolicy(flags, flagsplugin.Prefix("uplink-policy", prefix), hidden)
 }
 
 // SetFromFlags sets the ApplicationLink message from flags.
@@ -63,5 +77,89 @@ func (m *ApplicationLink) SetFromFlags(flags *pflag.FlagSet, prefix string) (pat
 		m.SkipPayloadCrypto = &wrapperspb.BoolValue{Value: val}
 		paths = append(paths, flagsplugin.Prefix("skip_payload_crypto", prefix))
 	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("uplink_policy", prefix)); changed {
+		if m.UplinkPolicy == nil {
+			m.UplinkPolicy = &ApplicationUplinkPolicy{}
+		}
+		if setPaths, err := m.UplinkPolicy.SetFromFlags(flags, flagsplugin.Prefix("uplink_policy", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
+	return paths, nil
+}
+
+// AddSelectFlagsForApplicationUplinkPolicy adds flags to select fields in ApplicationUplinkPolicy.
+func AddSelectFlagsForApplicationUplinkPolicy(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("max-uplinks", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("max-uplinks", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("window", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("window", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("max-decoded-payload-size", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("max-decoded-payload-size", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("action", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("action", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationUplinkPolicy message from select flags.
+func PathsFromSelectFlagsForApplicationUplinkPolicy(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("max_uplinks", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("max_uplinks", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("window", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("window", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("max_decoded_payload_size", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("max_decoded_payload_size", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("action", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("action", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForApplicationUplinkPolicy adds flags to select fields in ApplicationUplinkPolicy.
+func AddSetFlagsForApplicationUplinkPolicy(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("max-uplinks", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewDurationFlag(flagsplugin.Prefix("window", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("max-decoded-payload-size", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("action", prefix), flagsplugin.EnumValueDesc(ApplicationUplinkPolicy_Action_value), flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the ApplicationUplinkPolicy message from flags.
+func (m *ApplicationUplinkPolicy) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("max_uplinks", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.MaxUplinks = val
+		paths = append(paths, flagsplugin.Prefix("max_uplinks", prefix))
+	}
+	if val, changed, err := flagsplugin.GetDuration(flags, flagsplugin.Prefix("window", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Window = golang.SetDuration(val)
+		paths = append(paths, flagsplugin.Prefix("window", prefix))
+	}
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("max_decoded_payload_size", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.MaxDecodedPayloadSize = val
+		paths = append(paths, flagsplugin.Prefix("max_decoded_payload_size", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("action", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		enumValue, err := flagsplugin.SetEnumString(val, ApplicationUplinkPolicy_Action_value)
+		if err != nil {
+			return nil, err
+		}
+		m.Action = ApplicationUplinkPolicy_Action(enumValue)
+		paths = append(paths, flagsplugin.Prefix("action", prefix))
+	}
 	return paths, nil
 }
@@ -811,6 +811,177 @@ var AppAs_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/applicationserver.proto",
 }
 
+const (
+	RemotePayloadFormatter_EncodeDownlink_FullMethodName = "/ttn.lorawan.v3.RemotePayloadFormatter/EncodeDownlink"
+	RemotePayloadFormatter_DecodeUplink_FullMethodName   = "/ttn.lorawan.v3.RemotePayloadFormatter/DecodeUplink"
+	RemotePayloadFormatter_DecodeDownlink_FullMethodName = "/ttn.lorawan.v3.RemotePayloadFormatter/DecodeDownlink"
+)
+
+// RemotePayloadFormatterClient is the client API for RemotePayloadFormatter service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RemotePayloadFormatterClient interface {
+	// Encode the decoded payload of the downlink message to the FRMPayload.
+	EncodeDownlink(ctx context.Context, in *EncodeDownlinkRequest, opts ...grpc.CallOption) (*EncodeDownlinkResponse, error)
+	// Decode the FRMPayload of the uplink message.
+	DecodeUplink(ctx context.Context, in *DecodeUplinkRequest, opts ...grpc.CallOption) (*DecodeUplinkResponse, error)
+	// Decode the FRMPayload of the downlink message.
+	DecodeDownlink(ctx context.Context, in *DecodeDownlinkRequest, opts ...grpc.CallOption) (*DecodeDownlinkResponse, error)
+}
+
+type remotePayloadFormatterClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRemotePayloadFormatterClient(cc grpc.ClientConnInterface) RemotePayloadFormatterClient {
+	return &remotePayloadFormatterClient{cc}
+}
+
+func (c *remotePayloadFormatterClient) EncodeDownlink(ctx context.Context, in *EncodeDownlinkRequest, opts ...grpc.CallOption) (*EncodeDownlinkResponse, error) {
+	out := new(EncodeDownlinkResponse)
+	err := c.cc.Invoke(ctx, RemotePayloadFormatter_EncodeDownlink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remotePayloadFormatterClient) DecodeUplink(ctx context.Context, in *DecodeUplinkRequest, opts ...grpc.CallOption) (*DecodeUplinkResponse, error) {
+	out := new(DecodeUplinkResponse)
+	err := c.cc.Invoke(ctx, RemotePayloadFormatter_DecodeUplink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remotePayloadFormatterClient) DecodeDownlink(ctx context.Context, in *DecodeDownlinkRequest, opts ...grpc.CallOption) (*DecodeDownlinkResponse, error) {
+	out := new(DecodeDownlinkResponse)
+	err := c.cc.Invoke(ctx, RemotePayloadFormatter_DecodeDownlink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemotePayloadFormatterServer is the server API for RemotePayloadFormatter service.
+// All implementations must embed UnimplementedRemotePayloadFormatterServer
+// for forward compatibility
+type RemotePayloadFormatterServer interface {
+	// Encode the decoded payload of the downlink message to the FRMPayload.
+	EncodeDownlink(context.Context, *EncodeDownlinkRequest) (*EncodeDownlinkResponse, error)
+	// Decode the FRMPayload of the uplink message.
+	DecodeUplink(context.Context, *DecodeUplinkRequest) (*DecodeUplinkResponse, error)
+	// Decode the FRMPayload of the downlink message.
+	DecodeDownlink(context.Context, *DecodeDownlinkRequest) (*DecodeDownlinkResponse, error)
+	mustEmbedUnimplementedRemotePayloadFormatterServer()
+}
+
+// UnimplementedRemotePayloadFormatterServer must be embedded to have forward compatible implementations.
+type UnimplementedRemotePayloadFormatterServer struct {
+}
+
+func (UnimplementedRemotePayloadFormatterServer) EncodeDownlink(context.Context, *EncodeDownlinkRequest) (*EncodeDownlinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncodeDownlink not implemented")
+}
+func (UnimplementedRemotePayloadFormatterServer) DecodeUplink(context.Context, *DecodeUplinkRequest) (*DecodeUplinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeUplink not implemented")
+}
+func (UnimplementedRemotePayloadFormatterServer) DecodeDownlink(context.Context, *DecodeDownlinkRequest) (*DecodeDownlinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodeDownlink not implemented")
+}
+func (UnimplementedRemotePayloadFormatterServer) mustEmbedUnimplementedRemotePayloadFormatterServer() {
+}
+
+// UnsafeRemotePayloadFormatterServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RemotePayloadFormatterServer will
+// result in compilation errors.
+type UnsafeRemotePayloadFormatterServer interface {
+	mustEmbedUnimplementedRemotePayloadFormatterServer()
+}
+
+func RegisterRemotePayloadFormatterServer(s grpc.ServiceRegistrar, srv RemotePayloadFormatterServer) {
+	s.RegisterService(&RemotePayloadFormatter_ServiceDesc, srv)
+}
+
+func _RemotePayloadFormatter_EncodeDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EncodeDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemotePayloadFormatterServer).EncodeDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RemotePayloadFormatter_EncodeDownlink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemotePayloadFormatterServer).EncodeDownlink(ctx, req.(*EncodeDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemotePayloadFormatter_DecodeUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeUplinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemotePayloadFormatterServer).DecodeUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RemotePayloadFormatter_DecodeUplink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemotePayloadFormatterServer).DecodeUplink(ctx, req.(*DecodeUplinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemotePayloadFormatter_DecodeDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemotePayloadFormatterServer).DecodeDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RemotePayloadFormatter_DecodeDownlink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemotePayloadFormatterServer).DecodeDownlink(ctx, req.(*DecodeDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RemotePayloadFormatter_ServiceDesc is the grpc.ServiceDesc for RemotePayloadFormatter service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RemotePayloadFormatter_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.RemotePayloadFormatter",
+	HandlerType: (*RemotePayloadFormatterServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EncodeDownlink",
+			Handler:    _RemotePayloadFormatter_EncodeDownlink_Handler,
+		},
+		{
+			MethodName: "DecodeUplink",
+			Handler:    _RemotePayloadFormatter_DecodeUplink_Handler,
+		},
+		{
+			MethodName: "DecodeDownlink",
+			Handler:    _RemotePayloadFormatter_DecodeDownlink_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/applicationserver.proto",
+}
+
 const (
 	AsEndDeviceRegistry_Get_FullMethodName    = "/ttn.lorawan.v3.AsEndDeviceRegistry/Get"
 	AsEndDeviceRegistry_Set_FullMethodName    = "/ttn.lorawan.v3.AsEndDeviceRegistry/Set"
@@ -34,6 +34,11 @@ func (x *ApplicationLink) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			s.WriteBool(x.SkipPayloadCrypto.Value)
 		}
 	}
+	if x.UplinkPolicy != nil || s.HasField("uplink_policy") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("uplink_policy")
+		x.UplinkPolicy.MarshalProtoJSON(s.WithField("uplink_policy"))
+	}
 	s.WriteObjectEnd()
 }
 
@@ -69,6 +74,13 @@ func (x *ApplicationLink) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 				return
 			}
 			x.SkipPayloadCrypto = &wrapperspb.BoolValue{Value: v}
+		case "uplink_policy", "uplinkPolicy":
+			if s.ReadNil() {
+				x.UplinkPolicy = nil
+				return
+			}
+			x.UplinkPolicy = &ApplicationUplinkPolicy{}
+			x.UplinkPolicy.UnmarshalProtoJSON(s.WithField("uplink_policy", true))
 		}
 	})
 }
@@ -78,6 +90,124 @@ func (x *ApplicationLink) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
+// MarshalProtoJSON marshals the ApplicationUplinkPolicy_Action to JSON.
+func (x ApplicationUplinkPolicy_Action) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), ApplicationUplinkPolicy_Action_name)
+}
+
+// MarshalText marshals the ApplicationUplinkPolicy_Action to text.
+func (x ApplicationUplinkPolicy_Action) MarshalText() ([]byte, error) {
+	return []byte(jsonplugin.GetEnumString(int32(x), ApplicationUplinkPolicy_Action_name)), nil
+}
+
+// MarshalJSON marshals the ApplicationUplinkPolicy_Action to JSON.
+func (x ApplicationUplinkPolicy_Action) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationUplinkPolicy_Action from JSON.
+func (x *ApplicationUplinkPolicy_Action) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(ApplicationUplinkPolicy_Action_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read Action enum: %v", err)
+		return
+	}
+	*x = ApplicationUplinkPolicy_Action(v)
+}
+
+// UnmarshalText unmarshals the ApplicationUplinkPolicy_Action from text.
+func (x *ApplicationUplinkPolicy_Action) UnmarshalText(b []byte) error {
+	i, err := jsonplugin.ParseEnumString(string(b), ApplicationUplinkPolicy_Action_value)
+	if err != nil {
+		return err
+	}
+	*x = ApplicationUplinkPolicy_Action(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the ApplicationUplinkPolicy_Action from JSON.
+func (x *ApplicationUplinkPolicy_Action) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ApplicationUplinkPolicy message to JSON.
+func (x *ApplicationUplinkPolicy) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.MaxUplinks != 0 || s.HasField("max_uplinks") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("max_uplinks")
+		s.WriteUint32(x.MaxUplinks)
+	}
+	if x.Window != nil || s.HasField("window") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("window")
+		if x.Window == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.Window)
+		}
+	}
+	if x.MaxDecodedPayloadSize != 0 || s.HasField("max_decoded_payload_size") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("max_decoded_payload_size")
+		s.WriteUint32(x.MaxDecodedPayloadSize)
+	}
+	if x.Action != 0 || s.HasField("action") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("action")
+		x.Action.MarshalProtoJSON(s)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ApplicationUplinkPolicy to JSON.
+func (x *ApplicationUplinkPolicy) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationUplinkPolicy message from JSON.
+func (x *ApplicationUplinkPolicy) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "max_uplinks", "maxUplinks":
+			s.AddField("max_uplinks")
+			x.MaxUplinks = s.ReadUint32()
+		case "window":
+			s.AddField("window")
+			if s.ReadNil() {
+				x.Window = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Window = v
+		case "max_decoded_payload_size", "maxDecodedPayloadSize":
+			s.AddField("max_decoded_payload_size")
+			x.MaxDecodedPayloadSize = s.ReadUint32()
+		case "action":
+			s.AddField("action")
+			x.Action.UnmarshalProtoJSON(s)
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ApplicationUplinkPolicy from JSON.
+func (x *ApplicationUplinkPolicy) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
 // MarshalProtoJSON marshals the GetApplicationLinkRequest message to JSON.
 func (x *GetApplicationLinkRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
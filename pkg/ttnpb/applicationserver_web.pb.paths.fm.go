@@ -133,6 +133,10 @@ var ApplicationWebhookHealthFieldPathsTopLevel = []string{
 }
 var ApplicationWebhookFieldPathsNested = []string{
 	"base_url",
+	"batching",
+	"batching.max_delay",
+	"batching.max_messages",
+	"batching.max_size",
 	"created_at",
 	"downlink_ack",
 	"downlink_ack.path",
@@ -180,6 +184,7 @@ var ApplicationWebhookFieldPathsNested = []string{
 	"location_solved.path",
 	"service_data",
 	"service_data.path",
+	"signing_secrets",
 	"template_fields",
 	"template_ids",
 	"template_ids.template_id",
@@ -192,6 +197,7 @@ var ApplicationWebhookFieldPathsNested = []string{
 
 var ApplicationWebhookFieldPathsTopLevel = []string{
 	"base_url",
+	"batching",
 	"created_at",
 	"downlink_ack",
 	"downlink_api_key",
@@ -208,6 +214,7 @@ var ApplicationWebhookFieldPathsTopLevel = []string{
 	"join_accept",
 	"location_solved",
 	"service_data",
+	"signing_secrets",
 	"template_fields",
 	"template_ids",
 	"updated_at",
@@ -254,6 +261,10 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"field_mask",
 	"webhook",
 	"webhook.base_url",
+	"webhook.batching",
+	"webhook.batching.max_delay",
+	"webhook.batching.max_messages",
+	"webhook.batching.max_size",
 	"webhook.created_at",
 	"webhook.downlink_ack",
 	"webhook.downlink_ack.path",
@@ -301,6 +312,7 @@ var SetApplicationWebhookRequestFieldPathsNested = []string{
 	"webhook.location_solved.path",
 	"webhook.service_data",
 	"webhook.service_data.path",
+	"webhook.signing_secrets",
 	"webhook.template_fields",
 	"webhook.template_ids",
 	"webhook.template_ids.template_id",
@@ -332,6 +344,86 @@ var ListApplicationWebhookTemplatesRequestFieldPathsNested = []string{
 var ListApplicationWebhookTemplatesRequestFieldPathsTopLevel = []string{
 	"field_mask",
 }
+var ApplicationWebhookDeliveryFieldPathsNested = []string{
+	"attempts",
+	"body",
+	"created_at",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"headers",
+	"id",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+	"last_attempt_at",
+	"last_error",
+	"last_error.attributes",
+	"last_error.cause",
+	"last_error.cause.attributes",
+	"last_error.cause.correlation_id",
+	"last_error.cause.message_format",
+	"last_error.cause.name",
+	"last_error.cause.namespace",
+	"last_error.code",
+	"last_error.correlation_id",
+	"last_error.details",
+	"last_error.message_format",
+	"last_error.name",
+	"last_error.namespace",
+	"url",
+}
+
+var ApplicationWebhookDeliveryFieldPathsTopLevel = []string{
+	"attempts",
+	"body",
+	"created_at",
+	"end_device_ids",
+	"headers",
+	"id",
+	"ids",
+	"last_attempt_at",
+	"last_error",
+	"url",
+}
+var ApplicationWebhookDeliveriesFieldPathsNested = []string{
+	"deliveries",
+}
+
+var ApplicationWebhookDeliveriesFieldPathsTopLevel = []string{
+	"deliveries",
+}
+var ListApplicationWebhookDeadLettersRequestFieldPathsNested = []string{
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+	"limit",
+	"page",
+}
+
+var ListApplicationWebhookDeadLettersRequestFieldPathsTopLevel = []string{
+	"ids",
+	"limit",
+	"page",
+}
+var ApplicationWebhookDeadLettersRequestFieldPathsNested = []string{
+	"dead_letter_ids",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.webhook_id",
+}
+
+var ApplicationWebhookDeadLettersRequestFieldPathsTopLevel = []string{
+	"dead_letter_ids",
+	"ids",
+}
 var ApplicationWebhookTemplate_MessageFieldPathsNested = []string{
 	"path",
 }
@@ -372,3 +464,14 @@ var ApplicationWebhook_MessageFieldPathsNested = []string{
 var ApplicationWebhook_MessageFieldPathsTopLevel = []string{
 	"path",
 }
+var ApplicationWebhook_BatchingFieldPathsNested = []string{
+	"max_delay",
+	"max_messages",
+	"max_size",
+}
+
+var ApplicationWebhook_BatchingFieldPathsTopLevel = []string{
+	"max_delay",
+	"max_messages",
+	"max_size",
+}
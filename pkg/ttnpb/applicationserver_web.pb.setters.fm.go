@@ -1112,6 +1112,40 @@ func (dst *ApplicationWebhook) SetFields(src *ApplicationWebhook, paths ...strin
 			} else {
 				dst.FieldMask = nil
 			}
+		case "signing_secrets":
+			if len(subs) > 0 {
+				return fmt.Errorf("'signing_secrets' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SigningSecrets = src.SigningSecrets
+			} else {
+				dst.SigningSecrets = nil
+			}
+		case "batching":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhook_Batching
+				if (src == nil || src.Batching == nil) && dst.Batching == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Batching
+				}
+				if dst.Batching != nil {
+					newDst = dst.Batching
+				} else {
+					newDst = &ApplicationWebhook_Batching{}
+					dst.Batching = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Batching = src.Batching
+				} else {
+					dst.Batching = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -1360,6 +1394,279 @@ func (dst *ListApplicationWebhookTemplatesRequest) SetFields(src *ListApplicatio
 	return nil
 }
 
+func (dst *ApplicationWebhookDelivery) SetFields(src *ApplicationWebhookDelivery, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Id = src.Id
+			} else {
+				var zero string
+				dst.Id = zero
+			}
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if (src == nil || src.Ids == nil) && dst.Ids == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Ids
+				}
+				if dst.Ids != nil {
+					newDst = dst.Ids
+				} else {
+					newDst = &ApplicationWebhookIdentifiers{}
+					dst.Ids = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Ids = src.Ids
+				} else {
+					dst.Ids = nil
+				}
+			}
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Url = src.Url
+			} else {
+				var zero string
+				dst.Url = zero
+			}
+		case "headers":
+			if len(subs) > 0 {
+				return fmt.Errorf("'headers' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Headers = src.Headers
+			} else {
+				dst.Headers = nil
+			}
+		case "body":
+			if len(subs) > 0 {
+				return fmt.Errorf("'body' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Body = src.Body
+			} else {
+				dst.Body = nil
+			}
+		case "attempts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'attempts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Attempts = src.Attempts
+			} else {
+				var zero uint32
+				dst.Attempts = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				dst.CreatedAt = nil
+			}
+		case "last_attempt_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'last_attempt_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LastAttemptAt = src.LastAttemptAt
+			} else {
+				dst.LastAttemptAt = nil
+			}
+		case "last_error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.LastError == nil) && dst.LastError == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.LastError
+				}
+				if dst.LastError != nil {
+					newDst = dst.LastError
+				} else {
+					newDst = &ErrorDetails{}
+					dst.LastError = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.LastError = src.LastError
+				} else {
+					dst.LastError = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationWebhookDeliveries) SetFields(src *ApplicationWebhookDeliveries, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "deliveries":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deliveries' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Deliveries = src.Deliveries
+			} else {
+				dst.Deliveries = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListApplicationWebhookDeadLettersRequest) SetFields(src *ListApplicationWebhookDeadLettersRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if (src == nil || src.Ids == nil) && dst.Ids == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Ids
+				}
+				if dst.Ids != nil {
+					newDst = dst.Ids
+				} else {
+					newDst = &ApplicationWebhookIdentifiers{}
+					dst.Ids = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Ids = src.Ids
+				} else {
+					dst.Ids = nil
+				}
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationWebhookDeadLettersRequest) SetFields(src *ApplicationWebhookDeadLettersRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationWebhookIdentifiers
+				if (src == nil || src.Ids == nil) && dst.Ids == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Ids
+				}
+				if dst.Ids != nil {
+					newDst = dst.Ids
+				} else {
+					newDst = &ApplicationWebhookIdentifiers{}
+					dst.Ids = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Ids = src.Ids
+				} else {
+					dst.Ids = nil
+				}
+			}
+		case "dead_letter_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dead_letter_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeadLetterIds = src.DeadLetterIds
+			} else {
+				dst.DeadLetterIds = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ApplicationWebhookTemplate_Message) SetFields(src *ApplicationWebhookTemplate_Message, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -1463,3 +1770,43 @@ func (dst *ApplicationWebhook_Message) SetFields(src *ApplicationWebhook_Message
 	}
 	return nil
 }
+
+func (dst *ApplicationWebhook_Batching) SetFields(src *ApplicationWebhook_Batching, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "max_messages":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_messages' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxMessages = src.MaxMessages
+			} else {
+				var zero uint32
+				dst.MaxMessages = zero
+			}
+		case "max_size":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_size' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxSize = src.MaxSize
+			} else {
+				var zero uint32
+				dst.MaxSize = zero
+			}
+		case "max_delay":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_delay' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxDelay = src.MaxDelay
+			} else {
+				dst.MaxDelay = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
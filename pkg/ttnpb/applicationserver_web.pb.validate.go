@@ -1290,6 +1290,49 @@ func (m *ApplicationWebhook) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "signing_secrets":
+
+			if len(m.GetSigningSecrets()) > 2 {
+				return ApplicationWebhookValidationError{
+					field:  "signing_secrets",
+					reason: "value must contain no more than 2 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetSigningSecrets() {
+				_, _ = idx, item
+
+				if item == nil {
+					return ApplicationWebhookValidationError{
+						field:  fmt.Sprintf("signing_secrets[%v]", idx),
+						reason: "value is required",
+					}
+				}
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationWebhookValidationError{
+							field:  fmt.Sprintf("signing_secrets[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "batching":
+
+			if v, ok := interface{}(m.GetBatching()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookValidationError{
+						field:  "batching",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return ApplicationWebhookValidationError{
 				field:  name,
@@ -2086,6 +2129,505 @@ var _ interface {
 	ErrorName() string
 } = ListApplicationWebhookTemplatesRequestValidationError{}
 
+// ValidateFields checks the field values on ApplicationWebhookDelivery with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ApplicationWebhookDelivery) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookDeliveryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "id":
+			// no validation rules for Id
+		case "ids":
+
+			if m.GetIds() == nil {
+				return ApplicationWebhookDeliveryValidationError{
+					field:  "ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return ApplicationWebhookDeliveryValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "url":
+			// no validation rules for Url
+		case "headers":
+			// no validation rules for Headers
+		case "body":
+			// no validation rules for Body
+		case "attempts":
+			// no validation rules for Attempts
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_attempt_at":
+
+			if v, ok := interface{}(m.GetLastAttemptAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "last_attempt_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "last_error":
+
+			if v, ok := interface{}(m.GetLastError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeliveryValidationError{
+						field:  "last_error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationWebhookDeliveryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookDeliveryValidationError is the validation error returned
+// by ApplicationWebhookDelivery.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhookDeliveryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookDeliveryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookDeliveryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookDeliveryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookDeliveryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookDeliveryValidationError) ErrorName() string {
+	return "ApplicationWebhookDeliveryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookDeliveryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookDelivery.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookDeliveryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookDeliveryValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhookDeliveries with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ApplicationWebhookDeliveries) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookDeliveriesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "deliveries":
+
+			for idx, item := range m.GetDeliveries() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationWebhookDeliveriesValidationError{
+							field:  fmt.Sprintf("deliveries[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationWebhookDeliveriesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookDeliveriesValidationError is the validation error returned
+// by ApplicationWebhookDeliveries.ValidateFields if the designated
+// constraints aren't met.
+type ApplicationWebhookDeliveriesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookDeliveriesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookDeliveriesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookDeliveriesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookDeliveriesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookDeliveriesValidationError) ErrorName() string {
+	return "ApplicationWebhookDeliveriesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookDeliveriesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookDeliveries.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookDeliveriesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookDeliveriesValidationError{}
+
+// ValidateFields checks the field values on
+// ListApplicationWebhookDeadLettersRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *ListApplicationWebhookDeadLettersRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListApplicationWebhookDeadLettersRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if m.GetIds() == nil {
+				return ListApplicationWebhookDeadLettersRequestValidationError{
+					field:  "ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListApplicationWebhookDeadLettersRequestValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return ListApplicationWebhookDeadLettersRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		default:
+			return ListApplicationWebhookDeadLettersRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListApplicationWebhookDeadLettersRequestValidationError is the validation
+// error returned by ListApplicationWebhookDeadLettersRequest.ValidateFields
+// if the designated constraints aren't met.
+type ListApplicationWebhookDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListApplicationWebhookDeadLettersRequestValidationError) ErrorName() string {
+	return "ListApplicationWebhookDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListApplicationWebhookDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListApplicationWebhookDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListApplicationWebhookDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListApplicationWebhookDeadLettersRequestValidationError{}
+
+// ValidateFields checks the field values on
+// ApplicationWebhookDeadLettersRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *ApplicationWebhookDeadLettersRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookDeadLettersRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if m.GetIds() == nil {
+				return ApplicationWebhookDeadLettersRequestValidationError{
+					field:  "ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookDeadLettersRequestValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "dead_letter_ids":
+
+			if len(m.GetDeadLetterIds()) > 1000 {
+				return ApplicationWebhookDeadLettersRequestValidationError{
+					field:  "dead_letter_ids",
+					reason: "value must contain no more than 1000 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetDeadLetterIds() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 64 {
+					return ApplicationWebhookDeadLettersRequestValidationError{
+						field:  fmt.Sprintf("dead_letter_ids[%v]", idx),
+						reason: "value length must be at most 64 runes",
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationWebhookDeadLettersRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookDeadLettersRequestValidationError is the validation error
+// returned by ApplicationWebhookDeadLettersRequest.ValidateFields if the
+// designated constraints aren't met.
+type ApplicationWebhookDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookDeadLettersRequestValidationError) ErrorName() string {
+	return "ApplicationWebhookDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookDeadLettersRequestValidationError{}
+
 // ValidateFields checks the field values on ApplicationWebhookTemplate_Message
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, an error is returned.
@@ -2457,3 +2999,127 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ApplicationWebhook_MessageValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhook_Batching with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ApplicationWebhook_Batching) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhook_BatchingFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "max_messages":
+
+			if m.GetMaxMessages() > 1000 {
+				return ApplicationWebhook_BatchingValidationError{
+					field:  "max_messages",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "max_size":
+
+			if m.GetMaxSize() > 10485760 {
+				return ApplicationWebhook_BatchingValidationError{
+					field:  "max_size",
+					reason: "value must be less than or equal to 10485760",
+				}
+			}
+
+		case "max_delay":
+
+			if d := m.GetMaxDelay(); d != nil {
+				dur, err := d.AsDuration(), d.CheckValid()
+				if err != nil {
+					return ApplicationWebhook_BatchingValidationError{
+						field:  "max_delay",
+						reason: "value is not a valid duration",
+						cause:  err,
+					}
+				}
+
+				lte := time.Duration(60*time.Second + 0*time.Nanosecond)
+				gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+				if dur < gte || dur > lte {
+					return ApplicationWebhook_BatchingValidationError{
+						field:  "max_delay",
+						reason: "value must be inside range [0s, 1m0s]",
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationWebhook_BatchingValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhook_BatchingValidationError is the validation error returned
+// by ApplicationWebhook_Batching.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhook_BatchingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhook_BatchingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhook_BatchingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhook_BatchingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhook_BatchingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhook_BatchingValidationError) ErrorName() string {
+	return "ApplicationWebhook_BatchingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhook_BatchingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhook_Batching.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhook_BatchingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhook_BatchingValidationError{}
@@ -199,6 +199,63 @@ func (m *ApplicationWebhook_Message) SetFromFlags(flags *pflag.FlagSet, prefix s
 	return paths, nil
 }
 
+// AddSelectFlagsForApplicationWebhook_Batching adds flags to select fields in ApplicationWebhook_Batching.
+func AddSelectFlagsForApplicationWebhook_Batching(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("max-messages", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("max-messages", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("max-size", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("max-size", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("max-delay", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("max-delay", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forApplicationWebhook_Batching message from select flags.
+func PathsFromSelectFlagsForApplicationWebhook_Batching(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("max_messages", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("max_messages", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("max_size", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("max_size", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("max_delay", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("max_delay", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForApplicationWebhook_Batching adds flags to select fields in ApplicationWebhook_Batching.
+func AddSetFlagsForApplicationWebhook_Batching(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("max-messages", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("max-size", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewDurationFlag(flagsplugin.Prefix("max-delay", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the ApplicationWebhook_Batching message from flags.
+func (m *ApplicationWebhook_Batching) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("max_messages", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.MaxMessages = val
+		paths = append(paths, flagsplugin.Prefix("max_messages", prefix))
+	}
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("max_size", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.MaxSize = val
+		paths = append(paths, flagsplugin.Prefix("max_size", prefix))
+	}
+	if val, changed, err := flagsplugin.GetDuration(flags, flagsplugin.Prefix("max_delay", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.MaxDelay = golang.SetDuration(val)
+		paths = append(paths, flagsplugin.Prefix("max_delay", prefix))
+	}
+	return paths, nil
+}
+
 // AddSelectFlagsForApplicationWebhook adds flags to select fields in ApplicationWebhook.
 func AddSelectFlagsForApplicationWebhook(flags *pflag.FlagSet, prefix string, hidden bool) {
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("base-url", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("base-url", prefix), false), flagsplugin.WithHidden(hidden)))
@@ -233,6 +290,9 @@ func AddSelectFlagsForApplicationWebhook(flags *pflag.FlagSet, prefix string, hi
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("health-status", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("health-status", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForApplicationWebhookHealth(flags, flagsplugin.Prefix("health-status", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("field-mask", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("field-mask", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("signing-secrets", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("signing-secrets", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("batching", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("batching", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForApplicationWebhook_Batching(flags, flagsplugin.Prefix("batching", prefix), hidden)
 }
 
 // SelectFromFlags outputs the fieldmask paths forApplicationWebhook message from select flags.
@@ -397,6 +457,21 @@ func PathsFromSelectFlagsForApplicationWebhook(flags *pflag.FlagSet, prefix stri
 	} else if selected && val {
 		paths = append(paths, flagsplugin.Prefix("field_mask", prefix))
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("signing_secrets", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("signing_secrets", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("batching", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("batching", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForApplicationWebhook_Batching(flags, flagsplugin.Prefix("batching", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
 	return paths, nil
 }
 
@@ -422,6 +497,7 @@ func AddSetFlagsForApplicationWebhook(flags *pflag.FlagSet, prefix string, hidde
 	AddSetFlagsForApplicationWebhook_Message(flags, flagsplugin.Prefix("service-data", prefix), hidden)
 	// FIXME: Skipping HealthStatus because it does not seem to implement AddSetFlags.
 	flags.AddFlag(flagsplugin.NewStringSliceFlag(flagsplugin.Prefix("field-mask", prefix), "", flagsplugin.WithHidden(hidden)))
+	AddSetFlagsForApplicationWebhook_Batching(flags, flagsplugin.Prefix("batching", prefix), hidden)
 }
 
 // SetFromFlags sets the ApplicationWebhook message from flags.
@@ -593,5 +669,15 @@ func (m *ApplicationWebhook) SetFromFlags(flags *pflag.FlagSet, prefix string) (
 		m.FieldMask = golang.SetFieldMask(val)
 		paths = append(paths, flagsplugin.Prefix("field_mask", prefix))
 	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("batching", prefix)); changed {
+		if m.Batching == nil {
+			m.Batching = &ApplicationWebhook_Batching{}
+		}
+		if setPaths, err := m.Batching.SetFromFlags(flags, flagsplugin.Prefix("batching", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
 	return paths, nil
 }
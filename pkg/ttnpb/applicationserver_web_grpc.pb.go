@@ -34,13 +34,16 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ApplicationWebhookRegistry_GetFormats_FullMethodName    = "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetFormats"
-	ApplicationWebhookRegistry_GetTemplate_FullMethodName   = "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetTemplate"
-	ApplicationWebhookRegistry_ListTemplates_FullMethodName = "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListTemplates"
-	ApplicationWebhookRegistry_Get_FullMethodName           = "/ttn.lorawan.v3.ApplicationWebhookRegistry/Get"
-	ApplicationWebhookRegistry_List_FullMethodName          = "/ttn.lorawan.v3.ApplicationWebhookRegistry/List"
-	ApplicationWebhookRegistry_Set_FullMethodName           = "/ttn.lorawan.v3.ApplicationWebhookRegistry/Set"
-	ApplicationWebhookRegistry_Delete_FullMethodName        = "/ttn.lorawan.v3.ApplicationWebhookRegistry/Delete"
+	ApplicationWebhookRegistry_GetFormats_FullMethodName        = "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetFormats"
+	ApplicationWebhookRegistry_GetTemplate_FullMethodName       = "/ttn.lorawan.v3.ApplicationWebhookRegistry/GetTemplate"
+	ApplicationWebhookRegistry_ListTemplates_FullMethodName     = "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListTemplates"
+	ApplicationWebhookRegistry_Get_FullMethodName               = "/ttn.lorawan.v3.ApplicationWebhookRegistry/Get"
+	ApplicationWebhookRegistry_List_FullMethodName              = "/ttn.lorawan.v3.ApplicationWebhookRegistry/List"
+	ApplicationWebhookRegistry_Set_FullMethodName               = "/ttn.lorawan.v3.ApplicationWebhookRegistry/Set"
+	ApplicationWebhookRegistry_Delete_FullMethodName            = "/ttn.lorawan.v3.ApplicationWebhookRegistry/Delete"
+	ApplicationWebhookRegistry_ListDeadLetters_FullMethodName   = "/ttn.lorawan.v3.ApplicationWebhookRegistry/ListDeadLetters"
+	ApplicationWebhookRegistry_ReplayDeadLetters_FullMethodName = "/ttn.lorawan.v3.ApplicationWebhookRegistry/ReplayDeadLetters"
+	ApplicationWebhookRegistry_PurgeDeadLetters_FullMethodName  = "/ttn.lorawan.v3.ApplicationWebhookRegistry/PurgeDeadLetters"
 )
 
 // ApplicationWebhookRegistryClient is the client API for ApplicationWebhookRegistry service.
@@ -54,6 +57,13 @@ type ApplicationWebhookRegistryClient interface {
 	List(ctx context.Context, in *ListApplicationWebhooksRequest, opts ...grpc.CallOption) (*ApplicationWebhooks, error)
 	Set(ctx context.Context, in *SetApplicationWebhookRequest, opts ...grpc.CallOption) (*ApplicationWebhook, error)
 	Delete(ctx context.Context, in *ApplicationWebhookIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// List the webhook requests that could not be delivered.
+	// This requires the webhook delivery queue to be enabled in the Application Server.
+	ListDeadLetters(ctx context.Context, in *ListApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ApplicationWebhookDeliveries, error)
+	// Queue the webhook requests that could not be delivered for delivery again.
+	ReplayDeadLetters(ctx context.Context, in *ApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Delete the webhook requests that could not be delivered.
+	PurgeDeadLetters(ctx context.Context, in *ApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type applicationWebhookRegistryClient struct {
@@ -127,6 +137,33 @@ func (c *applicationWebhookRegistryClient) Delete(ctx context.Context, in *Appli
 	return out, nil
 }
 
+func (c *applicationWebhookRegistryClient) ListDeadLetters(ctx context.Context, in *ListApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*ApplicationWebhookDeliveries, error) {
+	out := new(ApplicationWebhookDeliveries)
+	err := c.cc.Invoke(ctx, ApplicationWebhookRegistry_ListDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) ReplayDeadLetters(ctx context.Context, in *ApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationWebhookRegistry_ReplayDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationWebhookRegistryClient) PurgeDeadLetters(ctx context.Context, in *ApplicationWebhookDeadLettersRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationWebhookRegistry_PurgeDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationWebhookRegistryServer is the server API for ApplicationWebhookRegistry service.
 // All implementations must embed UnimplementedApplicationWebhookRegistryServer
 // for forward compatibility
@@ -138,6 +175,13 @@ type ApplicationWebhookRegistryServer interface {
 	List(context.Context, *ListApplicationWebhooksRequest) (*ApplicationWebhooks, error)
 	Set(context.Context, *SetApplicationWebhookRequest) (*ApplicationWebhook, error)
 	Delete(context.Context, *ApplicationWebhookIdentifiers) (*emptypb.Empty, error)
+	// List the webhook requests that could not be delivered.
+	// This requires the webhook delivery queue to be enabled in the Application Server.
+	ListDeadLetters(context.Context, *ListApplicationWebhookDeadLettersRequest) (*ApplicationWebhookDeliveries, error)
+	// Queue the webhook requests that could not be delivered for delivery again.
+	ReplayDeadLetters(context.Context, *ApplicationWebhookDeadLettersRequest) (*emptypb.Empty, error)
+	// Delete the webhook requests that could not be delivered.
+	PurgeDeadLetters(context.Context, *ApplicationWebhookDeadLettersRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedApplicationWebhookRegistryServer()
 }
 
@@ -166,6 +210,15 @@ func (UnimplementedApplicationWebhookRegistryServer) Set(context.Context, *SetAp
 func (UnimplementedApplicationWebhookRegistryServer) Delete(context.Context, *ApplicationWebhookIdentifiers) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedApplicationWebhookRegistryServer) ListDeadLetters(context.Context, *ListApplicationWebhookDeadLettersRequest) (*ApplicationWebhookDeliveries, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedApplicationWebhookRegistryServer) ReplayDeadLetters(context.Context, *ApplicationWebhookDeadLettersRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetters not implemented")
+}
+func (UnimplementedApplicationWebhookRegistryServer) PurgeDeadLetters(context.Context, *ApplicationWebhookDeadLettersRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDeadLetters not implemented")
+}
 func (UnimplementedApplicationWebhookRegistryServer) mustEmbedUnimplementedApplicationWebhookRegistryServer() {
 }
 
@@ -306,6 +359,60 @@ func _ApplicationWebhookRegistry_Delete_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationWebhookRegistry_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationWebhookDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationWebhookRegistry_ListDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ListDeadLetters(ctx, req.(*ListApplicationWebhookDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationWebhookDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).ReplayDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationWebhookRegistry_ReplayDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).ReplayDeadLetters(ctx, req.(*ApplicationWebhookDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationWebhookRegistry_PurgeDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationWebhookDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationWebhookRegistryServer).PurgeDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationWebhookRegistry_PurgeDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationWebhookRegistryServer).PurgeDeadLetters(ctx, req.(*ApplicationWebhookDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApplicationWebhookRegistry_ServiceDesc is the grpc.ServiceDesc for ApplicationWebhookRegistry service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -341,6 +448,18 @@ var ApplicationWebhookRegistry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _ApplicationWebhookRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _ApplicationWebhookRegistry_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _ApplicationWebhookRegistry_ReplayDeadLetters_Handler,
+		},
+		{
+			MethodName: "PurgeDeadLetters",
+			Handler:    _ApplicationWebhookRegistry_PurgeDeadLetters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/applicationserver_web.proto",
@@ -588,6 +588,24 @@ func (x *ApplicationWebhook) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			golang.MarshalLegacyFieldMask(s, x.FieldMask)
 		}
 	}
+	if len(x.SigningSecrets) > 0 || s.HasField("signing_secrets") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("signing_secrets")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.SigningSecrets {
+			s.WriteMoreIf(&wroteElement)
+			// NOTE: Secret does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, element)
+		}
+		s.WriteArrayEnd()
+	}
+	if x.Batching != nil || s.HasField("batching") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("batching")
+		// NOTE: ApplicationWebhook_Batching does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Batching)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -807,6 +825,28 @@ func (x *ApplicationWebhook) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 				return
 			}
 			x.FieldMask = v
+		case "signing_secrets", "signingSecrets":
+			s.AddField("signing_secrets")
+			if s.ReadNil() {
+				x.SigningSecrets = nil
+				return
+			}
+			s.ReadArray(func() {
+				// NOTE: Secret does not seem to implement UnmarshalProtoJSON.
+				var v Secret
+				golang.UnmarshalMessage(s, &v)
+				x.SigningSecrets = append(x.SigningSecrets, &v)
+			})
+		case "batching":
+			s.AddField("batching")
+			if s.ReadNil() {
+				x.Batching = nil
+				return
+			}
+			// NOTE: ApplicationWebhook_Batching does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationWebhook_Batching
+			golang.UnmarshalMessage(s, &v)
+			x.Batching = &v
 		}
 	})
 }
@@ -1209,3 +1249,238 @@ func (x *ListApplicationWebhookTemplatesRequest) UnmarshalProtoJSON(s *jsonplugi
 func (x *ListApplicationWebhookTemplatesRequest) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the ApplicationWebhookDelivery message to JSON.
+func (x *ApplicationWebhookDelivery) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Id != "" || s.HasField("id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("id")
+		s.WriteString(x.Id)
+	}
+	if x.Ids != nil || s.HasField("ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("ids")
+		// NOTE: ApplicationWebhookIdentifiers does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Ids)
+	}
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.Url != "" || s.HasField("url") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("url")
+		s.WriteString(x.Url)
+	}
+	if x.Headers != nil || s.HasField("headers") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("headers")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.Headers {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			s.WriteString(v)
+		}
+		s.WriteObjectEnd()
+	}
+	if len(x.Body) > 0 || s.HasField("body") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("body")
+		s.WriteBytes(x.Body)
+	}
+	if x.Attempts != 0 || s.HasField("attempts") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("attempts")
+		s.WriteUint32(x.Attempts)
+	}
+	if x.CreatedAt != nil || s.HasField("created_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("created_at")
+		if x.CreatedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.CreatedAt)
+		}
+	}
+	if x.LastAttemptAt != nil || s.HasField("last_attempt_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("last_attempt_at")
+		if x.LastAttemptAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.LastAttemptAt)
+		}
+	}
+	if x.LastError != nil || s.HasField("last_error") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("last_error")
+		// NOTE: ErrorDetails does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.LastError)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ApplicationWebhookDelivery to JSON.
+func (x *ApplicationWebhookDelivery) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationWebhookDelivery message from JSON.
+func (x *ApplicationWebhookDelivery) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "id":
+			s.AddField("id")
+			x.Id = s.ReadString()
+		case "ids":
+			s.AddField("ids")
+			if s.ReadNil() {
+				x.Ids = nil
+				return
+			}
+			// NOTE: ApplicationWebhookIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationWebhookIdentifiers
+			golang.UnmarshalMessage(s, &v)
+			x.Ids = &v
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "url":
+			s.AddField("url")
+			x.Url = s.ReadString()
+		case "headers":
+			s.AddField("headers")
+			if s.ReadNil() {
+				x.Headers = nil
+				return
+			}
+			x.Headers = make(map[string]string)
+			s.ReadStringMap(func(key string) {
+				x.Headers[key] = s.ReadString()
+			})
+		case "body":
+			s.AddField("body")
+			x.Body = s.ReadBytes()
+		case "attempts":
+			s.AddField("attempts")
+			x.Attempts = s.ReadUint32()
+		case "created_at", "createdAt":
+			s.AddField("created_at")
+			if s.ReadNil() {
+				x.CreatedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.CreatedAt = v
+		case "last_attempt_at", "lastAttemptAt":
+			s.AddField("last_attempt_at")
+			if s.ReadNil() {
+				x.LastAttemptAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.LastAttemptAt = v
+		case "last_error", "lastError":
+			s.AddField("last_error")
+			if s.ReadNil() {
+				x.LastError = nil
+				return
+			}
+			// NOTE: ErrorDetails does not seem to implement UnmarshalProtoJSON.
+			var v ErrorDetails
+			golang.UnmarshalMessage(s, &v)
+			x.LastError = &v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ApplicationWebhookDelivery from JSON.
+func (x *ApplicationWebhookDelivery) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ApplicationWebhookDeliveries message to JSON.
+func (x *ApplicationWebhookDeliveries) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Deliveries) > 0 || s.HasField("deliveries") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("deliveries")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Deliveries {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("deliveries"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ApplicationWebhookDeliveries to JSON.
+func (x *ApplicationWebhookDeliveries) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ApplicationWebhookDeliveries message from JSON.
+func (x *ApplicationWebhookDeliveries) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "deliveries":
+			s.AddField("deliveries")
+			if s.ReadNil() {
+				x.Deliveries = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Deliveries = append(x.Deliveries, nil)
+					return
+				}
+				v := &ApplicationWebhookDelivery{}
+				v.UnmarshalProtoJSON(s.WithField("deliveries", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Deliveries = append(x.Deliveries, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ApplicationWebhookDeliveries from JSON.
+func (x *ApplicationWebhookDeliveries) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -252,6 +252,31 @@ var MessagePayloadEncoderFieldPathsTopLevel = []string{
 	"formatter",
 	"formatter_parameter",
 }
+var TestPayloadFormattersRequestFieldPathsNested = []string{
+	"formatters",
+	"formatters.down_formatter",
+	"formatters.down_formatter_parameter",
+	"formatters.up_formatter",
+	"formatters.up_formatter_parameter",
+	"version_ids",
+	"version_ids.band_id",
+	"version_ids.brand_id",
+	"version_ids.firmware_version",
+	"version_ids.hardware_version",
+	"version_ids.model_id",
+}
+
+var TestPayloadFormattersRequestFieldPathsTopLevel = []string{
+	"formatters",
+	"version_ids",
+}
+var TestPayloadFormattersResponseFieldPathsNested = []string{
+	"results",
+}
+
+var TestPayloadFormattersResponseFieldPathsTopLevel = []string{
+	"results",
+}
 var EndDeviceModel_HardwareVersionFieldPathsNested = []string{
 	"numeric",
 	"part_number",
@@ -429,3 +454,46 @@ var MessagePayloadEncoder_ExampleFieldPathsTopLevel = []string{
 	"input",
 	"output",
 }
+var TestPayloadFormattersResponse_ResultFieldPathsNested = []string{
+	"codec",
+	"decoded_output",
+	"decoded_output.data",
+	"decoded_output.errors",
+	"decoded_output.warnings",
+	"description",
+	"duration",
+	"encoded_output",
+	"encoded_output.errors",
+	"encoded_output.f_port",
+	"encoded_output.frm_payload",
+	"encoded_output.warnings",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+	"formatter",
+	"mismatches",
+	"passed",
+}
+
+var TestPayloadFormattersResponse_ResultFieldPathsTopLevel = []string{
+	"codec",
+	"decoded_output",
+	"description",
+	"duration",
+	"encoded_output",
+	"error",
+	"formatter",
+	"mismatches",
+	"passed",
+}
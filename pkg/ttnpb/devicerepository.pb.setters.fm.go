@@ -1077,6 +1077,87 @@ func (dst *MessagePayloadEncoder) SetFields(src *MessagePayloadEncoder, paths ..
 	return nil
 }
 
+func (dst *TestPayloadFormattersRequest) SetFields(src *TestPayloadFormattersRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "version_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceVersionIdentifiers
+				if (src == nil || src.VersionIds == nil) && dst.VersionIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.VersionIds
+				}
+				if dst.VersionIds != nil {
+					newDst = dst.VersionIds
+				} else {
+					newDst = &EndDeviceVersionIdentifiers{}
+					dst.VersionIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.VersionIds = src.VersionIds
+				} else {
+					dst.VersionIds = nil
+				}
+			}
+		case "formatters":
+			if len(subs) > 0 {
+				var newDst, newSrc *MessagePayloadFormatters
+				if (src == nil || src.Formatters == nil) && dst.Formatters == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Formatters
+				}
+				if dst.Formatters != nil {
+					newDst = dst.Formatters
+				} else {
+					newDst = &MessagePayloadFormatters{}
+					dst.Formatters = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Formatters = src.Formatters
+				} else {
+					dst.Formatters = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *TestPayloadFormattersResponse) SetFields(src *TestPayloadFormattersResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "results":
+			if len(subs) > 0 {
+				return fmt.Errorf("'results' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Results = src.Results
+			} else {
+				dst.Results = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *EndDeviceModel_HardwareVersion) SetFields(src *EndDeviceModel_HardwareVersion, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -1737,3 +1818,145 @@ func (dst *MessagePayloadEncoder_Example) SetFields(src *MessagePayloadEncoder_E
 	}
 	return nil
 }
+
+func (dst *TestPayloadFormattersResponse_Result) SetFields(src *TestPayloadFormattersResponse_Result, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "codec":
+			if len(subs) > 0 {
+				return fmt.Errorf("'codec' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Codec = src.Codec
+			} else {
+				dst.Codec = 0
+			}
+		case "description":
+			if len(subs) > 0 {
+				return fmt.Errorf("'description' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Description = src.Description
+			} else {
+				var zero string
+				dst.Description = zero
+			}
+		case "formatter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'formatter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Formatter = src.Formatter
+			} else {
+				dst.Formatter = 0
+			}
+		case "passed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'passed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Passed = src.Passed
+			} else {
+				var zero bool
+				dst.Passed = zero
+			}
+		case "mismatches":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mismatches' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Mismatches = src.Mismatches
+			} else {
+				dst.Mismatches = nil
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+		case "decoded_output":
+			if len(subs) > 0 {
+				var newDst, newSrc *DecodedMessagePayload
+				if (src == nil || src.DecodedOutput == nil) && dst.DecodedOutput == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DecodedOutput
+				}
+				if dst.DecodedOutput != nil {
+					newDst = dst.DecodedOutput
+				} else {
+					newDst = &DecodedMessagePayload{}
+					dst.DecodedOutput = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DecodedOutput = src.DecodedOutput
+				} else {
+					dst.DecodedOutput = nil
+				}
+			}
+		case "encoded_output":
+			if len(subs) > 0 {
+				var newDst, newSrc *EncodedMessagePayload
+				if (src == nil || src.EncodedOutput == nil) && dst.EncodedOutput == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EncodedOutput
+				}
+				if dst.EncodedOutput != nil {
+					newDst = dst.EncodedOutput
+				} else {
+					newDst = &EncodedMessagePayload{}
+					dst.EncodedOutput = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EncodedOutput = src.EncodedOutput
+				} else {
+					dst.EncodedOutput = nil
+				}
+			}
+		case "duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Duration = src.Duration
+			} else {
+				dst.Duration = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
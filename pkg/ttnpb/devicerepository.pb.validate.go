@@ -2153,6 +2153,218 @@ var _ interface {
 
 var _MessagePayloadEncoder_CodecId_Pattern = regexp.MustCompile("^([a-z0-9](?:[-]?[a-z0-9]){2,}|)?$")
 
+// ValidateFields checks the field values on TestPayloadFormattersRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *TestPayloadFormattersRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = TestPayloadFormattersRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "version_ids":
+
+			if m.GetVersionIds() == nil {
+				return TestPayloadFormattersRequestValidationError{
+					field:  "version_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetVersionIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TestPayloadFormattersRequestValidationError{
+						field:  "version_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "formatters":
+
+			if v, ok := interface{}(m.GetFormatters()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TestPayloadFormattersRequestValidationError{
+						field:  "formatters",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return TestPayloadFormattersRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// TestPayloadFormattersRequestValidationError is the validation error returned
+// by TestPayloadFormattersRequest.ValidateFields if the designated
+// constraints aren't met.
+type TestPayloadFormattersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestPayloadFormattersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestPayloadFormattersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestPayloadFormattersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestPayloadFormattersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestPayloadFormattersRequestValidationError) ErrorName() string {
+	return "TestPayloadFormattersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestPayloadFormattersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestPayloadFormattersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestPayloadFormattersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestPayloadFormattersRequestValidationError{}
+
+// ValidateFields checks the field values on TestPayloadFormattersResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *TestPayloadFormattersResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = TestPayloadFormattersResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "results":
+
+			for idx, item := range m.GetResults() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return TestPayloadFormattersResponseValidationError{
+							field:  fmt.Sprintf("results[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return TestPayloadFormattersResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// TestPayloadFormattersResponseValidationError is the validation error
+// returned by TestPayloadFormattersResponse.ValidateFields if the designated
+// constraints aren't met.
+type TestPayloadFormattersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestPayloadFormattersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestPayloadFormattersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestPayloadFormattersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestPayloadFormattersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestPayloadFormattersResponseValidationError) ErrorName() string {
+	return "TestPayloadFormattersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestPayloadFormattersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestPayloadFormattersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestPayloadFormattersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestPayloadFormattersResponseValidationError{}
+
 // ValidateFields checks the field values on EndDeviceModel_HardwareVersion
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, an error is returned.
@@ -3814,3 +4026,143 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MessagePayloadEncoder_ExampleValidationError{}
+
+// ValidateFields checks the field values on
+// TestPayloadFormattersResponse_Result with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *TestPayloadFormattersResponse_Result) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = TestPayloadFormattersResponse_ResultFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "codec":
+			// no validation rules for Codec
+		case "description":
+			// no validation rules for Description
+		case "formatter":
+			// no validation rules for Formatter
+		case "passed":
+			// no validation rules for Passed
+		case "mismatches":
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TestPayloadFormattersResponse_ResultValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "decoded_output":
+
+			if v, ok := interface{}(m.GetDecodedOutput()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TestPayloadFormattersResponse_ResultValidationError{
+						field:  "decoded_output",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "encoded_output":
+
+			if v, ok := interface{}(m.GetEncodedOutput()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TestPayloadFormattersResponse_ResultValidationError{
+						field:  "encoded_output",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "duration":
+
+			if v, ok := interface{}(m.GetDuration()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TestPayloadFormattersResponse_ResultValidationError{
+						field:  "duration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return TestPayloadFormattersResponse_ResultValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// TestPayloadFormattersResponse_ResultValidationError is the validation error
+// returned by TestPayloadFormattersResponse_Result.ValidateFields if the
+// designated constraints aren't met.
+type TestPayloadFormattersResponse_ResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestPayloadFormattersResponse_ResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestPayloadFormattersResponse_ResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestPayloadFormattersResponse_ResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestPayloadFormattersResponse_ResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestPayloadFormattersResponse_ResultValidationError) ErrorName() string {
+	return "TestPayloadFormattersResponse_ResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TestPayloadFormattersResponse_ResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestPayloadFormattersResponse_Result.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestPayloadFormattersResponse_ResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestPayloadFormattersResponse_ResultValidationError{}
@@ -33,14 +33,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	DeviceRepository_ListBrands_FullMethodName         = "/ttn.lorawan.v3.DeviceRepository/ListBrands"
-	DeviceRepository_GetBrand_FullMethodName           = "/ttn.lorawan.v3.DeviceRepository/GetBrand"
-	DeviceRepository_ListModels_FullMethodName         = "/ttn.lorawan.v3.DeviceRepository/ListModels"
-	DeviceRepository_GetModel_FullMethodName           = "/ttn.lorawan.v3.DeviceRepository/GetModel"
-	DeviceRepository_GetTemplate_FullMethodName        = "/ttn.lorawan.v3.DeviceRepository/GetTemplate"
-	DeviceRepository_GetUplinkDecoder_FullMethodName   = "/ttn.lorawan.v3.DeviceRepository/GetUplinkDecoder"
-	DeviceRepository_GetDownlinkDecoder_FullMethodName = "/ttn.lorawan.v3.DeviceRepository/GetDownlinkDecoder"
-	DeviceRepository_GetDownlinkEncoder_FullMethodName = "/ttn.lorawan.v3.DeviceRepository/GetDownlinkEncoder"
+	DeviceRepository_ListBrands_FullMethodName            = "/ttn.lorawan.v3.DeviceRepository/ListBrands"
+	DeviceRepository_GetBrand_FullMethodName              = "/ttn.lorawan.v3.DeviceRepository/GetBrand"
+	DeviceRepository_ListModels_FullMethodName            = "/ttn.lorawan.v3.DeviceRepository/ListModels"
+	DeviceRepository_GetModel_FullMethodName              = "/ttn.lorawan.v3.DeviceRepository/GetModel"
+	DeviceRepository_GetTemplate_FullMethodName           = "/ttn.lorawan.v3.DeviceRepository/GetTemplate"
+	DeviceRepository_GetUplinkDecoder_FullMethodName      = "/ttn.lorawan.v3.DeviceRepository/GetUplinkDecoder"
+	DeviceRepository_GetDownlinkDecoder_FullMethodName    = "/ttn.lorawan.v3.DeviceRepository/GetDownlinkDecoder"
+	DeviceRepository_GetDownlinkEncoder_FullMethodName    = "/ttn.lorawan.v3.DeviceRepository/GetDownlinkEncoder"
+	DeviceRepository_TestPayloadFormatters_FullMethodName = "/ttn.lorawan.v3.DeviceRepository/TestPayloadFormatters"
 )
 
 // DeviceRepositoryClient is the client API for DeviceRepository service.
@@ -55,6 +56,9 @@ type DeviceRepositoryClient interface {
 	GetUplinkDecoder(ctx context.Context, in *GetPayloadFormatterRequest, opts ...grpc.CallOption) (*MessagePayloadDecoder, error)
 	GetDownlinkDecoder(ctx context.Context, in *GetPayloadFormatterRequest, opts ...grpc.CallOption) (*MessagePayloadDecoder, error)
 	GetDownlinkEncoder(ctx context.Context, in *GetPayloadFormatterRequest, opts ...grpc.CallOption) (*MessagePayloadEncoder, error)
+	// Test payload formatters against the examples of the codec of the end device version.
+	// Only JavaScript and CayenneLPP payload formatters are supported.
+	TestPayloadFormatters(ctx context.Context, in *TestPayloadFormattersRequest, opts ...grpc.CallOption) (*TestPayloadFormattersResponse, error)
 }
 
 type deviceRepositoryClient struct {
@@ -137,6 +141,15 @@ func (c *deviceRepositoryClient) GetDownlinkEncoder(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *deviceRepositoryClient) TestPayloadFormatters(ctx context.Context, in *TestPayloadFormattersRequest, opts ...grpc.CallOption) (*TestPayloadFormattersResponse, error) {
+	out := new(TestPayloadFormattersResponse)
+	err := c.cc.Invoke(ctx, DeviceRepository_TestPayloadFormatters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DeviceRepositoryServer is the server API for DeviceRepository service.
 // All implementations must embed UnimplementedDeviceRepositoryServer
 // for forward compatibility
@@ -149,6 +162,9 @@ type DeviceRepositoryServer interface {
 	GetUplinkDecoder(context.Context, *GetPayloadFormatterRequest) (*MessagePayloadDecoder, error)
 	GetDownlinkDecoder(context.Context, *GetPayloadFormatterRequest) (*MessagePayloadDecoder, error)
 	GetDownlinkEncoder(context.Context, *GetPayloadFormatterRequest) (*MessagePayloadEncoder, error)
+	// Test payload formatters against the examples of the codec of the end device version.
+	// Only JavaScript and CayenneLPP payload formatters are supported.
+	TestPayloadFormatters(context.Context, *TestPayloadFormattersRequest) (*TestPayloadFormattersResponse, error)
 	mustEmbedUnimplementedDeviceRepositoryServer()
 }
 
@@ -180,6 +196,9 @@ func (UnimplementedDeviceRepositoryServer) GetDownlinkDecoder(context.Context, *
 func (UnimplementedDeviceRepositoryServer) GetDownlinkEncoder(context.Context, *GetPayloadFormatterRequest) (*MessagePayloadEncoder, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDownlinkEncoder not implemented")
 }
+func (UnimplementedDeviceRepositoryServer) TestPayloadFormatters(context.Context, *TestPayloadFormattersRequest) (*TestPayloadFormattersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TestPayloadFormatters not implemented")
+}
 func (UnimplementedDeviceRepositoryServer) mustEmbedUnimplementedDeviceRepositoryServer() {}
 
 // UnsafeDeviceRepositoryServer may be embedded to opt out of forward compatibility for this service.
@@ -337,6 +356,24 @@ func _DeviceRepository_GetDownlinkEncoder_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeviceRepository_TestPayloadFormatters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TestPayloadFormattersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeviceRepositoryServer).TestPayloadFormatters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeviceRepository_TestPayloadFormatters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeviceRepositoryServer).TestPayloadFormatters(ctx, req.(*TestPayloadFormattersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DeviceRepository_ServiceDesc is the grpc.ServiceDesc for DeviceRepository service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -376,6 +413,10 @@ var DeviceRepository_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDownlinkEncoder",
 			Handler:    _DeviceRepository_GetDownlinkEncoder_Handler,
 		},
+		{
+			MethodName: "TestPayloadFormatters",
+			Handler:    _DeviceRepository_TestPayloadFormatters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/devicerepository.proto",
@@ -1152,3 +1152,314 @@ func (x *MessagePayloadEncoder) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState)
 func (x *MessagePayloadEncoder) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the TestPayloadFormattersRequest message to JSON.
+func (x *TestPayloadFormattersRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.VersionIds != nil || s.HasField("version_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("version_ids")
+		// NOTE: EndDeviceVersionIdentifiers does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.VersionIds)
+	}
+	if x.Formatters != nil || s.HasField("formatters") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("formatters")
+		x.Formatters.MarshalProtoJSON(s.WithField("formatters"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the TestPayloadFormattersRequest to JSON.
+func (x *TestPayloadFormattersRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the TestPayloadFormattersRequest message from JSON.
+func (x *TestPayloadFormattersRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "version_ids", "versionIds":
+			s.AddField("version_ids")
+			if s.ReadNil() {
+				x.VersionIds = nil
+				return
+			}
+			// NOTE: EndDeviceVersionIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v EndDeviceVersionIdentifiers
+			golang.UnmarshalMessage(s, &v)
+			x.VersionIds = &v
+		case "formatters":
+			if s.ReadNil() {
+				x.Formatters = nil
+				return
+			}
+			x.Formatters = &MessagePayloadFormatters{}
+			x.Formatters.UnmarshalProtoJSON(s.WithField("formatters", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the TestPayloadFormattersRequest from JSON.
+func (x *TestPayloadFormattersRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the TestPayloadFormattersResponse_Result_Codec to JSON.
+func (x TestPayloadFormattersResponse_Result_Codec) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), TestPayloadFormattersResponse_Result_Codec_name)
+}
+
+// MarshalText marshals the TestPayloadFormattersResponse_Result_Codec to text.
+func (x TestPayloadFormattersResponse_Result_Codec) MarshalText() ([]byte, error) {
+	return []byte(jsonplugin.GetEnumString(int32(x), TestPayloadFormattersResponse_Result_Codec_name)), nil
+}
+
+// MarshalJSON marshals the TestPayloadFormattersResponse_Result_Codec to JSON.
+func (x TestPayloadFormattersResponse_Result_Codec) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the TestPayloadFormattersResponse_Result_Codec from JSON.
+func (x *TestPayloadFormattersResponse_Result_Codec) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(TestPayloadFormattersResponse_Result_Codec_value)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read Codec enum: %v", err)
+		return
+	}
+	*x = TestPayloadFormattersResponse_Result_Codec(v)
+}
+
+// UnmarshalText unmarshals the TestPayloadFormattersResponse_Result_Codec from text.
+func (x *TestPayloadFormattersResponse_Result_Codec) UnmarshalText(b []byte) error {
+	i, err := jsonplugin.ParseEnumString(string(b), TestPayloadFormattersResponse_Result_Codec_value)
+	if err != nil {
+		return err
+	}
+	*x = TestPayloadFormattersResponse_Result_Codec(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the TestPayloadFormattersResponse_Result_Codec from JSON.
+func (x *TestPayloadFormattersResponse_Result_Codec) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the TestPayloadFormattersResponse_Result message to JSON.
+func (x *TestPayloadFormattersResponse_Result) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Codec != 0 || s.HasField("codec") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("codec")
+		x.Codec.MarshalProtoJSON(s)
+	}
+	if x.Description != "" || s.HasField("description") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("description")
+		s.WriteString(x.Description)
+	}
+	if x.Formatter != 0 || s.HasField("formatter") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("formatter")
+		x.Formatter.MarshalProtoJSON(s)
+	}
+	if x.Passed || s.HasField("passed") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("passed")
+		s.WriteBool(x.Passed)
+	}
+	if len(x.Mismatches) > 0 || s.HasField("mismatches") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("mismatches")
+		s.WriteStringArray(x.Mismatches)
+	}
+	if x.Error != nil || s.HasField("error") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("error")
+		// NOTE: ErrorDetails does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Error)
+	}
+	if x.DecodedOutput != nil || s.HasField("decoded_output") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("decoded_output")
+		// NOTE: DecodedMessagePayload does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.DecodedOutput)
+	}
+	if x.EncodedOutput != nil || s.HasField("encoded_output") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("encoded_output")
+		// NOTE: EncodedMessagePayload does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.EncodedOutput)
+	}
+	if x.Duration != nil || s.HasField("duration") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("duration")
+		if x.Duration == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.Duration)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the TestPayloadFormattersResponse_Result to JSON.
+func (x *TestPayloadFormattersResponse_Result) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the TestPayloadFormattersResponse_Result message from JSON.
+func (x *TestPayloadFormattersResponse_Result) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "codec":
+			s.AddField("codec")
+			x.Codec.UnmarshalProtoJSON(s)
+		case "description":
+			s.AddField("description")
+			x.Description = s.ReadString()
+		case "formatter":
+			s.AddField("formatter")
+			x.Formatter.UnmarshalProtoJSON(s)
+		case "passed":
+			s.AddField("passed")
+			x.Passed = s.ReadBool()
+		case "mismatches":
+			s.AddField("mismatches")
+			if s.ReadNil() {
+				x.Mismatches = nil
+				return
+			}
+			x.Mismatches = s.ReadStringArray()
+		case "error":
+			s.AddField("error")
+			if s.ReadNil() {
+				x.Error = nil
+				return
+			}
+			// NOTE: ErrorDetails does not seem to implement UnmarshalProtoJSON.
+			var v ErrorDetails
+			golang.UnmarshalMessage(s, &v)
+			x.Error = &v
+		case "decoded_output", "decodedOutput":
+			s.AddField("decoded_output")
+			if s.ReadNil() {
+				x.DecodedOutput = nil
+				return
+			}
+			// NOTE: DecodedMessagePayload does not seem to implement UnmarshalProtoJSON.
+			var v DecodedMessagePayload
+			golang.UnmarshalMessage(s, &v)
+			x.DecodedOutput = &v
+		case "encoded_output", "encodedOutput":
+			s.AddField("encoded_output")
+			if s.ReadNil() {
+				x.EncodedOutput = nil
+				return
+			}
+			// NOTE: EncodedMessagePayload does not seem to implement UnmarshalProtoJSON.
+			var v EncodedMessagePayload
+			golang.UnmarshalMessage(s, &v)
+			x.EncodedOutput = &v
+		case "duration":
+			s.AddField("duration")
+			if s.ReadNil() {
+				x.Duration = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Duration = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the TestPayloadFormattersResponse_Result from JSON.
+func (x *TestPayloadFormattersResponse_Result) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the TestPayloadFormattersResponse message to JSON.
+func (x *TestPayloadFormattersResponse) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Results) > 0 || s.HasField("results") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("results")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Results {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("results"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the TestPayloadFormattersResponse to JSON.
+func (x *TestPayloadFormattersResponse) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the TestPayloadFormattersResponse message from JSON.
+func (x *TestPayloadFormattersResponse) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "results":
+			s.AddField("results")
+			if s.ReadNil() {
+				x.Results = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Results = append(x.Results, nil)
+					return
+				}
+				v := &TestPayloadFormattersResponse_Result{}
+				v.UnmarshalProtoJSON(s.WithField("results", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Results = append(x.Results, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the TestPayloadFormattersResponse from JSON.
+func (x *TestPayloadFormattersResponse) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -87,6 +87,7 @@ var GatewayFieldPathsNested = []string{
 	"claim_authentication_code.secret.value",
 	"claim_authentication_code.valid_from",
 	"claim_authentication_code.valid_to",
+	"client_certificate_fingerprint",
 	"contact_info",
 	"created_at",
 	"deleted_at",
@@ -122,6 +123,9 @@ var GatewayFieldPathsNested = []string{
 	"technical_contact.ids.user_ids",
 	"technical_contact.ids.user_ids.email",
 	"technical_contact.ids.user_ids.user_id",
+	"udp_pre_shared_key",
+	"udp_pre_shared_key.key_id",
+	"udp_pre_shared_key.value",
 	"update_channel",
 	"update_location_from_status",
 	"updated_at",
@@ -138,6 +142,7 @@ var GatewayFieldPathsTopLevel = []string{
 	"attributes",
 	"auto_update",
 	"claim_authentication_code",
+	"client_certificate_fingerprint",
 	"contact_info",
 	"created_at",
 	"deleted_at",
@@ -160,6 +165,7 @@ var GatewayFieldPathsTopLevel = []string{
 	"target_cups_key",
 	"target_cups_uri",
 	"technical_contact",
+	"udp_pre_shared_key",
 	"update_channel",
 	"update_location_from_status",
 	"updated_at",
@@ -238,6 +244,7 @@ var CreateGatewayRequestFieldPathsNested = []string{
 	"gateway.claim_authentication_code.secret.value",
 	"gateway.claim_authentication_code.valid_from",
 	"gateway.claim_authentication_code.valid_to",
+	"gateway.client_certificate_fingerprint",
 	"gateway.contact_info",
 	"gateway.created_at",
 	"gateway.deleted_at",
@@ -273,6 +280,9 @@ var CreateGatewayRequestFieldPathsNested = []string{
 	"gateway.technical_contact.ids.user_ids",
 	"gateway.technical_contact.ids.user_ids.email",
 	"gateway.technical_contact.ids.user_ids.user_id",
+	"gateway.udp_pre_shared_key",
+	"gateway.udp_pre_shared_key.key_id",
+	"gateway.udp_pre_shared_key.value",
 	"gateway.update_channel",
 	"gateway.update_location_from_status",
 	"gateway.updated_at",
@@ -306,6 +316,7 @@ var UpdateGatewayRequestFieldPathsNested = []string{
 	"gateway.claim_authentication_code.secret.value",
 	"gateway.claim_authentication_code.valid_from",
 	"gateway.claim_authentication_code.valid_to",
+	"gateway.client_certificate_fingerprint",
 	"gateway.contact_info",
 	"gateway.created_at",
 	"gateway.deleted_at",
@@ -341,6 +352,9 @@ var UpdateGatewayRequestFieldPathsNested = []string{
 	"gateway.technical_contact.ids.user_ids",
 	"gateway.technical_contact.ids.user_ids.email",
 	"gateway.technical_contact.ids.user_ids.user_id",
+	"gateway.udp_pre_shared_key",
+	"gateway.udp_pre_shared_key.key_id",
+	"gateway.udp_pre_shared_key.value",
 	"gateway.update_channel",
 	"gateway.update_location_from_status",
 	"gateway.updated_at",
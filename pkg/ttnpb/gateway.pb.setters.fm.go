@@ -700,6 +700,40 @@ func (dst *Gateway) SetFields(src *Gateway, paths ...string) error {
 				var zero bool
 				dst.DisablePacketBrokerForwarding = zero
 			}
+		case "client_certificate_fingerprint":
+			if len(subs) > 0 {
+				return fmt.Errorf("'client_certificate_fingerprint' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ClientCertificateFingerprint = src.ClientCertificateFingerprint
+			} else {
+				dst.ClientCertificateFingerprint = nil
+			}
+		case "udp_pre_shared_key":
+			if len(subs) > 0 {
+				var newDst, newSrc *Secret
+				if (src == nil || src.UdpPreSharedKey == nil) && dst.UdpPreSharedKey == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.UdpPreSharedKey
+				}
+				if dst.UdpPreSharedKey != nil {
+					newDst = dst.UdpPreSharedKey
+				} else {
+					newDst = &Secret{}
+					dst.UdpPreSharedKey = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UdpPreSharedKey = src.UdpPreSharedKey
+				} else {
+					dst.UdpPreSharedKey = nil
+				}
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
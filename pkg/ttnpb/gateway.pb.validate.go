@@ -964,6 +964,31 @@ func (m *Gateway) ValidateFields(paths ...string) error {
 
 		case "disable_packet_broker_forwarding":
 			// no validation rules for DisablePacketBrokerForwarding
+		case "client_certificate_fingerprint":
+
+			if len(m.GetClientCertificateFingerprint()) > 0 {
+
+				if len(m.GetClientCertificateFingerprint()) != 32 {
+					return GatewayValidationError{
+						field:  "client_certificate_fingerprint",
+						reason: "value length must be 32 bytes",
+					}
+				}
+
+			}
+
+		case "udp_pre_shared_key":
+
+			if v, ok := interface{}(m.GetUdpPreSharedKey()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayValidationError{
+						field:  "udp_pre_shared_key",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
 		default:
 			return GatewayValidationError{
 				field:  name,
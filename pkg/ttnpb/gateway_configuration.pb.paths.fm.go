@@ -8,6 +8,7 @@ var GetGatewayConfigurationRequestFieldPathsNested = []string{
 	"gateway_ids",
 	"gateway_ids.eui",
 	"gateway_ids.gateway_id",
+	"model",
 	"type",
 }
 
@@ -15,6 +16,7 @@ var GetGatewayConfigurationRequestFieldPathsTopLevel = []string{
 	"filename",
 	"format",
 	"gateway_ids",
+	"model",
 	"type",
 }
 var GetGatewayConfigurationResponseFieldPathsNested = []string{
@@ -24,3 +26,283 @@ var GetGatewayConfigurationResponseFieldPathsNested = []string{
 var GetGatewayConfigurationResponseFieldPathsTopLevel = []string{
 	"contents",
 }
+var GatewayConfigurationTemplateFieldPathsNested = []string{
+	"contents",
+	"created_at",
+	"description",
+	"filename",
+	"format",
+	"owner_ids",
+	"owner_ids.ids",
+	"owner_ids.ids.organization_ids",
+	"owner_ids.ids.organization_ids.organization_id",
+	"owner_ids.ids.user_ids",
+	"owner_ids.ids.user_ids.email",
+	"owner_ids.ids.user_ids.user_id",
+	"template_id",
+	"type",
+	"variables",
+	"version",
+}
+
+var GatewayConfigurationTemplateFieldPathsTopLevel = []string{
+	"contents",
+	"created_at",
+	"description",
+	"filename",
+	"format",
+	"owner_ids",
+	"template_id",
+	"type",
+	"variables",
+	"version",
+}
+var GatewayConfigurationTemplatesFieldPathsNested = []string{
+	"templates",
+}
+
+var GatewayConfigurationTemplatesFieldPathsTopLevel = []string{
+	"templates",
+}
+var SetGatewayConfigurationTemplateRequestFieldPathsNested = []string{
+	"template",
+	"template.contents",
+	"template.created_at",
+	"template.description",
+	"template.filename",
+	"template.format",
+	"template.owner_ids",
+	"template.owner_ids.ids",
+	"template.owner_ids.ids.organization_ids",
+	"template.owner_ids.ids.organization_ids.organization_id",
+	"template.owner_ids.ids.user_ids",
+	"template.owner_ids.ids.user_ids.email",
+	"template.owner_ids.ids.user_ids.user_id",
+	"template.template_id",
+	"template.type",
+	"template.variables",
+	"template.version",
+}
+
+var SetGatewayConfigurationTemplateRequestFieldPathsTopLevel = []string{
+	"template",
+}
+var GetGatewayConfigurationTemplateRequestFieldPathsNested = []string{
+	"owner_ids",
+	"owner_ids.ids",
+	"owner_ids.ids.organization_ids",
+	"owner_ids.ids.organization_ids.organization_id",
+	"owner_ids.ids.user_ids",
+	"owner_ids.ids.user_ids.email",
+	"owner_ids.ids.user_ids.user_id",
+	"template_id",
+	"version",
+}
+
+var GetGatewayConfigurationTemplateRequestFieldPathsTopLevel = []string{
+	"owner_ids",
+	"template_id",
+	"version",
+}
+var ListGatewayConfigurationTemplatesRequestFieldPathsNested = []string{
+	"owner_ids",
+	"owner_ids.ids",
+	"owner_ids.ids.organization_ids",
+	"owner_ids.ids.organization_ids.organization_id",
+	"owner_ids.ids.user_ids",
+	"owner_ids.ids.user_ids.email",
+	"owner_ids.ids.user_ids.user_id",
+}
+
+var ListGatewayConfigurationTemplatesRequestFieldPathsTopLevel = []string{
+	"owner_ids",
+}
+var DeleteGatewayConfigurationTemplateRequestFieldPathsNested = []string{
+	"owner_ids",
+	"owner_ids.ids",
+	"owner_ids.ids.organization_ids",
+	"owner_ids.ids.organization_ids.organization_id",
+	"owner_ids.ids.user_ids",
+	"owner_ids.ids.user_ids.email",
+	"owner_ids.ids.user_ids.user_id",
+	"template_id",
+}
+
+var DeleteGatewayConfigurationTemplateRequestFieldPathsTopLevel = []string{
+	"owner_ids",
+	"template_id",
+}
+var DiffGatewayConfigurationTemplateRequestFieldPathsNested = []string{
+	"from_version",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"owner_ids",
+	"owner_ids.ids",
+	"owner_ids.ids.organization_ids",
+	"owner_ids.ids.organization_ids.organization_id",
+	"owner_ids.ids.user_ids",
+	"owner_ids.ids.user_ids.email",
+	"owner_ids.ids.user_ids.user_id",
+	"template_id",
+	"to_version",
+}
+
+var DiffGatewayConfigurationTemplateRequestFieldPathsTopLevel = []string{
+	"from_version",
+	"gateway_ids",
+	"owner_ids",
+	"template_id",
+	"to_version",
+}
+var DiffGatewayConfigurationTemplateResponseFieldPathsNested = []string{
+	"diff",
+}
+
+var DiffGatewayConfigurationTemplateResponseFieldPathsTopLevel = []string{
+	"diff",
+}
+var GatewayConfigurationTemplateAssignmentFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"overrides",
+	"template_id",
+	"template_owner_ids",
+	"template_owner_ids.ids",
+	"template_owner_ids.ids.organization_ids",
+	"template_owner_ids.ids.organization_ids.organization_id",
+	"template_owner_ids.ids.user_ids",
+	"template_owner_ids.ids.user_ids.email",
+	"template_owner_ids.ids.user_ids.user_id",
+	"template_version",
+	"updated_at",
+	"variables",
+}
+
+var GatewayConfigurationTemplateAssignmentFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"overrides",
+	"template_id",
+	"template_owner_ids",
+	"template_version",
+	"updated_at",
+	"variables",
+}
+var SetGatewayConfigurationTemplateAssignmentRequestFieldPathsNested = []string{
+	"assignment",
+	"assignment.gateway_ids",
+	"assignment.gateway_ids.eui",
+	"assignment.gateway_ids.gateway_id",
+	"assignment.overrides",
+	"assignment.template_id",
+	"assignment.template_owner_ids",
+	"assignment.template_owner_ids.ids",
+	"assignment.template_owner_ids.ids.organization_ids",
+	"assignment.template_owner_ids.ids.organization_ids.organization_id",
+	"assignment.template_owner_ids.ids.user_ids",
+	"assignment.template_owner_ids.ids.user_ids.email",
+	"assignment.template_owner_ids.ids.user_ids.user_id",
+	"assignment.template_version",
+	"assignment.updated_at",
+	"assignment.variables",
+}
+
+var SetGatewayConfigurationTemplateAssignmentRequestFieldPathsTopLevel = []string{
+	"assignment",
+}
+var GetGatewayConfigurationTemplateAssignmentRequestFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var GetGatewayConfigurationTemplateAssignmentRequestFieldPathsTopLevel = []string{
+	"gateway_ids",
+}
+var RenderedGatewayConfigurationFieldPathsNested = []string{
+	"contents",
+	"filename",
+	"format",
+	"revision",
+	"template_id",
+	"template_owner_ids",
+	"template_owner_ids.ids",
+	"template_owner_ids.ids.organization_ids",
+	"template_owner_ids.ids.organization_ids.organization_id",
+	"template_owner_ids.ids.user_ids",
+	"template_owner_ids.ids.user_ids.email",
+	"template_owner_ids.ids.user_ids.user_id",
+	"template_version",
+	"type",
+}
+
+var RenderedGatewayConfigurationFieldPathsTopLevel = []string{
+	"contents",
+	"filename",
+	"format",
+	"revision",
+	"template_id",
+	"template_owner_ids",
+	"template_version",
+	"type",
+}
+var PreviewGatewayConfigurationRequestFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"overrides",
+	"template_id",
+	"template_owner_ids",
+	"template_owner_ids.ids",
+	"template_owner_ids.ids.organization_ids",
+	"template_owner_ids.ids.organization_ids.organization_id",
+	"template_owner_ids.ids.user_ids",
+	"template_owner_ids.ids.user_ids.email",
+	"template_owner_ids.ids.user_ids.user_id",
+	"template_version",
+	"variables",
+}
+
+var PreviewGatewayConfigurationRequestFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"overrides",
+	"template_id",
+	"template_owner_ids",
+	"template_version",
+	"variables",
+}
+var PullRenderedGatewayConfigurationRequestFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"revision",
+}
+
+var PullRenderedGatewayConfigurationRequestFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"revision",
+}
+var PullRenderedGatewayConfigurationResponseFieldPathsNested = []string{
+	"configuration",
+	"configuration.contents",
+	"configuration.filename",
+	"configuration.format",
+	"configuration.revision",
+	"configuration.template_id",
+	"configuration.template_owner_ids",
+	"configuration.template_owner_ids.ids",
+	"configuration.template_owner_ids.ids.organization_ids",
+	"configuration.template_owner_ids.ids.organization_ids.organization_id",
+	"configuration.template_owner_ids.ids.user_ids",
+	"configuration.template_owner_ids.ids.user_ids.email",
+	"configuration.template_owner_ids.ids.user_ids.user_id",
+	"configuration.template_version",
+	"configuration.type",
+	"modified",
+}
+
+var PullRenderedGatewayConfigurationResponseFieldPathsTopLevel = []string{
+	"configuration",
+	"modified",
+}
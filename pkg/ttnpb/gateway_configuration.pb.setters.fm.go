@@ -62,6 +62,16 @@ func (dst *GetGatewayConfigurationRequest) SetFields(src *GetGatewayConfiguratio
 				var zero string
 				dst.Filename = zero
 			}
+		case "model":
+			if len(subs) > 0 {
+				return fmt.Errorf("'model' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Model = src.Model
+			} else {
+				var zero string
+				dst.Model = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -89,3 +99,908 @@ func (dst *GetGatewayConfigurationResponse) SetFields(src *GetGatewayConfigurati
 	}
 	return nil
 }
+
+func (dst *GatewayConfigurationTemplate) SetFields(src *GatewayConfigurationTemplate, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "owner_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *OrganizationOrUserIdentifiers
+				if (src == nil || src.OwnerIds == nil) && dst.OwnerIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OwnerIds
+				}
+				if dst.OwnerIds != nil {
+					newDst = dst.OwnerIds
+				} else {
+					newDst = &OrganizationOrUserIdentifiers{}
+					dst.OwnerIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OwnerIds = src.OwnerIds
+				} else {
+					dst.OwnerIds = nil
+				}
+			}
+		case "template_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'template_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TemplateId = src.TemplateId
+			} else {
+				var zero string
+				dst.TemplateId = zero
+			}
+		case "version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Version = src.Version
+			} else {
+				var zero uint32
+				dst.Version = zero
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				dst.CreatedAt = nil
+			}
+		case "description":
+			if len(subs) > 0 {
+				return fmt.Errorf("'description' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Description = src.Description
+			} else {
+				var zero string
+				dst.Description = zero
+			}
+		case "format":
+			if len(subs) > 0 {
+				return fmt.Errorf("'format' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Format = src.Format
+			} else {
+				var zero string
+				dst.Format = zero
+			}
+		case "type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Type = src.Type
+			} else {
+				var zero string
+				dst.Type = zero
+			}
+		case "filename":
+			if len(subs) > 0 {
+				return fmt.Errorf("'filename' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Filename = src.Filename
+			} else {
+				var zero string
+				dst.Filename = zero
+			}
+		case "contents":
+			if len(subs) > 0 {
+				return fmt.Errorf("'contents' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Contents = src.Contents
+			} else {
+				dst.Contents = nil
+			}
+		case "variables":
+			if len(subs) > 0 {
+				return fmt.Errorf("'variables' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Variables = src.Variables
+			} else {
+				dst.Variables = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConfigurationTemplates) SetFields(src *GatewayConfigurationTemplates, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "templates":
+			if len(subs) > 0 {
+				return fmt.Errorf("'templates' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Templates = src.Templates
+			} else {
+				dst.Templates = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SetGatewayConfigurationTemplateRequest) SetFields(src *SetGatewayConfigurationTemplateRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "template":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayConfigurationTemplate
+				if (src == nil || src.Template == nil) && dst.Template == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Template
+				}
+				if dst.Template != nil {
+					newDst = dst.Template
+				} else {
+					newDst = &GatewayConfigurationTemplate{}
+					dst.Template = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Template = src.Template
+				} else {
+					dst.Template = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetGatewayConfigurationTemplateRequest) SetFields(src *GetGatewayConfigurationTemplateRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "owner_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *OrganizationOrUserIdentifiers
+				if (src == nil || src.OwnerIds == nil) && dst.OwnerIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OwnerIds
+				}
+				if dst.OwnerIds != nil {
+					newDst = dst.OwnerIds
+				} else {
+					newDst = &OrganizationOrUserIdentifiers{}
+					dst.OwnerIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OwnerIds = src.OwnerIds
+				} else {
+					dst.OwnerIds = nil
+				}
+			}
+		case "template_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'template_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TemplateId = src.TemplateId
+			} else {
+				var zero string
+				dst.TemplateId = zero
+			}
+		case "version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Version = src.Version
+			} else {
+				var zero uint32
+				dst.Version = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ListGatewayConfigurationTemplatesRequest) SetFields(src *ListGatewayConfigurationTemplatesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "owner_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *OrganizationOrUserIdentifiers
+				if (src == nil || src.OwnerIds == nil) && dst.OwnerIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OwnerIds
+				}
+				if dst.OwnerIds != nil {
+					newDst = dst.OwnerIds
+				} else {
+					newDst = &OrganizationOrUserIdentifiers{}
+					dst.OwnerIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OwnerIds = src.OwnerIds
+				} else {
+					dst.OwnerIds = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DeleteGatewayConfigurationTemplateRequest) SetFields(src *DeleteGatewayConfigurationTemplateRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "owner_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *OrganizationOrUserIdentifiers
+				if (src == nil || src.OwnerIds == nil) && dst.OwnerIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OwnerIds
+				}
+				if dst.OwnerIds != nil {
+					newDst = dst.OwnerIds
+				} else {
+					newDst = &OrganizationOrUserIdentifiers{}
+					dst.OwnerIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OwnerIds = src.OwnerIds
+				} else {
+					dst.OwnerIds = nil
+				}
+			}
+		case "template_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'template_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TemplateId = src.TemplateId
+			} else {
+				var zero string
+				dst.TemplateId = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DiffGatewayConfigurationTemplateRequest) SetFields(src *DiffGatewayConfigurationTemplateRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "owner_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *OrganizationOrUserIdentifiers
+				if (src == nil || src.OwnerIds == nil) && dst.OwnerIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.OwnerIds
+				}
+				if dst.OwnerIds != nil {
+					newDst = dst.OwnerIds
+				} else {
+					newDst = &OrganizationOrUserIdentifiers{}
+					dst.OwnerIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.OwnerIds = src.OwnerIds
+				} else {
+					dst.OwnerIds = nil
+				}
+			}
+		case "template_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'template_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TemplateId = src.TemplateId
+			} else {
+				var zero string
+				dst.TemplateId = zero
+			}
+		case "from_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'from_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FromVersion = src.FromVersion
+			} else {
+				var zero uint32
+				dst.FromVersion = zero
+			}
+		case "to_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'to_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ToVersion = src.ToVersion
+			} else {
+				var zero uint32
+				dst.ToVersion = zero
+			}
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DiffGatewayConfigurationTemplateResponse) SetFields(src *DiffGatewayConfigurationTemplateResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "diff":
+			if len(subs) > 0 {
+				return fmt.Errorf("'diff' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Diff = src.Diff
+			} else {
+				var zero string
+				dst.Diff = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConfigurationTemplateAssignment) SetFields(src *GatewayConfigurationTemplateAssignment, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "template_owner_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *OrganizationOrUserIdentifiers
+				if (src == nil || src.TemplateOwnerIds == nil) && dst.TemplateOwnerIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.TemplateOwnerIds
+				}
+				if dst.TemplateOwnerIds != nil {
+					newDst = dst.TemplateOwnerIds
+				} else {
+					newDst = &OrganizationOrUserIdentifiers{}
+					dst.TemplateOwnerIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.TemplateOwnerIds = src.TemplateOwnerIds
+				} else {
+					dst.TemplateOwnerIds = nil
+				}
+			}
+		case "template_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'template_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TemplateId = src.TemplateId
+			} else {
+				var zero string
+				dst.TemplateId = zero
+			}
+		case "template_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'template_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TemplateVersion = src.TemplateVersion
+			} else {
+				var zero uint32
+				dst.TemplateVersion = zero
+			}
+		case "variables":
+			if len(subs) > 0 {
+				return fmt.Errorf("'variables' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Variables = src.Variables
+			} else {
+				dst.Variables = nil
+			}
+		case "overrides":
+			if len(subs) > 0 {
+				return fmt.Errorf("'overrides' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Overrides = src.Overrides
+			} else {
+				dst.Overrides = nil
+			}
+		case "updated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAt = src.UpdatedAt
+			} else {
+				dst.UpdatedAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SetGatewayConfigurationTemplateAssignmentRequest) SetFields(src *SetGatewayConfigurationTemplateAssignmentRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "assignment":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayConfigurationTemplateAssignment
+				if (src == nil || src.Assignment == nil) && dst.Assignment == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Assignment
+				}
+				if dst.Assignment != nil {
+					newDst = dst.Assignment
+				} else {
+					newDst = &GatewayConfigurationTemplateAssignment{}
+					dst.Assignment = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Assignment = src.Assignment
+				} else {
+					dst.Assignment = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetGatewayConfigurationTemplateAssignmentRequest) SetFields(src *GetGatewayConfigurationTemplateAssignmentRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RenderedGatewayConfiguration) SetFields(src *RenderedGatewayConfiguration, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "template_owner_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *OrganizationOrUserIdentifiers
+				if (src == nil || src.TemplateOwnerIds == nil) && dst.TemplateOwnerIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.TemplateOwnerIds
+				}
+				if dst.TemplateOwnerIds != nil {
+					newDst = dst.TemplateOwnerIds
+				} else {
+					newDst = &OrganizationOrUserIdentifiers{}
+					dst.TemplateOwnerIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.TemplateOwnerIds = src.TemplateOwnerIds
+				} else {
+					dst.TemplateOwnerIds = nil
+				}
+			}
+		case "template_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'template_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TemplateId = src.TemplateId
+			} else {
+				var zero string
+				dst.TemplateId = zero
+			}
+		case "template_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'template_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TemplateVersion = src.TemplateVersion
+			} else {
+				var zero uint32
+				dst.TemplateVersion = zero
+			}
+		case "format":
+			if len(subs) > 0 {
+				return fmt.Errorf("'format' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Format = src.Format
+			} else {
+				var zero string
+				dst.Format = zero
+			}
+		case "type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Type = src.Type
+			} else {
+				var zero string
+				dst.Type = zero
+			}
+		case "filename":
+			if len(subs) > 0 {
+				return fmt.Errorf("'filename' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Filename = src.Filename
+			} else {
+				var zero string
+				dst.Filename = zero
+			}
+		case "revision":
+			if len(subs) > 0 {
+				return fmt.Errorf("'revision' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Revision = src.Revision
+			} else {
+				var zero string
+				dst.Revision = zero
+			}
+		case "contents":
+			if len(subs) > 0 {
+				return fmt.Errorf("'contents' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Contents = src.Contents
+			} else {
+				dst.Contents = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *PreviewGatewayConfigurationRequest) SetFields(src *PreviewGatewayConfigurationRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "template_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'template_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TemplateId = src.TemplateId
+			} else {
+				var zero string
+				dst.TemplateId = zero
+			}
+		case "template_owner_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *OrganizationOrUserIdentifiers
+				if (src == nil || src.TemplateOwnerIds == nil) && dst.TemplateOwnerIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.TemplateOwnerIds
+				}
+				if dst.TemplateOwnerIds != nil {
+					newDst = dst.TemplateOwnerIds
+				} else {
+					newDst = &OrganizationOrUserIdentifiers{}
+					dst.TemplateOwnerIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.TemplateOwnerIds = src.TemplateOwnerIds
+				} else {
+					dst.TemplateOwnerIds = nil
+				}
+			}
+		case "template_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'template_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TemplateVersion = src.TemplateVersion
+			} else {
+				var zero uint32
+				dst.TemplateVersion = zero
+			}
+		case "variables":
+			if len(subs) > 0 {
+				return fmt.Errorf("'variables' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Variables = src.Variables
+			} else {
+				dst.Variables = nil
+			}
+		case "overrides":
+			if len(subs) > 0 {
+				return fmt.Errorf("'overrides' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Overrides = src.Overrides
+			} else {
+				dst.Overrides = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *PullRenderedGatewayConfigurationRequest) SetFields(src *PullRenderedGatewayConfigurationRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "revision":
+			if len(subs) > 0 {
+				return fmt.Errorf("'revision' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Revision = src.Revision
+			} else {
+				var zero string
+				dst.Revision = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *PullRenderedGatewayConfigurationResponse) SetFields(src *PullRenderedGatewayConfigurationResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "modified":
+			if len(subs) > 0 {
+				return fmt.Errorf("'modified' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Modified = src.Modified
+			} else {
+				var zero bool
+				dst.Modified = zero
+			}
+		case "configuration":
+			if len(subs) > 0 {
+				var newDst, newSrc *RenderedGatewayConfiguration
+				if (src == nil || src.Configuration == nil) && dst.Configuration == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Configuration
+				}
+				if dst.Configuration != nil {
+					newDst = dst.Configuration
+				} else {
+					newDst = &RenderedGatewayConfiguration{}
+					dst.Configuration = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Configuration = src.Configuration
+				} else {
+					dst.Configuration = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -114,6 +114,22 @@ func (m *GetGatewayConfigurationRequest) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "model":
+
+			if utf8.RuneCountInString(m.GetModel()) > 64 {
+				return GetGatewayConfigurationRequestValidationError{
+					field:  "model",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+			if !_GetGatewayConfigurationRequest_Model_Pattern.MatchString(m.GetModel()) {
+				return GetGatewayConfigurationRequestValidationError{
+					field:  "model",
+					reason: "value does not match regex pattern \"^[a-z0-9]+(?:_[a-z0-9]+)*$|^$\"",
+				}
+			}
+
 		default:
 			return GetGatewayConfigurationRequestValidationError{
 				field:  name,
@@ -187,6 +203,8 @@ var _GetGatewayConfigurationRequest_Type_Pattern = regexp.MustCompile("^[a-z0-9]
 
 var _GetGatewayConfigurationRequest_Filename_Pattern = regexp.MustCompile("^[a-z0-9](?:[-._]?[a-z0-9]){2,}$|^$")
 
+var _GetGatewayConfigurationRequest_Model_Pattern = regexp.MustCompile("^[a-z0-9]+(?:_[a-z0-9]+)*$|^$")
+
 // ValidateFields checks the field values on GetGatewayConfigurationResponse
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, an error is returned.
@@ -270,3 +288,1921 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetGatewayConfigurationResponseValidationError{}
+
+// ValidateFields checks the field values on GatewayConfigurationTemplate with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayConfigurationTemplate) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConfigurationTemplateFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "owner_ids":
+
+			if m.GetOwnerIds() == nil {
+				return GatewayConfigurationTemplateValidationError{
+					field:  "owner_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetOwnerIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConfigurationTemplateValidationError{
+						field:  "owner_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "template_id":
+
+			if utf8.RuneCountInString(m.GetTemplateId()) > 36 {
+				return GatewayConfigurationTemplateValidationError{
+					field:  "template_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_GatewayConfigurationTemplate_TemplateId_Pattern.MatchString(m.GetTemplateId()) {
+				return GatewayConfigurationTemplateValidationError{
+					field:  "template_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "version":
+			// no validation rules for Version
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConfigurationTemplateValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "description":
+
+			if utf8.RuneCountInString(m.GetDescription()) > 2000 {
+				return GatewayConfigurationTemplateValidationError{
+					field:  "description",
+					reason: "value length must be at most 2000 runes",
+				}
+			}
+
+		case "format":
+
+			if utf8.RuneCountInString(m.GetFormat()) > 36 {
+				return GatewayConfigurationTemplateValidationError{
+					field:  "format",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_GatewayConfigurationTemplate_Format_Pattern.MatchString(m.GetFormat()) {
+				return GatewayConfigurationTemplateValidationError{
+					field:  "format",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "type":
+
+			if utf8.RuneCountInString(m.GetType()) > 36 {
+				return GatewayConfigurationTemplateValidationError{
+					field:  "type",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_GatewayConfigurationTemplate_Type_Pattern.MatchString(m.GetType()) {
+				return GatewayConfigurationTemplateValidationError{
+					field:  "type",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$\"",
+				}
+			}
+
+		case "filename":
+
+			if utf8.RuneCountInString(m.GetFilename()) > 36 {
+				return GatewayConfigurationTemplateValidationError{
+					field:  "filename",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_GatewayConfigurationTemplate_Filename_Pattern.MatchString(m.GetFilename()) {
+				return GatewayConfigurationTemplateValidationError{
+					field:  "filename",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-._]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "contents":
+
+			if len(m.GetContents()) > 65536 {
+				return GatewayConfigurationTemplateValidationError{
+					field:  "contents",
+					reason: "value length must be at most 65536 bytes",
+				}
+			}
+
+		case "variables":
+
+			if len(m.GetVariables()) > 50 {
+				return GatewayConfigurationTemplateValidationError{
+					field:  "variables",
+					reason: "value must contain no more than 50 pair(s)",
+				}
+			}
+
+			for key, val := range m.GetVariables() {
+				_ = val
+
+				if utf8.RuneCountInString(key) > 36 {
+					return GatewayConfigurationTemplateValidationError{
+						field:  fmt.Sprintf("variables[%v]", key),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_GatewayConfigurationTemplate_Variables_Pattern.MatchString(key) {
+					return GatewayConfigurationTemplateValidationError{
+						field:  fmt.Sprintf("variables[%v]", key),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[_-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+				if utf8.RuneCountInString(val) > 2000 {
+					return GatewayConfigurationTemplateValidationError{
+						field:  fmt.Sprintf("variables[%v]", key),
+						reason: "value length must be at most 2000 runes",
+					}
+				}
+
+			}
+
+		default:
+			return GatewayConfigurationTemplateValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConfigurationTemplateValidationError is the validation error returned
+// by GatewayConfigurationTemplate.ValidateFields if the designated
+// constraints aren't met.
+type GatewayConfigurationTemplateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConfigurationTemplateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConfigurationTemplateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConfigurationTemplateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConfigurationTemplateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConfigurationTemplateValidationError) ErrorName() string {
+	return "GatewayConfigurationTemplateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConfigurationTemplateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConfigurationTemplate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConfigurationTemplateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConfigurationTemplateValidationError{}
+
+var _GatewayConfigurationTemplate_TemplateId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+var _GatewayConfigurationTemplate_Format_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+var _GatewayConfigurationTemplate_Type_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$")
+
+var _GatewayConfigurationTemplate_Filename_Pattern = regexp.MustCompile("^[a-z0-9](?:[-._]?[a-z0-9]){2,}$")
+
+var _GatewayConfigurationTemplate_Variables_Pattern = regexp.MustCompile("^[a-z0-9](?:[_-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on GatewayConfigurationTemplates with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayConfigurationTemplates) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConfigurationTemplatesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "templates":
+
+			for idx, item := range m.GetTemplates() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConfigurationTemplatesValidationError{
+							field:  fmt.Sprintf("templates[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayConfigurationTemplatesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConfigurationTemplatesValidationError is the validation error
+// returned by GatewayConfigurationTemplates.ValidateFields if the designated
+// constraints aren't met.
+type GatewayConfigurationTemplatesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConfigurationTemplatesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConfigurationTemplatesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConfigurationTemplatesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConfigurationTemplatesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConfigurationTemplatesValidationError) ErrorName() string {
+	return "GatewayConfigurationTemplatesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConfigurationTemplatesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConfigurationTemplates.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConfigurationTemplatesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConfigurationTemplatesValidationError{}
+
+// ValidateFields checks the field values on
+// SetGatewayConfigurationTemplateRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *SetGatewayConfigurationTemplateRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetGatewayConfigurationTemplateRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "template":
+
+			if m.GetTemplate() == nil {
+				return SetGatewayConfigurationTemplateRequestValidationError{
+					field:  "template",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetTemplate()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetGatewayConfigurationTemplateRequestValidationError{
+						field:  "template",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SetGatewayConfigurationTemplateRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetGatewayConfigurationTemplateRequestValidationError is the validation
+// error returned by SetGatewayConfigurationTemplateRequest.ValidateFields if
+// the designated constraints aren't met.
+type SetGatewayConfigurationTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetGatewayConfigurationTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetGatewayConfigurationTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetGatewayConfigurationTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetGatewayConfigurationTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetGatewayConfigurationTemplateRequestValidationError) ErrorName() string {
+	return "SetGatewayConfigurationTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetGatewayConfigurationTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetGatewayConfigurationTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetGatewayConfigurationTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetGatewayConfigurationTemplateRequestValidationError{}
+
+// ValidateFields checks the field values on
+// GetGatewayConfigurationTemplateRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *GetGatewayConfigurationTemplateRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetGatewayConfigurationTemplateRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "owner_ids":
+
+			if m.GetOwnerIds() == nil {
+				return GetGatewayConfigurationTemplateRequestValidationError{
+					field:  "owner_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetOwnerIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConfigurationTemplateRequestValidationError{
+						field:  "owner_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "template_id":
+
+			if utf8.RuneCountInString(m.GetTemplateId()) > 36 {
+				return GetGatewayConfigurationTemplateRequestValidationError{
+					field:  "template_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_GetGatewayConfigurationTemplateRequest_TemplateId_Pattern.MatchString(m.GetTemplateId()) {
+				return GetGatewayConfigurationTemplateRequestValidationError{
+					field:  "template_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "version":
+			// no validation rules for Version
+		default:
+			return GetGatewayConfigurationTemplateRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetGatewayConfigurationTemplateRequestValidationError is the validation
+// error returned by GetGatewayConfigurationTemplateRequest.ValidateFields if
+// the designated constraints aren't met.
+type GetGatewayConfigurationTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGatewayConfigurationTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGatewayConfigurationTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGatewayConfigurationTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGatewayConfigurationTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGatewayConfigurationTemplateRequestValidationError) ErrorName() string {
+	return "GetGatewayConfigurationTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGatewayConfigurationTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGatewayConfigurationTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGatewayConfigurationTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGatewayConfigurationTemplateRequestValidationError{}
+
+var _GetGatewayConfigurationTemplateRequest_TemplateId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on
+// ListGatewayConfigurationTemplatesRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *ListGatewayConfigurationTemplatesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListGatewayConfigurationTemplatesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "owner_ids":
+
+			if m.GetOwnerIds() == nil {
+				return ListGatewayConfigurationTemplatesRequestValidationError{
+					field:  "owner_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetOwnerIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListGatewayConfigurationTemplatesRequestValidationError{
+						field:  "owner_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ListGatewayConfigurationTemplatesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListGatewayConfigurationTemplatesRequestValidationError is the validation
+// error returned by ListGatewayConfigurationTemplatesRequest.ValidateFields
+// if the designated constraints aren't met.
+type ListGatewayConfigurationTemplatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListGatewayConfigurationTemplatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListGatewayConfigurationTemplatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListGatewayConfigurationTemplatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListGatewayConfigurationTemplatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListGatewayConfigurationTemplatesRequestValidationError) ErrorName() string {
+	return "ListGatewayConfigurationTemplatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListGatewayConfigurationTemplatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListGatewayConfigurationTemplatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListGatewayConfigurationTemplatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListGatewayConfigurationTemplatesRequestValidationError{}
+
+// ValidateFields checks the field values on
+// DeleteGatewayConfigurationTemplateRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *DeleteGatewayConfigurationTemplateRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DeleteGatewayConfigurationTemplateRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "owner_ids":
+
+			if m.GetOwnerIds() == nil {
+				return DeleteGatewayConfigurationTemplateRequestValidationError{
+					field:  "owner_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetOwnerIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DeleteGatewayConfigurationTemplateRequestValidationError{
+						field:  "owner_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "template_id":
+
+			if utf8.RuneCountInString(m.GetTemplateId()) > 36 {
+				return DeleteGatewayConfigurationTemplateRequestValidationError{
+					field:  "template_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_DeleteGatewayConfigurationTemplateRequest_TemplateId_Pattern.MatchString(m.GetTemplateId()) {
+				return DeleteGatewayConfigurationTemplateRequestValidationError{
+					field:  "template_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return DeleteGatewayConfigurationTemplateRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteGatewayConfigurationTemplateRequestValidationError is the validation
+// error returned by DeleteGatewayConfigurationTemplateRequest.ValidateFields
+// if the designated constraints aren't met.
+type DeleteGatewayConfigurationTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteGatewayConfigurationTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteGatewayConfigurationTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteGatewayConfigurationTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteGatewayConfigurationTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteGatewayConfigurationTemplateRequestValidationError) ErrorName() string {
+	return "DeleteGatewayConfigurationTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteGatewayConfigurationTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteGatewayConfigurationTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteGatewayConfigurationTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteGatewayConfigurationTemplateRequestValidationError{}
+
+var _DeleteGatewayConfigurationTemplateRequest_TemplateId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on
+// DiffGatewayConfigurationTemplateRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *DiffGatewayConfigurationTemplateRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DiffGatewayConfigurationTemplateRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "owner_ids":
+
+			if m.GetOwnerIds() == nil {
+				return DiffGatewayConfigurationTemplateRequestValidationError{
+					field:  "owner_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetOwnerIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DiffGatewayConfigurationTemplateRequestValidationError{
+						field:  "owner_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "template_id":
+
+			if utf8.RuneCountInString(m.GetTemplateId()) > 36 {
+				return DiffGatewayConfigurationTemplateRequestValidationError{
+					field:  "template_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_DiffGatewayConfigurationTemplateRequest_TemplateId_Pattern.MatchString(m.GetTemplateId()) {
+				return DiffGatewayConfigurationTemplateRequestValidationError{
+					field:  "template_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "from_version":
+
+			if m.GetFromVersion() <= 0 {
+				return DiffGatewayConfigurationTemplateRequestValidationError{
+					field:  "from_version",
+					reason: "value must be greater than 0",
+				}
+			}
+
+		case "to_version":
+			// no validation rules for ToVersion
+		case "gateway_ids":
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DiffGatewayConfigurationTemplateRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return DiffGatewayConfigurationTemplateRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DiffGatewayConfigurationTemplateRequestValidationError is the validation
+// error returned by DiffGatewayConfigurationTemplateRequest.ValidateFields if
+// the designated constraints aren't met.
+type DiffGatewayConfigurationTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffGatewayConfigurationTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffGatewayConfigurationTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffGatewayConfigurationTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffGatewayConfigurationTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffGatewayConfigurationTemplateRequestValidationError) ErrorName() string {
+	return "DiffGatewayConfigurationTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffGatewayConfigurationTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffGatewayConfigurationTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffGatewayConfigurationTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffGatewayConfigurationTemplateRequestValidationError{}
+
+var _DiffGatewayConfigurationTemplateRequest_TemplateId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on
+// DiffGatewayConfigurationTemplateResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *DiffGatewayConfigurationTemplateResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DiffGatewayConfigurationTemplateResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "diff":
+			// no validation rules for Diff
+		default:
+			return DiffGatewayConfigurationTemplateResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DiffGatewayConfigurationTemplateResponseValidationError is the validation
+// error returned by DiffGatewayConfigurationTemplateResponse.ValidateFields
+// if the designated constraints aren't met.
+type DiffGatewayConfigurationTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffGatewayConfigurationTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffGatewayConfigurationTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffGatewayConfigurationTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffGatewayConfigurationTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffGatewayConfigurationTemplateResponseValidationError) ErrorName() string {
+	return "DiffGatewayConfigurationTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffGatewayConfigurationTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffGatewayConfigurationTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DiffGatewayConfigurationTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffGatewayConfigurationTemplateResponseValidationError{}
+
+// ValidateFields checks the field values on
+// GatewayConfigurationTemplateAssignment with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *GatewayConfigurationTemplateAssignment) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConfigurationTemplateAssignmentFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return GatewayConfigurationTemplateAssignmentValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConfigurationTemplateAssignmentValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "template_owner_ids":
+
+			if m.GetTemplateOwnerIds() == nil {
+				return GatewayConfigurationTemplateAssignmentValidationError{
+					field:  "template_owner_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetTemplateOwnerIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConfigurationTemplateAssignmentValidationError{
+						field:  "template_owner_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "template_id":
+
+			if utf8.RuneCountInString(m.GetTemplateId()) > 36 {
+				return GatewayConfigurationTemplateAssignmentValidationError{
+					field:  "template_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_GatewayConfigurationTemplateAssignment_TemplateId_Pattern.MatchString(m.GetTemplateId()) {
+				return GatewayConfigurationTemplateAssignmentValidationError{
+					field:  "template_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "template_version":
+			// no validation rules for TemplateVersion
+		case "variables":
+
+			if len(m.GetVariables()) > 50 {
+				return GatewayConfigurationTemplateAssignmentValidationError{
+					field:  "variables",
+					reason: "value must contain no more than 50 pair(s)",
+				}
+			}
+
+			for key, val := range m.GetVariables() {
+				_ = val
+
+				if utf8.RuneCountInString(key) > 36 {
+					return GatewayConfigurationTemplateAssignmentValidationError{
+						field:  fmt.Sprintf("variables[%v]", key),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_GatewayConfigurationTemplateAssignment_Variables_Pattern.MatchString(key) {
+					return GatewayConfigurationTemplateAssignmentValidationError{
+						field:  fmt.Sprintf("variables[%v]", key),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[_-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+				if utf8.RuneCountInString(val) > 2000 {
+					return GatewayConfigurationTemplateAssignmentValidationError{
+						field:  fmt.Sprintf("variables[%v]", key),
+						reason: "value length must be at most 2000 runes",
+					}
+				}
+
+			}
+
+		case "overrides":
+
+			if len(m.GetOverrides()) > 16384 {
+				return GatewayConfigurationTemplateAssignmentValidationError{
+					field:  "overrides",
+					reason: "value length must be at most 16384 bytes",
+				}
+			}
+
+		case "updated_at":
+
+			if v, ok := interface{}(m.GetUpdatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConfigurationTemplateAssignmentValidationError{
+						field:  "updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayConfigurationTemplateAssignmentValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConfigurationTemplateAssignmentValidationError is the validation
+// error returned by GatewayConfigurationTemplateAssignment.ValidateFields if
+// the designated constraints aren't met.
+type GatewayConfigurationTemplateAssignmentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConfigurationTemplateAssignmentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConfigurationTemplateAssignmentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConfigurationTemplateAssignmentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConfigurationTemplateAssignmentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConfigurationTemplateAssignmentValidationError) ErrorName() string {
+	return "GatewayConfigurationTemplateAssignmentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConfigurationTemplateAssignmentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConfigurationTemplateAssignment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConfigurationTemplateAssignmentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConfigurationTemplateAssignmentValidationError{}
+
+var _GatewayConfigurationTemplateAssignment_TemplateId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+var _GatewayConfigurationTemplateAssignment_Variables_Pattern = regexp.MustCompile("^[a-z0-9](?:[_-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on
+// SetGatewayConfigurationTemplateAssignmentRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *SetGatewayConfigurationTemplateAssignmentRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetGatewayConfigurationTemplateAssignmentRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "assignment":
+
+			if m.GetAssignment() == nil {
+				return SetGatewayConfigurationTemplateAssignmentRequestValidationError{
+					field:  "assignment",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetAssignment()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetGatewayConfigurationTemplateAssignmentRequestValidationError{
+						field:  "assignment",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SetGatewayConfigurationTemplateAssignmentRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetGatewayConfigurationTemplateAssignmentRequestValidationError is the
+// validation error returned by
+// SetGatewayConfigurationTemplateAssignmentRequest.ValidateFields if the
+// designated constraints aren't met.
+type SetGatewayConfigurationTemplateAssignmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetGatewayConfigurationTemplateAssignmentRequestValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e SetGatewayConfigurationTemplateAssignmentRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e SetGatewayConfigurationTemplateAssignmentRequestValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e SetGatewayConfigurationTemplateAssignmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetGatewayConfigurationTemplateAssignmentRequestValidationError) ErrorName() string {
+	return "SetGatewayConfigurationTemplateAssignmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetGatewayConfigurationTemplateAssignmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetGatewayConfigurationTemplateAssignmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetGatewayConfigurationTemplateAssignmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetGatewayConfigurationTemplateAssignmentRequestValidationError{}
+
+// ValidateFields checks the field values on
+// GetGatewayConfigurationTemplateAssignmentRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *GetGatewayConfigurationTemplateAssignmentRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetGatewayConfigurationTemplateAssignmentRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return GetGatewayConfigurationTemplateAssignmentRequestValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConfigurationTemplateAssignmentRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetGatewayConfigurationTemplateAssignmentRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetGatewayConfigurationTemplateAssignmentRequestValidationError is the
+// validation error returned by
+// GetGatewayConfigurationTemplateAssignmentRequest.ValidateFields if the
+// designated constraints aren't met.
+type GetGatewayConfigurationTemplateAssignmentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGatewayConfigurationTemplateAssignmentRequestValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e GetGatewayConfigurationTemplateAssignmentRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e GetGatewayConfigurationTemplateAssignmentRequestValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e GetGatewayConfigurationTemplateAssignmentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGatewayConfigurationTemplateAssignmentRequestValidationError) ErrorName() string {
+	return "GetGatewayConfigurationTemplateAssignmentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGatewayConfigurationTemplateAssignmentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGatewayConfigurationTemplateAssignmentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGatewayConfigurationTemplateAssignmentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGatewayConfigurationTemplateAssignmentRequestValidationError{}
+
+// ValidateFields checks the field values on RenderedGatewayConfiguration with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *RenderedGatewayConfiguration) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RenderedGatewayConfigurationFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "template_owner_ids":
+
+			if v, ok := interface{}(m.GetTemplateOwnerIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RenderedGatewayConfigurationValidationError{
+						field:  "template_owner_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "template_id":
+			// no validation rules for TemplateId
+		case "template_version":
+			// no validation rules for TemplateVersion
+		case "format":
+			// no validation rules for Format
+		case "type":
+			// no validation rules for Type
+		case "filename":
+			// no validation rules for Filename
+		case "revision":
+			// no validation rules for Revision
+		case "contents":
+			// no validation rules for Contents
+		default:
+			return RenderedGatewayConfigurationValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RenderedGatewayConfigurationValidationError is the validation error returned
+// by RenderedGatewayConfiguration.ValidateFields if the designated
+// constraints aren't met.
+type RenderedGatewayConfigurationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenderedGatewayConfigurationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenderedGatewayConfigurationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenderedGatewayConfigurationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenderedGatewayConfigurationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenderedGatewayConfigurationValidationError) ErrorName() string {
+	return "RenderedGatewayConfigurationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenderedGatewayConfigurationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenderedGatewayConfiguration.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RenderedGatewayConfigurationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenderedGatewayConfigurationValidationError{}
+
+// ValidateFields checks the field values on PreviewGatewayConfigurationRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *PreviewGatewayConfigurationRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = PreviewGatewayConfigurationRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return PreviewGatewayConfigurationRequestValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return PreviewGatewayConfigurationRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "template_id":
+
+			if utf8.RuneCountInString(m.GetTemplateId()) > 36 {
+				return PreviewGatewayConfigurationRequestValidationError{
+					field:  "template_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_PreviewGatewayConfigurationRequest_TemplateId_Pattern.MatchString(m.GetTemplateId()) {
+				return PreviewGatewayConfigurationRequestValidationError{
+					field:  "template_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$\"",
+				}
+			}
+
+		case "template_owner_ids":
+
+			if v, ok := interface{}(m.GetTemplateOwnerIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return PreviewGatewayConfigurationRequestValidationError{
+						field:  "template_owner_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "template_version":
+			// no validation rules for TemplateVersion
+		case "variables":
+
+			if len(m.GetVariables()) > 50 {
+				return PreviewGatewayConfigurationRequestValidationError{
+					field:  "variables",
+					reason: "value must contain no more than 50 pair(s)",
+				}
+			}
+
+			for key, val := range m.GetVariables() {
+				_ = val
+
+				if utf8.RuneCountInString(key) > 36 {
+					return PreviewGatewayConfigurationRequestValidationError{
+						field:  fmt.Sprintf("variables[%v]", key),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_PreviewGatewayConfigurationRequest_Variables_Pattern.MatchString(key) {
+					return PreviewGatewayConfigurationRequestValidationError{
+						field:  fmt.Sprintf("variables[%v]", key),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[_-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+				if utf8.RuneCountInString(val) > 2000 {
+					return PreviewGatewayConfigurationRequestValidationError{
+						field:  fmt.Sprintf("variables[%v]", key),
+						reason: "value length must be at most 2000 runes",
+					}
+				}
+
+			}
+
+		case "overrides":
+
+			if len(m.GetOverrides()) > 16384 {
+				return PreviewGatewayConfigurationRequestValidationError{
+					field:  "overrides",
+					reason: "value length must be at most 16384 bytes",
+				}
+			}
+
+		default:
+			return PreviewGatewayConfigurationRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// PreviewGatewayConfigurationRequestValidationError is the validation error
+// returned by PreviewGatewayConfigurationRequest.ValidateFields if the
+// designated constraints aren't met.
+type PreviewGatewayConfigurationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewGatewayConfigurationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewGatewayConfigurationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewGatewayConfigurationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewGatewayConfigurationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewGatewayConfigurationRequestValidationError) ErrorName() string {
+	return "PreviewGatewayConfigurationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewGatewayConfigurationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewGatewayConfigurationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewGatewayConfigurationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewGatewayConfigurationRequestValidationError{}
+
+var _PreviewGatewayConfigurationRequest_TemplateId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$|^$")
+
+var _PreviewGatewayConfigurationRequest_Variables_Pattern = regexp.MustCompile("^[a-z0-9](?:[_-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on
+// PullRenderedGatewayConfigurationRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *PullRenderedGatewayConfigurationRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = PullRenderedGatewayConfigurationRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return PullRenderedGatewayConfigurationRequestValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return PullRenderedGatewayConfigurationRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "revision":
+
+			if utf8.RuneCountInString(m.GetRevision()) > 64 {
+				return PullRenderedGatewayConfigurationRequestValidationError{
+					field:  "revision",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		default:
+			return PullRenderedGatewayConfigurationRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// PullRenderedGatewayConfigurationRequestValidationError is the validation
+// error returned by PullRenderedGatewayConfigurationRequest.ValidateFields if
+// the designated constraints aren't met.
+type PullRenderedGatewayConfigurationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PullRenderedGatewayConfigurationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PullRenderedGatewayConfigurationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PullRenderedGatewayConfigurationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PullRenderedGatewayConfigurationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PullRenderedGatewayConfigurationRequestValidationError) ErrorName() string {
+	return "PullRenderedGatewayConfigurationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PullRenderedGatewayConfigurationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPullRenderedGatewayConfigurationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PullRenderedGatewayConfigurationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PullRenderedGatewayConfigurationRequestValidationError{}
+
+// ValidateFields checks the field values on
+// PullRenderedGatewayConfigurationResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *PullRenderedGatewayConfigurationResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = PullRenderedGatewayConfigurationResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "modified":
+			// no validation rules for Modified
+		case "configuration":
+
+			if v, ok := interface{}(m.GetConfiguration()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return PullRenderedGatewayConfigurationResponseValidationError{
+						field:  "configuration",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return PullRenderedGatewayConfigurationResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// PullRenderedGatewayConfigurationResponseValidationError is the validation
+// error returned by PullRenderedGatewayConfigurationResponse.ValidateFields
+// if the designated constraints aren't met.
+type PullRenderedGatewayConfigurationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PullRenderedGatewayConfigurationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PullRenderedGatewayConfigurationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PullRenderedGatewayConfigurationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PullRenderedGatewayConfigurationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PullRenderedGatewayConfigurationResponseValidationError) ErrorName() string {
+	return "PullRenderedGatewayConfigurationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PullRenderedGatewayConfigurationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPullRenderedGatewayConfigurationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PullRenderedGatewayConfigurationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PullRenderedGatewayConfigurationResponseValidationError{}
@@ -25,6 +25,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -33,7 +34,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	GatewayConfigurationService_GetGatewayConfiguration_FullMethodName = "/ttn.lorawan.v3.GatewayConfigurationService/GetGatewayConfiguration"
+	GatewayConfigurationService_GetGatewayConfiguration_FullMethodName          = "/ttn.lorawan.v3.GatewayConfigurationService/GetGatewayConfiguration"
+	GatewayConfigurationService_PreviewGatewayConfiguration_FullMethodName      = "/ttn.lorawan.v3.GatewayConfigurationService/PreviewGatewayConfiguration"
+	GatewayConfigurationService_PullRenderedGatewayConfiguration_FullMethodName = "/ttn.lorawan.v3.GatewayConfigurationService/PullRenderedGatewayConfiguration"
 )
 
 // GatewayConfigurationServiceClient is the client API for GatewayConfigurationService service.
@@ -41,6 +44,13 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type GatewayConfigurationServiceClient interface {
 	GetGatewayConfiguration(ctx context.Context, in *GetGatewayConfigurationRequest, opts ...grpc.CallOption) (*GetGatewayConfigurationResponse, error)
+	// Render the configuration of the gateway from a template, without changing the template assignment.
+	// If a template is given, this requires the rights to list gateways of the user or organization that owns the
+	// template.
+	PreviewGatewayConfiguration(ctx context.Context, in *PreviewGatewayConfigurationRequest, opts ...grpc.CallOption) (*RenderedGatewayConfiguration, error)
+	// Pull the configuration of the gateway that is rendered from its assigned template.
+	// Gateways and provisioning tools poll this rpc with the revision that they have to check for updates.
+	PullRenderedGatewayConfiguration(ctx context.Context, in *PullRenderedGatewayConfigurationRequest, opts ...grpc.CallOption) (*PullRenderedGatewayConfigurationResponse, error)
 }
 
 type gatewayConfigurationServiceClient struct {
@@ -60,11 +70,36 @@ func (c *gatewayConfigurationServiceClient) GetGatewayConfiguration(ctx context.
 	return out, nil
 }
 
+func (c *gatewayConfigurationServiceClient) PreviewGatewayConfiguration(ctx context.Context, in *PreviewGatewayConfigurationRequest, opts ...grpc.CallOption) (*RenderedGatewayConfiguration, error) {
+	out := new(RenderedGatewayConfiguration)
+	err := c.cc.Invoke(ctx, GatewayConfigurationService_PreviewGatewayConfiguration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayConfigurationServiceClient) PullRenderedGatewayConfiguration(ctx context.Context, in *PullRenderedGatewayConfigurationRequest, opts ...grpc.CallOption) (*PullRenderedGatewayConfigurationResponse, error) {
+	out := new(PullRenderedGatewayConfigurationResponse)
+	err := c.cc.Invoke(ctx, GatewayConfigurationService_PullRenderedGatewayConfiguration_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GatewayConfigurationServiceServer is the server API for GatewayConfigurationService service.
 // All implementations must embed UnimplementedGatewayConfigurationServiceServer
 // for forward compatibility
 type GatewayConfigurationServiceServer interface {
 	GetGatewayConfiguration(context.Context, *GetGatewayConfigurationRequest) (*GetGatewayConfigurationResponse, error)
+	// Render the configuration of the gateway from a template, without changing the template assignment.
+	// If a template is given, this requires the rights to list gateways of the user or organization that owns the
+	// template.
+	PreviewGatewayConfiguration(context.Context, *PreviewGatewayConfigurationRequest) (*RenderedGatewayConfiguration, error)
+	// Pull the configuration of the gateway that is rendered from its assigned template.
+	// Gateways and provisioning tools poll this rpc with the revision that they have to check for updates.
+	PullRenderedGatewayConfiguration(context.Context, *PullRenderedGatewayConfigurationRequest) (*PullRenderedGatewayConfigurationResponse, error)
 	mustEmbedUnimplementedGatewayConfigurationServiceServer()
 }
 
@@ -75,6 +110,12 @@ type UnimplementedGatewayConfigurationServiceServer struct {
 func (UnimplementedGatewayConfigurationServiceServer) GetGatewayConfiguration(context.Context, *GetGatewayConfigurationRequest) (*GetGatewayConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConfiguration not implemented")
 }
+func (UnimplementedGatewayConfigurationServiceServer) PreviewGatewayConfiguration(context.Context, *PreviewGatewayConfigurationRequest) (*RenderedGatewayConfiguration, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewGatewayConfiguration not implemented")
+}
+func (UnimplementedGatewayConfigurationServiceServer) PullRenderedGatewayConfiguration(context.Context, *PullRenderedGatewayConfigurationRequest) (*PullRenderedGatewayConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PullRenderedGatewayConfiguration not implemented")
+}
 func (UnimplementedGatewayConfigurationServiceServer) mustEmbedUnimplementedGatewayConfigurationServiceServer() {
 }
 
@@ -107,6 +148,42 @@ func _GatewayConfigurationService_GetGatewayConfiguration_Handler(srv interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayConfigurationService_PreviewGatewayConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewGatewayConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayConfigurationServiceServer).PreviewGatewayConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayConfigurationService_PreviewGatewayConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayConfigurationServiceServer).PreviewGatewayConfiguration(ctx, req.(*PreviewGatewayConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayConfigurationService_PullRenderedGatewayConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PullRenderedGatewayConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayConfigurationServiceServer).PullRenderedGatewayConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayConfigurationService_PullRenderedGatewayConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayConfigurationServiceServer).PullRenderedGatewayConfiguration(ctx, req.(*PullRenderedGatewayConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GatewayConfigurationService_ServiceDesc is the grpc.ServiceDesc for GatewayConfigurationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -118,6 +195,396 @@ var GatewayConfigurationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetGatewayConfiguration",
 			Handler:    _GatewayConfigurationService_GetGatewayConfiguration_Handler,
 		},
+		{
+			MethodName: "PreviewGatewayConfiguration",
+			Handler:    _GatewayConfigurationService_PreviewGatewayConfiguration_Handler,
+		},
+		{
+			MethodName: "PullRenderedGatewayConfiguration",
+			Handler:    _GatewayConfigurationService_PullRenderedGatewayConfiguration_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/gateway_configuration.proto",
+}
+
+const (
+	GatewayConfigurationTemplateRegistry_SetTemplate_FullMethodName      = "/ttn.lorawan.v3.GatewayConfigurationTemplateRegistry/SetTemplate"
+	GatewayConfigurationTemplateRegistry_GetTemplate_FullMethodName      = "/ttn.lorawan.v3.GatewayConfigurationTemplateRegistry/GetTemplate"
+	GatewayConfigurationTemplateRegistry_ListTemplates_FullMethodName    = "/ttn.lorawan.v3.GatewayConfigurationTemplateRegistry/ListTemplates"
+	GatewayConfigurationTemplateRegistry_DeleteTemplate_FullMethodName   = "/ttn.lorawan.v3.GatewayConfigurationTemplateRegistry/DeleteTemplate"
+	GatewayConfigurationTemplateRegistry_DiffTemplate_FullMethodName     = "/ttn.lorawan.v3.GatewayConfigurationTemplateRegistry/DiffTemplate"
+	GatewayConfigurationTemplateRegistry_SetAssignment_FullMethodName    = "/ttn.lorawan.v3.GatewayConfigurationTemplateRegistry/SetAssignment"
+	GatewayConfigurationTemplateRegistry_GetAssignment_FullMethodName    = "/ttn.lorawan.v3.GatewayConfigurationTemplateRegistry/GetAssignment"
+	GatewayConfigurationTemplateRegistry_DeleteAssignment_FullMethodName = "/ttn.lorawan.v3.GatewayConfigurationTemplateRegistry/DeleteAssignment"
+)
+
+// GatewayConfigurationTemplateRegistryClient is the client API for GatewayConfigurationTemplateRegistry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type GatewayConfigurationTemplateRegistryClient interface {
+	// Set the template. This creates a new version of the template.
+	// This requires the rights to create gateways of the user or organization that owns the template.
+	SetTemplate(ctx context.Context, in *SetGatewayConfigurationTemplateRequest, opts ...grpc.CallOption) (*GatewayConfigurationTemplate, error)
+	// Get a version of the template.
+	// This requires the rights to list gateways of the user or organization that owns the template.
+	GetTemplate(ctx context.Context, in *GetGatewayConfigurationTemplateRequest, opts ...grpc.CallOption) (*GatewayConfigurationTemplate, error)
+	// List the latest versions of the templates of the user or organization.
+	// This requires the rights to list gateways of the user or organization.
+	ListTemplates(ctx context.Context, in *ListGatewayConfigurationTemplatesRequest, opts ...grpc.CallOption) (*GatewayConfigurationTemplates, error)
+	// Delete the template and all its versions.
+	// Templates that are assigned to gateways cannot be deleted; delete or change the assignments first.
+	// This requires the rights to create gateways of the user or organization that owns the template.
+	DeleteTemplate(ctx context.Context, in *DeleteGatewayConfigurationTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Compare two versions of the template.
+	// This requires the rights to list gateways of the user or organization that owns the template, and the rights to
+	// read the gateway if the configurations that are rendered for the gateway are compared.
+	DiffTemplate(ctx context.Context, in *DiffGatewayConfigurationTemplateRequest, opts ...grpc.CallOption) (*DiffGatewayConfigurationTemplateResponse, error)
+	// Assign a template to the gateway.
+	// This requires the rights to list gateways of the user or organization that owns the template.
+	SetAssignment(ctx context.Context, in *SetGatewayConfigurationTemplateAssignmentRequest, opts ...grpc.CallOption) (*GatewayConfigurationTemplateAssignment, error)
+	// Get the template assignment of the gateway.
+	GetAssignment(ctx context.Context, in *GetGatewayConfigurationTemplateAssignmentRequest, opts ...grpc.CallOption) (*GatewayConfigurationTemplateAssignment, error)
+	// Remove the template assignment of the gateway.
+	DeleteAssignment(ctx context.Context, in *GetGatewayConfigurationTemplateAssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type gatewayConfigurationTemplateRegistryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewGatewayConfigurationTemplateRegistryClient(cc grpc.ClientConnInterface) GatewayConfigurationTemplateRegistryClient {
+	return &gatewayConfigurationTemplateRegistryClient{cc}
+}
+
+func (c *gatewayConfigurationTemplateRegistryClient) SetTemplate(ctx context.Context, in *SetGatewayConfigurationTemplateRequest, opts ...grpc.CallOption) (*GatewayConfigurationTemplate, error) {
+	out := new(GatewayConfigurationTemplate)
+	err := c.cc.Invoke(ctx, GatewayConfigurationTemplateRegistry_SetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayConfigurationTemplateRegistryClient) GetTemplate(ctx context.Context, in *GetGatewayConfigurationTemplateRequest, opts ...grpc.CallOption) (*GatewayConfigurationTemplate, error) {
+	out := new(GatewayConfigurationTemplate)
+	err := c.cc.Invoke(ctx, GatewayConfigurationTemplateRegistry_GetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayConfigurationTemplateRegistryClient) ListTemplates(ctx context.Context, in *ListGatewayConfigurationTemplatesRequest, opts ...grpc.CallOption) (*GatewayConfigurationTemplates, error) {
+	out := new(GatewayConfigurationTemplates)
+	err := c.cc.Invoke(ctx, GatewayConfigurationTemplateRegistry_ListTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayConfigurationTemplateRegistryClient) DeleteTemplate(ctx context.Context, in *DeleteGatewayConfigurationTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GatewayConfigurationTemplateRegistry_DeleteTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayConfigurationTemplateRegistryClient) DiffTemplate(ctx context.Context, in *DiffGatewayConfigurationTemplateRequest, opts ...grpc.CallOption) (*DiffGatewayConfigurationTemplateResponse, error) {
+	out := new(DiffGatewayConfigurationTemplateResponse)
+	err := c.cc.Invoke(ctx, GatewayConfigurationTemplateRegistry_DiffTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayConfigurationTemplateRegistryClient) SetAssignment(ctx context.Context, in *SetGatewayConfigurationTemplateAssignmentRequest, opts ...grpc.CallOption) (*GatewayConfigurationTemplateAssignment, error) {
+	out := new(GatewayConfigurationTemplateAssignment)
+	err := c.cc.Invoke(ctx, GatewayConfigurationTemplateRegistry_SetAssignment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayConfigurationTemplateRegistryClient) GetAssignment(ctx context.Context, in *GetGatewayConfigurationTemplateAssignmentRequest, opts ...grpc.CallOption) (*GatewayConfigurationTemplateAssignment, error) {
+	out := new(GatewayConfigurationTemplateAssignment)
+	err := c.cc.Invoke(ctx, GatewayConfigurationTemplateRegistry_GetAssignment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayConfigurationTemplateRegistryClient) DeleteAssignment(ctx context.Context, in *GetGatewayConfigurationTemplateAssignmentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GatewayConfigurationTemplateRegistry_DeleteAssignment_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GatewayConfigurationTemplateRegistryServer is the server API for GatewayConfigurationTemplateRegistry service.
+// All implementations must embed UnimplementedGatewayConfigurationTemplateRegistryServer
+// for forward compatibility
+type GatewayConfigurationTemplateRegistryServer interface {
+	// Set the template. This creates a new version of the template.
+	// This requires the rights to create gateways of the user or organization that owns the template.
+	SetTemplate(context.Context, *SetGatewayConfigurationTemplateRequest) (*GatewayConfigurationTemplate, error)
+	// Get a version of the template.
+	// This requires the rights to list gateways of the user or organization that owns the template.
+	GetTemplate(context.Context, *GetGatewayConfigurationTemplateRequest) (*GatewayConfigurationTemplate, error)
+	// List the latest versions of the templates of the user or organization.
+	// This requires the rights to list gateways of the user or organization.
+	ListTemplates(context.Context, *ListGatewayConfigurationTemplatesRequest) (*GatewayConfigurationTemplates, error)
+	// Delete the template and all its versions.
+	// Templates that are assigned to gateways cannot be deleted; delete or change the assignments first.
+	// This requires the rights to create gateways of the user or organization that owns the template.
+	DeleteTemplate(context.Context, *DeleteGatewayConfigurationTemplateRequest) (*emptypb.Empty, error)
+	// Compare two versions of the template.
+	// This requires the rights to list gateways of the user or organization that owns the template, and the rights to
+	// read the gateway if the configurations that are rendered for the gateway are compared.
+	DiffTemplate(context.Context, *DiffGatewayConfigurationTemplateRequest) (*DiffGatewayConfigurationTemplateResponse, error)
+	// Assign a template to the gateway.
+	// This requires the rights to list gateways of the user or organization that owns the template.
+	SetAssignment(context.Context, *SetGatewayConfigurationTemplateAssignmentRequest) (*GatewayConfigurationTemplateAssignment, error)
+	// Get the template assignment of the gateway.
+	GetAssignment(context.Context, *GetGatewayConfigurationTemplateAssignmentRequest) (*GatewayConfigurationTemplateAssignment, error)
+	// Remove the template assignment of the gateway.
+	DeleteAssignment(context.Context, *GetGatewayConfigurationTemplateAssignmentRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedGatewayConfigurationTemplateRegistryServer()
+}
+
+// UnimplementedGatewayConfigurationTemplateRegistryServer must be embedded to have forward compatible implementations.
+type UnimplementedGatewayConfigurationTemplateRegistryServer struct {
+}
+
+func (UnimplementedGatewayConfigurationTemplateRegistryServer) SetTemplate(context.Context, *SetGatewayConfigurationTemplateRequest) (*GatewayConfigurationTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTemplate not implemented")
+}
+func (UnimplementedGatewayConfigurationTemplateRegistryServer) GetTemplate(context.Context, *GetGatewayConfigurationTemplateRequest) (*GatewayConfigurationTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedGatewayConfigurationTemplateRegistryServer) ListTemplates(context.Context, *ListGatewayConfigurationTemplatesRequest) (*GatewayConfigurationTemplates, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
+}
+func (UnimplementedGatewayConfigurationTemplateRegistryServer) DeleteTemplate(context.Context, *DeleteGatewayConfigurationTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedGatewayConfigurationTemplateRegistryServer) DiffTemplate(context.Context, *DiffGatewayConfigurationTemplateRequest) (*DiffGatewayConfigurationTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffTemplate not implemented")
+}
+func (UnimplementedGatewayConfigurationTemplateRegistryServer) SetAssignment(context.Context, *SetGatewayConfigurationTemplateAssignmentRequest) (*GatewayConfigurationTemplateAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAssignment not implemented")
+}
+func (UnimplementedGatewayConfigurationTemplateRegistryServer) GetAssignment(context.Context, *GetGatewayConfigurationTemplateAssignmentRequest) (*GatewayConfigurationTemplateAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignment not implemented")
+}
+func (UnimplementedGatewayConfigurationTemplateRegistryServer) DeleteAssignment(context.Context, *GetGatewayConfigurationTemplateAssignmentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssignment not implemented")
+}
+func (UnimplementedGatewayConfigurationTemplateRegistryServer) mustEmbedUnimplementedGatewayConfigurationTemplateRegistryServer() {
+}
+
+// UnsafeGatewayConfigurationTemplateRegistryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GatewayConfigurationTemplateRegistryServer will
+// result in compilation errors.
+type UnsafeGatewayConfigurationTemplateRegistryServer interface {
+	mustEmbedUnimplementedGatewayConfigurationTemplateRegistryServer()
+}
+
+func RegisterGatewayConfigurationTemplateRegistryServer(s grpc.ServiceRegistrar, srv GatewayConfigurationTemplateRegistryServer) {
+	s.RegisterService(&GatewayConfigurationTemplateRegistry_ServiceDesc, srv)
+}
+
+func _GatewayConfigurationTemplateRegistry_SetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGatewayConfigurationTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayConfigurationTemplateRegistryServer).SetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayConfigurationTemplateRegistry_SetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayConfigurationTemplateRegistryServer).SetTemplate(ctx, req.(*SetGatewayConfigurationTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayConfigurationTemplateRegistry_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayConfigurationTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayConfigurationTemplateRegistryServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayConfigurationTemplateRegistry_GetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayConfigurationTemplateRegistryServer).GetTemplate(ctx, req.(*GetGatewayConfigurationTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayConfigurationTemplateRegistry_ListTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGatewayConfigurationTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayConfigurationTemplateRegistryServer).ListTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayConfigurationTemplateRegistry_ListTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayConfigurationTemplateRegistryServer).ListTemplates(ctx, req.(*ListGatewayConfigurationTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayConfigurationTemplateRegistry_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGatewayConfigurationTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayConfigurationTemplateRegistryServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayConfigurationTemplateRegistry_DeleteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayConfigurationTemplateRegistryServer).DeleteTemplate(ctx, req.(*DeleteGatewayConfigurationTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayConfigurationTemplateRegistry_DiffTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffGatewayConfigurationTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayConfigurationTemplateRegistryServer).DiffTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayConfigurationTemplateRegistry_DiffTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayConfigurationTemplateRegistryServer).DiffTemplate(ctx, req.(*DiffGatewayConfigurationTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayConfigurationTemplateRegistry_SetAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGatewayConfigurationTemplateAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayConfigurationTemplateRegistryServer).SetAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayConfigurationTemplateRegistry_SetAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayConfigurationTemplateRegistryServer).SetAssignment(ctx, req.(*SetGatewayConfigurationTemplateAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayConfigurationTemplateRegistry_GetAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayConfigurationTemplateAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayConfigurationTemplateRegistryServer).GetAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayConfigurationTemplateRegistry_GetAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayConfigurationTemplateRegistryServer).GetAssignment(ctx, req.(*GetGatewayConfigurationTemplateAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayConfigurationTemplateRegistry_DeleteAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayConfigurationTemplateAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayConfigurationTemplateRegistryServer).DeleteAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayConfigurationTemplateRegistry_DeleteAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayConfigurationTemplateRegistryServer).DeleteAssignment(ctx, req.(*GetGatewayConfigurationTemplateAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// GatewayConfigurationTemplateRegistry_ServiceDesc is the grpc.ServiceDesc for GatewayConfigurationTemplateRegistry service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var GatewayConfigurationTemplateRegistry_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.GatewayConfigurationTemplateRegistry",
+	HandlerType: (*GatewayConfigurationTemplateRegistryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetTemplate",
+			Handler:    _GatewayConfigurationTemplateRegistry_SetTemplate_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _GatewayConfigurationTemplateRegistry_GetTemplate_Handler,
+		},
+		{
+			MethodName: "ListTemplates",
+			Handler:    _GatewayConfigurationTemplateRegistry_ListTemplates_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _GatewayConfigurationTemplateRegistry_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "DiffTemplate",
+			Handler:    _GatewayConfigurationTemplateRegistry_DiffTemplate_Handler,
+		},
+		{
+			MethodName: "SetAssignment",
+			Handler:    _GatewayConfigurationTemplateRegistry_SetAssignment_Handler,
+		},
+		{
+			MethodName: "GetAssignment",
+			Handler:    _GatewayConfigurationTemplateRegistry_GetAssignment_Handler,
+		},
+		{
+			MethodName: "DeleteAssignment",
+			Handler:    _GatewayConfigurationTemplateRegistry_DeleteAssignment_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/gateway_configuration.proto",
@@ -7,6 +7,7 @@
 package ttnpb
 
 import (
+	golang "github.com/TheThingsIndustries/protoc-gen-go-json/golang"
 	jsonplugin "github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
 )
 
@@ -38,6 +39,11 @@ func (x *GetGatewayConfigurationRequest) MarshalProtoJSON(s *jsonplugin.MarshalS
 		s.WriteObjectField("filename")
 		s.WriteString(x.Filename)
 	}
+	if x.Model != "" || s.HasField("model") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("model")
+		s.WriteString(x.Model)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -71,6 +77,9 @@ func (x *GetGatewayConfigurationRequest) UnmarshalProtoJSON(s *jsonplugin.Unmars
 		case "filename":
 			s.AddField("filename")
 			x.Filename = s.ReadString()
+		case "model":
+			s.AddField("model")
+			x.Model = s.ReadString()
 		}
 	})
 }
@@ -79,3 +88,471 @@ func (x *GetGatewayConfigurationRequest) UnmarshalProtoJSON(s *jsonplugin.Unmars
 func (x *GetGatewayConfigurationRequest) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the DiffGatewayConfigurationTemplateRequest message to JSON.
+func (x *DiffGatewayConfigurationTemplateRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.OwnerIds != nil || s.HasField("owner_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("owner_ids")
+		// NOTE: OrganizationOrUserIdentifiers does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.OwnerIds)
+	}
+	if x.TemplateId != "" || s.HasField("template_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("template_id")
+		s.WriteString(x.TemplateId)
+	}
+	if x.FromVersion != 0 || s.HasField("from_version") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("from_version")
+		s.WriteUint32(x.FromVersion)
+	}
+	if x.ToVersion != 0 || s.HasField("to_version") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("to_version")
+		s.WriteUint32(x.ToVersion)
+	}
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the DiffGatewayConfigurationTemplateRequest to JSON.
+func (x *DiffGatewayConfigurationTemplateRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the DiffGatewayConfigurationTemplateRequest message from JSON.
+func (x *DiffGatewayConfigurationTemplateRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "owner_ids", "ownerIds":
+			s.AddField("owner_ids")
+			if s.ReadNil() {
+				x.OwnerIds = nil
+				return
+			}
+			// NOTE: OrganizationOrUserIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v OrganizationOrUserIdentifiers
+			golang.UnmarshalMessage(s, &v)
+			x.OwnerIds = &v
+		case "template_id", "templateId":
+			s.AddField("template_id")
+			x.TemplateId = s.ReadString()
+		case "from_version", "fromVersion":
+			s.AddField("from_version")
+			x.FromVersion = s.ReadUint32()
+		case "to_version", "toVersion":
+			s.AddField("to_version")
+			x.ToVersion = s.ReadUint32()
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the DiffGatewayConfigurationTemplateRequest from JSON.
+func (x *DiffGatewayConfigurationTemplateRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayConfigurationTemplateAssignment message to JSON.
+func (x *GatewayConfigurationTemplateAssignment) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.TemplateOwnerIds != nil || s.HasField("template_owner_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("template_owner_ids")
+		// NOTE: OrganizationOrUserIdentifiers does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.TemplateOwnerIds)
+	}
+	if x.TemplateId != "" || s.HasField("template_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("template_id")
+		s.WriteString(x.TemplateId)
+	}
+	if x.TemplateVersion != 0 || s.HasField("template_version") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("template_version")
+		s.WriteUint32(x.TemplateVersion)
+	}
+	if x.Variables != nil || s.HasField("variables") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("variables")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.Variables {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			s.WriteString(v)
+		}
+		s.WriteObjectEnd()
+	}
+	if len(x.Overrides) > 0 || s.HasField("overrides") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("overrides")
+		s.WriteBytes(x.Overrides)
+	}
+	if x.UpdatedAt != nil || s.HasField("updated_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("updated_at")
+		if x.UpdatedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.UpdatedAt)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayConfigurationTemplateAssignment to JSON.
+func (x *GatewayConfigurationTemplateAssignment) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayConfigurationTemplateAssignment message from JSON.
+func (x *GatewayConfigurationTemplateAssignment) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "template_owner_ids", "templateOwnerIds":
+			s.AddField("template_owner_ids")
+			if s.ReadNil() {
+				x.TemplateOwnerIds = nil
+				return
+			}
+			// NOTE: OrganizationOrUserIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v OrganizationOrUserIdentifiers
+			golang.UnmarshalMessage(s, &v)
+			x.TemplateOwnerIds = &v
+		case "template_id", "templateId":
+			s.AddField("template_id")
+			x.TemplateId = s.ReadString()
+		case "template_version", "templateVersion":
+			s.AddField("template_version")
+			x.TemplateVersion = s.ReadUint32()
+		case "variables":
+			s.AddField("variables")
+			if s.ReadNil() {
+				x.Variables = nil
+				return
+			}
+			x.Variables = make(map[string]string)
+			s.ReadStringMap(func(key string) {
+				x.Variables[key] = s.ReadString()
+			})
+		case "overrides":
+			s.AddField("overrides")
+			x.Overrides = s.ReadBytes()
+		case "updated_at", "updatedAt":
+			s.AddField("updated_at")
+			if s.ReadNil() {
+				x.UpdatedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.UpdatedAt = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayConfigurationTemplateAssignment from JSON.
+func (x *GatewayConfigurationTemplateAssignment) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the SetGatewayConfigurationTemplateAssignmentRequest message to JSON.
+func (x *SetGatewayConfigurationTemplateAssignmentRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Assignment != nil || s.HasField("assignment") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("assignment")
+		x.Assignment.MarshalProtoJSON(s.WithField("assignment"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the SetGatewayConfigurationTemplateAssignmentRequest to JSON.
+func (x *SetGatewayConfigurationTemplateAssignmentRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the SetGatewayConfigurationTemplateAssignmentRequest message from JSON.
+func (x *SetGatewayConfigurationTemplateAssignmentRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "assignment":
+			if s.ReadNil() {
+				x.Assignment = nil
+				return
+			}
+			x.Assignment = &GatewayConfigurationTemplateAssignment{}
+			x.Assignment.UnmarshalProtoJSON(s.WithField("assignment", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the SetGatewayConfigurationTemplateAssignmentRequest from JSON.
+func (x *SetGatewayConfigurationTemplateAssignmentRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GetGatewayConfigurationTemplateAssignmentRequest message to JSON.
+func (x *GetGatewayConfigurationTemplateAssignmentRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetGatewayConfigurationTemplateAssignmentRequest to JSON.
+func (x *GetGatewayConfigurationTemplateAssignmentRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetGatewayConfigurationTemplateAssignmentRequest message from JSON.
+func (x *GetGatewayConfigurationTemplateAssignmentRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GetGatewayConfigurationTemplateAssignmentRequest from JSON.
+func (x *GetGatewayConfigurationTemplateAssignmentRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the PreviewGatewayConfigurationRequest message to JSON.
+func (x *PreviewGatewayConfigurationRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.TemplateId != "" || s.HasField("template_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("template_id")
+		s.WriteString(x.TemplateId)
+	}
+	if x.TemplateOwnerIds != nil || s.HasField("template_owner_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("template_owner_ids")
+		// NOTE: OrganizationOrUserIdentifiers does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.TemplateOwnerIds)
+	}
+	if x.TemplateVersion != 0 || s.HasField("template_version") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("template_version")
+		s.WriteUint32(x.TemplateVersion)
+	}
+	if x.Variables != nil || s.HasField("variables") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("variables")
+		s.WriteObjectStart()
+		var wroteElement bool
+		for k, v := range x.Variables {
+			s.WriteMoreIf(&wroteElement)
+			s.WriteObjectStringField(k)
+			s.WriteString(v)
+		}
+		s.WriteObjectEnd()
+	}
+	if len(x.Overrides) > 0 || s.HasField("overrides") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("overrides")
+		s.WriteBytes(x.Overrides)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the PreviewGatewayConfigurationRequest to JSON.
+func (x *PreviewGatewayConfigurationRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the PreviewGatewayConfigurationRequest message from JSON.
+func (x *PreviewGatewayConfigurationRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "template_id", "templateId":
+			s.AddField("template_id")
+			x.TemplateId = s.ReadString()
+		case "template_owner_ids", "templateOwnerIds":
+			s.AddField("template_owner_ids")
+			if s.ReadNil() {
+				x.TemplateOwnerIds = nil
+				return
+			}
+			// NOTE: OrganizationOrUserIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v OrganizationOrUserIdentifiers
+			golang.UnmarshalMessage(s, &v)
+			x.TemplateOwnerIds = &v
+		case "template_version", "templateVersion":
+			s.AddField("template_version")
+			x.TemplateVersion = s.ReadUint32()
+		case "variables":
+			s.AddField("variables")
+			if s.ReadNil() {
+				x.Variables = nil
+				return
+			}
+			x.Variables = make(map[string]string)
+			s.ReadStringMap(func(key string) {
+				x.Variables[key] = s.ReadString()
+			})
+		case "overrides":
+			s.AddField("overrides")
+			x.Overrides = s.ReadBytes()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the PreviewGatewayConfigurationRequest from JSON.
+func (x *PreviewGatewayConfigurationRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the PullRenderedGatewayConfigurationRequest message to JSON.
+func (x *PullRenderedGatewayConfigurationRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.Revision != "" || s.HasField("revision") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("revision")
+		s.WriteString(x.Revision)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the PullRenderedGatewayConfigurationRequest to JSON.
+func (x *PullRenderedGatewayConfigurationRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the PullRenderedGatewayConfigurationRequest message from JSON.
+func (x *PullRenderedGatewayConfigurationRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "revision":
+			s.AddField("revision")
+			x.Revision = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the PullRenderedGatewayConfigurationRequest from JSON.
+func (x *PullRenderedGatewayConfigurationRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -10,6 +10,7 @@ import (
 	flagsplugin "github.com/TheThingsIndustries/protoc-gen-go-flags/flagsplugin"
 	golang "github.com/TheThingsIndustries/protoc-gen-go-flags/golang"
 	pflag "github.com/spf13/pflag"
+	customflags "go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/customflags"
 )
 
 // AddSelectFlagsForGatewayVersionIdentifiers adds flags to select fields in GatewayVersionIdentifiers.
@@ -217,6 +218,9 @@ func AddSelectFlagsForGateway(flags *pflag.FlagSet, prefix string, hidden bool)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("lrfhss", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("lrfhss", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForGateway_LRFHSS(flags, flagsplugin.Prefix("lrfhss", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("disable-packet-broker-forwarding", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("disable-packet-broker-forwarding", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("client-certificate-fingerprint", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("client-certificate-fingerprint", prefix), false), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("udp-pre-shared-key", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("udp-pre-shared-key", prefix), true), flagsplugin.WithHidden(hidden)))
+	AddSelectFlagsForSecret(flags, flagsplugin.Prefix("udp-pre-shared-key", prefix), hidden)
 }
 
 // SelectFromFlags outputs the fieldmask paths forGateway message from select flags.
@@ -396,6 +400,21 @@ func PathsFromSelectFlagsForGateway(flags *pflag.FlagSet, prefix string) (paths
 	} else if selected && val {
 		paths = append(paths, flagsplugin.Prefix("disable_packet_broker_forwarding", prefix))
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("client_certificate_fingerprint", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("client_certificate_fingerprint", prefix))
+	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("udp_pre_shared_key", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("udp_pre_shared_key", prefix))
+	}
+	if selectPaths, err := PathsFromSelectFlagsForSecret(flags, flagsplugin.Prefix("udp_pre_shared_key", prefix)); err != nil {
+		return nil, err
+	} else {
+		paths = append(paths, selectPaths...)
+	}
 	return paths, nil
 }
 
@@ -429,6 +448,8 @@ func AddSetFlagsForGateway(flags *pflag.FlagSet, prefix string, hidden bool) {
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("require-authenticated-connection", prefix), "", flagsplugin.WithHidden(hidden)))
 	AddSetFlagsForGateway_LRFHSS(flags, flagsplugin.Prefix("lrfhss", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("disable-packet-broker-forwarding", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(customflags.New32BytesFlag(flagsplugin.Prefix("client-certificate-fingerprint", prefix), "", flagsplugin.WithHidden(hidden)))
+	AddSetFlagsForSecret(flags, flagsplugin.Prefix("udp-pre-shared-key", prefix), hidden)
 }
 
 // SetFromFlags sets the Gateway message from flags.
@@ -627,6 +648,22 @@ func (m *Gateway) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []str
 		m.DisablePacketBrokerForwarding = val
 		paths = append(paths, flagsplugin.Prefix("disable_packet_broker_forwarding", prefix))
 	}
+	if val, changed, err := customflags.GetExactBytes(flags, flagsplugin.Prefix("client_certificate_fingerprint", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.ClientCertificateFingerprint = val
+		paths = append(paths, flagsplugin.Prefix("client_certificate_fingerprint", prefix))
+	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("udp_pre_shared_key", prefix)); changed {
+		if m.UdpPreSharedKey == nil {
+			m.UdpPreSharedKey = &Secret{}
+		}
+		if setPaths, err := m.UdpPreSharedKey.SetFromFlags(flags, flagsplugin.Prefix("udp_pre_shared_key", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
 	return paths, nil
 }
 
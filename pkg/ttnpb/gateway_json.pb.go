@@ -262,6 +262,17 @@ func (x *Gateway) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		s.WriteObjectField("disable_packet_broker_forwarding")
 		s.WriteBool(x.DisablePacketBrokerForwarding)
 	}
+	if len(x.ClientCertificateFingerprint) > 0 || s.HasField("client_certificate_fingerprint") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("client_certificate_fingerprint")
+		types.MarshalHEXBytes(s.WithField("client_certificate_fingerprint"), x.ClientCertificateFingerprint)
+	}
+	if x.UdpPreSharedKey != nil || s.HasField("udp_pre_shared_key") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("udp_pre_shared_key")
+		// NOTE: Secret does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.UdpPreSharedKey)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -498,6 +509,19 @@ func (x *Gateway) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 		case "disable_packet_broker_forwarding", "disablePacketBrokerForwarding":
 			s.AddField("disable_packet_broker_forwarding")
 			x.DisablePacketBrokerForwarding = s.ReadBool()
+		case "client_certificate_fingerprint", "clientCertificateFingerprint":
+			s.AddField("client_certificate_fingerprint")
+			x.ClientCertificateFingerprint = types.Unmarshal32Bytes(s.WithField("client_certificate_fingerprint", false))
+		case "udp_pre_shared_key", "udpPreSharedKey":
+			s.AddField("udp_pre_shared_key")
+			if s.ReadNil() {
+				x.UdpPreSharedKey = nil
+				return
+			}
+			// NOTE: Secret does not seem to implement UnmarshalProtoJSON.
+			var v Secret
+			golang.UnmarshalMessage(s, &v)
+			x.UdpPreSharedKey = &v
 		}
 	})
 }
@@ -297,3 +297,480 @@ var BatchGetGatewayConnectionStatsResponseFieldPathsNested = []string{
 var BatchGetGatewayConnectionStatsResponseFieldPathsTopLevel = []string{
 	"entries",
 }
+var GatewayConnectionStatsHistoryPointFieldPathsNested = []string{
+	"downlink_count",
+	"duration",
+	"round_trip_time_percentiles",
+	"round_trip_time_percentiles.p90",
+	"round_trip_time_percentiles.p95",
+	"round_trip_time_percentiles.p99",
+	"round_trip_times",
+	"round_trip_times.count",
+	"round_trip_times.max",
+	"round_trip_times.median",
+	"round_trip_times.min",
+	"status_metrics",
+	"sub_bands",
+	"time",
+	"tx_acknowledgment_count",
+	"uplink_count",
+}
+
+var GatewayConnectionStatsHistoryPointFieldPathsTopLevel = []string{
+	"downlink_count",
+	"duration",
+	"round_trip_time_percentiles",
+	"round_trip_times",
+	"status_metrics",
+	"sub_bands",
+	"time",
+	"tx_acknowledgment_count",
+	"uplink_count",
+}
+var GetGatewayConnectionStatsHistoryRequestFieldPathsNested = []string{
+	"from",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"step",
+	"to",
+}
+
+var GetGatewayConnectionStatsHistoryRequestFieldPathsTopLevel = []string{
+	"from",
+	"gateway_ids",
+	"step",
+	"to",
+}
+var GatewayConnectionStatsHistoryFieldPathsNested = []string{
+	"points",
+}
+
+var GatewayConnectionStatsHistoryFieldPathsTopLevel = []string{
+	"points",
+}
+var RunGatewayCommandRequestFieldPathsNested = []string{
+	"arguments",
+	"command",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var RunGatewayCommandRequestFieldPathsTopLevel = []string{
+	"arguments",
+	"command",
+	"gateway_ids",
+}
+var GatewayRemoteShellStartFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"term",
+}
+
+var GatewayRemoteShellStartFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"term",
+}
+var GatewayRemoteShellRequestFieldPathsNested = []string{
+	"message",
+	"message.data",
+	"message.start",
+	"message.start.gateway_ids",
+	"message.start.gateway_ids.eui",
+	"message.start.gateway_ids.gateway_id",
+	"message.start.term",
+}
+
+var GatewayRemoteShellRequestFieldPathsTopLevel = []string{
+	"message",
+}
+var GatewayRemoteShellResponseFieldPathsNested = []string{
+	"data",
+}
+
+var GatewayRemoteShellResponseFieldPathsTopLevel = []string{
+	"data",
+}
+var ScheduleProprietaryDownlinkRequestFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"raw_payload",
+	"request",
+	"request.absolute_time",
+	"request.advanced",
+	"request.class",
+	"request.downlink_paths",
+	"request.frequency_plan_id",
+	"request.priority",
+	"request.rx1_data_rate",
+	"request.rx1_data_rate.modulation",
+	"request.rx1_data_rate.modulation.fsk",
+	"request.rx1_data_rate.modulation.fsk.bit_rate",
+	"request.rx1_data_rate.modulation.lora",
+	"request.rx1_data_rate.modulation.lora.bandwidth",
+	"request.rx1_data_rate.modulation.lora.coding_rate",
+	"request.rx1_data_rate.modulation.lora.spreading_factor",
+	"request.rx1_data_rate.modulation.lrfhss",
+	"request.rx1_data_rate.modulation.lrfhss.coding_rate",
+	"request.rx1_data_rate.modulation.lrfhss.modulation_type",
+	"request.rx1_data_rate.modulation.lrfhss.operating_channel_width",
+	"request.rx1_delay",
+	"request.rx1_frequency",
+	"request.rx2_data_rate",
+	"request.rx2_data_rate.modulation",
+	"request.rx2_data_rate.modulation.fsk",
+	"request.rx2_data_rate.modulation.fsk.bit_rate",
+	"request.rx2_data_rate.modulation.lora",
+	"request.rx2_data_rate.modulation.lora.bandwidth",
+	"request.rx2_data_rate.modulation.lora.coding_rate",
+	"request.rx2_data_rate.modulation.lora.spreading_factor",
+	"request.rx2_data_rate.modulation.lrfhss",
+	"request.rx2_data_rate.modulation.lrfhss.coding_rate",
+	"request.rx2_data_rate.modulation.lrfhss.modulation_type",
+	"request.rx2_data_rate.modulation.lrfhss.operating_channel_width",
+	"request.rx2_frequency",
+}
+
+var ScheduleProprietaryDownlinkRequestFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"raw_payload",
+	"request",
+}
+var CaptureGatewayTrafficRequestFieldPathsNested = []string{
+	"duration",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var CaptureGatewayTrafficRequestFieldPathsTopLevel = []string{
+	"duration",
+	"gateway_ids",
+}
+var GatewayTrafficCaptureFieldPathsNested = []string{
+	"message",
+	"message.downlink_message",
+	"message.downlink_message.correlation_ids",
+	"message.downlink_message.end_device_ids",
+	"message.downlink_message.end_device_ids.application_ids",
+	"message.downlink_message.end_device_ids.application_ids.application_id",
+	"message.downlink_message.end_device_ids.dev_addr",
+	"message.downlink_message.end_device_ids.dev_eui",
+	"message.downlink_message.end_device_ids.device_id",
+	"message.downlink_message.end_device_ids.join_eui",
+	"message.downlink_message.payload",
+	"message.downlink_message.payload.Payload",
+	"message.downlink_message.payload.Payload.join_accept_payload",
+	"message.downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"message.downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"message.downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"message.downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"message.downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"message.downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"message.downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"message.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"message.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"message.downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"message.downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"message.downlink_message.payload.Payload.join_accept_payload.net_id",
+	"message.downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"message.downlink_message.payload.Payload.join_request_payload",
+	"message.downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"message.downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"message.downlink_message.payload.Payload.join_request_payload.join_eui",
+	"message.downlink_message.payload.Payload.mac_payload",
+	"message.downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"message.downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"message.downlink_message.payload.Payload.mac_payload.f_port",
+	"message.downlink_message.payload.Payload.mac_payload.frm_payload",
+	"message.downlink_message.payload.Payload.mac_payload.full_f_cnt",
+	"message.downlink_message.payload.Payload.rejoin_request_payload",
+	"message.downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"message.downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"message.downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"message.downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"message.downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"message.downlink_message.payload.m_hdr",
+	"message.downlink_message.payload.m_hdr.m_type",
+	"message.downlink_message.payload.m_hdr.major",
+	"message.downlink_message.payload.mic",
+	"message.downlink_message.raw_payload",
+	"message.downlink_message.session_key_id",
+	"message.downlink_message.settings",
+	"message.downlink_message.settings.request",
+	"message.downlink_message.settings.request.absolute_time",
+	"message.downlink_message.settings.request.advanced",
+	"message.downlink_message.settings.request.class",
+	"message.downlink_message.settings.request.downlink_paths",
+	"message.downlink_message.settings.request.frequency_plan_id",
+	"message.downlink_message.settings.request.priority",
+	"message.downlink_message.settings.request.rx1_data_rate",
+	"message.downlink_message.settings.request.rx1_data_rate.modulation",
+	"message.downlink_message.settings.request.rx1_data_rate.modulation.fsk",
+	"message.downlink_message.settings.request.rx1_data_rate.modulation.fsk.bit_rate",
+	"message.downlink_message.settings.request.rx1_data_rate.modulation.lora",
+	"message.downlink_message.settings.request.rx1_data_rate.modulation.lora.bandwidth",
+	"message.downlink_message.settings.request.rx1_data_rate.modulation.lora.coding_rate",
+	"message.downlink_message.settings.request.rx1_data_rate.modulation.lora.spreading_factor",
+	"message.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss",
+	"message.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss.coding_rate",
+	"message.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss.modulation_type",
+	"message.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss.operating_channel_width",
+	"message.downlink_message.settings.request.rx1_delay",
+	"message.downlink_message.settings.request.rx1_frequency",
+	"message.downlink_message.settings.request.rx2_data_rate",
+	"message.downlink_message.settings.request.rx2_data_rate.modulation",
+	"message.downlink_message.settings.request.rx2_data_rate.modulation.fsk",
+	"message.downlink_message.settings.request.rx2_data_rate.modulation.fsk.bit_rate",
+	"message.downlink_message.settings.request.rx2_data_rate.modulation.lora",
+	"message.downlink_message.settings.request.rx2_data_rate.modulation.lora.bandwidth",
+	"message.downlink_message.settings.request.rx2_data_rate.modulation.lora.coding_rate",
+	"message.downlink_message.settings.request.rx2_data_rate.modulation.lora.spreading_factor",
+	"message.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss",
+	"message.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss.coding_rate",
+	"message.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss.modulation_type",
+	"message.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss.operating_channel_width",
+	"message.downlink_message.settings.request.rx2_frequency",
+	"message.downlink_message.settings.scheduled",
+	"message.downlink_message.settings.scheduled.concentrator_timestamp",
+	"message.downlink_message.settings.scheduled.data_rate",
+	"message.downlink_message.settings.scheduled.data_rate.modulation",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.lora.coding_rate",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.lrfhss",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.lrfhss.coding_rate",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.lrfhss.modulation_type",
+	"message.downlink_message.settings.scheduled.data_rate.modulation.lrfhss.operating_channel_width",
+	"message.downlink_message.settings.scheduled.downlink",
+	"message.downlink_message.settings.scheduled.downlink.antenna_index",
+	"message.downlink_message.settings.scheduled.downlink.invert_polarization",
+	"message.downlink_message.settings.scheduled.downlink.tx_power",
+	"message.downlink_message.settings.scheduled.enable_crc",
+	"message.downlink_message.settings.scheduled.frequency",
+	"message.downlink_message.settings.scheduled.time",
+	"message.downlink_message.settings.scheduled.timestamp",
+	"message.tx_acknowledgment",
+	"message.tx_acknowledgment.correlation_ids",
+	"message.tx_acknowledgment.downlink_message",
+	"message.tx_acknowledgment.downlink_message.correlation_ids",
+	"message.tx_acknowledgment.downlink_message.end_device_ids",
+	"message.tx_acknowledgment.downlink_message.end_device_ids.application_ids",
+	"message.tx_acknowledgment.downlink_message.end_device_ids.application_ids.application_id",
+	"message.tx_acknowledgment.downlink_message.end_device_ids.dev_addr",
+	"message.tx_acknowledgment.downlink_message.end_device_ids.dev_eui",
+	"message.tx_acknowledgment.downlink_message.end_device_ids.device_id",
+	"message.tx_acknowledgment.downlink_message.end_device_ids.join_eui",
+	"message.tx_acknowledgment.downlink_message.payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dev_addr",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.encrypted",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.join_nonce",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.net_id",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_accept_payload.rx_delay",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_request_payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.dev_eui",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.dev_nonce",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.join_request_payload.join_eui",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.decoded_payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.f_port",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.frm_payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.mac_payload.full_f_cnt",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.net_id",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"message.tx_acknowledgment.downlink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"message.tx_acknowledgment.downlink_message.payload.m_hdr",
+	"message.tx_acknowledgment.downlink_message.payload.m_hdr.m_type",
+	"message.tx_acknowledgment.downlink_message.payload.m_hdr.major",
+	"message.tx_acknowledgment.downlink_message.payload.mic",
+	"message.tx_acknowledgment.downlink_message.raw_payload",
+	"message.tx_acknowledgment.downlink_message.session_key_id",
+	"message.tx_acknowledgment.downlink_message.settings",
+	"message.tx_acknowledgment.downlink_message.settings.request",
+	"message.tx_acknowledgment.downlink_message.settings.request.absolute_time",
+	"message.tx_acknowledgment.downlink_message.settings.request.advanced",
+	"message.tx_acknowledgment.downlink_message.settings.request.class",
+	"message.tx_acknowledgment.downlink_message.settings.request.downlink_paths",
+	"message.tx_acknowledgment.downlink_message.settings.request.frequency_plan_id",
+	"message.tx_acknowledgment.downlink_message.settings.request.priority",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.fsk",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.fsk.bit_rate",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lora",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lora.bandwidth",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lora.coding_rate",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lora.spreading_factor",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss.coding_rate",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss.modulation_type",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_data_rate.modulation.lrfhss.operating_channel_width",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_delay",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx1_frequency",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.fsk",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.fsk.bit_rate",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lora",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lora.bandwidth",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lora.coding_rate",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lora.spreading_factor",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss.coding_rate",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss.modulation_type",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_data_rate.modulation.lrfhss.operating_channel_width",
+	"message.tx_acknowledgment.downlink_message.settings.request.rx2_frequency",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.concentrator_timestamp",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.fsk",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.fsk.bit_rate",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.bandwidth",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.coding_rate",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lora.spreading_factor",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lrfhss",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lrfhss.coding_rate",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lrfhss.modulation_type",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.data_rate.modulation.lrfhss.operating_channel_width",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.downlink",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.downlink.antenna_index",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.downlink.invert_polarization",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.downlink.tx_power",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.enable_crc",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.frequency",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.time",
+	"message.tx_acknowledgment.downlink_message.settings.scheduled.timestamp",
+	"message.tx_acknowledgment.result",
+	"message.uplink_message",
+	"message.uplink_message.consumed_airtime",
+	"message.uplink_message.correlation_ids",
+	"message.uplink_message.crc_status",
+	"message.uplink_message.device_channel_index",
+	"message.uplink_message.payload",
+	"message.uplink_message.payload.Payload",
+	"message.uplink_message.payload.Payload.join_accept_payload",
+	"message.uplink_message.payload.Payload.join_accept_payload.cf_list",
+	"message.uplink_message.payload.Payload.join_accept_payload.cf_list.ch_masks",
+	"message.uplink_message.payload.Payload.join_accept_payload.cf_list.freq",
+	"message.uplink_message.payload.Payload.join_accept_payload.cf_list.type",
+	"message.uplink_message.payload.Payload.join_accept_payload.dev_addr",
+	"message.uplink_message.payload.Payload.join_accept_payload.dl_settings",
+	"message.uplink_message.payload.Payload.join_accept_payload.dl_settings.opt_neg",
+	"message.uplink_message.payload.Payload.join_accept_payload.dl_settings.rx1_dr_offset",
+	"message.uplink_message.payload.Payload.join_accept_payload.dl_settings.rx2_dr",
+	"message.uplink_message.payload.Payload.join_accept_payload.encrypted",
+	"message.uplink_message.payload.Payload.join_accept_payload.join_nonce",
+	"message.uplink_message.payload.Payload.join_accept_payload.net_id",
+	"message.uplink_message.payload.Payload.join_accept_payload.rx_delay",
+	"message.uplink_message.payload.Payload.join_request_payload",
+	"message.uplink_message.payload.Payload.join_request_payload.dev_eui",
+	"message.uplink_message.payload.Payload.join_request_payload.dev_nonce",
+	"message.uplink_message.payload.Payload.join_request_payload.join_eui",
+	"message.uplink_message.payload.Payload.mac_payload",
+	"message.uplink_message.payload.Payload.mac_payload.decoded_payload",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.dev_addr",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_cnt",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.ack",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.adr_ack_req",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.class_b",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_ctrl.f_pending",
+	"message.uplink_message.payload.Payload.mac_payload.f_hdr.f_opts",
+	"message.uplink_message.payload.Payload.mac_payload.f_port",
+	"message.uplink_message.payload.Payload.mac_payload.frm_payload",
+	"message.uplink_message.payload.Payload.mac_payload.full_f_cnt",
+	"message.uplink_message.payload.Payload.rejoin_request_payload",
+	"message.uplink_message.payload.Payload.rejoin_request_payload.dev_eui",
+	"message.uplink_message.payload.Payload.rejoin_request_payload.join_eui",
+	"message.uplink_message.payload.Payload.rejoin_request_payload.net_id",
+	"message.uplink_message.payload.Payload.rejoin_request_payload.rejoin_cnt",
+	"message.uplink_message.payload.Payload.rejoin_request_payload.rejoin_type",
+	"message.uplink_message.payload.m_hdr",
+	"message.uplink_message.payload.m_hdr.m_type",
+	"message.uplink_message.payload.m_hdr.major",
+	"message.uplink_message.payload.mic",
+	"message.uplink_message.raw_payload",
+	"message.uplink_message.received_at",
+	"message.uplink_message.rx_metadata",
+	"message.uplink_message.settings",
+	"message.uplink_message.settings.concentrator_timestamp",
+	"message.uplink_message.settings.data_rate",
+	"message.uplink_message.settings.data_rate.modulation",
+	"message.uplink_message.settings.data_rate.modulation.fsk",
+	"message.uplink_message.settings.data_rate.modulation.fsk.bit_rate",
+	"message.uplink_message.settings.data_rate.modulation.lora",
+	"message.uplink_message.settings.data_rate.modulation.lora.bandwidth",
+	"message.uplink_message.settings.data_rate.modulation.lora.coding_rate",
+	"message.uplink_message.settings.data_rate.modulation.lora.spreading_factor",
+	"message.uplink_message.settings.data_rate.modulation.lrfhss",
+	"message.uplink_message.settings.data_rate.modulation.lrfhss.coding_rate",
+	"message.uplink_message.settings.data_rate.modulation.lrfhss.modulation_type",
+	"message.uplink_message.settings.data_rate.modulation.lrfhss.operating_channel_width",
+	"message.uplink_message.settings.downlink",
+	"message.uplink_message.settings.downlink.antenna_index",
+	"message.uplink_message.settings.downlink.invert_polarization",
+	"message.uplink_message.settings.downlink.tx_power",
+	"message.uplink_message.settings.enable_crc",
+	"message.uplink_message.settings.frequency",
+	"message.uplink_message.settings.time",
+	"message.uplink_message.settings.timestamp",
+	"time",
+}
+
+var GatewayTrafficCaptureFieldPathsTopLevel = []string{
+	"message",
+	"time",
+}
+var GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesFieldPathsNested = []string{
+	"p90",
+	"p95",
+	"p99",
+}
+
+var GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesFieldPathsTopLevel = []string{
+	"p90",
+	"p95",
+	"p99",
+}
@@ -243,3 +243,724 @@ func (dst *BatchGetGatewayConnectionStatsResponse) SetFields(src *BatchGetGatewa
 	}
 	return nil
 }
+
+func (dst *GatewayConnectionStatsHistoryPoint) SetFields(src *GatewayConnectionStatsHistoryPoint, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				dst.Time = nil
+			}
+		case "duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Duration = src.Duration
+			} else {
+				dst.Duration = nil
+			}
+		case "uplink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'uplink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UplinkCount = src.UplinkCount
+			} else {
+				var zero uint64
+				dst.UplinkCount = zero
+			}
+		case "downlink_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'downlink_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DownlinkCount = src.DownlinkCount
+			} else {
+				var zero uint64
+				dst.DownlinkCount = zero
+			}
+		case "tx_acknowledgment_count":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_acknowledgment_count' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxAcknowledgmentCount = src.TxAcknowledgmentCount
+			} else {
+				var zero uint64
+				dst.TxAcknowledgmentCount = zero
+			}
+		case "round_trip_times":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayConnectionStats_RoundTripTimes
+				if (src == nil || src.RoundTripTimes == nil) && dst.RoundTripTimes == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.RoundTripTimes
+				}
+				if dst.RoundTripTimes != nil {
+					newDst = dst.RoundTripTimes
+				} else {
+					newDst = &GatewayConnectionStats_RoundTripTimes{}
+					dst.RoundTripTimes = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.RoundTripTimes = src.RoundTripTimes
+				} else {
+					dst.RoundTripTimes = nil
+				}
+			}
+		case "sub_bands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sub_bands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SubBands = src.SubBands
+			} else {
+				dst.SubBands = nil
+			}
+		case "status_metrics":
+			if len(subs) > 0 {
+				return fmt.Errorf("'status_metrics' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.StatusMetrics = src.StatusMetrics
+			} else {
+				dst.StatusMetrics = nil
+			}
+		case "round_trip_time_percentiles":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles
+				if (src == nil || src.RoundTripTimePercentiles == nil) && dst.RoundTripTimePercentiles == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.RoundTripTimePercentiles
+				}
+				if dst.RoundTripTimePercentiles != nil {
+					newDst = dst.RoundTripTimePercentiles
+				} else {
+					newDst = &GatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles{}
+					dst.RoundTripTimePercentiles = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.RoundTripTimePercentiles = src.RoundTripTimePercentiles
+				} else {
+					dst.RoundTripTimePercentiles = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetGatewayConnectionStatsHistoryRequest) SetFields(src *GetGatewayConnectionStatsHistoryRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "from":
+			if len(subs) > 0 {
+				return fmt.Errorf("'from' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.From = src.From
+			} else {
+				dst.From = nil
+			}
+		case "to":
+			if len(subs) > 0 {
+				return fmt.Errorf("'to' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.To = src.To
+			} else {
+				dst.To = nil
+			}
+		case "step":
+			if len(subs) > 0 {
+				return fmt.Errorf("'step' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Step = src.Step
+			} else {
+				dst.Step = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionStatsHistory) SetFields(src *GatewayConnectionStatsHistory, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "points":
+			if len(subs) > 0 {
+				return fmt.Errorf("'points' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Points = src.Points
+			} else {
+				dst.Points = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RunGatewayCommandRequest) SetFields(src *RunGatewayCommandRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "command":
+			if len(subs) > 0 {
+				return fmt.Errorf("'command' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Command = src.Command
+			} else {
+				var zero string
+				dst.Command = zero
+			}
+		case "arguments":
+			if len(subs) > 0 {
+				return fmt.Errorf("'arguments' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Arguments = src.Arguments
+			} else {
+				dst.Arguments = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayRemoteShellStart) SetFields(src *GatewayRemoteShellStart, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "term":
+			if len(subs) > 0 {
+				return fmt.Errorf("'term' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Term = src.Term
+			} else {
+				var zero string
+				dst.Term = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayRemoteShellRequest) SetFields(src *GatewayRemoteShellRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+
+		case "message":
+			if len(subs) == 0 && src == nil {
+				dst.Message = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Message = src.Message
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field '%s'", name)
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "start":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Message.(*GatewayRemoteShellRequest_Start)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Message == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'start', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Message.(*GatewayRemoteShellRequest_Start)
+					if dstValid := dstTypeOk || dst.Message == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'start', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *GatewayRemoteShellStart
+						if srcTypeOk {
+							newSrc = src.Message.(*GatewayRemoteShellRequest_Start).Start
+						}
+						if dstTypeOk {
+							newDst = dst.Message.(*GatewayRemoteShellRequest_Start).Start
+						} else if srcTypeOk {
+							newDst = &GatewayRemoteShellStart{}
+							dst.Message = &GatewayRemoteShellRequest_Start{Start: newDst}
+						} else {
+							dst.Message = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Message = src.Message
+						} else {
+							dst.Message = nil
+						}
+					}
+				case "data":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Message.(*GatewayRemoteShellRequest_Data)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Message == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'data', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Message.(*GatewayRemoteShellRequest_Data)
+					if dstValid := dstTypeOk || dst.Message == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'data', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						return fmt.Errorf("'data' has no subfields, but %s were specified", oneofSubs)
+					}
+					if srcTypeOk {
+						dst.Message = src.Message
+					} else {
+						dst.Message = nil
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayRemoteShellResponse) SetFields(src *GatewayRemoteShellResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "data":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Data = src.Data
+			} else {
+				dst.Data = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ScheduleProprietaryDownlinkRequest) SetFields(src *ScheduleProprietaryDownlinkRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "raw_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'raw_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RawPayload = src.RawPayload
+			} else {
+				dst.RawPayload = nil
+			}
+		case "request":
+			if len(subs) > 0 {
+				var newDst, newSrc *TxRequest
+				if (src == nil || src.Request == nil) && dst.Request == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Request
+				}
+				if dst.Request != nil {
+					newDst = dst.Request
+				} else {
+					newDst = &TxRequest{}
+					dst.Request = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Request = src.Request
+				} else {
+					dst.Request = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *CaptureGatewayTrafficRequest) SetFields(src *CaptureGatewayTrafficRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "duration":
+			if len(subs) > 0 {
+				return fmt.Errorf("'duration' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Duration = src.Duration
+			} else {
+				dst.Duration = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayTrafficCapture) SetFields(src *GatewayTrafficCapture, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				dst.Time = nil
+			}
+
+		case "message":
+			if len(subs) == 0 && src == nil {
+				dst.Message = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Message = src.Message
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field '%s'", name)
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "uplink_message":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Message.(*GatewayTrafficCapture_UplinkMessage)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Message == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'uplink_message', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Message.(*GatewayTrafficCapture_UplinkMessage)
+					if dstValid := dstTypeOk || dst.Message == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'uplink_message', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *UplinkMessage
+						if srcTypeOk {
+							newSrc = src.Message.(*GatewayTrafficCapture_UplinkMessage).UplinkMessage
+						}
+						if dstTypeOk {
+							newDst = dst.Message.(*GatewayTrafficCapture_UplinkMessage).UplinkMessage
+						} else if srcTypeOk {
+							newDst = &UplinkMessage{}
+							dst.Message = &GatewayTrafficCapture_UplinkMessage{UplinkMessage: newDst}
+						} else {
+							dst.Message = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Message = src.Message
+						} else {
+							dst.Message = nil
+						}
+					}
+				case "downlink_message":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Message.(*GatewayTrafficCapture_DownlinkMessage)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Message == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'downlink_message', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Message.(*GatewayTrafficCapture_DownlinkMessage)
+					if dstValid := dstTypeOk || dst.Message == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'downlink_message', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *DownlinkMessage
+						if srcTypeOk {
+							newSrc = src.Message.(*GatewayTrafficCapture_DownlinkMessage).DownlinkMessage
+						}
+						if dstTypeOk {
+							newDst = dst.Message.(*GatewayTrafficCapture_DownlinkMessage).DownlinkMessage
+						} else if srcTypeOk {
+							newDst = &DownlinkMessage{}
+							dst.Message = &GatewayTrafficCapture_DownlinkMessage{DownlinkMessage: newDst}
+						} else {
+							dst.Message = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Message = src.Message
+						} else {
+							dst.Message = nil
+						}
+					}
+				case "tx_acknowledgment":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Message.(*GatewayTrafficCapture_TxAcknowledgment)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Message == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'tx_acknowledgment', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Message.(*GatewayTrafficCapture_TxAcknowledgment)
+					if dstValid := dstTypeOk || dst.Message == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'tx_acknowledgment', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *TxAcknowledgment
+						if srcTypeOk {
+							newSrc = src.Message.(*GatewayTrafficCapture_TxAcknowledgment).TxAcknowledgment
+						}
+						if dstTypeOk {
+							newDst = dst.Message.(*GatewayTrafficCapture_TxAcknowledgment).TxAcknowledgment
+						} else if srcTypeOk {
+							newDst = &TxAcknowledgment{}
+							dst.Message = &GatewayTrafficCapture_TxAcknowledgment{TxAcknowledgment: newDst}
+						} else {
+							dst.Message = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Message = src.Message
+						} else {
+							dst.Message = nil
+						}
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles) SetFields(src *GatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "p90":
+			if len(subs) > 0 {
+				return fmt.Errorf("'p90' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.P90 = src.P90
+			} else {
+				dst.P90 = nil
+			}
+		case "p95":
+			if len(subs) > 0 {
+				return fmt.Errorf("'p95' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.P95 = src.P95
+			} else {
+				dst.P95 = nil
+			}
+		case "p99":
+			if len(subs) > 0 {
+				return fmt.Errorf("'p99' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.P99 = src.P99
+			} else {
+				dst.P99 = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
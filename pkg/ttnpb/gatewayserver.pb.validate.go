@@ -667,3 +667,1381 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = BatchGetGatewayConnectionStatsResponseValidationError{}
+
+// ValidateFields checks the field values on GatewayConnectionStatsHistoryPoint
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *GatewayConnectionStatsHistoryPoint) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionStatsHistoryPointFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "time":
+
+			if m.GetTime() == nil {
+				return GatewayConnectionStatsHistoryPointValidationError{
+					field:  "time",
+					reason: "value is required",
+				}
+			}
+
+		case "duration":
+
+			if m.GetDuration() == nil {
+				return GatewayConnectionStatsHistoryPointValidationError{
+					field:  "duration",
+					reason: "value is required",
+				}
+			}
+
+		case "uplink_count":
+			// no validation rules for UplinkCount
+		case "downlink_count":
+			// no validation rules for DownlinkCount
+		case "tx_acknowledgment_count":
+			// no validation rules for TxAcknowledgmentCount
+		case "round_trip_times":
+
+			if v, ok := interface{}(m.GetRoundTripTimes()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsHistoryPointValidationError{
+						field:  "round_trip_times",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "sub_bands":
+
+			for idx, item := range m.GetSubBands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConnectionStatsHistoryPointValidationError{
+							field:  fmt.Sprintf("sub_bands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "status_metrics":
+			// no validation rules for StatusMetrics
+		case "round_trip_time_percentiles":
+
+			if v, ok := interface{}(m.GetRoundTripTimePercentiles()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsHistoryPointValidationError{
+						field:  "round_trip_time_percentiles",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayConnectionStatsHistoryPointValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionStatsHistoryPointValidationError is the validation error
+// returned by GatewayConnectionStatsHistoryPoint.ValidateFields if the
+// designated constraints aren't met.
+type GatewayConnectionStatsHistoryPointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionStatsHistoryPointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionStatsHistoryPointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionStatsHistoryPointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionStatsHistoryPointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionStatsHistoryPointValidationError) ErrorName() string {
+	return "GatewayConnectionStatsHistoryPointValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionStatsHistoryPointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionStatsHistoryPoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionStatsHistoryPointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionStatsHistoryPointValidationError{}
+
+// ValidateFields checks the field values on
+// GetGatewayConnectionStatsHistoryRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *GetGatewayConnectionStatsHistoryRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetGatewayConnectionStatsHistoryRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return GetGatewayConnectionStatsHistoryRequestValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "from":
+
+			if m.GetFrom() == nil {
+				return GetGatewayConnectionStatsHistoryRequestValidationError{
+					field:  "from",
+					reason: "value is required",
+				}
+			}
+
+		case "to":
+
+			if v, ok := interface{}(m.GetTo()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "to",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "step":
+
+			if d := m.GetStep(); d != nil {
+				dur, err := d.AsDuration(), d.CheckValid()
+				if err != nil {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "step",
+						reason: "value is not a valid duration",
+						cause:  err,
+					}
+				}
+
+				lte := time.Duration(2592000*time.Second + 0*time.Nanosecond)
+				gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+				if dur < gte || dur > lte {
+					return GetGatewayConnectionStatsHistoryRequestValidationError{
+						field:  "step",
+						reason: "value must be inside range [0s, 720h0m0s]",
+					}
+				}
+
+			}
+
+		default:
+			return GetGatewayConnectionStatsHistoryRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetGatewayConnectionStatsHistoryRequestValidationError is the validation
+// error returned by GetGatewayConnectionStatsHistoryRequest.ValidateFields if
+// the designated constraints aren't met.
+type GetGatewayConnectionStatsHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) ErrorName() string {
+	return "GetGatewayConnectionStatsHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetGatewayConnectionStatsHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetGatewayConnectionStatsHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetGatewayConnectionStatsHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetGatewayConnectionStatsHistoryRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayConnectionStatsHistory with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayConnectionStatsHistory) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionStatsHistoryFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "points":
+
+			for idx, item := range m.GetPoints() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayConnectionStatsHistoryValidationError{
+							field:  fmt.Sprintf("points[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return GatewayConnectionStatsHistoryValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionStatsHistoryValidationError is the validation error
+// returned by GatewayConnectionStatsHistory.ValidateFields if the designated
+// constraints aren't met.
+type GatewayConnectionStatsHistoryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionStatsHistoryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionStatsHistoryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionStatsHistoryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionStatsHistoryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionStatsHistoryValidationError) ErrorName() string {
+	return "GatewayConnectionStatsHistoryValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionStatsHistoryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionStatsHistory.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionStatsHistoryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionStatsHistoryValidationError{}
+
+// ValidateFields checks the field values on RunGatewayCommandRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RunGatewayCommandRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RunGatewayCommandRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return RunGatewayCommandRequestValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RunGatewayCommandRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "command":
+
+			if l := utf8.RuneCountInString(m.GetCommand()); l < 1 || l > 256 {
+				return RunGatewayCommandRequestValidationError{
+					field:  "command",
+					reason: "value length must be between 1 and 256 runes, inclusive",
+				}
+			}
+
+		case "arguments":
+
+			if len(m.GetArguments()) > 32 {
+				return RunGatewayCommandRequestValidationError{
+					field:  "arguments",
+					reason: "value must contain no more than 32 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetArguments() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 256 {
+					return RunGatewayCommandRequestValidationError{
+						field:  fmt.Sprintf("arguments[%v]", idx),
+						reason: "value length must be at most 256 runes",
+					}
+				}
+
+			}
+
+		default:
+			return RunGatewayCommandRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RunGatewayCommandRequestValidationError is the validation error returned by
+// RunGatewayCommandRequest.ValidateFields if the designated constraints
+// aren't met.
+type RunGatewayCommandRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RunGatewayCommandRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RunGatewayCommandRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RunGatewayCommandRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RunGatewayCommandRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RunGatewayCommandRequestValidationError) ErrorName() string {
+	return "RunGatewayCommandRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RunGatewayCommandRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRunGatewayCommandRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RunGatewayCommandRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RunGatewayCommandRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayRemoteShellStart with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayRemoteShellStart) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayRemoteShellStartFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return GatewayRemoteShellStartValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayRemoteShellStartValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "term":
+
+			if utf8.RuneCountInString(m.GetTerm()) > 64 {
+				return GatewayRemoteShellStartValidationError{
+					field:  "term",
+					reason: "value length must be at most 64 runes",
+				}
+			}
+
+		default:
+			return GatewayRemoteShellStartValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayRemoteShellStartValidationError is the validation error returned by
+// GatewayRemoteShellStart.ValidateFields if the designated constraints aren't met.
+type GatewayRemoteShellStartValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayRemoteShellStartValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayRemoteShellStartValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayRemoteShellStartValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayRemoteShellStartValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayRemoteShellStartValidationError) ErrorName() string {
+	return "GatewayRemoteShellStartValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayRemoteShellStartValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayRemoteShellStart.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayRemoteShellStartValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayRemoteShellStartValidationError{}
+
+// ValidateFields checks the field values on GatewayRemoteShellRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayRemoteShellRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayRemoteShellRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "message":
+			if len(subs) == 0 {
+				subs = []string{
+					"start", "data",
+				}
+			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "start":
+					w, ok := m.Message.(*GatewayRemoteShellRequest_Start)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetStart()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return GatewayRemoteShellRequestValidationError{
+								field:  "start",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "data":
+					w, ok := m.Message.(*GatewayRemoteShellRequest_Data)
+					if !ok || w == nil {
+						continue
+					}
+
+					if len(m.GetData()) > 4096 {
+						return GatewayRemoteShellRequestValidationError{
+							field:  "data",
+							reason: "value length must be at most 4096 bytes",
+						}
+					}
+
+				}
+			}
+		default:
+			return GatewayRemoteShellRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayRemoteShellRequestValidationError is the validation error returned by
+// GatewayRemoteShellRequest.ValidateFields if the designated constraints
+// aren't met.
+type GatewayRemoteShellRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayRemoteShellRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayRemoteShellRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayRemoteShellRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayRemoteShellRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayRemoteShellRequestValidationError) ErrorName() string {
+	return "GatewayRemoteShellRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayRemoteShellRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayRemoteShellRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayRemoteShellRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayRemoteShellRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayRemoteShellResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayRemoteShellResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayRemoteShellResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "data":
+			// no validation rules for Data
+		default:
+			return GatewayRemoteShellResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayRemoteShellResponseValidationError is the validation error returned
+// by GatewayRemoteShellResponse.ValidateFields if the designated constraints
+// aren't met.
+type GatewayRemoteShellResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayRemoteShellResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayRemoteShellResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayRemoteShellResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayRemoteShellResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayRemoteShellResponseValidationError) ErrorName() string {
+	return "GatewayRemoteShellResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayRemoteShellResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayRemoteShellResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayRemoteShellResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayRemoteShellResponseValidationError{}
+
+// ValidateFields checks the field values on ScheduleProprietaryDownlinkRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ScheduleProprietaryDownlinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ScheduleProprietaryDownlinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return ScheduleProprietaryDownlinkRequestValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ScheduleProprietaryDownlinkRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "raw_payload":
+
+			if l := len(m.GetRawPayload()); l < 1 || l > 256 {
+				return ScheduleProprietaryDownlinkRequestValidationError{
+					field:  "raw_payload",
+					reason: "value length must be between 1 and 256 bytes, inclusive",
+				}
+			}
+
+		case "request":
+
+			if m.GetRequest() == nil {
+				return ScheduleProprietaryDownlinkRequestValidationError{
+					field:  "request",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetRequest()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ScheduleProprietaryDownlinkRequestValidationError{
+						field:  "request",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ScheduleProprietaryDownlinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ScheduleProprietaryDownlinkRequestValidationError is the validation error
+// returned by ScheduleProprietaryDownlinkRequest.ValidateFields if the
+// designated constraints aren't met.
+type ScheduleProprietaryDownlinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleProprietaryDownlinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleProprietaryDownlinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleProprietaryDownlinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleProprietaryDownlinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleProprietaryDownlinkRequestValidationError) ErrorName() string {
+	return "ScheduleProprietaryDownlinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleProprietaryDownlinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleProprietaryDownlinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ScheduleProprietaryDownlinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleProprietaryDownlinkRequestValidationError{}
+
+// ValidateFields checks the field values on CaptureGatewayTrafficRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *CaptureGatewayTrafficRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CaptureGatewayTrafficRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return CaptureGatewayTrafficRequestValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CaptureGatewayTrafficRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "duration":
+
+			if m.GetDuration() == nil {
+				return CaptureGatewayTrafficRequestValidationError{
+					field:  "duration",
+					reason: "value is required",
+				}
+			}
+
+			if d := m.GetDuration(); d != nil {
+				dur, err := d.AsDuration(), d.CheckValid()
+				if err != nil {
+					return CaptureGatewayTrafficRequestValidationError{
+						field:  "duration",
+						reason: "value is not a valid duration",
+						cause:  err,
+					}
+				}
+
+				lte := time.Duration(3600*time.Second + 0*time.Nanosecond)
+				gt := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+				if dur <= gt || dur > lte {
+					return CaptureGatewayTrafficRequestValidationError{
+						field:  "duration",
+						reason: "value must be inside range (0s, 1h0m0s]",
+					}
+				}
+
+			}
+
+		default:
+			return CaptureGatewayTrafficRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CaptureGatewayTrafficRequestValidationError is the validation error returned
+// by CaptureGatewayTrafficRequest.ValidateFields if the designated
+// constraints aren't met.
+type CaptureGatewayTrafficRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CaptureGatewayTrafficRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CaptureGatewayTrafficRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CaptureGatewayTrafficRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CaptureGatewayTrafficRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CaptureGatewayTrafficRequestValidationError) ErrorName() string {
+	return "CaptureGatewayTrafficRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CaptureGatewayTrafficRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCaptureGatewayTrafficRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CaptureGatewayTrafficRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CaptureGatewayTrafficRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayTrafficCapture with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayTrafficCapture) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayTrafficCaptureFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "time":
+
+			if m.GetTime() == nil {
+				return GatewayTrafficCaptureValidationError{
+					field:  "time",
+					reason: "value is required",
+				}
+			}
+
+		case "message":
+			if len(subs) == 0 {
+				subs = []string{
+					"uplink_message", "downlink_message", "tx_acknowledgment",
+				}
+			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "uplink_message":
+					w, ok := m.Message.(*GatewayTrafficCapture_UplinkMessage)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetUplinkMessage()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return GatewayTrafficCaptureValidationError{
+								field:  "uplink_message",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "downlink_message":
+					w, ok := m.Message.(*GatewayTrafficCapture_DownlinkMessage)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetDownlinkMessage()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return GatewayTrafficCaptureValidationError{
+								field:  "downlink_message",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "tx_acknowledgment":
+					w, ok := m.Message.(*GatewayTrafficCapture_TxAcknowledgment)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetTxAcknowledgment()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return GatewayTrafficCaptureValidationError{
+								field:  "tx_acknowledgment",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				}
+			}
+		default:
+			return GatewayTrafficCaptureValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayTrafficCaptureValidationError is the validation error returned by
+// GatewayTrafficCapture.ValidateFields if the designated constraints aren't met.
+type GatewayTrafficCaptureValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayTrafficCaptureValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayTrafficCaptureValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayTrafficCaptureValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayTrafficCaptureValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayTrafficCaptureValidationError) ErrorName() string {
+	return "GatewayTrafficCaptureValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayTrafficCaptureValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayTrafficCapture.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayTrafficCaptureValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayTrafficCaptureValidationError{}
+
+// ValidateFields checks the field values on
+// GatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "p90":
+
+			if v, ok := interface{}(m.GetP90()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError{
+						field:  "p90",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "p95":
+
+			if v, ok := interface{}(m.GetP95()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError{
+						field:  "p95",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "p99":
+
+			if v, ok := interface{}(m.GetP99()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError{
+						field:  "p99",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError
+// is the validation error returned by
+// GatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles.ValidateFields
+// if the designated constraints aren't met.
+type GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError) Key() bool {
+	return e.key
+}
+
+// ErrorName returns error name.
+func (e GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError) ErrorName() string {
+	return "GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionStatsHistoryPoint_RoundTripTimePercentiles.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionStatsHistoryPoint_RoundTripTimePercentilesValidationError{}
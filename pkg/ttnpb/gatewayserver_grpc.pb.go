@@ -372,8 +372,14 @@ var NsGs_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Gs_GetGatewayConnectionStats_FullMethodName      = "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats"
-	Gs_BatchGetGatewayConnectionStats_FullMethodName = "/ttn.lorawan.v3.Gs/BatchGetGatewayConnectionStats"
+	Gs_GetGatewayConnectionStats_FullMethodName        = "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats"
+	Gs_BatchGetGatewayConnectionStats_FullMethodName   = "/ttn.lorawan.v3.Gs/BatchGetGatewayConnectionStats"
+	Gs_GetGatewayConnectionStatsHistory_FullMethodName = "/ttn.lorawan.v3.Gs/GetGatewayConnectionStatsHistory"
+	Gs_RunGatewayCommand_FullMethodName                = "/ttn.lorawan.v3.Gs/RunGatewayCommand"
+	Gs_GatewayRemoteShell_FullMethodName               = "/ttn.lorawan.v3.Gs/GatewayRemoteShell"
+	Gs_StreamProprietaryUplinks_FullMethodName         = "/ttn.lorawan.v3.Gs/StreamProprietaryUplinks"
+	Gs_ScheduleProprietaryDownlink_FullMethodName      = "/ttn.lorawan.v3.Gs/ScheduleProprietaryDownlink"
+	Gs_CaptureGatewayTraffic_FullMethodName            = "/ttn.lorawan.v3.Gs/CaptureGatewayTraffic"
 )
 
 // GsClient is the client API for Gs service.
@@ -388,6 +394,28 @@ type GsClient interface {
 	// Gateways that are not connected or are part of a different cluster are ignored.
 	// It is up to the client to make sure that the gateways are in the requested cluster.
 	BatchGetGatewayConnectionStats(ctx context.Context, in *BatchGetGatewayConnectionStatsRequest, opts ...grpc.CallOption) (*BatchGetGatewayConnectionStatsResponse, error)
+	// Get the history of the statistics about the gateway connections to the Gateway Server.
+	// The history is recorded at a fixed interval and kept for a limited time.
+	GetGatewayConnectionStatsHistory(ctx context.Context, in *GetGatewayConnectionStatsHistoryRequest, opts ...grpc.CallOption) (*GatewayConnectionStatsHistory, error)
+	// Run a command on a gateway that is connected to the Gateway Server.
+	// The command is run asynchronously by the gateway, and its output is not returned.
+	// This is only supported by LoRa Basics Station gateways.
+	RunGatewayCommand(ctx context.Context, in *RunGatewayCommandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Open a remote shell session on a gateway that is connected to the Gateway Server.
+	// The first request message starts the session, subsequent request messages contain the input
+	// of the remote shell. The response messages contain the output of the remote shell.
+	// This is only supported by LoRa Basics Station gateways.
+	GatewayRemoteShell(ctx context.Context, opts ...grpc.CallOption) (Gs_GatewayRemoteShellClient, error)
+	// Stream the proprietary uplink messages received by a gateway that is connected to the Gateway Server.
+	// Proprietary uplink messages are uplink messages with the proprietary MType, which are not handled by the
+	// Network Server.
+	StreamProprietaryUplinks(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (Gs_StreamProprietaryUplinksClient, error)
+	// Schedule a proprietary downlink message on a gateway that is connected to the Gateway Server.
+	ScheduleProprietaryDownlink(ctx context.Context, in *ScheduleProprietaryDownlinkRequest, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error)
+	// Capture the raw traffic of a gateway that is connected to the Gateway Server.
+	// The stream contains the uplink messages, downlink messages and transmission acknowledgments
+	// of the gateway connection, and ends when the requested duration elapsed.
+	CaptureGatewayTraffic(ctx context.Context, in *CaptureGatewayTrafficRequest, opts ...grpc.CallOption) (Gs_CaptureGatewayTrafficClient, error)
 }
 
 type gsClient struct {
@@ -416,6 +444,128 @@ func (c *gsClient) BatchGetGatewayConnectionStats(ctx context.Context, in *Batch
 	return out, nil
 }
 
+func (c *gsClient) GetGatewayConnectionStatsHistory(ctx context.Context, in *GetGatewayConnectionStatsHistoryRequest, opts ...grpc.CallOption) (*GatewayConnectionStatsHistory, error) {
+	out := new(GatewayConnectionStatsHistory)
+	err := c.cc.Invoke(ctx, Gs_GetGatewayConnectionStatsHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) RunGatewayCommand(ctx context.Context, in *RunGatewayCommandRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Gs_RunGatewayCommand_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) GatewayRemoteShell(ctx context.Context, opts ...grpc.CallOption) (Gs_GatewayRemoteShellClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gs_ServiceDesc.Streams[0], Gs_GatewayRemoteShell_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gsGatewayRemoteShellClient{stream}
+	return x, nil
+}
+
+type Gs_GatewayRemoteShellClient interface {
+	Send(*GatewayRemoteShellRequest) error
+	Recv() (*GatewayRemoteShellResponse, error)
+	grpc.ClientStream
+}
+
+type gsGatewayRemoteShellClient struct {
+	grpc.ClientStream
+}
+
+func (x *gsGatewayRemoteShellClient) Send(m *GatewayRemoteShellRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gsGatewayRemoteShellClient) Recv() (*GatewayRemoteShellResponse, error) {
+	m := new(GatewayRemoteShellResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gsClient) StreamProprietaryUplinks(ctx context.Context, in *GatewayIdentifiers, opts ...grpc.CallOption) (Gs_StreamProprietaryUplinksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gs_ServiceDesc.Streams[1], Gs_StreamProprietaryUplinks_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gsStreamProprietaryUplinksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gs_StreamProprietaryUplinksClient interface {
+	Recv() (*GatewayUplinkMessage, error)
+	grpc.ClientStream
+}
+
+type gsStreamProprietaryUplinksClient struct {
+	grpc.ClientStream
+}
+
+func (x *gsStreamProprietaryUplinksClient) Recv() (*GatewayUplinkMessage, error) {
+	m := new(GatewayUplinkMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gsClient) ScheduleProprietaryDownlink(ctx context.Context, in *ScheduleProprietaryDownlinkRequest, opts ...grpc.CallOption) (*ScheduleDownlinkResponse, error) {
+	out := new(ScheduleDownlinkResponse)
+	err := c.cc.Invoke(ctx, Gs_ScheduleProprietaryDownlink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gsClient) CaptureGatewayTraffic(ctx context.Context, in *CaptureGatewayTrafficRequest, opts ...grpc.CallOption) (Gs_CaptureGatewayTrafficClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gs_ServiceDesc.Streams[2], Gs_CaptureGatewayTraffic_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gsCaptureGatewayTrafficClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gs_CaptureGatewayTrafficClient interface {
+	Recv() (*GatewayTrafficCapture, error)
+	grpc.ClientStream
+}
+
+type gsCaptureGatewayTrafficClient struct {
+	grpc.ClientStream
+}
+
+func (x *gsCaptureGatewayTrafficClient) Recv() (*GatewayTrafficCapture, error) {
+	m := new(GatewayTrafficCapture)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // GsServer is the server API for Gs service.
 // All implementations must embed UnimplementedGsServer
 // for forward compatibility
@@ -428,6 +578,28 @@ type GsServer interface {
 	// Gateways that are not connected or are part of a different cluster are ignored.
 	// It is up to the client to make sure that the gateways are in the requested cluster.
 	BatchGetGatewayConnectionStats(context.Context, *BatchGetGatewayConnectionStatsRequest) (*BatchGetGatewayConnectionStatsResponse, error)
+	// Get the history of the statistics about the gateway connections to the Gateway Server.
+	// The history is recorded at a fixed interval and kept for a limited time.
+	GetGatewayConnectionStatsHistory(context.Context, *GetGatewayConnectionStatsHistoryRequest) (*GatewayConnectionStatsHistory, error)
+	// Run a command on a gateway that is connected to the Gateway Server.
+	// The command is run asynchronously by the gateway, and its output is not returned.
+	// This is only supported by LoRa Basics Station gateways.
+	RunGatewayCommand(context.Context, *RunGatewayCommandRequest) (*emptypb.Empty, error)
+	// Open a remote shell session on a gateway that is connected to the Gateway Server.
+	// The first request message starts the session, subsequent request messages contain the input
+	// of the remote shell. The response messages contain the output of the remote shell.
+	// This is only supported by LoRa Basics Station gateways.
+	GatewayRemoteShell(Gs_GatewayRemoteShellServer) error
+	// Stream the proprietary uplink messages received by a gateway that is connected to the Gateway Server.
+	// Proprietary uplink messages are uplink messages with the proprietary MType, which are not handled by the
+	// Network Server.
+	StreamProprietaryUplinks(*GatewayIdentifiers, Gs_StreamProprietaryUplinksServer) error
+	// Schedule a proprietary downlink message on a gateway that is connected to the Gateway Server.
+	ScheduleProprietaryDownlink(context.Context, *ScheduleProprietaryDownlinkRequest) (*ScheduleDownlinkResponse, error)
+	// Capture the raw traffic of a gateway that is connected to the Gateway Server.
+	// The stream contains the uplink messages, downlink messages and transmission acknowledgments
+	// of the gateway connection, and ends when the requested duration elapsed.
+	CaptureGatewayTraffic(*CaptureGatewayTrafficRequest, Gs_CaptureGatewayTrafficServer) error
 	mustEmbedUnimplementedGsServer()
 }
 
@@ -441,6 +613,24 @@ func (UnimplementedGsServer) GetGatewayConnectionStats(context.Context, *Gateway
 func (UnimplementedGsServer) BatchGetGatewayConnectionStats(context.Context, *BatchGetGatewayConnectionStatsRequest) (*BatchGetGatewayConnectionStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchGetGatewayConnectionStats not implemented")
 }
+func (UnimplementedGsServer) GetGatewayConnectionStatsHistory(context.Context, *GetGatewayConnectionStatsHistoryRequest) (*GatewayConnectionStatsHistory, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGatewayConnectionStatsHistory not implemented")
+}
+func (UnimplementedGsServer) RunGatewayCommand(context.Context, *RunGatewayCommandRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunGatewayCommand not implemented")
+}
+func (UnimplementedGsServer) GatewayRemoteShell(Gs_GatewayRemoteShellServer) error {
+	return status.Errorf(codes.Unimplemented, "method GatewayRemoteShell not implemented")
+}
+func (UnimplementedGsServer) StreamProprietaryUplinks(*GatewayIdentifiers, Gs_StreamProprietaryUplinksServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamProprietaryUplinks not implemented")
+}
+func (UnimplementedGsServer) ScheduleProprietaryDownlink(context.Context, *ScheduleProprietaryDownlinkRequest) (*ScheduleDownlinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleProprietaryDownlink not implemented")
+}
+func (UnimplementedGsServer) CaptureGatewayTraffic(*CaptureGatewayTrafficRequest, Gs_CaptureGatewayTrafficServer) error {
+	return status.Errorf(codes.Unimplemented, "method CaptureGatewayTraffic not implemented")
+}
 func (UnimplementedGsServer) mustEmbedUnimplementedGsServer() {}
 
 // UnsafeGsServer may be embedded to opt out of forward compatibility for this service.
@@ -490,6 +680,128 @@ func _Gs_BatchGetGatewayConnectionStats_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gs_GetGatewayConnectionStatsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGatewayConnectionStatsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).GetGatewayConnectionStatsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gs_GetGatewayConnectionStatsHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).GetGatewayConnectionStatsHistory(ctx, req.(*GetGatewayConnectionStatsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_RunGatewayCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunGatewayCommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).RunGatewayCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gs_RunGatewayCommand_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).RunGatewayCommand(ctx, req.(*RunGatewayCommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_GatewayRemoteShell_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GsServer).GatewayRemoteShell(&gsGatewayRemoteShellServer{stream})
+}
+
+type Gs_GatewayRemoteShellServer interface {
+	Send(*GatewayRemoteShellResponse) error
+	Recv() (*GatewayRemoteShellRequest, error)
+	grpc.ServerStream
+}
+
+type gsGatewayRemoteShellServer struct {
+	grpc.ServerStream
+}
+
+func (x *gsGatewayRemoteShellServer) Send(m *GatewayRemoteShellResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gsGatewayRemoteShellServer) Recv() (*GatewayRemoteShellRequest, error) {
+	m := new(GatewayRemoteShellRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Gs_StreamProprietaryUplinks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GatewayIdentifiers)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GsServer).StreamProprietaryUplinks(m, &gsStreamProprietaryUplinksServer{stream})
+}
+
+type Gs_StreamProprietaryUplinksServer interface {
+	Send(*GatewayUplinkMessage) error
+	grpc.ServerStream
+}
+
+type gsStreamProprietaryUplinksServer struct {
+	grpc.ServerStream
+}
+
+func (x *gsStreamProprietaryUplinksServer) Send(m *GatewayUplinkMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Gs_ScheduleProprietaryDownlink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleProprietaryDownlinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsServer).ScheduleProprietaryDownlink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Gs_ScheduleProprietaryDownlink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsServer).ScheduleProprietaryDownlink(ctx, req.(*ScheduleProprietaryDownlinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gs_CaptureGatewayTraffic_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CaptureGatewayTrafficRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GsServer).CaptureGatewayTraffic(m, &gsCaptureGatewayTrafficServer{stream})
+}
+
+type Gs_CaptureGatewayTrafficServer interface {
+	Send(*GatewayTrafficCapture) error
+	grpc.ServerStream
+}
+
+type gsCaptureGatewayTrafficServer struct {
+	grpc.ServerStream
+}
+
+func (x *gsCaptureGatewayTrafficServer) Send(m *GatewayTrafficCapture) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Gs_ServiceDesc is the grpc.ServiceDesc for Gs service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -505,7 +817,36 @@ var Gs_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BatchGetGatewayConnectionStats",
 			Handler:    _Gs_BatchGetGatewayConnectionStats_Handler,
 		},
+		{
+			MethodName: "GetGatewayConnectionStatsHistory",
+			Handler:    _Gs_GetGatewayConnectionStatsHistory_Handler,
+		},
+		{
+			MethodName: "RunGatewayCommand",
+			Handler:    _Gs_RunGatewayCommand_Handler,
+		},
+		{
+			MethodName: "ScheduleProprietaryDownlink",
+			Handler:    _Gs_ScheduleProprietaryDownlink_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GatewayRemoteShell",
+			Handler:       _Gs_GatewayRemoteShell_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StreamProprietaryUplinks",
+			Handler:       _Gs_StreamProprietaryUplinks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "CaptureGatewayTraffic",
+			Handler:       _Gs_CaptureGatewayTraffic_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/gatewayserver.proto",
 }
@@ -308,3 +308,518 @@ func (x *BatchGetGatewayConnectionStatsRequest) UnmarshalProtoJSON(s *jsonplugin
 func (x *BatchGetGatewayConnectionStatsRequest) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the GetGatewayConnectionStatsHistoryRequest message to JSON.
+func (x *GetGatewayConnectionStatsHistoryRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.From != nil || s.HasField("from") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("from")
+		if x.From == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.From)
+		}
+	}
+	if x.To != nil || s.HasField("to") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("to")
+		if x.To == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.To)
+		}
+	}
+	if x.Step != nil || s.HasField("step") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("step")
+		if x.Step == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.Step)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GetGatewayConnectionStatsHistoryRequest to JSON.
+func (x *GetGatewayConnectionStatsHistoryRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GetGatewayConnectionStatsHistoryRequest message from JSON.
+func (x *GetGatewayConnectionStatsHistoryRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "from":
+			s.AddField("from")
+			if s.ReadNil() {
+				x.From = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.From = v
+		case "to":
+			s.AddField("to")
+			if s.ReadNil() {
+				x.To = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.To = v
+		case "step":
+			s.AddField("step")
+			if s.ReadNil() {
+				x.Step = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Step = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GetGatewayConnectionStatsHistoryRequest from JSON.
+func (x *GetGatewayConnectionStatsHistoryRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the RunGatewayCommandRequest message to JSON.
+func (x *RunGatewayCommandRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.Command != "" || s.HasField("command") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("command")
+		s.WriteString(x.Command)
+	}
+	if len(x.Arguments) > 0 || s.HasField("arguments") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("arguments")
+		s.WriteStringArray(x.Arguments)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the RunGatewayCommandRequest to JSON.
+func (x *RunGatewayCommandRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the RunGatewayCommandRequest message from JSON.
+func (x *RunGatewayCommandRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "command":
+			s.AddField("command")
+			x.Command = s.ReadString()
+		case "arguments":
+			s.AddField("arguments")
+			if s.ReadNil() {
+				x.Arguments = nil
+				return
+			}
+			x.Arguments = s.ReadStringArray()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the RunGatewayCommandRequest from JSON.
+func (x *RunGatewayCommandRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayRemoteShellStart message to JSON.
+func (x *GatewayRemoteShellStart) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.Term != "" || s.HasField("term") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("term")
+		s.WriteString(x.Term)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayRemoteShellStart to JSON.
+func (x *GatewayRemoteShellStart) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayRemoteShellStart message from JSON.
+func (x *GatewayRemoteShellStart) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "term":
+			s.AddField("term")
+			x.Term = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayRemoteShellStart from JSON.
+func (x *GatewayRemoteShellStart) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayRemoteShellRequest message to JSON.
+func (x *GatewayRemoteShellRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Message != nil {
+		switch ov := x.Message.(type) {
+		case *GatewayRemoteShellRequest_Start:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("start")
+			ov.Start.MarshalProtoJSON(s.WithField("start"))
+		case *GatewayRemoteShellRequest_Data:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("data")
+			s.WriteBytes(ov.Data)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayRemoteShellRequest to JSON.
+func (x *GatewayRemoteShellRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayRemoteShellRequest message from JSON.
+func (x *GatewayRemoteShellRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "start":
+			ov := &GatewayRemoteShellRequest_Start{}
+			x.Message = ov
+			if s.ReadNil() {
+				ov.Start = nil
+				return
+			}
+			ov.Start = &GatewayRemoteShellStart{}
+			ov.Start.UnmarshalProtoJSON(s.WithField("start", true))
+		case "data":
+			s.AddField("data")
+			ov := &GatewayRemoteShellRequest_Data{}
+			x.Message = ov
+			ov.Data = s.ReadBytes()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayRemoteShellRequest from JSON.
+func (x *GatewayRemoteShellRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ScheduleProprietaryDownlinkRequest message to JSON.
+func (x *ScheduleProprietaryDownlinkRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if len(x.RawPayload) > 0 || s.HasField("raw_payload") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("raw_payload")
+		s.WriteBytes(x.RawPayload)
+	}
+	if x.Request != nil || s.HasField("request") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("request")
+		x.Request.MarshalProtoJSON(s.WithField("request"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ScheduleProprietaryDownlinkRequest to JSON.
+func (x *ScheduleProprietaryDownlinkRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ScheduleProprietaryDownlinkRequest message from JSON.
+func (x *ScheduleProprietaryDownlinkRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "raw_payload", "rawPayload":
+			s.AddField("raw_payload")
+			x.RawPayload = s.ReadBytes()
+		case "request":
+			if s.ReadNil() {
+				x.Request = nil
+				return
+			}
+			x.Request = &TxRequest{}
+			x.Request.UnmarshalProtoJSON(s.WithField("request", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ScheduleProprietaryDownlinkRequest from JSON.
+func (x *ScheduleProprietaryDownlinkRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the CaptureGatewayTrafficRequest message to JSON.
+func (x *CaptureGatewayTrafficRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.Duration != nil || s.HasField("duration") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("duration")
+		if x.Duration == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.Duration)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the CaptureGatewayTrafficRequest to JSON.
+func (x *CaptureGatewayTrafficRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the CaptureGatewayTrafficRequest message from JSON.
+func (x *CaptureGatewayTrafficRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "duration":
+			s.AddField("duration")
+			if s.ReadNil() {
+				x.Duration = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Duration = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the CaptureGatewayTrafficRequest from JSON.
+func (x *CaptureGatewayTrafficRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayTrafficCapture message to JSON.
+func (x *GatewayTrafficCapture) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Time != nil || s.HasField("time") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("time")
+		if x.Time == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.Time)
+		}
+	}
+	if x.Message != nil {
+		switch ov := x.Message.(type) {
+		case *GatewayTrafficCapture_UplinkMessage:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("uplink_message")
+			ov.UplinkMessage.MarshalProtoJSON(s.WithField("uplink_message"))
+		case *GatewayTrafficCapture_DownlinkMessage:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("downlink_message")
+			ov.DownlinkMessage.MarshalProtoJSON(s.WithField("downlink_message"))
+		case *GatewayTrafficCapture_TxAcknowledgment:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("tx_acknowledgment")
+			ov.TxAcknowledgment.MarshalProtoJSON(s.WithField("tx_acknowledgment"))
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayTrafficCapture to JSON.
+func (x *GatewayTrafficCapture) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayTrafficCapture message from JSON.
+func (x *GatewayTrafficCapture) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "time":
+			s.AddField("time")
+			if s.ReadNil() {
+				x.Time = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.Time = v
+		case "uplink_message", "uplinkMessage":
+			ov := &GatewayTrafficCapture_UplinkMessage{}
+			x.Message = ov
+			if s.ReadNil() {
+				ov.UplinkMessage = nil
+				return
+			}
+			ov.UplinkMessage = &UplinkMessage{}
+			ov.UplinkMessage.UnmarshalProtoJSON(s.WithField("uplink_message", true))
+		case "downlink_message", "downlinkMessage":
+			ov := &GatewayTrafficCapture_DownlinkMessage{}
+			x.Message = ov
+			if s.ReadNil() {
+				ov.DownlinkMessage = nil
+				return
+			}
+			ov.DownlinkMessage = &DownlinkMessage{}
+			ov.DownlinkMessage.UnmarshalProtoJSON(s.WithField("downlink_message", true))
+		case "tx_acknowledgment", "txAcknowledgment":
+			ov := &GatewayTrafficCapture_TxAcknowledgment{}
+			x.Message = ov
+			if s.ReadNil() {
+				ov.TxAcknowledgment = nil
+				return
+			}
+			ov.TxAcknowledgment = &TxAcknowledgment{}
+			ov.TxAcknowledgment.UnmarshalProtoJSON(s.WithField("tx_acknowledgment", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayTrafficCapture from JSON.
+func (x *GatewayTrafficCapture) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
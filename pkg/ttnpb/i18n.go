@@ -102,6 +102,7 @@ func init() {
 	defineEnum(PayloadFormatter_FORMATTER_GRPC_SERVICE, "gRPC service")
 	defineEnum(PayloadFormatter_FORMATTER_JAVASCRIPT, "JavaScript")
 	defineEnum(PayloadFormatter_FORMATTER_CAYENNELPP, "Cayenne LPP")
+	defineEnum(PayloadFormatter_FORMATTER_WASM, "WebAssembly")
 
 	defineEnum(Right_RIGHT_USER_INFO, "view user information")
 	defineEnum(Right_RIGHT_USER_SETTINGS_BASIC, "edit basic user settings")
@@ -153,6 +154,7 @@ func init() {
 	defineEnum(Right_RIGHT_GATEWAY_LOCATION_READ, "view gateway location")
 	defineEnum(Right_RIGHT_GATEWAY_WRITE_SECRETS, "store secrets for a gateway")
 	defineEnum(Right_RIGHT_GATEWAY_READ_SECRETS, "retrieve secrets associated with a gateway")
+	defineEnum(Right_RIGHT_GATEWAY_REMOTE_SHELL, "open remote shell sessions and run commands on a gateway")
 	defineEnum(Right_RIGHT_GATEWAY_ALL, "all gateway rights")
 
 	defineEnum(Right_RIGHT_ORGANIZATION_INFO, "view organization information")
@@ -462,6 +462,19 @@ var GatewayTxAcknowledgmentFieldPathsTopLevel = []string{
 	"gateway_ids",
 	"tx_ack",
 }
+var GatewayDownlinkLoadFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"sub_bands",
+	"tx_failure_rate",
+}
+
+var GatewayDownlinkLoadFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"sub_bands",
+	"tx_failure_rate",
+}
 var GatewayUplinkMessageFieldPathsNested = []string{
 	"band_id",
 	"message",
@@ -567,6 +580,7 @@ var ApplicationUplinkFieldPathsNested = []string{
 	"network_ids.tenant_id",
 	"normalized_payload",
 	"normalized_payload_warnings",
+	"policy_violations",
 	"received_at",
 	"rx_metadata",
 	"session_key_id",
@@ -614,6 +628,7 @@ var ApplicationUplinkFieldPathsTopLevel = []string{
 	"network_ids",
 	"normalized_payload",
 	"normalized_payload_warnings",
+	"policy_violations",
 	"received_at",
 	"rx_metadata",
 	"session_key_id",
@@ -962,6 +977,7 @@ var ApplicationUpFieldPathsNested = []string{
 	"up.uplink_message.network_ids.tenant_id",
 	"up.uplink_message.normalized_payload",
 	"up.uplink_message.normalized_payload_warnings",
+	"up.uplink_message.policy_violations",
 	"up.uplink_message.received_at",
 	"up.uplink_message.rx_metadata",
 	"up.uplink_message.session_key_id",
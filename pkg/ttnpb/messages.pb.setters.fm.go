@@ -425,6 +425,61 @@ func (dst *GatewayTxAcknowledgment) SetFields(src *GatewayTxAcknowledgment, path
 	return nil
 }
 
+func (dst *GatewayDownlinkLoad) SetFields(src *GatewayDownlinkLoad, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "sub_bands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'sub_bands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SubBands = src.SubBands
+			} else {
+				dst.SubBands = nil
+			}
+		case "tx_failure_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'tx_failure_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TxFailureRate = src.TxFailureRate
+			} else {
+				var zero float32
+				dst.TxFailureRate = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *GatewayUplinkMessage) SetFields(src *GatewayUplinkMessage, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -704,6 +759,15 @@ func (dst *ApplicationUplink) SetFields(src *ApplicationUplink, paths ...string)
 					dst.NetworkIds = nil
 				}
 			}
+		case "policy_violations":
+			if len(subs) > 0 {
+				return fmt.Errorf("'policy_violations' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PolicyViolations = src.PolicyViolations
+			} else {
+				dst.PolicyViolations = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
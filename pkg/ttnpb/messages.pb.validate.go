@@ -616,6 +616,132 @@ var _ interface {
 	ErrorName() string
 } = GatewayTxAcknowledgmentValidationError{}
 
+// ValidateFields checks the field values on GatewayDownlinkLoad with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayDownlinkLoad) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayDownlinkLoadFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return GatewayDownlinkLoadValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayDownlinkLoadValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "sub_bands":
+
+			for idx, item := range m.GetSubBands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return GatewayDownlinkLoadValidationError{
+							field:  fmt.Sprintf("sub_bands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "tx_failure_rate":
+
+			if val := m.GetTxFailureRate(); val < 0 || val > 1 {
+				return GatewayDownlinkLoadValidationError{
+					field:  "tx_failure_rate",
+					reason: "value must be inside range [0, 1]",
+				}
+			}
+
+		default:
+			return GatewayDownlinkLoadValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayDownlinkLoadValidationError is the validation error returned by
+// GatewayDownlinkLoad.ValidateFields if the designated constraints aren't met.
+type GatewayDownlinkLoadValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayDownlinkLoadValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayDownlinkLoadValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayDownlinkLoadValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayDownlinkLoadValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayDownlinkLoadValidationError) ErrorName() string {
+	return "GatewayDownlinkLoadValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayDownlinkLoadValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayDownlinkLoad.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayDownlinkLoadValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayDownlinkLoadValidationError{}
+
 // ValidateFields checks the field values on GatewayUplinkMessage with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -914,6 +1040,8 @@ func (m *ApplicationUplink) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "policy_violations":
+
 		default:
 			return ApplicationUplinkValidationError{
 				field:  name,
@@ -2554,10 +2682,10 @@ func (m *MessagePayloadFormatters) ValidateFields(paths ...string) error {
 
 		case "up_formatter_parameter":
 
-			if utf8.RuneCountInString(m.GetUpFormatterParameter()) > 40960 {
+			if utf8.RuneCountInString(m.GetUpFormatterParameter()) > 1398104 {
 				return MessagePayloadFormattersValidationError{
 					field:  "up_formatter_parameter",
-					reason: "value length must be at most 40960 runes",
+					reason: "value length must be at most 1398104 runes",
 				}
 			}
 
@@ -2572,10 +2700,10 @@ func (m *MessagePayloadFormatters) ValidateFields(paths ...string) error {
 
 		case "down_formatter_parameter":
 
-			if utf8.RuneCountInString(m.GetDownFormatterParameter()) > 40960 {
+			if utf8.RuneCountInString(m.GetDownFormatterParameter()) > 1398104 {
 				return MessagePayloadFormattersValidationError{
 					field:  "down_formatter_parameter",
-					reason: "value length must be at most 40960 runes",
+					reason: "value length must be at most 1398104 runes",
 				}
 			}
 
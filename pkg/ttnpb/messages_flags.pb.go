@@ -36,6 +36,7 @@ func AddSelectFlagsForApplicationUplink(flags *pflag.FlagSet, prefix string, hid
 	AddSelectFlagsForEndDeviceVersionIdentifiers(flags, flagsplugin.Prefix("version-ids", prefix), hidden)
 	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("network-ids", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("network-ids", prefix), true), flagsplugin.WithHidden(hidden)))
 	AddSelectFlagsForNetworkIdentifiers(flags, flagsplugin.Prefix("network-ids", prefix), hidden)
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("policy-violations", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("policy-violations", prefix), false), flagsplugin.WithHidden(hidden)))
 }
 
 // SelectFromFlags outputs the fieldmask paths forApplicationUplink message from select flags.
@@ -150,6 +151,11 @@ func PathsFromSelectFlagsForApplicationUplink(flags *pflag.FlagSet, prefix strin
 	} else {
 		paths = append(paths, selectPaths...)
 	}
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("policy_violations", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("policy_violations", prefix))
+	}
 	return paths, nil
 }
 
@@ -173,6 +179,7 @@ func AddSetFlagsForApplicationUplink(flags *pflag.FlagSet, prefix string, hidden
 	// FIXME: Skipping Locations because maps with message value types are currently not supported.
 	AddSetFlagsForEndDeviceVersionIdentifiers(flags, flagsplugin.Prefix("version-ids", prefix), hidden)
 	// FIXME: Skipping NetworkIds because it does not seem to implement AddSetFlags.
+	flags.AddFlag(flagsplugin.NewStringSliceFlag(flagsplugin.Prefix("policy-violations", prefix), "", flagsplugin.WithHidden(hidden)))
 }
 
 // SetFromFlags sets the ApplicationUplink message from flags.
@@ -263,6 +270,12 @@ func (m *ApplicationUplink) SetFromFlags(flags *pflag.FlagSet, prefix string) (p
 		}
 	}
 	// FIXME: Skipping NetworkIds because it does not seem to implement AddSetFlags.
+	if val, changed, err := flagsplugin.GetStringSlice(flags, flagsplugin.Prefix("policy_violations", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.PolicyViolations = val
+		paths = append(paths, flagsplugin.Prefix("policy_violations", prefix))
+	}
 	return paths, nil
 }
 
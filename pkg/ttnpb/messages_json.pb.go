@@ -35,6 +35,7 @@ var PayloadFormatter_customvalue = map[string]int32{
 	"GRPC_SERVICE": 2,
 	"JAVASCRIPT":   3,
 	"CAYENNELPP":   4,
+	"WASM":         5,
 }
 
 // UnmarshalProtoJSON unmarshals the PayloadFormatter from JSON.
@@ -525,6 +526,84 @@ func (x *GatewayTxAcknowledgment) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
+// MarshalProtoJSON marshals the GatewayDownlinkLoad message to JSON.
+func (x *GatewayDownlinkLoad) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if len(x.SubBands) > 0 || s.HasField("sub_bands") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("sub_bands")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.SubBands {
+			s.WriteMoreIf(&wroteElement)
+			// NOTE: GatewayConnectionStats_SubBand does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, element)
+		}
+		s.WriteArrayEnd()
+	}
+	if x.TxFailureRate != 0 || s.HasField("tx_failure_rate") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("tx_failure_rate")
+		s.WriteFloat32(x.TxFailureRate)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayDownlinkLoad to JSON.
+func (x *GatewayDownlinkLoad) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayDownlinkLoad message from JSON.
+func (x *GatewayDownlinkLoad) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "sub_bands", "subBands":
+			s.AddField("sub_bands")
+			if s.ReadNil() {
+				x.SubBands = nil
+				return
+			}
+			s.ReadArray(func() {
+				// NOTE: GatewayConnectionStats_SubBand does not seem to implement UnmarshalProtoJSON.
+				var v GatewayConnectionStats_SubBand
+				golang.UnmarshalMessage(s, &v)
+				x.SubBands = append(x.SubBands, &v)
+			})
+		case "tx_failure_rate", "txFailureRate":
+			s.AddField("tx_failure_rate")
+			x.TxFailureRate = s.ReadFloat32()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayDownlinkLoad from JSON.
+func (x *GatewayDownlinkLoad) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
 // MarshalProtoJSON marshals the GatewayUplinkMessage message to JSON.
 func (x *GatewayUplinkMessage) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
@@ -714,6 +793,11 @@ func (x *ApplicationUplink) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		s.WriteObjectField("network_ids")
 		x.NetworkIds.MarshalProtoJSON(s.WithField("network_ids"))
 	}
+	if len(x.PolicyViolations) > 0 || s.HasField("policy_violations") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("policy_violations")
+		s.WriteStringArray(x.PolicyViolations)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -873,6 +957,13 @@ func (x *ApplicationUplink) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 			}
 			x.NetworkIds = &NetworkIdentifiers{}
 			x.NetworkIds.UnmarshalProtoJSON(s.WithField("network_ids", true))
+		case "policy_violations", "policyViolations":
+			s.AddField("policy_violations")
+			if s.ReadNil() {
+				x.PolicyViolations = nil
+				return
+			}
+			x.PolicyViolations = s.ReadStringArray()
 		}
 	})
 }
@@ -32,3 +32,106 @@ var GetDeviceAdressPrefixesResponseFieldPathsNested = []string{
 var GetDeviceAdressPrefixesResponseFieldPathsTopLevel = []string{
 	"dev_addr_prefixes",
 }
+var ChannelMigrationCampaignIdentifiersFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"campaign_id",
+}
+
+var ChannelMigrationCampaignIdentifiersFieldPathsTopLevel = []string{
+	"application_ids",
+	"campaign_id",
+}
+var ChannelMigrationCampaignFieldPathsNested = []string{
+	"channel_mask",
+	"channels",
+	"device_ids",
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.campaign_id",
+	"rollback_timeout",
+	"rollout_rate",
+}
+
+var ChannelMigrationCampaignFieldPathsTopLevel = []string{
+	"channel_mask",
+	"channels",
+	"device_ids",
+	"ids",
+	"rollback_timeout",
+	"rollout_rate",
+}
+var ChannelMigrationCampaignStatusFieldPathsNested = []string{
+	"campaign",
+	"campaign.channel_mask",
+	"campaign.channels",
+	"campaign.device_ids",
+	"campaign.ids",
+	"campaign.ids.application_ids",
+	"campaign.ids.application_ids.application_id",
+	"campaign.ids.campaign_id",
+	"campaign.rollback_timeout",
+	"campaign.rollout_rate",
+	"canceled",
+	"created_at",
+	"devices",
+}
+
+var ChannelMigrationCampaignStatusFieldPathsTopLevel = []string{
+	"campaign",
+	"canceled",
+	"created_at",
+	"devices",
+}
+var ChannelMigrationCampaignStatusesFieldPathsNested = []string{
+	"statuses",
+}
+
+var ChannelMigrationCampaignStatusesFieldPathsTopLevel = []string{
+	"statuses",
+}
+var CancelChannelMigrationCampaignRequestFieldPathsNested = []string{
+	"ids",
+	"ids.application_ids",
+	"ids.application_ids.application_id",
+	"ids.campaign_id",
+	"rollback",
+}
+
+var CancelChannelMigrationCampaignRequestFieldPathsTopLevel = []string{
+	"ids",
+	"rollback",
+}
+var ChannelMigrationCampaignStatus_DeviceFieldPathsNested = []string{
+	"applied_at",
+	"device_id",
+	"error",
+	"error.attributes",
+	"error.cause",
+	"error.cause.attributes",
+	"error.cause.correlation_id",
+	"error.cause.message_format",
+	"error.cause.name",
+	"error.cause.namespace",
+	"error.code",
+	"error.correlation_id",
+	"error.details",
+	"error.message_format",
+	"error.name",
+	"error.namespace",
+	"previous_channels",
+	"state",
+	"target_channels",
+	"updated_at",
+}
+
+var ChannelMigrationCampaignStatus_DeviceFieldPathsTopLevel = []string{
+	"applied_at",
+	"device_id",
+	"error",
+	"previous_channels",
+	"state",
+	"target_channels",
+	"updated_at",
+}
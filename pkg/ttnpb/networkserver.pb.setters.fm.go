@@ -93,3 +93,352 @@ func (dst *GetDeviceAdressPrefixesResponse) SetFields(src *GetDeviceAdressPrefix
 	}
 	return nil
 }
+
+func (dst *ChannelMigrationCampaignIdentifiers) SetFields(src *ChannelMigrationCampaignIdentifiers, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "campaign_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'campaign_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CampaignId = src.CampaignId
+			} else {
+				var zero string
+				dst.CampaignId = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ChannelMigrationCampaign) SetFields(src *ChannelMigrationCampaign, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ChannelMigrationCampaignIdentifiers
+				if (src == nil || src.Ids == nil) && dst.Ids == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Ids
+				}
+				if dst.Ids != nil {
+					newDst = dst.Ids
+				} else {
+					newDst = &ChannelMigrationCampaignIdentifiers{}
+					dst.Ids = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Ids = src.Ids
+				} else {
+					dst.Ids = nil
+				}
+			}
+		case "device_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceIds = src.DeviceIds
+			} else {
+				dst.DeviceIds = nil
+			}
+		case "channels":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channels' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Channels = src.Channels
+			} else {
+				dst.Channels = nil
+			}
+		case "channel_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'channel_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ChannelMask = src.ChannelMask
+			} else {
+				dst.ChannelMask = nil
+			}
+		case "rollout_rate":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rollout_rate' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RolloutRate = src.RolloutRate
+			} else {
+				var zero uint32
+				dst.RolloutRate = zero
+			}
+		case "rollback_timeout":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rollback_timeout' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RollbackTimeout = src.RollbackTimeout
+			} else {
+				dst.RollbackTimeout = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ChannelMigrationCampaignStatus) SetFields(src *ChannelMigrationCampaignStatus, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "campaign":
+			if len(subs) > 0 {
+				var newDst, newSrc *ChannelMigrationCampaign
+				if (src == nil || src.Campaign == nil) && dst.Campaign == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Campaign
+				}
+				if dst.Campaign != nil {
+					newDst = dst.Campaign
+				} else {
+					newDst = &ChannelMigrationCampaign{}
+					dst.Campaign = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Campaign = src.Campaign
+				} else {
+					dst.Campaign = nil
+				}
+			}
+		case "created_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'created_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CreatedAt = src.CreatedAt
+			} else {
+				dst.CreatedAt = nil
+			}
+		case "canceled":
+			if len(subs) > 0 {
+				return fmt.Errorf("'canceled' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Canceled = src.Canceled
+			} else {
+				var zero bool
+				dst.Canceled = zero
+			}
+		case "devices":
+			if len(subs) > 0 {
+				return fmt.Errorf("'devices' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Devices = src.Devices
+			} else {
+				dst.Devices = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ChannelMigrationCampaignStatuses) SetFields(src *ChannelMigrationCampaignStatuses, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "statuses":
+			if len(subs) > 0 {
+				return fmt.Errorf("'statuses' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Statuses = src.Statuses
+			} else {
+				dst.Statuses = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *CancelChannelMigrationCampaignRequest) SetFields(src *CancelChannelMigrationCampaignRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ChannelMigrationCampaignIdentifiers
+				if (src == nil || src.Ids == nil) && dst.Ids == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Ids
+				}
+				if dst.Ids != nil {
+					newDst = dst.Ids
+				} else {
+					newDst = &ChannelMigrationCampaignIdentifiers{}
+					dst.Ids = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Ids = src.Ids
+				} else {
+					dst.Ids = nil
+				}
+			}
+		case "rollback":
+			if len(subs) > 0 {
+				return fmt.Errorf("'rollback' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Rollback = src.Rollback
+			} else {
+				var zero bool
+				dst.Rollback = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ChannelMigrationCampaignStatus_Device) SetFields(src *ChannelMigrationCampaignStatus_Device, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceId = src.DeviceId
+			} else {
+				var zero string
+				dst.DeviceId = zero
+			}
+		case "state":
+			if len(subs) > 0 {
+				return fmt.Errorf("'state' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.State = src.State
+			} else {
+				dst.State = 0
+			}
+		case "applied_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'applied_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AppliedAt = src.AppliedAt
+			} else {
+				dst.AppliedAt = nil
+			}
+		case "updated_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UpdatedAt = src.UpdatedAt
+			} else {
+				dst.UpdatedAt = nil
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+		case "previous_channels":
+			if len(subs) > 0 {
+				return fmt.Errorf("'previous_channels' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PreviousChannels = src.PreviousChannels
+			} else {
+				dst.PreviousChannels = nil
+			}
+		case "target_channels":
+			if len(subs) > 0 {
+				return fmt.Errorf("'target_channels' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TargetChannels = src.TargetChannels
+			} else {
+				dst.TargetChannels = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -413,3 +413,812 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetDeviceAdressPrefixesResponseValidationError{}
+
+// ValidateFields checks the field values on
+// ChannelMigrationCampaignIdentifiers with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *ChannelMigrationCampaignIdentifiers) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ChannelMigrationCampaignIdentifiersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return ChannelMigrationCampaignIdentifiersValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ChannelMigrationCampaignIdentifiersValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "campaign_id":
+
+			if utf8.RuneCountInString(m.GetCampaignId()) > 36 {
+				return ChannelMigrationCampaignIdentifiersValidationError{
+					field:  "campaign_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_ChannelMigrationCampaignIdentifiers_CampaignId_Pattern.MatchString(m.GetCampaignId()) {
+				return ChannelMigrationCampaignIdentifiersValidationError{
+					field:  "campaign_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return ChannelMigrationCampaignIdentifiersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ChannelMigrationCampaignIdentifiersValidationError is the validation error
+// returned by ChannelMigrationCampaignIdentifiers.ValidateFields if the
+// designated constraints aren't met.
+type ChannelMigrationCampaignIdentifiersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChannelMigrationCampaignIdentifiersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChannelMigrationCampaignIdentifiersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChannelMigrationCampaignIdentifiersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChannelMigrationCampaignIdentifiersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChannelMigrationCampaignIdentifiersValidationError) ErrorName() string {
+	return "ChannelMigrationCampaignIdentifiersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChannelMigrationCampaignIdentifiersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChannelMigrationCampaignIdentifiers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChannelMigrationCampaignIdentifiersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChannelMigrationCampaignIdentifiersValidationError{}
+
+var _ChannelMigrationCampaignIdentifiers_CampaignId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on ChannelMigrationCampaign with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ChannelMigrationCampaign) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ChannelMigrationCampaignFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if m.GetIds() == nil {
+				return ChannelMigrationCampaignValidationError{
+					field:  "ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ChannelMigrationCampaignValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "device_ids":
+
+			if l := len(m.GetDeviceIds()); l < 1 || l > 10000 {
+				return ChannelMigrationCampaignValidationError{
+					field:  "device_ids",
+					reason: "value must contain between 1 and 10000 items, inclusive",
+				}
+			}
+
+			_ChannelMigrationCampaign_DeviceIds_Unique := make(map[string]struct{}, len(m.GetDeviceIds()))
+
+			for idx, item := range m.GetDeviceIds() {
+				_, _ = idx, item
+
+				if _, exists := _ChannelMigrationCampaign_DeviceIds_Unique[item]; exists {
+					return ChannelMigrationCampaignValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "repeated value must contain unique items",
+					}
+				} else {
+					_ChannelMigrationCampaign_DeviceIds_Unique[item] = struct{}{}
+				}
+
+				if utf8.RuneCountInString(item) > 36 {
+					return ChannelMigrationCampaignValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_ChannelMigrationCampaign_DeviceIds_Pattern.MatchString(item) {
+					return ChannelMigrationCampaignValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+			}
+
+		case "channels":
+
+			if len(m.GetChannels()) > 255 {
+				return ChannelMigrationCampaignValidationError{
+					field:  "channels",
+					reason: "value must contain no more than 255 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetChannels() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ChannelMigrationCampaignValidationError{
+							field:  fmt.Sprintf("channels[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "channel_mask":
+
+			if len(m.GetChannelMask()) > 255 {
+				return ChannelMigrationCampaignValidationError{
+					field:  "channel_mask",
+					reason: "value must contain no more than 255 item(s)",
+				}
+			}
+
+		case "rollout_rate":
+
+			if m.GetRolloutRate() < 1 {
+				return ChannelMigrationCampaignValidationError{
+					field:  "rollout_rate",
+					reason: "value must be greater than or equal to 1",
+				}
+			}
+
+		case "rollback_timeout":
+
+			if d := m.GetRollbackTimeout(); d != nil {
+				dur, err := d.AsDuration(), d.CheckValid()
+				if err != nil {
+					return ChannelMigrationCampaignValidationError{
+						field:  "rollback_timeout",
+						reason: "value is not a valid duration",
+						cause:  err,
+					}
+				}
+
+				gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+				if dur < gte {
+					return ChannelMigrationCampaignValidationError{
+						field:  "rollback_timeout",
+						reason: "value must be greater than or equal to 0s",
+					}
+				}
+
+			}
+
+		default:
+			return ChannelMigrationCampaignValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ChannelMigrationCampaignValidationError is the validation error returned by
+// ChannelMigrationCampaign.ValidateFields if the designated constraints
+// aren't met.
+type ChannelMigrationCampaignValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChannelMigrationCampaignValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChannelMigrationCampaignValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChannelMigrationCampaignValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChannelMigrationCampaignValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChannelMigrationCampaignValidationError) ErrorName() string {
+	return "ChannelMigrationCampaignValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChannelMigrationCampaignValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChannelMigrationCampaign.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChannelMigrationCampaignValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChannelMigrationCampaignValidationError{}
+
+var _ChannelMigrationCampaign_DeviceIds_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on ChannelMigrationCampaignStatus
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ChannelMigrationCampaignStatus) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ChannelMigrationCampaignStatusFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "campaign":
+
+			if v, ok := interface{}(m.GetCampaign()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ChannelMigrationCampaignStatusValidationError{
+						field:  "campaign",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "created_at":
+
+			if v, ok := interface{}(m.GetCreatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ChannelMigrationCampaignStatusValidationError{
+						field:  "created_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "canceled":
+			// no validation rules for Canceled
+		case "devices":
+
+			for idx, item := range m.GetDevices() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ChannelMigrationCampaignStatusValidationError{
+							field:  fmt.Sprintf("devices[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ChannelMigrationCampaignStatusValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ChannelMigrationCampaignStatusValidationError is the validation error
+// returned by ChannelMigrationCampaignStatus.ValidateFields if the designated
+// constraints aren't met.
+type ChannelMigrationCampaignStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChannelMigrationCampaignStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChannelMigrationCampaignStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChannelMigrationCampaignStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChannelMigrationCampaignStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChannelMigrationCampaignStatusValidationError) ErrorName() string {
+	return "ChannelMigrationCampaignStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChannelMigrationCampaignStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChannelMigrationCampaignStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChannelMigrationCampaignStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChannelMigrationCampaignStatusValidationError{}
+
+// ValidateFields checks the field values on ChannelMigrationCampaignStatuses
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ChannelMigrationCampaignStatuses) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ChannelMigrationCampaignStatusesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "statuses":
+
+			for idx, item := range m.GetStatuses() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ChannelMigrationCampaignStatusesValidationError{
+							field:  fmt.Sprintf("statuses[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ChannelMigrationCampaignStatusesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ChannelMigrationCampaignStatusesValidationError is the validation error
+// returned by ChannelMigrationCampaignStatuses.ValidateFields if the
+// designated constraints aren't met.
+type ChannelMigrationCampaignStatusesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChannelMigrationCampaignStatusesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChannelMigrationCampaignStatusesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChannelMigrationCampaignStatusesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChannelMigrationCampaignStatusesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChannelMigrationCampaignStatusesValidationError) ErrorName() string {
+	return "ChannelMigrationCampaignStatusesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChannelMigrationCampaignStatusesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChannelMigrationCampaignStatuses.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChannelMigrationCampaignStatusesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChannelMigrationCampaignStatusesValidationError{}
+
+// ValidateFields checks the field values on
+// CancelChannelMigrationCampaignRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *CancelChannelMigrationCampaignRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CancelChannelMigrationCampaignRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "ids":
+
+			if m.GetIds() == nil {
+				return CancelChannelMigrationCampaignRequestValidationError{
+					field:  "ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CancelChannelMigrationCampaignRequestValidationError{
+						field:  "ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "rollback":
+			// no validation rules for Rollback
+		default:
+			return CancelChannelMigrationCampaignRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CancelChannelMigrationCampaignRequestValidationError is the validation error
+// returned by CancelChannelMigrationCampaignRequest.ValidateFields if the
+// designated constraints aren't met.
+type CancelChannelMigrationCampaignRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelChannelMigrationCampaignRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelChannelMigrationCampaignRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelChannelMigrationCampaignRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelChannelMigrationCampaignRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelChannelMigrationCampaignRequestValidationError) ErrorName() string {
+	return "CancelChannelMigrationCampaignRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelChannelMigrationCampaignRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelChannelMigrationCampaignRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelChannelMigrationCampaignRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelChannelMigrationCampaignRequestValidationError{}
+
+// ValidateFields checks the field values on
+// ChannelMigrationCampaignStatus_Device with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *ChannelMigrationCampaignStatus_Device) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ChannelMigrationCampaignStatus_DeviceFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "device_id":
+			// no validation rules for DeviceId
+		case "state":
+			// no validation rules for State
+		case "applied_at":
+
+			if v, ok := interface{}(m.GetAppliedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ChannelMigrationCampaignStatus_DeviceValidationError{
+						field:  "applied_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "updated_at":
+
+			if v, ok := interface{}(m.GetUpdatedAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ChannelMigrationCampaignStatus_DeviceValidationError{
+						field:  "updated_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ChannelMigrationCampaignStatus_DeviceValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "previous_channels":
+
+			for idx, item := range m.GetPreviousChannels() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ChannelMigrationCampaignStatus_DeviceValidationError{
+							field:  fmt.Sprintf("previous_channels[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "target_channels":
+
+			for idx, item := range m.GetTargetChannels() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ChannelMigrationCampaignStatus_DeviceValidationError{
+							field:  fmt.Sprintf("target_channels[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ChannelMigrationCampaignStatus_DeviceValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ChannelMigrationCampaignStatus_DeviceValidationError is the validation error
+// returned by ChannelMigrationCampaignStatus_Device.ValidateFields if the
+// designated constraints aren't met.
+type ChannelMigrationCampaignStatus_DeviceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChannelMigrationCampaignStatus_DeviceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChannelMigrationCampaignStatus_DeviceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChannelMigrationCampaignStatus_DeviceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChannelMigrationCampaignStatus_DeviceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChannelMigrationCampaignStatus_DeviceValidationError) ErrorName() string {
+	return "ChannelMigrationCampaignStatus_DeviceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChannelMigrationCampaignStatus_DeviceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChannelMigrationCampaignStatus_Device.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChannelMigrationCampaignStatus_DeviceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChannelMigrationCampaignStatus_DeviceValidationError{}
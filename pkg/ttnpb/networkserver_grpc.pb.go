@@ -238,6 +238,257 @@ var Ns_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "lorawan-stack/api/networkserver.proto",
 }
 
+const (
+	NsChannelMigrationCampaigns_Create_FullMethodName = "/ttn.lorawan.v3.NsChannelMigrationCampaigns/Create"
+	NsChannelMigrationCampaigns_Get_FullMethodName    = "/ttn.lorawan.v3.NsChannelMigrationCampaigns/Get"
+	NsChannelMigrationCampaigns_List_FullMethodName   = "/ttn.lorawan.v3.NsChannelMigrationCampaigns/List"
+	NsChannelMigrationCampaigns_Cancel_FullMethodName = "/ttn.lorawan.v3.NsChannelMigrationCampaigns/Cancel"
+	NsChannelMigrationCampaigns_Delete_FullMethodName = "/ttn.lorawan.v3.NsChannelMigrationCampaigns/Delete"
+)
+
+// NsChannelMigrationCampaignsClient is the client API for NsChannelMigrationCampaigns service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type NsChannelMigrationCampaignsClient interface {
+	// Create a channel plan migration campaign.
+	// The campaign is applied gradually to the end devices, at most rollout_rate end devices per rollout step.
+	Create(ctx context.Context, in *ChannelMigrationCampaign, opts ...grpc.CallOption) (*ChannelMigrationCampaignStatus, error)
+	// Get the status of a channel plan migration campaign.
+	Get(ctx context.Context, in *ChannelMigrationCampaignIdentifiers, opts ...grpc.CallOption) (*ChannelMigrationCampaignStatus, error)
+	// List the status of the channel plan migration campaigns of an application.
+	List(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ChannelMigrationCampaignStatuses, error)
+	// Cancel a channel plan migration campaign, optionally rolling back end devices to which it was already applied.
+	Cancel(ctx context.Context, in *CancelChannelMigrationCampaignRequest, opts ...grpc.CallOption) (*ChannelMigrationCampaignStatus, error)
+	// Delete a channel plan migration campaign. The end devices are not altered.
+	Delete(ctx context.Context, in *ChannelMigrationCampaignIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type nsChannelMigrationCampaignsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNsChannelMigrationCampaignsClient(cc grpc.ClientConnInterface) NsChannelMigrationCampaignsClient {
+	return &nsChannelMigrationCampaignsClient{cc}
+}
+
+func (c *nsChannelMigrationCampaignsClient) Create(ctx context.Context, in *ChannelMigrationCampaign, opts ...grpc.CallOption) (*ChannelMigrationCampaignStatus, error) {
+	out := new(ChannelMigrationCampaignStatus)
+	err := c.cc.Invoke(ctx, NsChannelMigrationCampaigns_Create_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsChannelMigrationCampaignsClient) Get(ctx context.Context, in *ChannelMigrationCampaignIdentifiers, opts ...grpc.CallOption) (*ChannelMigrationCampaignStatus, error) {
+	out := new(ChannelMigrationCampaignStatus)
+	err := c.cc.Invoke(ctx, NsChannelMigrationCampaigns_Get_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsChannelMigrationCampaignsClient) List(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ChannelMigrationCampaignStatuses, error) {
+	out := new(ChannelMigrationCampaignStatuses)
+	err := c.cc.Invoke(ctx, NsChannelMigrationCampaigns_List_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsChannelMigrationCampaignsClient) Cancel(ctx context.Context, in *CancelChannelMigrationCampaignRequest, opts ...grpc.CallOption) (*ChannelMigrationCampaignStatus, error) {
+	out := new(ChannelMigrationCampaignStatus)
+	err := c.cc.Invoke(ctx, NsChannelMigrationCampaigns_Cancel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *nsChannelMigrationCampaignsClient) Delete(ctx context.Context, in *ChannelMigrationCampaignIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, NsChannelMigrationCampaigns_Delete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NsChannelMigrationCampaignsServer is the server API for NsChannelMigrationCampaigns service.
+// All implementations must embed UnimplementedNsChannelMigrationCampaignsServer
+// for forward compatibility
+type NsChannelMigrationCampaignsServer interface {
+	// Create a channel plan migration campaign.
+	// The campaign is applied gradually to the end devices, at most rollout_rate end devices per rollout step.
+	Create(context.Context, *ChannelMigrationCampaign) (*ChannelMigrationCampaignStatus, error)
+	// Get the status of a channel plan migration campaign.
+	Get(context.Context, *ChannelMigrationCampaignIdentifiers) (*ChannelMigrationCampaignStatus, error)
+	// List the status of the channel plan migration campaigns of an application.
+	List(context.Context, *ApplicationIdentifiers) (*ChannelMigrationCampaignStatuses, error)
+	// Cancel a channel plan migration campaign, optionally rolling back end devices to which it was already applied.
+	Cancel(context.Context, *CancelChannelMigrationCampaignRequest) (*ChannelMigrationCampaignStatus, error)
+	// Delete a channel plan migration campaign. The end devices are not altered.
+	Delete(context.Context, *ChannelMigrationCampaignIdentifiers) (*emptypb.Empty, error)
+	mustEmbedUnimplementedNsChannelMigrationCampaignsServer()
+}
+
+// UnimplementedNsChannelMigrationCampaignsServer must be embedded to have forward compatible implementations.
+type UnimplementedNsChannelMigrationCampaignsServer struct {
+}
+
+func (UnimplementedNsChannelMigrationCampaignsServer) Create(context.Context, *ChannelMigrationCampaign) (*ChannelMigrationCampaignStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (UnimplementedNsChannelMigrationCampaignsServer) Get(context.Context, *ChannelMigrationCampaignIdentifiers) (*ChannelMigrationCampaignStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedNsChannelMigrationCampaignsServer) List(context.Context, *ApplicationIdentifiers) (*ChannelMigrationCampaignStatuses, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedNsChannelMigrationCampaignsServer) Cancel(context.Context, *CancelChannelMigrationCampaignRequest) (*ChannelMigrationCampaignStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
+}
+func (UnimplementedNsChannelMigrationCampaignsServer) Delete(context.Context, *ChannelMigrationCampaignIdentifiers) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedNsChannelMigrationCampaignsServer) mustEmbedUnimplementedNsChannelMigrationCampaignsServer() {
+}
+
+// UnsafeNsChannelMigrationCampaignsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to NsChannelMigrationCampaignsServer will
+// result in compilation errors.
+type UnsafeNsChannelMigrationCampaignsServer interface {
+	mustEmbedUnimplementedNsChannelMigrationCampaignsServer()
+}
+
+func RegisterNsChannelMigrationCampaignsServer(s grpc.ServiceRegistrar, srv NsChannelMigrationCampaignsServer) {
+	s.RegisterService(&NsChannelMigrationCampaigns_ServiceDesc, srv)
+}
+
+func _NsChannelMigrationCampaigns_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelMigrationCampaign)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsChannelMigrationCampaignsServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsChannelMigrationCampaigns_Create_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsChannelMigrationCampaignsServer).Create(ctx, req.(*ChannelMigrationCampaign))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsChannelMigrationCampaigns_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelMigrationCampaignIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsChannelMigrationCampaignsServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsChannelMigrationCampaigns_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsChannelMigrationCampaignsServer).Get(ctx, req.(*ChannelMigrationCampaignIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsChannelMigrationCampaigns_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsChannelMigrationCampaignsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsChannelMigrationCampaigns_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsChannelMigrationCampaignsServer).List(ctx, req.(*ApplicationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsChannelMigrationCampaigns_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelChannelMigrationCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsChannelMigrationCampaignsServer).Cancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsChannelMigrationCampaigns_Cancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsChannelMigrationCampaignsServer).Cancel(ctx, req.(*CancelChannelMigrationCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NsChannelMigrationCampaigns_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelMigrationCampaignIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsChannelMigrationCampaignsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsChannelMigrationCampaigns_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsChannelMigrationCampaignsServer).Delete(ctx, req.(*ChannelMigrationCampaignIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// NsChannelMigrationCampaigns_ServiceDesc is the grpc.ServiceDesc for NsChannelMigrationCampaigns service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var NsChannelMigrationCampaigns_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ttn.lorawan.v3.NsChannelMigrationCampaigns",
+	HandlerType: (*NsChannelMigrationCampaignsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _NsChannelMigrationCampaigns_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _NsChannelMigrationCampaigns_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _NsChannelMigrationCampaigns_List_Handler,
+		},
+		{
+			MethodName: "Cancel",
+			Handler:    _NsChannelMigrationCampaigns_Cancel_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _NsChannelMigrationCampaigns_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lorawan-stack/api/networkserver.proto",
+}
+
 const (
 	AsNs_DownlinkQueueReplace_FullMethodName = "/ttn.lorawan.v3.AsNs/DownlinkQueueReplace"
 	AsNs_DownlinkQueuePush_FullMethodName    = "/ttn.lorawan.v3.AsNs/DownlinkQueuePush"
@@ -417,6 +668,7 @@ var AsNs_ServiceDesc = grpc.ServiceDesc{
 const (
 	GsNs_HandleUplink_FullMethodName           = "/ttn.lorawan.v3.GsNs/HandleUplink"
 	GsNs_ReportTxAcknowledgment_FullMethodName = "/ttn.lorawan.v3.GsNs/ReportTxAcknowledgment"
+	GsNs_ReportDownlinkLoad_FullMethodName     = "/ttn.lorawan.v3.GsNs/ReportDownlinkLoad"
 )
 
 // GsNsClient is the client API for GsNs service.
@@ -427,6 +679,9 @@ type GsNsClient interface {
 	HandleUplink(ctx context.Context, in *UplinkMessage, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Called by the Gateway Server when a Tx acknowledgment arrives.
 	ReportTxAcknowledgment(ctx context.Context, in *GatewayTxAcknowledgment, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Called by the Gateway Server to report the downlink load of a connected gateway.
+	// The Network Server uses the load when selecting downlink paths.
+	ReportDownlinkLoad(ctx context.Context, in *GatewayDownlinkLoad, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type gsNsClient struct {
@@ -455,6 +710,15 @@ func (c *gsNsClient) ReportTxAcknowledgment(ctx context.Context, in *GatewayTxAc
 	return out, nil
 }
 
+func (c *gsNsClient) ReportDownlinkLoad(ctx context.Context, in *GatewayDownlinkLoad, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GsNs_ReportDownlinkLoad_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GsNsServer is the server API for GsNs service.
 // All implementations must embed UnimplementedGsNsServer
 // for forward compatibility
@@ -463,6 +727,9 @@ type GsNsServer interface {
 	HandleUplink(context.Context, *UplinkMessage) (*emptypb.Empty, error)
 	// Called by the Gateway Server when a Tx acknowledgment arrives.
 	ReportTxAcknowledgment(context.Context, *GatewayTxAcknowledgment) (*emptypb.Empty, error)
+	// Called by the Gateway Server to report the downlink load of a connected gateway.
+	// The Network Server uses the load when selecting downlink paths.
+	ReportDownlinkLoad(context.Context, *GatewayDownlinkLoad) (*emptypb.Empty, error)
 	mustEmbedUnimplementedGsNsServer()
 }
 
@@ -476,6 +743,9 @@ func (UnimplementedGsNsServer) HandleUplink(context.Context, *UplinkMessage) (*e
 func (UnimplementedGsNsServer) ReportTxAcknowledgment(context.Context, *GatewayTxAcknowledgment) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ReportTxAcknowledgment not implemented")
 }
+func (UnimplementedGsNsServer) ReportDownlinkLoad(context.Context, *GatewayDownlinkLoad) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportDownlinkLoad not implemented")
+}
 func (UnimplementedGsNsServer) mustEmbedUnimplementedGsNsServer() {}
 
 // UnsafeGsNsServer may be embedded to opt out of forward compatibility for this service.
@@ -525,6 +795,24 @@ func _GsNs_ReportTxAcknowledgment_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GsNs_ReportDownlinkLoad_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GatewayDownlinkLoad)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GsNsServer).ReportDownlinkLoad(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GsNs_ReportDownlinkLoad_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GsNsServer).ReportDownlinkLoad(ctx, req.(*GatewayDownlinkLoad))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // GsNs_ServiceDesc is the grpc.ServiceDesc for GsNs service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -540,6 +828,10 @@ var GsNs_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ReportTxAcknowledgment",
 			Handler:    _GsNs_ReportTxAcknowledgment_Handler,
 		},
+		{
+			MethodName: "ReportDownlinkLoad",
+			Handler:    _GsNs_ReportDownlinkLoad_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lorawan-stack/api/networkserver.proto",
@@ -7,6 +7,7 @@
 package ttnpb
 
 import (
+	golang "github.com/TheThingsIndustries/protoc-gen-go-json/golang"
 	jsonplugin "github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
 	types "go.thethings.network/lorawan-stack/v3/pkg/types"
 )
@@ -190,3 +191,464 @@ func (x *GetDeviceAdressPrefixesResponse) UnmarshalProtoJSON(s *jsonplugin.Unmar
 func (x *GetDeviceAdressPrefixesResponse) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the ChannelMigrationCampaign message to JSON.
+func (x *ChannelMigrationCampaign) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Ids != nil || s.HasField("ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("ids")
+		// NOTE: ChannelMigrationCampaignIdentifiers does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Ids)
+	}
+	if len(x.DeviceIds) > 0 || s.HasField("device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("device_ids")
+		s.WriteStringArray(x.DeviceIds)
+	}
+	if len(x.Channels) > 0 || s.HasField("channels") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("channels")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Channels {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("channels"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.ChannelMask) > 0 || s.HasField("channel_mask") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("channel_mask")
+		s.WriteBoolArray(x.ChannelMask)
+	}
+	if x.RolloutRate != 0 || s.HasField("rollout_rate") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rollout_rate")
+		s.WriteUint32(x.RolloutRate)
+	}
+	if x.RollbackTimeout != nil || s.HasField("rollback_timeout") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("rollback_timeout")
+		if x.RollbackTimeout == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalDuration(s, x.RollbackTimeout)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ChannelMigrationCampaign to JSON.
+func (x *ChannelMigrationCampaign) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ChannelMigrationCampaign message from JSON.
+func (x *ChannelMigrationCampaign) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "ids":
+			s.AddField("ids")
+			if s.ReadNil() {
+				x.Ids = nil
+				return
+			}
+			// NOTE: ChannelMigrationCampaignIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v ChannelMigrationCampaignIdentifiers
+			golang.UnmarshalMessage(s, &v)
+			x.Ids = &v
+		case "device_ids", "deviceIds":
+			s.AddField("device_ids")
+			if s.ReadNil() {
+				x.DeviceIds = nil
+				return
+			}
+			x.DeviceIds = s.ReadStringArray()
+		case "channels":
+			s.AddField("channels")
+			if s.ReadNil() {
+				x.Channels = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Channels = append(x.Channels, nil)
+					return
+				}
+				v := &MACParameters_Channel{}
+				v.UnmarshalProtoJSON(s.WithField("channels", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Channels = append(x.Channels, v)
+			})
+		case "channel_mask", "channelMask":
+			s.AddField("channel_mask")
+			if s.ReadNil() {
+				x.ChannelMask = nil
+				return
+			}
+			x.ChannelMask = s.ReadBoolArray()
+		case "rollout_rate", "rolloutRate":
+			s.AddField("rollout_rate")
+			x.RolloutRate = s.ReadUint32()
+		case "rollback_timeout", "rollbackTimeout":
+			s.AddField("rollback_timeout")
+			if s.ReadNil() {
+				x.RollbackTimeout = nil
+				return
+			}
+			v := golang.UnmarshalDuration(s)
+			if s.Err() != nil {
+				return
+			}
+			x.RollbackTimeout = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ChannelMigrationCampaign from JSON.
+func (x *ChannelMigrationCampaign) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ChannelMigrationCampaignStatus_Device message to JSON.
+func (x *ChannelMigrationCampaignStatus_Device) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.DeviceId != "" || s.HasField("device_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("device_id")
+		s.WriteString(x.DeviceId)
+	}
+	if x.State != 0 || s.HasField("state") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("state")
+		s.WriteEnum(int32(x.State), ChannelMigrationDeviceState_name)
+	}
+	if x.AppliedAt != nil || s.HasField("applied_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("applied_at")
+		if x.AppliedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.AppliedAt)
+		}
+	}
+	if x.UpdatedAt != nil || s.HasField("updated_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("updated_at")
+		if x.UpdatedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.UpdatedAt)
+		}
+	}
+	if x.Error != nil || s.HasField("error") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("error")
+		// NOTE: ErrorDetails does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Error)
+	}
+	if len(x.PreviousChannels) > 0 || s.HasField("previous_channels") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("previous_channels")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.PreviousChannels {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("previous_channels"))
+		}
+		s.WriteArrayEnd()
+	}
+	if len(x.TargetChannels) > 0 || s.HasField("target_channels") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("target_channels")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.TargetChannels {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("target_channels"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ChannelMigrationCampaignStatus_Device to JSON.
+func (x *ChannelMigrationCampaignStatus_Device) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ChannelMigrationCampaignStatus_Device message from JSON.
+func (x *ChannelMigrationCampaignStatus_Device) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "device_id", "deviceId":
+			s.AddField("device_id")
+			x.DeviceId = s.ReadString()
+		case "state":
+			s.AddField("state")
+			x.State = ChannelMigrationDeviceState(s.ReadEnum(ChannelMigrationDeviceState_value))
+		case "applied_at", "appliedAt":
+			s.AddField("applied_at")
+			if s.ReadNil() {
+				x.AppliedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.AppliedAt = v
+		case "updated_at", "updatedAt":
+			s.AddField("updated_at")
+			if s.ReadNil() {
+				x.UpdatedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.UpdatedAt = v
+		case "error":
+			s.AddField("error")
+			if s.ReadNil() {
+				x.Error = nil
+				return
+			}
+			// NOTE: ErrorDetails does not seem to implement UnmarshalProtoJSON.
+			var v ErrorDetails
+			golang.UnmarshalMessage(s, &v)
+			x.Error = &v
+		case "previous_channels", "previousChannels":
+			s.AddField("previous_channels")
+			if s.ReadNil() {
+				x.PreviousChannels = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.PreviousChannels = append(x.PreviousChannels, nil)
+					return
+				}
+				v := &MACParameters_Channel{}
+				v.UnmarshalProtoJSON(s.WithField("previous_channels", false))
+				if s.Err() != nil {
+					return
+				}
+				x.PreviousChannels = append(x.PreviousChannels, v)
+			})
+		case "target_channels", "targetChannels":
+			s.AddField("target_channels")
+			if s.ReadNil() {
+				x.TargetChannels = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.TargetChannels = append(x.TargetChannels, nil)
+					return
+				}
+				v := &MACParameters_Channel{}
+				v.UnmarshalProtoJSON(s.WithField("target_channels", false))
+				if s.Err() != nil {
+					return
+				}
+				x.TargetChannels = append(x.TargetChannels, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ChannelMigrationCampaignStatus_Device from JSON.
+func (x *ChannelMigrationCampaignStatus_Device) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ChannelMigrationCampaignStatus message to JSON.
+func (x *ChannelMigrationCampaignStatus) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Campaign != nil || s.HasField("campaign") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("campaign")
+		x.Campaign.MarshalProtoJSON(s.WithField("campaign"))
+	}
+	if x.CreatedAt != nil || s.HasField("created_at") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("created_at")
+		if x.CreatedAt == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalTimestamp(s, x.CreatedAt)
+		}
+	}
+	if x.Canceled || s.HasField("canceled") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("canceled")
+		s.WriteBool(x.Canceled)
+	}
+	if len(x.Devices) > 0 || s.HasField("devices") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("devices")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Devices {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("devices"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ChannelMigrationCampaignStatus to JSON.
+func (x *ChannelMigrationCampaignStatus) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ChannelMigrationCampaignStatus message from JSON.
+func (x *ChannelMigrationCampaignStatus) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "campaign":
+			if s.ReadNil() {
+				x.Campaign = nil
+				return
+			}
+			x.Campaign = &ChannelMigrationCampaign{}
+			x.Campaign.UnmarshalProtoJSON(s.WithField("campaign", true))
+		case "created_at", "createdAt":
+			s.AddField("created_at")
+			if s.ReadNil() {
+				x.CreatedAt = nil
+				return
+			}
+			v := golang.UnmarshalTimestamp(s)
+			if s.Err() != nil {
+				return
+			}
+			x.CreatedAt = v
+		case "canceled":
+			s.AddField("canceled")
+			x.Canceled = s.ReadBool()
+		case "devices":
+			s.AddField("devices")
+			if s.ReadNil() {
+				x.Devices = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Devices = append(x.Devices, nil)
+					return
+				}
+				v := &ChannelMigrationCampaignStatus_Device{}
+				v.UnmarshalProtoJSON(s.WithField("devices", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Devices = append(x.Devices, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ChannelMigrationCampaignStatus from JSON.
+func (x *ChannelMigrationCampaignStatus) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ChannelMigrationCampaignStatuses message to JSON.
+func (x *ChannelMigrationCampaignStatuses) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Statuses) > 0 || s.HasField("statuses") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("statuses")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Statuses {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("statuses"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ChannelMigrationCampaignStatuses to JSON.
+func (x *ChannelMigrationCampaignStatuses) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ChannelMigrationCampaignStatuses message from JSON.
+func (x *ChannelMigrationCampaignStatuses) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "statuses":
+			s.AddField("statuses")
+			if s.ReadNil() {
+				x.Statuses = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Statuses = append(x.Statuses, nil)
+					return
+				}
+				v := &ChannelMigrationCampaignStatus{}
+				v.UnmarshalProtoJSON(s.WithField("statuses", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Statuses = append(x.Statuses, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ChannelMigrationCampaignStatuses from JSON.
+func (x *ChannelMigrationCampaignStatuses) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -30,6 +30,13 @@ var (
 	AllClusterRights      = &Rights{}
 	AllAdminRights        = &Rights{}
 	AllRights             = &Rights{}
+
+	// explicitGatewayRights are the gateway rights which are not implied by RIGHT_GATEWAY_ALL,
+	// and must be granted explicitly.
+	explicitGatewayRights = RightsFrom(
+		Right_RIGHT_GATEWAY_REMOTE_SHELL,
+	)
+	impliedGatewayRights = &Rights{}
 )
 
 func init() {
@@ -65,6 +72,7 @@ func init() {
 	AllGatewayRights = AllGatewayRights.Sorted()
 	AllOrganizationRights = AllOrganizationRights.Sorted()
 	AllRights = AllRights.Sorted()
+	impliedGatewayRights = AllGatewayRights.Sub(explicitGatewayRights).Sorted()
 }
 
 // Implied returns the Right's implied rights.
@@ -82,7 +90,7 @@ func (r Right) Implied() *Rights {
 			Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
 		)
 	case Right_RIGHT_GATEWAY_ALL:
-		return AllGatewayRights
+		return impliedGatewayRights
 	case Right_RIGHT_GATEWAY_LINK:
 		return RightsFrom(
 			Right_RIGHT_GATEWAY_INFO,
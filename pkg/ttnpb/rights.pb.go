@@ -145,6 +145,9 @@ const (
 	Right_RIGHT_GATEWAY_WRITE_SECRETS Right = 57
 	// The right to retrieve secrets associated with this gateway.
 	Right_RIGHT_GATEWAY_READ_SECRETS Right = 58
+	// The right to open remote shell sessions and run commands on gateways.
+	// This right is not implied by RIGHT_GATEWAY_ALL, and must be granted explicitly.
+	Right_RIGHT_GATEWAY_REMOTE_SHELL Right = 64
 	// The pseudo-right for all (current and future) gateway rights.
 	Right_RIGHT_GATEWAY_ALL Right = 40
 	// The right to view organization information.
@@ -231,6 +234,7 @@ var (
 		39: "RIGHT_GATEWAY_LOCATION_READ",
 		57: "RIGHT_GATEWAY_WRITE_SECRETS",
 		58: "RIGHT_GATEWAY_READ_SECRETS",
+		64: "RIGHT_GATEWAY_REMOTE_SHELL",
 		40: "RIGHT_GATEWAY_ALL",
 		41: "RIGHT_ORGANIZATION_INFO",
 		42: "RIGHT_ORGANIZATION_SETTINGS_BASIC",
@@ -297,6 +301,7 @@ var (
 		"RIGHT_GATEWAY_LOCATION_READ":              39,
 		"RIGHT_GATEWAY_WRITE_SECRETS":              57,
 		"RIGHT_GATEWAY_READ_SECRETS":               58,
+		"RIGHT_GATEWAY_REMOTE_SHELL":               64,
 		"RIGHT_GATEWAY_ALL":                        40,
 		"RIGHT_ORGANIZATION_INFO":                  41,
 		"RIGHT_ORGANIZATION_SETTINGS_BASIC":        42,
@@ -774,7 +779,7 @@ var file_lorawan_stack_api_rights_proto_rawDesc = []byte{
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
 	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61,
 	0x74, 0x6f, 0x72, 0x52, 0x0d, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x62, 0x6f, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x73, 0x2a, 0xdf, 0x10, 0x0a, 0x05, 0x52, 0x69, 0x67, 0x68, 0x74, 0x12, 0x11, 0x0a, 0x0d,
+	0x72, 0x73, 0x2a, 0xff, 0x10, 0x0a, 0x05, 0x52, 0x69, 0x67, 0x68, 0x74, 0x12, 0x11, 0x0a, 0x0d,
 	0x72, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x10, 0x00, 0x12,
 	0x13, 0x0a, 0x0f, 0x52, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x49, 0x4e,
 	0x46, 0x4f, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x52, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x55, 0x53,
@@ -872,7 +877,9 @@ var file_lorawan_stack_api_rights_proto_rawDesc = []byte{
 	0x54, 0x5f, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41, 0x59, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f,
 	0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x53, 0x10, 0x39, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x49, 0x47,
 	0x48, 0x54, 0x5f, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41, 0x59, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f,
-	0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x53, 0x10, 0x3a, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x49, 0x47,
+	0x53, 0x45, 0x43, 0x52, 0x45, 0x54, 0x53, 0x10, 0x3a, 0x12, 0x1e, 0x0a, 0x1a, 0x52, 0x49, 0x47,
+	0x48, 0x54, 0x5f, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41, 0x59, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54,
+	0x45, 0x5f, 0x53, 0x48, 0x45, 0x4c, 0x4c, 0x10, 0x40, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x49, 0x47,
 	0x48, 0x54, 0x5f, 0x47, 0x41, 0x54, 0x45, 0x57, 0x41, 0x59, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x28,
 	0x12, 0x1b, 0x0a, 0x17, 0x52, 0x49, 0x47, 0x48, 0x54, 0x5f, 0x4f, 0x52, 0x47, 0x41, 0x4e, 0x49,
 	0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x29, 0x12, 0x25, 0x0a,
@@ -74,6 +74,12 @@ func TestRights(t *testing.T) {
 		a.So(ttnpb.RightsFrom(ttnpb.Right_RIGHT_GATEWAY_ALL).Implied().GetRights(), should.Contain, ttnpb.Right_RIGHT_GATEWAY_DELETE)
 		a.So(ttnpb.RightsFrom(ttnpb.Right_RIGHT_ORGANIZATION_ALL).Implied().GetRights(), should.Contain, ttnpb.Right_RIGHT_ORGANIZATION_DELETE)
 		a.So(ttnpb.RightsFrom(ttnpb.Right_RIGHT_USER_ALL).Implied().GetRights(), should.Contain, ttnpb.Right_RIGHT_USER_DELETE)
+		// The remote shell right is not implied by all gateway rights, and must be granted explicitly.
+		a.So(ttnpb.RightsFrom(ttnpb.Right_RIGHT_GATEWAY_ALL).Implied().GetRights(), should.NotContain, ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL)
+		a.So(
+			ttnpb.RightsFrom(ttnpb.Right_RIGHT_GATEWAY_ALL, ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL).Implied().GetRights(),
+			should.Contain, ttnpb.Right_RIGHT_GATEWAY_REMOTE_SHELL,
+		)
 	})
 	t.Run("IncludesAll", func(t *testing.T) {
 		a := assertions.New(t)
@@ -192,6 +192,8 @@ var (
 	TxErrTxPower TxError = "TX_POWER"
 	// TxErrGPSUnlocked is returned if packet rejected because GPS is unlocked, so GPS timestamp cannot be used
 	TxErrGPSUnlocked TxError = "GPS_UNLOCKED"
+	// TxErrChannelBusy is returned if packet rejected because listen-before-talk detected activity on the channel
+	TxErrChannelBusy TxError = "CHANNEL_BUSY"
 )
 
 // TxPacketAck contains a Tx acknowledgment packet
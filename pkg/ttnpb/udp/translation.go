@@ -103,6 +103,7 @@ var (
 		TxErrTxFreq:          ttnpb.TxAcknowledgment_TX_FREQ,
 		TxErrTxPower:         ttnpb.TxAcknowledgment_TX_POWER,
 		TxErrGPSUnlocked:     ttnpb.TxAcknowledgment_GPS_UNLOCKED,
+		TxErrChannelBusy:     ttnpb.TxAcknowledgment_CHANNEL_BUSY,
 	}
 	semtechAckError = map[ttnpb.TxAcknowledgment_Result]TxError{
 		ttnpb.TxAcknowledgment_SUCCESS:          TxErrNone,
@@ -113,6 +114,7 @@ var (
 		ttnpb.TxAcknowledgment_TX_FREQ:          TxErrTxFreq,
 		ttnpb.TxAcknowledgment_TX_POWER:         TxErrTxPower,
 		ttnpb.TxAcknowledgment_GPS_UNLOCKED:     TxErrGPSUnlocked,
+		ttnpb.TxAcknowledgment_CHANNEL_BUSY:     TxErrChannelBusy,
 	}
 )
 
@@ -171,3 +171,6 @@ func Unmarshal8Bytes(s *jsonplugin.UnmarshalState) []byte { return unmarshalNByt
 
 // Unmarshal16Bytes unmarshals 16 bytes from JSON. It accepts both hex and base64 encoding.
 func Unmarshal16Bytes(s *jsonplugin.UnmarshalState) []byte { return unmarshalNBytes(s, 16) }
+
+// Unmarshal32Bytes unmarshals 32 bytes from JSON. It accepts both hex and base64 encoding.
+func Unmarshal32Bytes(s *jsonplugin.UnmarshalState) []byte { return unmarshalNBytes(s, 32) }
@@ -219,6 +219,17 @@ class Gateways {
     return Marshaler.payloadSingleResponse(response)
   }
 
+  async getStatisticsHistoryById(id, from, to, step) {
+    const response = await this._api.Gs.GetGatewayConnectionStatsHistory(
+      {
+        routeParams: { 'gateway_ids.gateway_id': id },
+      },
+      { from, to, step },
+    )
+
+    return Marshaler.payloadSingleResponse(response)
+  }
+
   async getRightsById(gatewayId) {
     const result = await this._api.GatewayAccess.ListRights({
       routeParams: { gateway_id: gatewayId },